@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// apiKeyCmd represents the api-key command
+// see api_key_*.go for sub_commands
+var apiKeyCmd = &cobra.Command{
+	Use:   "api-key <subcommand>",
+	Short: "API key related subcommands",
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cmd.Help(); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(apiKeyCmd)
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	expiresInFlag string = "expires-in"
+)
+
+// apiKeyCreateCmd represents the create command
+var apiKeyCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Creates a new API key",
+	Long: `Creates a new API key authenticating as the given user,
+e.g. for service-to-service communication.
+
+The key is only printed once and cannot be retrieved
+afterwards. Its scopes are restricted to the scopes of
+its owner, defaulting to all of them.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		userID, err := cmd.Flags().GetString(userIDFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		name, err := cmd.Flags().GetString(nameFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		scopes, err := cmd.Flags().GetStringSlice(scopeFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		expiresIn, err := cmd.Flags().GetDuration(expiresInFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		apiKey := &models.APIKey{
+			UserID: userID,
+			Name:   name,
+			Scopes: scopes,
+		}
+
+		if expiresIn > 0 {
+			apiKey.ExpiresAt = null.TimeFrom(time.Now().Add(expiresIn))
+		}
+
+		runAPIKeyCreate(apiKey)
+	},
+}
+
+func init() {
+	apiKeyCmd.AddCommand(apiKeyCreateCmd)
+	apiKeyCreateCmd.Flags().String(userIDFlag, "", "ID of user the API key authenticates as.")
+	apiKeyCreateCmd.Flags().String(nameFlag, "", "Name of API key, used to identify it when listing keys.")
+	apiKeyCreateCmd.Flags().StringSlice(scopeFlag, []string{}, "Scope granted to the API key, may be repeated. Defaults to all scopes of the user.")
+	apiKeyCreateCmd.Flags().Duration(expiresInFlag, 0, "Duration after which the API key expires. Keys without expiry stay valid until revoked.")
+	if err := apiKeyCreateCmd.MarkFlagRequired(userIDFlag); err != nil {
+		log.Fatal().Err(err).Msg("Failed to mark flag as required")
+	}
+	if err := apiKeyCreateCmd.MarkFlagRequired(nameFlag); err != nil {
+		log.Fatal().Err(err).Msg("Failed to mark flag as required")
+	}
+}
+
+func runAPIKeyCreate(apiKey *models.APIKey) {
+	ctx := context.Background()
+
+	config := config.DefaultServiceConfigFromEnv()
+	db, err := sql.Open("postgres", config.Database.ConnectionString())
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to the database")
+	}
+	defer db.Close()
+
+	user, err := models.FindUser(ctx, db, apiKey.UserID)
+	if err != nil {
+		log.Fatal().Err(err).Str("user_id", apiKey.UserID).Msg("Failed to load user")
+	}
+
+	if len(apiKey.Scopes) == 0 {
+		apiKey.Scopes = user.Scopes
+	} else if !util.ContainsAllString(user.Scopes, apiKey.Scopes...) {
+		log.Fatal().Strs("scopes", apiKey.Scopes).Strs("user_scopes", user.Scopes).Msg("API key scopes must be granted to the user")
+	}
+
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to generate API key")
+	}
+
+	apiKey.Prefix = prefix
+	apiKey.KeyHash = hash
+
+	if err := apiKey.Insert(ctx, db, boil.Infer()); err != nil {
+		log.Fatal().Err(err).Msg("Failed to insert API key")
+	}
+
+	fmt.Printf("API key ID: %s\n", apiKey.ID)
+	fmt.Printf("API key: %s\n", key)
+}
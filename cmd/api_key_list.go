@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// apiKeyListCmd represents the list command
+var apiKeyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the API keys of a user",
+	Run: func(cmd *cobra.Command, args []string) {
+		userID, err := cmd.Flags().GetString(userIDFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		runAPIKeyList(userID)
+	},
+}
+
+func init() {
+	apiKeyCmd.AddCommand(apiKeyListCmd)
+	apiKeyListCmd.Flags().String(userIDFlag, "", "ID of user to list API keys for.")
+	if err := apiKeyListCmd.MarkFlagRequired(userIDFlag); err != nil {
+		log.Fatal().Err(err).Msg("Failed to mark flag as required")
+	}
+}
+
+func runAPIKeyList(userID string) {
+	ctx := context.Background()
+
+	config := config.DefaultServiceConfigFromEnv()
+	db, err := sql.Open("postgres", config.Database.ConnectionString())
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to the database")
+	}
+	defer db.Close()
+
+	apiKeys, err := models.APIKeys(
+		models.APIKeyWhere.UserID.EQ(userID),
+		qm.OrderBy(models.APIKeyColumns.CreatedAt+" DESC"),
+	).All(ctx, db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to load API keys")
+	}
+
+	for _, apiKey := range apiKeys {
+		expiresAt := "never"
+		if apiKey.ExpiresAt.Valid {
+			expiresAt = apiKey.ExpiresAt.Time.Format(time.RFC3339)
+		}
+
+		lastUsedAt := "never"
+		if apiKey.LastUsedAt.Valid {
+			lastUsedAt = apiKey.LastUsedAt.Time.Format(time.RFC3339)
+		}
+
+		fmt.Printf("%s\t%s\t%s\t%s\texpires: %s\tlast used: %s\n", apiKey.ID, apiKey.Prefix, apiKey.Name, strings.Join(apiKey.Scopes, ","), expiresAt, lastUsedAt)
+	}
+}
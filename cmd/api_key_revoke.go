@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// apiKeyRevokeCmd represents the revoke command
+var apiKeyRevokeCmd = &cobra.Command{
+	Use:   "revoke <id>",
+	Short: "Revokes an API key",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runAPIKeyRevoke(args[0])
+	},
+}
+
+func init() {
+	apiKeyCmd.AddCommand(apiKeyRevokeCmd)
+}
+
+func runAPIKeyRevoke(id string) {
+	ctx := context.Background()
+
+	config := config.DefaultServiceConfigFromEnv()
+	db, err := sql.Open("postgres", config.Database.ConnectionString())
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to the database")
+	}
+	defer db.Close()
+
+	rowsAff, err := models.APIKeys(models.APIKeyWhere.ID.EQ(id)).DeleteAll(ctx, db)
+	if err != nil {
+		log.Fatal().Err(err).Str("api_key_id", id).Msg("Failed to delete API key")
+	}
+
+	if rowsAff == 0 {
+		log.Fatal().Str("api_key_id", id).Msg("API key not found")
+	}
+
+	fmt.Printf("Revoked API key %s\n", id)
+}
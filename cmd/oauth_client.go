@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// oauthClientCmd represents the oauth-client command
+// see oauth_client_*.go for sub_commands
+var oauthClientCmd = &cobra.Command{
+	Use:   "oauth-client <subcommand>",
+	Short: "OAuth client related subcommands",
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cmd.Help(); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(oauthClientCmd)
+}
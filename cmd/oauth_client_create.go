@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/hashing"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	nameFlag        string = "name"
+	redirectURIFlag string = "redirect-uri"
+	grantTypeFlag   string = "grant-type"
+	scopeFlag       string = "scope"
+	publicFlag      string = "public"
+	userIDFlag      string = "user-id"
+
+	// oauthClientSecretLength is the number of random bytes client secrets are generated from
+	oauthClientSecretLength = 32
+)
+
+// oauthClientCreateCmd represents the create command
+var oauthClientCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Registers a new OAuth client",
+	Long: `Registers a new OAuth client allowed to obtain tokens
+from our OAuth2 authorization server.
+
+Confidential clients receive a client secret, which is
+only printed once and cannot be retrieved afterwards.
+Public clients (e.g. native apps) have no secret and must
+use PKCE. Clients using the client_credentials grant
+require a service user (--user-id) to act on behalf of.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		name, err := cmd.Flags().GetString(nameFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		redirectURIs, err := cmd.Flags().GetStringSlice(redirectURIFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		grantTypes, err := cmd.Flags().GetStringSlice(grantTypeFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		scopes, err := cmd.Flags().GetStringSlice(scopeFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		public, err := cmd.Flags().GetBool(publicFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		userID, err := cmd.Flags().GetString(userIDFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		client := &models.OauthClient{
+			Name:         name,
+			RedirectUris: redirectURIs,
+			GrantTypes:   grantTypes,
+			Scopes:       scopes,
+			UserID:       null.NewString(userID, len(userID) > 0),
+		}
+
+		runOAuthClientCreate(client, public)
+	},
+}
+
+func init() {
+	oauthClientCmd.AddCommand(oauthClientCreateCmd)
+	oauthClientCreateCmd.Flags().String(nameFlag, "", "Name of client, listed in the sessions of users who authorized it.")
+	oauthClientCreateCmd.Flags().StringSlice(redirectURIFlag, []string{}, "Redirect URI registered for the authorization code grant, may be repeated.")
+	oauthClientCreateCmd.Flags().StringSlice(grantTypeFlag, []string{auth.OAuthGrantTypeAuthorizationCode.String(), auth.OAuthGrantTypeRefreshToken.String()}, "Grant type the client may use, may be repeated.")
+	oauthClientCreateCmd.Flags().StringSlice(scopeFlag, []string{auth.AuthScopeApp.String()}, "Scope the client may request, may be repeated.")
+	oauthClientCreateCmd.Flags().Bool(publicFlag, false, "Registers a public client without a client secret.")
+	oauthClientCreateCmd.Flags().String(userIDFlag, "", "ID of service user the client acts on behalf of using the client_credentials grant.")
+	if err := oauthClientCreateCmd.MarkFlagRequired(nameFlag); err != nil {
+		log.Fatal().Err(err).Msg("Failed to mark flag as required")
+	}
+}
+
+func runOAuthClientCreate(client *models.OauthClient, public bool) {
+	for _, g := range client.GrantTypes {
+		supported := false
+		for _, s := range auth.OAuthGrantTypes() {
+			if g == s.String() {
+				supported = true
+				break
+			}
+		}
+
+		if !supported {
+			log.Fatal().Str("grant_type", g).Msg("Unsupported grant type")
+		}
+
+		if g == auth.OAuthGrantTypeAuthorizationCode.String() && len(client.RedirectUris) == 0 {
+			log.Fatal().Msg("Authorization code grant requires at least one redirect URI")
+		}
+
+		if g == auth.OAuthGrantTypeClientCredentials.String() && (public || !client.UserID.Valid) {
+			log.Fatal().Msg("Client credentials grant requires a confidential client with a service user")
+		}
+	}
+
+	var secret string
+	if !public {
+		var err error
+		secret, err = util.GenerateRandomHexString(oauthClientSecretLength)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to generate client secret")
+		}
+
+		hash, err := hashing.HashPassword(secret, hashing.DefaultArgon2Params)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to hash client secret")
+		}
+
+		client.SecretHash = null.StringFrom(hash)
+	}
+
+	config := config.DefaultServiceConfigFromEnv()
+	db, err := sql.Open("postgres", config.Database.ConnectionString())
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to the database")
+	}
+	defer db.Close()
+
+	if err := client.Insert(context.Background(), db, boil.Infer()); err != nil {
+		log.Fatal().Err(err).Msg("Failed to insert OAuth client")
+	}
+
+	fmt.Printf("Client ID: %s\n", client.ID)
+	if !public {
+		fmt.Printf("Client secret: %s\n", secret)
+	}
+}
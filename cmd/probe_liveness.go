@@ -7,6 +7,7 @@ import (
 
 	"allaboutapps.dev/aw/go-starter/internal/api/handlers/common"
 	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/storage"
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 )
@@ -24,7 +25,7 @@ non zero exitcode on encountered errors.
 
 A typical usecase of this command are liveness probes 
 to take action if dependant services (e.g. DB, NFS 
-mounts) become unstable. You may also use this to 
+mounts, blobstore) become unstable. You may also use this to 
 ensure all requirements are fulfilled before starting
 the app server.`,
 	Run: func(cmd *cobra.Command, args []string) {
@@ -51,10 +52,15 @@ func runLiveness(verbose bool) {
 	}
 	defer db.Close()
 
+	blobstore, err := storage.New(config.Storage)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize blobstore")
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), config.Management.LivenessTimeout)
 	defer cancel()
 
-	str, errs := common.ProbeLiveness(ctx, db, config.Management.ProbeWriteablePathsAbs, config.Management.ProbeWriteableTouchfile)
+	str, errs := common.ProbeLiveness(ctx, db, blobstore, config.Management.ProbeWriteablePathsAbs, config.Management.ProbeWriteableTouchfile)
 
 	if verbose {
 		fmt.Print(str)
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// pushCmd represents the push command
+// see push_*.go for sub_commands
+var pushCmd = &cobra.Command{
+	Use:   "push <subcommand>",
+	Short: "Push notification related subcommands",
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cmd.Help(); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(pushCmd)
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	segmentFlag  string = "segment"
+	languageFlag string = "language"
+	sqlFlag      string = "sql"
+	titleFlag    string = "title"
+	bodyFlag     string = "body"
+	titleKeyFlag string = "title-key"
+	bodyKeyFlag  string = "body-key"
+	linkFlag     string = "link"
+	imageURLFlag string = "image-url"
+	dataFlag     string = "data"
+)
+
+// pushBroadcastCmd represents the broadcast command
+var pushBroadcastCmd = &cobra.Command{
+	Use:   "broadcast",
+	Short: "Sends a push message to a segment of users",
+	Long: `Sends a push message to all devices of the users in the
+given segment: all users with the app scope, users with the
+app scope in the given language or users selected by the
+given SQL query (a single statement, executed in a read only
+transaction limited by SERVER_PUSH_SEGMENT_QUERY_TIMEOUT).
+
+The message is enqueued for delivery by the push workers of
+the server, i18n keys are resolved in the language of each
+user. Delivery statistics of the campaign are available via
+GET /api/v1/admin/push/campaigns/:id.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		flags := cmd.Flags()
+
+		segmentType, err := flags.GetString(segmentFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		lang, err := flags.GetString(languageFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		query, err := flags.GetString(sqlFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		// the notification is omitted for data messages
+		notification := &push.Notification{}
+		hasNotification := false
+		for flag, value := range map[string]*string{
+			titleFlag:    &notification.Title,
+			bodyFlag:     &notification.Body,
+			titleKeyFlag: &notification.TitleKey,
+			bodyKeyFlag:  &notification.BodyKey,
+			linkFlag:     &notification.Link,
+			imageURLFlag: &notification.ImageURL,
+		} {
+			if *value, err = flags.GetString(flag); err != nil {
+				log.Fatal().Err(err).Msg("Failed to parse args")
+			}
+			hasNotification = hasNotification || len(*value) > 0
+		}
+
+		data, err := flags.GetStringToString(dataFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		msg := push.Message{Data: data}
+		if hasNotification {
+			msg.Notification = notification
+		}
+
+		segment := push.Segment{
+			Type:     push.SegmentType(segmentType),
+			Language: lang,
+			SQL:      query,
+		}
+
+		runPushBroadcast(segment, msg)
+	},
+}
+
+func init() {
+	pushCmd.AddCommand(pushBroadcastCmd)
+	pushBroadcastCmd.Flags().String(segmentFlag, string(push.SegmentTypeAll), "Segment of users to send to: all, language or sql.")
+	pushBroadcastCmd.Flags().String(languageFlag, "", "Language of the users of the language segment, e.g. de.")
+	pushBroadcastCmd.Flags().String(sqlFlag, "", "Query selecting the IDs of the users of the sql segment.")
+	pushBroadcastCmd.Flags().String(titleFlag, "", "Title of the notification.")
+	pushBroadcastCmd.Flags().String(bodyFlag, "", "Body of the notification.")
+	pushBroadcastCmd.Flags().String(titleKeyFlag, "", "i18n key of the title, takes precedence over --title.")
+	pushBroadcastCmd.Flags().String(bodyKeyFlag, "", "i18n key of the body, takes precedence over --body.")
+	pushBroadcastCmd.Flags().String(linkFlag, "", "Deep link opened when tapping the notification.")
+	pushBroadcastCmd.Flags().String(imageURLFlag, "", "URL of an image displayed within the notification.")
+	pushBroadcastCmd.Flags().StringToString(dataFlag, nil, "Custom data delivered to the app, e.g. --data id=42,type=news.")
+}
+
+func runPushBroadcast(segment push.Segment, msg push.Message) {
+	ctx := context.Background()
+
+	config := config.DefaultServiceConfigFromEnv()
+	s := api.NewServer(config)
+
+	if err := s.InitDB(ctx); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize database")
+	}
+	defer s.DB.Close()
+
+	if err := s.InitI18n(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize i18n service")
+	}
+
+	if err := s.InitPush(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize push service")
+	}
+
+	campaign, err := s.Push.SendToSegment(ctx, segment, msg)
+	if err != nil {
+		if campaign != nil {
+			log.Fatal().Err(err).Str("push_campaign_id", campaign.ID).Int("tokens_targeted", campaign.TokensTargeted).Msg("Failed to enqueue push campaign")
+		}
+		log.Fatal().Err(err).Msg("Failed to send push campaign")
+	}
+
+	fmt.Printf("Enqueued push campaign %s for %d users (%d push tokens)\n", campaign.ID, campaign.UsersTargeted, campaign.TokensTargeted)
+}
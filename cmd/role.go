@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// roleCmd represents the role command
+// see role_*.go for sub_commands
+var roleCmd = &cobra.Command{
+	Use:   "role <subcommand>",
+	Short: "Role related subcommands",
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cmd.Help(); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(roleCmd)
+}
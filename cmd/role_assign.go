@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	roleFlag string = "role"
+)
+
+// roleAssignCmd represents the assign command
+var roleAssignCmd = &cobra.Command{
+	Use:   "assign",
+	Short: "Assigns a role to a user",
+	Long: `Assigns a role to a user, granting all permissions
+bundled by the role. Default roles are granted to all
+users and do not need to be assigned.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		userID, err := cmd.Flags().GetString(userIDFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		roleName, err := cmd.Flags().GetString(roleFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		runRoleAssign(userID, roleName)
+	},
+}
+
+func init() {
+	roleCmd.AddCommand(roleAssignCmd)
+	roleAssignCmd.Flags().String(userIDFlag, "", "ID of user to assign role to.")
+	roleAssignCmd.Flags().String(roleFlag, "", "Name of role to assign, e.g. admin.")
+	if err := roleAssignCmd.MarkFlagRequired(userIDFlag); err != nil {
+		log.Fatal().Err(err).Msg("Failed to mark flag as required")
+	}
+	if err := roleAssignCmd.MarkFlagRequired(roleFlag); err != nil {
+		log.Fatal().Err(err).Msg("Failed to mark flag as required")
+	}
+}
+
+func runRoleAssign(userID string, roleName string) {
+	ctx := context.Background()
+
+	config := config.DefaultServiceConfigFromEnv()
+	db, err := sql.Open("postgres", config.Database.ConnectionString())
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to the database")
+	}
+	defer db.Close()
+
+	role, err := models.Roles(models.RoleWhere.Name.EQ(roleName)).One(ctx, db)
+	if err != nil {
+		log.Fatal().Err(err).Str("role", roleName).Msg("Failed to load role")
+	}
+
+	userRole := models.UserRole{
+		UserID: userID,
+		RoleID: role.ID,
+	}
+
+	if err := userRole.Upsert(ctx, db, false, []string{models.UserRoleColumns.UserID, models.UserRoleColumns.RoleID}, boil.None(), boil.Infer()); err != nil {
+		log.Fatal().Err(err).Str("user_id", userID).Str("role", roleName).Msg("Failed to assign role to user")
+	}
+
+	fmt.Printf("Assigned role %s to user %s\n", role.Name, userID)
+}
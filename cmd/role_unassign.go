@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+// roleUnassignCmd represents the unassign command
+var roleUnassignCmd = &cobra.Command{
+	Use:   "unassign",
+	Short: "Removes a role from a user",
+	Run: func(cmd *cobra.Command, args []string) {
+		userID, err := cmd.Flags().GetString(userIDFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		roleName, err := cmd.Flags().GetString(roleFlag)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Failed to parse args")
+		}
+
+		runRoleUnassign(userID, roleName)
+	},
+}
+
+func init() {
+	roleCmd.AddCommand(roleUnassignCmd)
+	roleUnassignCmd.Flags().String(userIDFlag, "", "ID of user to remove role from.")
+	roleUnassignCmd.Flags().String(roleFlag, "", "Name of role to remove, e.g. admin.")
+	if err := roleUnassignCmd.MarkFlagRequired(userIDFlag); err != nil {
+		log.Fatal().Err(err).Msg("Failed to mark flag as required")
+	}
+	if err := roleUnassignCmd.MarkFlagRequired(roleFlag); err != nil {
+		log.Fatal().Err(err).Msg("Failed to mark flag as required")
+	}
+}
+
+func runRoleUnassign(userID string, roleName string) {
+	ctx := context.Background()
+
+	config := config.DefaultServiceConfigFromEnv()
+	db, err := sql.Open("postgres", config.Database.ConnectionString())
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to connect to the database")
+	}
+	defer db.Close()
+
+	role, err := models.Roles(models.RoleWhere.Name.EQ(roleName)).One(ctx, db)
+	if err != nil {
+		log.Fatal().Err(err).Str("role", roleName).Msg("Failed to load role")
+	}
+
+	rowsAff, err := models.UserRoles(
+		models.UserRoleWhere.UserID.EQ(userID),
+		models.UserRoleWhere.RoleID.EQ(role.ID),
+	).DeleteAll(ctx, db)
+	if err != nil {
+		log.Fatal().Err(err).Str("user_id", userID).Str("role", roleName).Msg("Failed to remove role from user")
+	}
+
+	if rowsAff == 0 {
+		log.Fatal().Str("user_id", userID).Str("role", roleName).Msg("Role is not assigned to user")
+	}
+
+	fmt.Printf("Removed role %s from user %s\n", role.Name, userID)
+}
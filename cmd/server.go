@@ -99,16 +99,43 @@ func runServer() {
 		log.Fatal().Err(err).Msg("Failed to initialize mailer")
 	}
 
+	if err := s.InitI18n(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize i18n service")
+	}
+
 	if err := s.InitPush(); err != nil {
 		log.Fatal().Err(err).Msg("Failed to initialize push service")
 	}
 
-	if err := s.InitI18n(); err != nil {
-		log.Fatal().Err(err).Msg("Failed to initialize i18n service")
+	if err := s.InitJWT(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize JWT service")
+	}
+
+	if err := s.InitLockout(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize auth lockout service")
+	}
+
+	if err := s.InitOIDC(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize OIDC service")
+	}
+
+	if err := s.InitRateLimiter(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize rate limiter")
+	}
+
+	if err := s.InitBlobstore(); err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize blobstore")
 	}
 
 	router.Init(s)
 
+	backgroundCtx, cancelBackground := context.WithCancel(context.Background())
+	go s.PurgeAuthRecords(backgroundCtx)
+	go s.PurgeRateLimitCounters(backgroundCtx)
+	go s.PurgeStaleUploads(backgroundCtx)
+	go s.PurgePushMessages(backgroundCtx)
+	go s.RunPushWorkers(backgroundCtx)
+
 	go func() {
 		if err := s.Start(); err != nil {
 			if errors.Is(err, http.ErrServerClosed) {
@@ -123,6 +150,8 @@ func runServer() {
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 	<-quit
 
+	cancelBackground()
+
 	ctx, cancel = context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// DeleteUser deletes the given user, cascading to all rows belonging to them (profile, tokens, push tokens, ...).
+// If a grace period is set, the user is soft deleted instead: they are deactivated, signed out on all devices and
+// only purged by PurgeDeletedUsers once the grace period has passed.
+func DeleteUser(ctx context.Context, exec boil.ContextExecutor, user *models.User, gracePeriod time.Duration) error {
+	if gracePeriod <= 0 {
+		if _, err := user.Delete(ctx, exec); err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+
+		return nil
+	}
+
+	user.IsActive = false
+	user.DeletedAt = null.TimeFrom(time.Now())
+
+	if _, err := user.Update(ctx, exec, boil.Whitelist(models.UserColumns.IsActive, models.UserColumns.DeletedAt, models.UserColumns.UpdatedAt)); err != nil {
+		return fmt.Errorf("failed to soft delete user: %w", err)
+	}
+
+	return RevokeUserTokens(ctx, exec, user.ID)
+}
+
+// PurgeDeletedUsers deletes all soft deleted users whose grace period has passed, returning the number of users purged.
+func PurgeDeletedUsers(ctx context.Context, exec boil.ContextExecutor, gracePeriod time.Duration) (int64, error) {
+	purged, err := models.Users(models.UserWhere.DeletedAt.LT(null.TimeFrom(time.Now().Add(-gracePeriod)))).DeleteAll(ctx, exec)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge deleted users: %w", err)
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+
+	"allaboutapps.dev/aw/go-starter/internal/util"
+)
+
+const (
+	// APIKeyScheme is the authorization scheme API keys are provided with, e.g. `Authorization: ApiKey ak_...`
+	APIKeyScheme = "ApiKey"
+
+	// apiKeyMarker is prepended to all API keys, making them easily recognizable (e.g. by secret scanners)
+	apiKeyMarker = "ak"
+	// apiKeyPrefixLength and apiKeySecretLength are the number of random bytes the public prefix
+	// identifying a key and its secret part are generated from
+	apiKeyPrefixLength = 8
+	apiKeySecretLength = 32
+)
+
+// GenerateAPIKey returns a new random API key formatted like `ak_<prefix>_<secret>` as well as its public prefix used
+// to look up the key and the hash to store. The plain key is only known to the caller and cannot be retrieved afterwards.
+func GenerateAPIKey() (key string, prefix string, hash string, err error) {
+	prefix, err = util.GenerateRandomHexString(apiKeyPrefixLength)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	secret, err := util.GenerateRandomHexString(apiKeySecretLength)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	key = strings.Join([]string{apiKeyMarker, prefix, secret}, "_")
+
+	return key, prefix, HashAPIKey(key), nil
+}
+
+// ParseAPIKeyPrefix returns the public prefix of the given API key, reporting whether the key is well formed.
+func ParseAPIKeyPrefix(key string) (string, bool) {
+	parts := strings.Split(key, "_")
+	if len(parts) != 3 || parts[0] != apiKeyMarker ||
+		len(parts[1]) != hex.EncodedLen(apiKeyPrefixLength) ||
+		len(parts[2]) != hex.EncodedLen(apiKeySecretLength) {
+		return "", false
+	}
+
+	return parts[1], true
+}
+
+// HashAPIKey returns the hash of the given API key to be stored. As API keys are long random strings,
+// a fast hash suffices and allows keys to be verified on every request.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// CompareAPIKeyAndHash reports whether the given API key matches the stored hash in constant time.
+func CompareAPIKeyAndHash(key string, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashAPIKey(key)), []byte(hash)) == 1
+}
@@ -0,0 +1,45 @@
+package auth_test
+
+import (
+	"strings"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAPIKey(t *testing.T) {
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(key, "ak_"+prefix+"_"))
+	assert.Len(t, prefix, 16)
+	assert.NotContains(t, hash, key)
+
+	parsedPrefix, ok := auth.ParseAPIKeyPrefix(key)
+	require.True(t, ok)
+	assert.Equal(t, prefix, parsedPrefix)
+
+	assert.True(t, auth.CompareAPIKeyAndHash(key, hash))
+	assert.False(t, auth.CompareAPIKeyAndHash(key+"0", hash))
+
+	otherKey, otherPrefix, _, err := auth.GenerateAPIKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, key, otherKey)
+	assert.NotEqual(t, prefix, otherPrefix)
+}
+
+func TestParseAPIKeyPrefixMalformed(t *testing.T) {
+	for _, key := range []string{
+		"",
+		"c1247d8d-0d65-41c4-bc86-ec041d2ac437",
+		"ak_0123456789abcdef",
+		"xx_0123456789abcdef_0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		"ak_0123456789abcde_0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		"ak_0123456789abcdef_0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef_",
+	} {
+		_, ok := auth.ParseAPIKeyPrefix(key)
+		assert.False(t, ok, key)
+	}
+}
@@ -11,4 +11,10 @@ type AuthenticationResult struct {
 	User       *models.User
 	ValidUntil time.Time
 	Scopes     []string
+	SessionID  string
+	// Restricted marks credentials restricted to a subset of the user's scopes, e.g. API keys or access tokens issued to OAuth clients.
+	// Restricted credentials are only granted the permissions of default roles, not the ones of roles assigned to the user.
+	Restricted bool
+	// Permissions holds the effective permissions of the user. If nil, they are resolved on first access, see PermissionsFromContext.
+	Permissions []string
 }
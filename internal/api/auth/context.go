@@ -20,6 +20,16 @@ func EnrichContextWithCredentials(ctx context.Context, result AuthenticationResu
 	c = context.WithValue(c, util.CTXKeyUser, result.User)
 	// Store access token used for authentication in context
 	c = context.WithValue(c, util.CTXKeyAccessToken, result.Token)
+	// Store session the access token was issued for in context
+	c = context.WithValue(c, util.CTXKeySessionID, result.SessionID)
+	// Store scopes the credentials used for authentication are restricted to (if any) in context
+	if result.Scopes != nil {
+		c = context.WithValue(c, util.CTXKeyScopes, result.Scopes)
+	}
+	// Store whether the credentials used for authentication are restricted (e.g. API keys) in context
+	c = context.WithValue(c, util.CTXKeyRestricted, result.Restricted)
+	// Store cache for the user's effective permissions, which are only resolved once required
+	c = context.WithValue(c, util.CTXKeyPermissions, &permissionsCache{permissions: result.Permissions, restricted: result.Restricted})
 
 	return c
 }
@@ -80,3 +90,52 @@ func AccessTokenFromContext(ctx context.Context) *string {
 func AccessTokenFromEchoContext(c echo.Context) *string {
 	return AccessTokenFromContext(c.Request().Context())
 }
+
+// SessionIDFromContext returns the ID of the session the access token used for authentication was issued for from a context.
+// If no authentication was provided or the access token was not issued as part of a session, an empty string will be returned instead.
+func SessionIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(util.CTXKeySessionID).(string)
+	return id
+}
+
+// SessionIDFromEchoContext returns the ID of the session the access token used for authentication was issued for from an echo context.
+// If no authentication was provided or the access token was not issued as part of a session, an empty string will be returned instead.
+func SessionIDFromEchoContext(c echo.Context) string {
+	return SessionIDFromContext(c.Request().Context())
+}
+
+// ScopesFromContext returns the scopes granted to the credentials used for authentication from a context. Credentials
+// not restricted to a subset of scopes (e.g. access tokens issued on login) grant all of the authenticated user's scopes.
+// If no authentication was provided, nil will be returned instead.
+func ScopesFromContext(ctx context.Context) []string {
+	if scopes, ok := ctx.Value(util.CTXKeyScopes).([]string); ok {
+		return scopes
+	}
+
+	user := UserFromContext(ctx)
+	if user == nil {
+		return nil
+	}
+
+	return user.Scopes
+}
+
+// ScopesFromEchoContext returns the scopes granted to the credentials used for authentication from an echo context. Credentials
+// not restricted to a subset of scopes (e.g. access tokens issued on login) grant all of the authenticated user's scopes.
+// If no authentication was provided, nil will be returned instead.
+func ScopesFromEchoContext(c echo.Context) []string {
+	return ScopesFromContext(c.Request().Context())
+}
+
+// RestrictedFromContext reports whether the credentials used for authentication are restricted (e.g. API keys or access
+// tokens issued to OAuth clients, see AuthenticationResult) from a context. If no authentication was provided, false will be returned.
+func RestrictedFromContext(ctx context.Context) bool {
+	restricted, _ := ctx.Value(util.CTXKeyRestricted).(bool)
+	return restricted
+}
+
+// RestrictedFromEchoContext reports whether the credentials used for authentication are restricted (e.g. API keys or access
+// tokens issued to OAuth clients, see AuthenticationResult) from an echo context. If no authentication was provided, false will be returned.
+func RestrictedFromEchoContext(c echo.Context) bool {
+	return RestrictedFromContext(c.Request().Context())
+}
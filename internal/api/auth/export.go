@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/strmangle"
+)
+
+// ExportRow represents a single database row of a data export, keyed by column name.
+type ExportRow map[string]interface{}
+
+// UserExport contains every row belonging to a user, as required for data exports (GDPR Art. 20).
+type UserExport struct {
+	User   ExportRow
+	Tables map[string][]ExportRow
+}
+
+// exportRedactedColumns holds columns containing credentials (or hashes thereof), which are never exported.
+var exportRedactedColumns = []string{"password", "token", "secret", "secret_hash", "key_hash", "code", "code_hash", "code_challenge", "search_vector"}
+
+// ExportUserData collects every row belonging to the given user, keyed by table name.
+// Tables are discovered from the user's sqlboiler relationships (models.UserRels), so rows of newly
+// added tables referencing users are included automatically once models have been regenerated.
+func ExportUserData(ctx context.Context, exec boil.ContextExecutor, userID string) (*UserExport, error) {
+	rels := reflect.ValueOf(models.UserRels)
+
+	mods := []qm.QueryMod{models.UserWhere.ID.EQ(userID)}
+	for i := 0; i < rels.NumField(); i++ {
+		mods = append(mods, qm.Load(rels.Field(i).String()))
+	}
+
+	user, err := models.Users(mods...).One(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user with relationships: %w", err)
+	}
+
+	userRow, err := toExportRow(user)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &UserExport{
+		User:   userRow,
+		Tables: make(map[string][]ExportRow, rels.NumField()),
+	}
+
+	loaded := reflect.ValueOf(user.R).Elem()
+	for i := 0; i < rels.NumField(); i++ {
+		rel := rels.Field(i).String()
+		value := loaded.FieldByName(rel)
+
+		rows := []ExportRow{}
+		switch value.Kind() {
+		case reflect.Ptr:
+			// to-one relationship, e.g. AppUserProfile
+			if !value.IsNil() {
+				row, err := toExportRow(value.Interface())
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, row)
+			}
+		case reflect.Slice:
+			// to-many relationship, e.g. PushTokens
+			for j := 0; j < value.Len(); j++ {
+				row, err := toExportRow(value.Index(j).Interface())
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, row)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported relationship %q of kind %s", rel, value.Kind())
+		}
+
+		export.Tables[relToTableName(rel)] = rows
+	}
+
+	return export, nil
+}
+
+// toExportRow converts a model to an ExportRow using its column names (json tags), dropping redacted columns.
+func toExportRow(model interface{}) (ExportRow, error) {
+	raw, err := json.Marshal(model)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal model: %w", err)
+	}
+
+	var row ExportRow
+	if err := json.Unmarshal(raw, &row); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal model: %w", err)
+	}
+
+	for column := range row {
+		if util.ContainsString(exportRedactedColumns, column) {
+			delete(row, column)
+		}
+	}
+
+	return row, nil
+}
+
+// relToTableName converts a sqlboiler relationship name to its table name, e.g. APIKeys to api_keys
+// or AppUserProfile to app_user_profiles.
+func relToTableName(rel string) string {
+	runes := []rune(rel)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// start a new word at the beginning of a lowercase word or after an acronym
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+
+		b.WriteRune(r)
+	}
+
+	return strmangle.Plural(b.String())
+}
@@ -0,0 +1,197 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/volatiletech/null/v8"
+)
+
+const (
+	// minHS256KeyLength is the minimum length of HS256 secrets in bytes, matching the output size of SHA-256
+	minHS256KeyLength = 32
+)
+
+var (
+	ErrJWTUnknownKeyID = errors.New("unknown JWT key ID")
+)
+
+// JWTClaims represents the claims embedded in JWT access tokens. Besides the standard claims, the user's scopes,
+// the time the user last authenticated, the time the user verified their email address and the session (refresh
+// token family) the token was issued for are included, allowing requests to be authenticated without accessing the database.
+type JWTClaims struct {
+	jwt.RegisteredClaims
+	Scopes          []string `json:"scopes,omitempty"`
+	SessionID       string   `json:"sid,omitempty"`
+	AuthTime        int64    `json:"auth_time,omitempty"`
+	EmailVerifiedAt int64    `json:"email_verified_at,omitempty"`
+	ClientID        string   `json:"client_id,omitempty"`
+}
+
+// JWTService issues and verifies signed JWT access tokens.
+// Tokens are signed using the configured signing key, while all configured keys are accepted for verification
+// based on the token's `kid` header, allowing keys to be rotated without invalidating tokens already issued.
+type JWTService struct {
+	config           config.AuthServerJWT
+	method           jwt.SigningMethod
+	signingKey       interface{}
+	verificationKeys map[string]interface{}
+}
+
+func NewJWTService(cfg config.AuthServerJWT) (*JWTService, error) {
+	s := &JWTService{
+		config:           cfg,
+		verificationKeys: make(map[string]interface{}, len(cfg.Keys)),
+	}
+
+	switch config.AuthJWTSigningMethod(cfg.SigningMethod) {
+	case config.AuthJWTSigningMethodHS256:
+		s.method = jwt.SigningMethodHS256
+	case config.AuthJWTSigningMethodEdDSA:
+		s.method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported JWT signing method: %s", cfg.SigningMethod)
+	}
+
+	for kid, encoded := range cfg.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode JWT key %q: %w", kid, err)
+		}
+
+		switch s.method {
+		case jwt.SigningMethodHS256:
+			if len(key) < minHS256KeyLength {
+				return nil, fmt.Errorf("JWT key %q is too short, requires at least %d bytes", kid, minHS256KeyLength)
+			}
+
+			s.verificationKeys[kid] = key
+			if kid == cfg.SigningKeyID {
+				s.signingKey = key
+			}
+		case jwt.SigningMethodEdDSA:
+			if len(key) != ed25519.SeedSize {
+				return nil, fmt.Errorf("JWT key %q must be an Ed25519 seed of %d bytes", kid, ed25519.SeedSize)
+			}
+
+			privateKey := ed25519.NewKeyFromSeed(key)
+			s.verificationKeys[kid] = privateKey.Public()
+			if kid == cfg.SigningKeyID {
+				s.signingKey = privateKey
+			}
+		}
+	}
+
+	if s.signingKey == nil {
+		return nil, fmt.Errorf("JWT signing key %q is not configured", cfg.SigningKeyID)
+	}
+
+	return s, nil
+}
+
+// AccessTokenValidity returns the duration JWT access tokens issued are valid for.
+func (s *JWTService) AccessTokenValidity() time.Duration {
+	return s.config.AccessTokenValidity
+}
+
+// IssueAccessToken returns a signed JWT access token for the given user and session.
+func (s *JWTService) IssueAccessToken(user *models.User, sessionID string) (string, error) {
+	return s.IssueClientAccessToken(user, sessionID, "")
+}
+
+// IssueClientAccessToken works like IssueAccessToken, but marks the token as issued to the OAuth client with the given ID (if any).
+// Tokens issued to OAuth clients are restricted credentials, which are only granted the permissions of default roles.
+func (s *JWTService) IssueClientAccessToken(user *models.User, sessionID string, clientID string) (string, error) {
+	now := time.Now()
+
+	claims := JWTClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.config.Issuer,
+			Subject:   user.ID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.config.AccessTokenValidity)),
+		},
+		Scopes:    user.Scopes,
+		SessionID: sessionID,
+		ClientID:  clientID,
+	}
+
+	if user.LastAuthenticatedAt.Valid {
+		claims.AuthTime = user.LastAuthenticatedAt.Time.Unix()
+	}
+
+	if user.EmailVerifiedAt.Valid {
+		claims.EmailVerifiedAt = user.EmailVerifiedAt.Time.Unix()
+	}
+
+	token := jwt.NewWithClaims(s.method, claims)
+	token.Header["kid"] = s.config.SigningKeyID
+
+	return token.SignedString(s.signingKey)
+}
+
+// ParseAccessToken verifies the given JWT access token and returns the authentication result embedded.
+// As no database lookup is performed, the user returned only carries the information stored in the token's claims.
+func (s *JWTService) ParseAccessToken(token string) (AuthenticationResult, error) {
+	parser := jwt.NewParser(
+		jwt.WithValidMethods([]string{s.method.Alg()}),
+		jwt.WithIssuer(s.config.Issuer),
+		jwt.WithExpirationRequired(),
+	)
+
+	var claims JWTClaims
+	if _, err := parser.ParseWithClaims(token, &claims, s.keyFunc); err != nil {
+		return AuthenticationResult{}, err
+	}
+
+	if len(claims.Subject) == 0 {
+		return AuthenticationResult{}, errors.New("JWT is missing subject")
+	}
+
+	user := &models.User{
+		ID:       claims.Subject,
+		IsActive: true,
+		Scopes:   claims.Scopes,
+	}
+
+	if claims.AuthTime > 0 {
+		user.LastAuthenticatedAt = null.TimeFrom(time.Unix(claims.AuthTime, 0))
+	}
+
+	if claims.EmailVerifiedAt > 0 {
+		user.EmailVerifiedAt = null.TimeFrom(time.Unix(claims.EmailVerifiedAt, 0))
+	}
+
+	return AuthenticationResult{
+		Token:      token,
+		User:       user,
+		ValidUntil: claims.ExpiresAt.Time,
+		Scopes:     claims.Scopes,
+		SessionID:  claims.SessionID,
+		Restricted: len(claims.ClientID) > 0,
+	}, nil
+}
+
+func (s *JWTService) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := s.verificationKeys[kid]
+	if !ok {
+		return nil, ErrJWTUnknownKeyID
+	}
+
+	return key, nil
+}
+
+// IsJWT reports whether the given token is formatted like a JWT (three dot separated segments).
+// The token's signature and claims are not verified.
+func IsJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
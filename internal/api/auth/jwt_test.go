@@ -0,0 +1,165 @@
+package auth_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+)
+
+func jwtTestConfig(method config.AuthJWTSigningMethod, signingKeyID string) config.AuthServerJWT {
+	return config.AuthServerJWT{
+		SigningMethod: method.String(),
+		SigningKeyID:  signingKeyID,
+		Keys: map[string]string{
+			"key1": base64.StdEncoding.EncodeToString([]byte(strings.Repeat("1", 32))),
+			"key2": base64.StdEncoding.EncodeToString([]byte(strings.Repeat("2", 32))),
+		},
+		Issuer:              "go-starter-test",
+		AccessTokenValidity: time.Minute,
+	}
+}
+
+func TestJWTServiceIssueAndParse(t *testing.T) {
+	for _, method := range []config.AuthJWTSigningMethod{config.AuthJWTSigningMethodHS256, config.AuthJWTSigningMethodEdDSA} {
+		t.Run(method.String(), func(t *testing.T) {
+			s, err := auth.NewJWTService(jwtTestConfig(method, "key1"))
+			require.NoError(t, err)
+
+			lastAuthenticatedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+			emailVerifiedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+			user := &models.User{
+				ID:                  "f6ede5d8-e22a-4ca5-aa12-67821865a3e5",
+				Scopes:              []string{"app"},
+				LastAuthenticatedAt: null.TimeFrom(lastAuthenticatedAt),
+				EmailVerifiedAt:     null.TimeFrom(emailVerifiedAt),
+			}
+
+			token, err := s.IssueAccessToken(user, "0b8e3d4b-7c2f-4b53-9a58-3c0f0f1e6d21")
+			require.NoError(t, err)
+			assert.True(t, auth.IsJWT(token))
+
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, &auth.JWTClaims{})
+			require.NoError(t, err)
+			assert.Equal(t, method.String(), parsed.Header["alg"])
+			assert.Equal(t, "key1", parsed.Header["kid"])
+
+			res, err := s.ParseAccessToken(token)
+			require.NoError(t, err)
+			assert.Equal(t, token, res.Token)
+			assert.Equal(t, user.ID, res.User.ID)
+			assert.True(t, res.User.IsActive)
+			assert.Equal(t, user.Scopes, res.User.Scopes)
+			assert.Equal(t, []string(user.Scopes), res.Scopes)
+			assert.True(t, lastAuthenticatedAt.Equal(res.User.LastAuthenticatedAt.Time))
+			assert.True(t, emailVerifiedAt.Equal(res.User.EmailVerifiedAt.Time))
+			assert.Equal(t, "0b8e3d4b-7c2f-4b53-9a58-3c0f0f1e6d21", res.SessionID)
+			assert.WithinDuration(t, time.Now().Add(time.Minute), res.ValidUntil, time.Second*10)
+			assert.False(t, res.Restricted)
+
+			// tokens issued to OAuth clients are restricted credentials
+			clientToken, err := s.IssueClientAccessToken(user, "", "test-client")
+			require.NoError(t, err)
+
+			res, err = s.ParseAccessToken(clientToken)
+			require.NoError(t, err)
+			assert.True(t, res.Restricted)
+		})
+	}
+}
+
+func TestJWTServiceKeyRotation(t *testing.T) {
+	oldService, err := auth.NewJWTService(jwtTestConfig(config.AuthJWTSigningMethodHS256, "key1"))
+	require.NoError(t, err)
+
+	token, err := oldService.IssueAccessToken(&models.User{ID: "f6ede5d8-e22a-4ca5-aa12-67821865a3e5"}, "")
+	require.NoError(t, err)
+
+	// tokens signed with the previous key remain valid after rotating the signing key
+	rotatedConfig := jwtTestConfig(config.AuthJWTSigningMethodHS256, "key2")
+	rotatedService, err := auth.NewJWTService(rotatedConfig)
+	require.NoError(t, err)
+
+	_, err = rotatedService.ParseAccessToken(token)
+	assert.NoError(t, err)
+
+	// but are rejected once the previous key has been removed
+	delete(rotatedConfig.Keys, "key1")
+	retiredService, err := auth.NewJWTService(rotatedConfig)
+	require.NoError(t, err)
+
+	_, err = retiredService.ParseAccessToken(token)
+	assert.Error(t, err)
+}
+
+func TestJWTServiceParseInvalid(t *testing.T) {
+	s, err := auth.NewJWTService(jwtTestConfig(config.AuthJWTSigningMethodHS256, "key1"))
+	require.NoError(t, err)
+
+	user := &models.User{ID: "f6ede5d8-e22a-4ca5-aa12-67821865a3e5"}
+
+	expiredConfig := jwtTestConfig(config.AuthJWTSigningMethodHS256, "key1")
+	expiredConfig.AccessTokenValidity = -time.Minute
+	expiredService, err := auth.NewJWTService(expiredConfig)
+	require.NoError(t, err)
+	expiredToken, err := expiredService.IssueAccessToken(user, "")
+	require.NoError(t, err)
+
+	otherIssuerConfig := jwtTestConfig(config.AuthJWTSigningMethodHS256, "key1")
+	otherIssuerConfig.Issuer = "someone-else"
+	otherIssuerService, err := auth.NewJWTService(otherIssuerConfig)
+	require.NoError(t, err)
+	otherIssuerToken, err := otherIssuerService.IssueAccessToken(user, "")
+	require.NoError(t, err)
+
+	eddsaService, err := auth.NewJWTService(jwtTestConfig(config.AuthJWTSigningMethodEdDSA, "key1"))
+	require.NoError(t, err)
+	eddsaToken, err := eddsaService.IssueAccessToken(user, "")
+	require.NoError(t, err)
+
+	validToken, err := s.IssueAccessToken(user, "")
+	require.NoError(t, err)
+	parts := strings.Split(validToken, ".")
+	tamperedToken := parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"76a79a2b-fbd8-45a0-b35b-671a28a87acf"}`)) + "." + parts[2]
+
+	for name, token := range map[string]string{
+		"expired":          expiredToken,
+		"other issuer":     otherIssuerToken,
+		"other method":     eddsaToken,
+		"tampered payload": tamperedToken,
+		"malformed":        "not.a.jwt",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := s.ParseAccessToken(token)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewJWTServiceInvalidConfig(t *testing.T) {
+	unknownSigningKey := jwtTestConfig(config.AuthJWTSigningMethodHS256, "key3")
+	_, err := auth.NewJWTService(unknownSigningKey)
+	assert.Error(t, err)
+
+	unknownMethod := jwtTestConfig(config.AuthJWTSigningMethod("RS256"), "key1")
+	_, err = auth.NewJWTService(unknownMethod)
+	assert.Error(t, err)
+
+	shortKey := jwtTestConfig(config.AuthJWTSigningMethodHS256, "key1")
+	shortKey.Keys["key1"] = base64.StdEncoding.EncodeToString([]byte("short"))
+	_, err = auth.NewJWTService(shortKey)
+	assert.Error(t, err)
+
+	invalidEncoding := jwtTestConfig(config.AuthJWTSigningMethodEdDSA, "key1")
+	invalidEncoding.Keys["key1"] = "not base64!"
+	_, err = auth.NewJWTService(invalidEncoding)
+	assert.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// CurrentLegalDocuments returns the latest published version of every legal document type, ordered by type.
+// Documents are returned in the given locale if available, falling back to fallbackLocale and any other
+// locale the latest version has been published in otherwise.
+func CurrentLegalDocuments(ctx context.Context, exec boil.ContextExecutor, locale string, fallbackLocale string) (models.LegalDocumentSlice, error) {
+	documents, err := models.LegalDocuments(
+		qm.Distinct(fmt.Sprintf("ON (%s) %s.*", models.LegalDocumentTableColumns.Type, models.TableNames.LegalDocuments)),
+		models.LegalDocumentWhere.PublishedAt.LTE(time.Now()),
+		qm.Where(fmt.Sprintf(
+			"%[1]s.version = (SELECT MAX(d.version) FROM %[1]s d WHERE d.type = %[1]s.type AND d.published_at <= NOW())",
+			models.TableNames.LegalDocuments,
+		)),
+		qm.OrderBy(fmt.Sprintf("%[1]s ASC, %[2]s = ? DESC, %[2]s = ? DESC, %[2]s ASC",
+			models.LegalDocumentTableColumns.Type,
+			models.LegalDocumentTableColumns.Locale,
+		), locale, fallbackLocale),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load current legal documents: %w", err)
+	}
+
+	return documents, nil
+}
+
+// pendingLegalDocumentsMods returns query mods selecting all published mandatory legal documents the given user
+// has not accepted yet. A document counts as accepted if the user has accepted any document of the same type with
+// an equal or newer version, regardless of its locale.
+func pendingLegalDocumentsMods(userID string) []qm.QueryMod {
+	return []qm.QueryMod{
+		models.LegalDocumentWhere.Mandatory.EQ(true),
+		models.LegalDocumentWhere.PublishedAt.LTE(time.Now()),
+		qm.Where(fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM %[1]s a INNER JOIN %[2]s d ON d.id = a.legal_document_id WHERE a.user_id = ? AND d.type = %[2]s.type AND d.version >= %[2]s.version)",
+			models.TableNames.LegalAcceptances,
+			models.TableNames.LegalDocuments,
+		), userID),
+	}
+}
+
+// PendingLegalDocumentTypes returns the types of all legal documents the given user is required to (re-)accept,
+// as a newer mandatory version has been published since their last acceptance. The result is never nil.
+func PendingLegalDocumentTypes(ctx context.Context, exec boil.ContextExecutor, userID string) ([]string, error) {
+	mods := append(pendingLegalDocumentsMods(userID),
+		qm.Distinct(models.LegalDocumentTableColumns.Type),
+		qm.OrderBy(models.LegalDocumentTableColumns.Type),
+	)
+
+	documents, err := models.LegalDocuments(mods...).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pending legal documents: %w", err)
+	}
+
+	types := make([]string, 0, len(documents))
+	for _, document := range documents {
+		types = append(types, document.Type)
+	}
+
+	return types, nil
+}
+
+// HasPendingLegalDocuments reports whether the given user is required to (re-)accept any mandatory legal document.
+func HasPendingLegalDocuments(ctx context.Context, exec boil.ContextExecutor, userID string) (bool, error) {
+	pending, err := models.LegalDocuments(pendingLegalDocumentsMods(userID)...).Exists(ctx, exec)
+	if err != nil {
+		return false, fmt.Errorf("failed to check for pending legal documents: %w", err)
+	}
+
+	return pending, nil
+}
+
+// AcceptLegalDocuments records the given user's acceptance of the legal documents provided. Documents accepted
+// before keep their original acceptance time. The app user profile's legal accepted at timestamp is updated
+// as well, if the user has a profile.
+func AcceptLegalDocuments(ctx context.Context, exec boil.ContextExecutor, userID string, documents models.LegalDocumentSlice) error {
+	now := time.Now()
+
+	for _, document := range documents {
+		acceptance := models.LegalAcceptance{
+			UserID:          userID,
+			LegalDocumentID: document.ID,
+			AcceptedAt:      now,
+		}
+
+		if err := acceptance.Upsert(ctx, exec, false, []string{models.LegalAcceptanceColumns.UserID, models.LegalAcceptanceColumns.LegalDocumentID}, boil.None(), boil.Infer()); err != nil {
+			return fmt.Errorf("failed to upsert legal acceptance: %w", err)
+		}
+	}
+
+	if _, err := models.AppUserProfiles(models.AppUserProfileWhere.UserID.EQ(userID)).UpdateAll(ctx, exec, models.M{
+		models.AppUserProfileColumns.LegalAcceptedAt: null.TimeFrom(now),
+		models.AppUserProfileColumns.UpdatedAt:       now,
+	}); err != nil {
+		return fmt.Errorf("failed to update legal accepted at of app user profile: %w", err)
+	}
+
+	return nil
+}
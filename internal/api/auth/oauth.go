@@ -0,0 +1,35 @@
+package auth
+
+type OAuthGrantType string
+
+const (
+	OAuthGrantTypeAuthorizationCode OAuthGrantType = "authorization_code"
+	OAuthGrantTypeRefreshToken      OAuthGrantType = "refresh_token"
+	OAuthGrantTypeClientCredentials OAuthGrantType = "client_credentials"
+)
+
+func (g OAuthGrantType) String() string {
+	return string(g)
+}
+
+const (
+	// OAuthResponseTypeCode is the only response type supported by our authorization endpoint
+	OAuthResponseTypeCode = "code"
+	// OAuthCodeChallengeMethodS256 is the only PKCE code challenge method supported, `plain` is deliberately rejected
+	OAuthCodeChallengeMethodS256 = "S256"
+)
+
+const (
+	OAuthClientAuthMethodSecretBasic = "client_secret_basic"
+	OAuthClientAuthMethodSecretPost  = "client_secret_post"
+	OAuthClientAuthMethodNone        = "none"
+)
+
+// OAuthGrantTypes returns all grant types supported by our OAuth2 authorization server.
+func OAuthGrantTypes() []OAuthGrantType {
+	return []OAuthGrantType{
+		OAuthGrantTypeAuthorizationCode,
+		OAuthGrantTypeRefreshToken,
+		OAuthGrantTypeClientCredentials,
+	}
+}
@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Permission grants access to an action on a resource, formatted as `<resource>:<action>`.
+// Permissions are bundled by roles (see roles table), which are assigned to users.
+type Permission string
+
+const (
+	// PermissionAll grants all permissions, e.g. to administrators
+	PermissionAll Permission = "*"
+	// PermissionPushSend allows sending push notifications
+	PermissionPushSend Permission = "push:send"
+	// PermissionAPIKeysRevoke allows revoking API keys of other users
+	PermissionAPIKeysRevoke Permission = "api_keys:revoke"
+	// PermissionPushSegmentSQL allows sending push campaigns to SQL segments, executing arbitrary (read only) queries
+	PermissionPushSegmentSQL Permission = "push:segment_sql"
+)
+
+const (
+	permissionWildcard  = "*"
+	permissionSeparator = ":"
+)
+
+var (
+	ErrNotAuthenticated = errors.New("not authenticated")
+)
+
+func (p Permission) String() string {
+	return string(p)
+}
+
+// HasPermission reports whether the granted permissions include the given permission, either directly or via
+// wildcards granting all permissions (`*`) or all actions on the permission's resource (e.g. `push:*`).
+func HasPermission(granted []string, permission Permission) bool {
+	resource, _, _ := strings.Cut(permission.String(), permissionSeparator)
+	resourceWildcard := resource + permissionSeparator + permissionWildcard
+
+	for _, g := range granted {
+		if g == permissionWildcard || g == permission.String() || g == resourceWildcard {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ResolvePermissions returns the effective permissions of the given user, combining the permissions
+// of all roles assigned to the user as well as all default roles. The result is never nil.
+func ResolvePermissions(ctx context.Context, exec boil.ContextExecutor, userID string) ([]string, error) {
+	roles, err := models.Roles(db.CombineWithOr([]qm.QueryMod{
+		models.RoleWhere.IsDefault.EQ(true),
+		qm.Where(fmt.Sprintf("%s IN (SELECT %s FROM %s WHERE %s = ?)",
+			models.RoleTableColumns.ID,
+			models.UserRoleColumns.RoleID,
+			models.TableNames.UserRoles,
+			models.UserRoleColumns.UserID,
+		), userID),
+	})...).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load roles of user: %w", err)
+	}
+
+	return permissionsOfRoles(roles), nil
+}
+
+// ResolveDefaultPermissions returns the permissions of all default roles, granted to every user. The result is never nil.
+func ResolveDefaultPermissions(ctx context.Context, exec boil.ContextExecutor) ([]string, error) {
+	roles, err := models.Roles(models.RoleWhere.IsDefault.EQ(true)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load default roles: %w", err)
+	}
+
+	return permissionsOfRoles(roles), nil
+}
+
+func permissionsOfRoles(roles models.RoleSlice) []string {
+	permissions := make([]string, 0)
+	for _, role := range roles {
+		for _, permission := range role.Permissions {
+			if !util.ContainsString(permissions, permission) {
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+
+	return permissions
+}
+
+// permissionsCache stores the effective permissions of the authenticated user for the duration of a request.
+type permissionsCache struct {
+	sync.Mutex
+	permissions []string
+	restricted  bool
+}
+
+// PermissionsFromContext returns the effective permissions of the currently authenticated user from a context. Permissions are
+// resolved using exec on first access and cached in the context for the remainder of the request. Restricted credentials (e.g. API
+// keys, see AuthenticationResult) are only granted the permissions of default roles, so an administrator's API key does not inherit
+// all of their permissions. If no authentication was provided, ErrNotAuthenticated will be returned instead.
+func PermissionsFromContext(ctx context.Context, exec boil.ContextExecutor) ([]string, error) {
+	user := UserFromContext(ctx)
+	cache, ok := ctx.Value(util.CTXKeyPermissions).(*permissionsCache)
+	if user == nil || !ok {
+		return nil, ErrNotAuthenticated
+	}
+
+	cache.Lock()
+	defer cache.Unlock()
+
+	if cache.permissions == nil {
+		var permissions []string
+		var err error
+		if cache.restricted {
+			permissions, err = ResolveDefaultPermissions(ctx, exec)
+		} else {
+			permissions, err = ResolvePermissions(ctx, exec, user.ID)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		cache.permissions = permissions
+	}
+
+	return cache.permissions, nil
+}
+
+// Subject describes the authenticated user an authorization decision is made for.
+type Subject struct {
+	User        *models.User
+	Permissions []string
+}
+
+// Policy decides whether a subject is allowed to perform an action, e.g. on a specific resource.
+type Policy func(subject Subject) bool
+
+// PermissionPolicy allows subjects with the given permission.
+func PermissionPolicy(permission Permission) Policy {
+	return func(subject Subject) bool {
+		return HasPermission(subject.Permissions, permission)
+	}
+}
+
+// OwnerPolicy allows the user with the given ID, e.g. the owner of the resource accessed.
+func OwnerPolicy(ownerID string) Policy {
+	return func(subject Subject) bool {
+		return subject.User.ID == ownerID
+	}
+}
+
+// AnyPolicy allows subjects allowed by any of the given policies, e.g.
+// `AnyPolicy(OwnerPolicy(resource.UserID), PermissionPolicy(PermissionAll))` for "owner or admin".
+func AnyPolicy(policies ...Policy) Policy {
+	return func(subject Subject) bool {
+		for _, policy := range policies {
+			if policy(subject) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Authorize evaluates the given policy for the currently authenticated user of a context, resolving the user's
+// permissions using exec if required. If no authentication was provided, ErrNotAuthenticated will be returned instead.
+func Authorize(ctx context.Context, exec boil.ContextExecutor, policy Policy) (bool, error) {
+	permissions, err := PermissionsFromContext(ctx, exec)
+	if err != nil {
+		return false, err
+	}
+
+	return policy(Subject{
+		User:        UserFromContext(ctx),
+		Permissions: permissions,
+	}), nil
+}
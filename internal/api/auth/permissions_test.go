@@ -0,0 +1,44 @@
+package auth_test
+
+import (
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasPermission(t *testing.T) {
+	assert.True(t, auth.HasPermission([]string{"push:send"}, auth.PermissionPushSend))
+	assert.True(t, auth.HasPermission([]string{"users:read", "push:*"}, auth.PermissionPushSend))
+	assert.True(t, auth.HasPermission([]string{"*"}, auth.PermissionPushSend))
+	assert.True(t, auth.HasPermission([]string{"*"}, auth.PermissionAll))
+
+	assert.False(t, auth.HasPermission(nil, auth.PermissionPushSend))
+	assert.False(t, auth.HasPermission([]string{"push:read", "api_keys:*"}, auth.PermissionPushSend))
+	assert.False(t, auth.HasPermission([]string{"push"}, auth.PermissionPushSend))
+	assert.False(t, auth.HasPermission([]string{"push:*"}, auth.PermissionAll))
+}
+
+func TestPolicies(t *testing.T) {
+	owner := auth.Subject{
+		User:        &models.User{ID: "f6ede5d8-e22a-4ca5-aa12-67821865a3e5"},
+		Permissions: []string{"push:send"},
+	}
+	admin := auth.Subject{
+		User:        &models.User{ID: "76a79a2b-ebe5-4c77-b2b5-7d9a8bad5b58"},
+		Permissions: []string{"*"},
+	}
+	other := auth.Subject{
+		User:        &models.User{ID: "b4fdc1d2-6b33-4c4a-bd6e-3d1eabc9b06c"},
+		Permissions: []string{"push:send"},
+	}
+
+	policy := auth.AnyPolicy(auth.OwnerPolicy(owner.User.ID), auth.PermissionPolicy(auth.PermissionAPIKeysRevoke))
+
+	assert.True(t, policy(owner))
+	assert.True(t, policy(admin))
+	assert.False(t, policy(other))
+
+	assert.False(t, auth.AnyPolicy()(owner))
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// RevokeUserTokens destroys all access and refresh tokens as well as all sessions of the given user, signing them
+// out on all devices. JWT access tokens already issued are rejected as their sessions are gone, unless their revocation
+// checks are disabled (SERVER_AUTH_JWT_CHECK_REVOCATION), in which case they remain valid until they expire.
+func RevokeUserTokens(ctx context.Context, exec boil.ContextExecutor, userID string) error {
+	if _, err := models.AccessTokens(models.AccessTokenWhere.UserID.EQ(userID)).DeleteAll(ctx, exec); err != nil {
+		return fmt.Errorf("failed to delete access tokens: %w", err)
+	}
+
+	if _, err := models.RefreshTokens(models.RefreshTokenWhere.UserID.EQ(userID)).DeleteAll(ctx, exec); err != nil {
+		return fmt.Errorf("failed to delete refresh tokens: %w", err)
+	}
+
+	if _, err := models.Sessions(models.SessionWhere.UserID.EQ(userID)).DeleteAll(ctx, exec); err != nil {
+		return fmt.Errorf("failed to delete sessions: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeRefreshTokens deletes all refresh tokens which have been rotated or revoked longer than the retention period
+// ago, returning the number of refresh tokens purged. Replaying a purged refresh token is still rejected, but no
+// longer revokes the token family it belonged to.
+func PurgeRefreshTokens(ctx context.Context, exec boil.ContextExecutor, retention time.Duration) (int64, error) {
+	before := null.TimeFrom(time.Now().Add(-retention))
+
+	purged, err := models.RefreshTokens(db.CombineWithOr([]qm.QueryMod{
+		models.RefreshTokenWhere.RotatedAt.LT(before),
+		models.RefreshTokenWhere.RevokedAt.LT(before),
+	})...).DeleteAll(ctx, exec)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge refresh tokens: %w", err)
+	}
+
+	return purged, nil
+}
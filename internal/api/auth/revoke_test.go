@@ -0,0 +1,61 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestPurgeRefreshTokens(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		retention := 24 * time.Hour
+
+		rotated := &models.RefreshToken{
+			UserID:    fixtures.User1.ID,
+			FamilyID:  fixtures.User1RefreshToken1.FamilyID,
+			RotatedAt: null.TimeFrom(time.Now().Add(-retention - time.Minute)),
+		}
+		require.NoError(t, rotated.Insert(ctx, db, boil.Infer()))
+
+		revoked := &models.RefreshToken{
+			UserID:    fixtures.User1.ID,
+			RevokedAt: null.TimeFrom(time.Now().Add(-retention - time.Minute)),
+		}
+		require.NoError(t, revoked.Insert(ctx, db, boil.Infer()))
+
+		recentlyRotated := &models.RefreshToken{
+			UserID:    fixtures.User1.ID,
+			FamilyID:  fixtures.User1RefreshToken1.FamilyID,
+			RotatedAt: null.TimeFrom(time.Now().Add(-time.Minute)),
+		}
+		require.NoError(t, recentlyRotated.Insert(ctx, db, boil.Infer()))
+
+		purged, err := auth.PurgeRefreshTokens(ctx, db, retention)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+
+		for _, refreshToken := range []*models.RefreshToken{rotated, revoked} {
+			exists, err := models.RefreshTokenExists(ctx, db, refreshToken.Token)
+			require.NoError(t, err)
+			assert.False(t, exists)
+		}
+
+		// recently rotated tokens are kept for reuse detection, active tokens are never purged
+		for _, refreshToken := range []*models.RefreshToken{recentlyRotated, fixtures.User1RefreshToken1} {
+			exists, err := models.RefreshTokenExists(ctx, db, refreshToken.Token)
+			require.NoError(t, err)
+			assert.True(t, exists)
+		}
+	})
+}
@@ -4,8 +4,25 @@ type Scope string
 
 const (
 	AuthScopeApp Scope = "app"
+	AuthScopeCMS Scope = "cms"
 )
 
 func (s Scope) String() string {
 	return string(s)
 }
+
+// IntersectScopes returns the scopes contained in both of the given lists, e.g. restricting a user's
+// scopes to the ones granted to an OAuth client. The order of the first list is retained.
+func IntersectScopes(scopes []string, granted []string) []string {
+	res := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		for _, g := range granted {
+			if scope == g {
+				res = append(res, scope)
+				break
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	adminTypes "allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAdminPushCampaignRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.GET("/push/campaigns/:id", getAdminPushCampaignHandler(s))
+}
+
+func getAdminPushCampaignHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := adminTypes.NewGetAdminPushCampaignRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		campaign, err := findPushCampaign(ctx, s.DB, params.ID)
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, pushCampaignToAdminPushCampaign(campaign))
+	}
+}
@@ -0,0 +1,30 @@
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAdminPushCampaignNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User1)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/push/campaigns/5f5e3a25-6f8e-4b7c-9f45-1c2a0c3e5d71", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrNotFoundPushCampaignNotFound.Type, *response.Type)
+	})
+}
@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	adminTypes "allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetAdminUserRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.GET("/users/:id", getAdminUserHandler(s))
+}
+
+func getAdminUserHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		params := adminTypes.NewGetAdminUserRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user, err := findUser(ctx, s.DB, params.ID)
+		if err != nil {
+			return err
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, userToAdminUser(user))
+	}
+}
@@ -0,0 +1,54 @@
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAdminUserSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User1)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users/"+fixtures.UserDeactivated.ID, nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.AdminUser
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, fixtures.UserDeactivated.ID, response.ID.String())
+		assert.Equal(t, fixtures.UserDeactivated.Username.String, response.Username)
+		assert.False(t, *response.IsActive)
+		assert.False(t, *response.EmailVerified)
+		assert.Nil(t, response.LastAuthenticatedAt)
+	})
+}
+
+func TestGetAdminUserNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User1)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users/5f5e3a25-6f8e-4b7c-9f45-1c2a0c3e5d71", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrNotFoundUserNotFound.Type, *response.Type)
+	})
+}
@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	adminTypes "allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func GetAdminUsersRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.GET("/users", getAdminUsersHandler(s))
+}
+
+func getAdminUsersHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := adminTypes.NewGetAdminUsersRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		filters := []qm.QueryMod{}
+
+		tsQuery := db.SearchStringToTSQuery(params.Search)
+		if len(tsQuery) > 0 {
+			filters = append(filters, db.WhereTSMatch(tsQuery, models.TableNames.Users, models.UserColumns.SearchVector))
+		}
+
+		if params.Username != nil && len(*params.Username) > 0 {
+			filters = append(filters, db.ILike("%"+*params.Username+"%", models.TableNames.Users, models.UserColumns.Username))
+		}
+
+		if params.IsActive != nil {
+			filters = append(filters, models.UserWhere.IsActive.EQ(*params.IsActive))
+		}
+
+		total, err := models.Users(filters...).Count(ctx, s.DB)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to count users")
+			return err
+		}
+
+		if len(tsQuery) > 0 {
+			filters = append(filters, db.OrderByTSRank(types.OrderDirDesc, tsQuery, models.TableNames.Users, models.UserColumns.SearchVector))
+		}
+
+		// ordering by ID as well guarantees a stable order across pages
+		filters = append(filters,
+			db.OrderBy(types.OrderDir(*params.OrderDir), models.TableNames.Users, *params.OrderBy),
+			db.OrderBy(types.OrderDirAsc, models.TableNames.Users, models.UserColumns.ID),
+			qm.Limit(int(*params.Limit)),
+			qm.Offset(int(*params.Offset)),
+		)
+
+		users, err := models.Users(filters...).All(ctx, s.DB)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load users")
+			return err
+		}
+
+		response := &types.GetAdminUsersResponse{
+			Paginatable: types.Paginatable{
+				Limit:  params.Limit,
+				Offset: params.Offset,
+				Total:  swag.Int64(total),
+			},
+			Data: make([]*types.AdminUser, 0, len(users)),
+		}
+
+		for _, user := range users {
+			response.Data = append(response.Data, userToAdminUser(user))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
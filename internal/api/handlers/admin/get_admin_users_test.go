@@ -0,0 +1,137 @@
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func grantCMSScope(ctx context.Context, t *testing.T, s *api.Server, user *models.User) {
+	t.Helper()
+
+	user.Scopes = append(user.Scopes, "cms")
+	_, err := user.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes))
+	require.NoError(t, err)
+}
+
+func TestGetAdminUsersSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User1)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users?orderBy=username&orderDir=desc&limit=2", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetAdminUsersResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, int64(3), *response.Total)
+		assert.Equal(t, int64(2), *response.Limit)
+		assert.Equal(t, int64(0), *response.Offset)
+		require.Len(t, response.Data, 2)
+		assert.Equal(t, fixtures.UserDeactivated.ID, response.Data[0].ID.String())
+		assert.Equal(t, fixtures.User2.ID, response.Data[1].ID.String())
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/users?orderBy=username&orderDir=desc&limit=2&offset=2", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, int64(3), *response.Total)
+		require.Len(t, response.Data, 1)
+		assert.Equal(t, fixtures.User1.ID, response.Data[0].ID.String())
+		assert.Equal(t, fixtures.User1.Username.String, response.Data[0].Username)
+		assert.ElementsMatch(t, []string{"app", "cms"}, response.Data[0].Scopes)
+		assert.True(t, *response.Data[0].IsActive)
+		assert.True(t, *response.Data[0].EmailVerified)
+		assert.False(t, *response.Data[0].PasswordResetRequired)
+	})
+}
+
+func TestGetAdminUsersFilters(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User1)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users?search=user2", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetAdminUsersResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, int64(1), *response.Total)
+		require.Len(t, response.Data, 1)
+		assert.Equal(t, fixtures.User2.ID, response.Data[0].ID.String())
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/users?username=DEACTIVATED", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, int64(1), *response.Total)
+		require.Len(t, response.Data, 1)
+		assert.Equal(t, fixtures.UserDeactivated.ID, response.Data[0].ID.String())
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/users?is_active=true&orderBy=created_at", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, int64(2), *response.Total)
+		require.Len(t, response.Data, 2)
+		for _, user := range response.Data {
+			assert.True(t, *user.IsActive)
+		}
+	})
+}
+
+func TestGetAdminUsersInvalidOrderBy(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User1)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users?orderBy=password", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+	})
+}
+
+func TestGetAdminUsersMissingScope(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *middleware.ErrForbiddenMissingScopes.Type, *response.Type)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/users", nil, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
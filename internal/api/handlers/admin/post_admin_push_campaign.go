@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PostAdminPushCampaignRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.POST("/push/campaigns", postAdminPushCampaignHandler(s))
+}
+
+func postAdminPushCampaignHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostAdminPushCampaignPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		segment := push.Segment{
+			Type:     push.SegmentType(*body.Segment),
+			Language: body.Language,
+			SQL:      body.SQL,
+		}
+
+		// SQL segments execute the query provided as is, which is only allowed for explicitly permitted users (e.g. admins)
+		if segment.Type == push.SegmentTypeSQL {
+			allowed, err := auth.Authorize(ctx, s.DB, auth.PermissionPolicy(auth.PermissionPushSegmentSQL))
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to authorize SQL push segment")
+				return err
+			}
+
+			if !allowed {
+				log.Debug().Msg("User is not allowed to send push campaigns to SQL segments")
+				return middleware.ErrForbiddenMissingPermissions
+			}
+		}
+
+		campaign, err := s.Push.SendToSegment(ctx, segment, pushMessageFromPayload(body.Message))
+		if err != nil {
+			switch {
+			case errors.Is(err, push.ErrSegmentInvalid):
+				log.Debug().Err(err).Str("segment", *body.Segment).Msg("Invalid push segment")
+				return httperrors.ErrBadRequestInvalidPushSegment
+			case errors.Is(err, push.ErrMessageEmpty):
+				log.Debug().Err(err).Msg("Invalid push message")
+				return httperrors.ErrBadRequestInvalidPushMessage
+			}
+
+			log.Debug().Err(err).Str("segment", *body.Segment).Msg("Failed to send push campaign")
+			return err
+		}
+
+		log.Debug().Str("push_campaign_id", campaign.ID).Int("tokens_targeted", campaign.TokensTargeted).Msg("Successfully enqueued push campaign")
+
+		return util.ValidateAndReturn(c, http.StatusCreated, pushCampaignToAdminPushCampaign(campaign))
+	}
+}
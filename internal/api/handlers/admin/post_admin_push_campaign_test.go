@@ -0,0 +1,175 @@
+package admin_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// grantAdminRole assigns the admin role to the user, granting all permissions.
+func grantAdminRole(ctx context.Context, t *testing.T, s *api.Server, user *models.User) {
+	t.Helper()
+
+	admin, err := models.Roles(models.RoleWhere.Name.EQ("admin")).One(ctx, s.DB)
+	require.NoError(t, err)
+
+	userRole := models.UserRole{
+		UserID: user.ID,
+		RoleID: admin.ID,
+	}
+	err = userRole.Insert(ctx, s.DB, boil.Infer())
+	require.NoError(t, err)
+}
+
+func TestPostAdminPushCampaignSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User2)
+
+		payload := test.GenericPayload{
+			"segment": "all",
+			"message": test.GenericPayload{
+				"notification": test.GenericPayload{
+					"title": "Hello",
+					"body":  "World",
+				},
+				"data":        map[string]string{"id": "42"},
+				"ttl_seconds": 3600,
+			},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/push/campaigns", payload, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.AdminPushCampaign
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, models.PushCampaignSegmentAll, *response.Segment)
+		assert.Nil(t, response.SegmentValue)
+		assert.Equal(t, int64(1), *response.UsersTargeted)
+		assert.Equal(t, int64(1), *response.TokensTargeted)
+		assert.Equal(t, int64(1), *response.Pending)
+		assert.NotNil(t, response.EnqueuedAt)
+
+		_, err := s.Push.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/admin/push/campaigns/"+response.ID.String(), nil, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+
+		// the FCM fixture token of User1 is rejected by the mock provider
+		assert.Equal(t, int64(0), *response.Delivered)
+		assert.Equal(t, int64(1), *response.InvalidToken)
+		assert.Equal(t, int64(0), *response.Failed)
+		assert.Equal(t, int64(0), *response.Pending)
+	})
+}
+
+func TestPostAdminPushCampaignInvalidSegment(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User2)
+		grantAdminRole(ctx, t, s, fixtures.User2)
+
+		tests := []test.GenericPayload{
+			{"segment": "language", "message": test.GenericPayload{"data": map[string]string{"id": "42"}}},
+			{"segment": "sql", "sql": "SELECT nope FROM", "message": test.GenericPayload{"data": map[string]string{"id": "42"}}},
+		}
+
+		for _, payload := range tests {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/admin/push/campaigns", payload, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+
+			require.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+			var response httperrors.HTTPError
+			test.ParseResponseAndValidate(t, res, &response)
+
+			assert.Equal(t, *httperrors.ErrBadRequestInvalidPushSegment.Type, *response.Type)
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/push/campaigns", test.GenericPayload{
+			"segment": "all",
+			"message": test.GenericPayload{},
+		}, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+
+		require.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrBadRequestInvalidPushMessage.Type, *response.Type)
+
+		campaignCount, err := models.PushCampaigns().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), campaignCount)
+	})
+}
+
+func TestPostAdminPushCampaignSQLSegment(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User2)
+
+		payload := test.GenericPayload{
+			"segment": "sql",
+			"sql":     fmt.Sprintf("SELECT id FROM users WHERE username = '%s'", fixtures.User1.Username.String),
+			"message": test.GenericPayload{"data": map[string]string{"id": "42"}},
+		}
+
+		// the cms scope alone does not allow executing arbitrary queries
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/push/campaigns", payload, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+
+		require.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var errResponse httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &errResponse)
+		assert.Equal(t, *middleware.ErrForbiddenMissingPermissions.Type, *errResponse.Type)
+
+		grantAdminRole(ctx, t, s, fixtures.User2)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/admin/push/campaigns", payload, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.AdminPushCampaign
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, models.PushCampaignSegmentSQL, *response.Segment)
+		assert.Equal(t, int64(1), *response.UsersTargeted)
+	})
+}
+
+func TestPostAdminPushCampaignMissingScope(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"segment": "all",
+			"message": test.GenericPayload{"data": map[string]string{"id": "42"}},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/push/campaigns", payload, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+	})
+}
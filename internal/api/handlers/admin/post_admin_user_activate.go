@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	adminTypes "allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostAdminUserActivateRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.POST("/users/:id/activate", postAdminUserActivateHandler(s))
+}
+
+func postAdminUserActivateHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := adminTypes.NewPostAdminUserActivateRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user, err := findUser(ctx, s.DB, params.ID)
+		if err != nil {
+			return err
+		}
+
+		// activating users within their account deletion grace period cancels the deletion
+		user.IsActive = true
+		user.DeletedAt = null.Time{}
+		if _, err := user.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.IsActive, models.UserColumns.DeletedAt, models.UserColumns.UpdatedAt)); err != nil {
+			log.Debug().Err(err).Str("target_user_id", user.ID).Msg("Failed to activate user")
+			return err
+		}
+
+		log.Debug().Str("target_user_id", user.ID).Msg("Successfully activated user")
+
+		return util.ValidateAndReturn(c, http.StatusOK, userToAdminUser(user))
+	}
+}
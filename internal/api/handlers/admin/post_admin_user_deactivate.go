@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	adminTypes "allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostAdminUserDeactivateRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.POST("/users/:id/deactivate", postAdminUserDeactivateHandler(s))
+}
+
+func postAdminUserDeactivateHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := adminTypes.NewPostAdminUserDeactivateRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user, err := findUser(ctx, s.DB, params.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			user.IsActive = false
+			if _, err := user.Update(ctx, tx, boil.Whitelist(models.UserColumns.IsActive, models.UserColumns.UpdatedAt)); err != nil {
+				log.Debug().Err(err).Str("target_user_id", user.ID).Msg("Failed to deactivate user")
+				return err
+			}
+
+			// Deactivated users are rejected by the auth middleware, however they must not be able to refresh their tokens either
+			if err := auth.RevokeUserTokens(ctx, tx, user.ID); err != nil {
+				log.Debug().Err(err).Str("target_user_id", user.ID).Msg("Failed to revoke tokens of user")
+				return err
+			}
+
+			return nil
+		}); err != nil {
+			log.Debug().Err(err).Str("target_user_id", user.ID).Msg("Failed to deactivate user")
+			return err
+		}
+
+		log.Debug().Str("target_user_id", user.ID).Msg("Successfully deactivated user")
+
+		return util.ValidateAndReturn(c, http.StatusOK, userToAdminUser(user))
+	}
+}
@@ -0,0 +1,92 @@
+package admin_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAdminUserDeactivateSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User1)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fixtures.User2.ID+"/deactivate", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.AdminUser
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.False(t, *response.IsActive)
+
+		err := fixtures.User2.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, fixtures.User2.IsActive)
+
+		err = fixtures.User2AccessToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User2RefreshToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fixtures.User2.ID+"/activate", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.True(t, *response.IsActive)
+
+		err = fixtures.User2.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, fixtures.User2.IsActive)
+	})
+}
+
+func TestPostAdminUserActivateCancelsAccountDeletion(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User1)
+
+		err := auth.DeleteUser(ctx, s.DB, fixtures.User2, time.Hour)
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/admin/users/"+fixtures.User2.ID, nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.AdminUser
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.False(t, *response.IsActive)
+		assert.NotNil(t, response.DeletedAt)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fixtures.User2.ID+"/activate", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		response = types.AdminUser{}
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.True(t, *response.IsActive)
+		assert.Nil(t, response.DeletedAt)
+
+		err = fixtures.User2.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, fixtures.User2.IsActive)
+		assert.False(t, fixtures.User2.DeletedAt.Valid)
+	})
+}
@@ -0,0 +1,93 @@
+package admin
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	adminTypes "allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostAdminUserForcePasswordResetRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.POST("/users/:id/force-password-reset", postAdminUserForcePasswordResetHandler(s))
+}
+
+func postAdminUserForcePasswordResetHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := adminTypes.NewPostAdminUserForcePasswordResetRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user, err := findUser(ctx, s.DB, params.ID)
+		if err != nil {
+			return err
+		}
+
+		if !user.Password.Valid {
+			log.Debug().Str("target_user_id", user.ID).Msg("User is missing password, forbidding password reset")
+			return httperrors.ErrForbiddenNotLocalUser
+		}
+
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			user.PasswordResetRequired = true
+			if _, err := user.Update(ctx, tx, boil.Whitelist(models.UserColumns.PasswordResetRequired, models.UserColumns.UpdatedAt)); err != nil {
+				log.Debug().Err(err).Str("target_user_id", user.ID).Msg("Failed to update user")
+				return err
+			}
+
+			if err := auth.RevokeUserTokens(ctx, tx, user.ID); err != nil {
+				log.Debug().Err(err).Str("target_user_id", user.ID).Msg("Failed to revoke tokens of user")
+				return err
+			}
+
+			passwordResetToken := models.PasswordResetToken{
+				UserID:     user.ID,
+				ValidUntil: time.Now().Add(s.Config.Auth.PasswordResetTokenValidity),
+			}
+
+			if err := passwordResetToken.Insert(ctx, tx, boil.Infer()); err != nil {
+				log.Debug().Err(err).Str("target_user_id", user.ID).Msg("Failed to insert password reset token")
+				return err
+			}
+
+			u, err := url.Parse(s.Config.Frontend.BaseURL)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to parse frontend base URL")
+				return err
+			}
+
+			u.Path = path.Join(u.Path, s.Config.Frontend.PasswordResetEndpoint)
+
+			q := u.Query()
+			q.Set("token", passwordResetToken.Token)
+			u.RawQuery = q.Encode()
+
+			if err := s.Mailer.SendPasswordReset(ctx, user.Username.String, u.String()); err != nil {
+				log.Debug().Err(err).Str("target_user_id", user.ID).Msg("Failed to send password reset email")
+				return err
+			}
+
+			return nil
+		}); err != nil {
+			log.Debug().Err(err).Str("target_user_id", user.ID).Msg("Failed to force password reset of user")
+			return err
+		}
+
+		log.Debug().Str("target_user_id", user.ID).Msg("Successfully forced password reset of user")
+
+		return util.ValidateAndReturn(c, http.StatusOK, userToAdminUser(user))
+	}
+}
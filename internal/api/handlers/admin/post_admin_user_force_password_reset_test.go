@@ -0,0 +1,102 @@
+package admin_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/mailer/transport"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestPostAdminUserForcePasswordResetSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User1)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fixtures.User2.ID+"/force-password-reset", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.AdminUser
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.True(t, *response.PasswordResetRequired)
+
+		err := fixtures.User2AccessToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+
+		passwordResetToken, err := fixtures.User2.PasswordResetTokens().One(ctx, s.DB)
+		require.NoError(t, err)
+
+		mt, ok := s.Mailer.Transport.(*transport.MockMailTransport)
+		require.True(t, ok)
+		mail := mt.GetLastSentMail()
+		require.NotNil(t, mail)
+		assert.Equal(t, []string{fixtures.User2.Username.String}, mail.To)
+		assert.Contains(t, string(mail.HTML), fmt.Sprintf("http://localhost:3000/set-new-password?token=%s", passwordResetToken.Token))
+
+		// the old password can no longer be used to log in
+		payload := test.GenericPayload{
+			"username": fixtures.User2.Username,
+			"password": test.PlainTestUserPassword,
+		}
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var errResponse httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &errResponse)
+		assert.Equal(t, *httperrors.ErrForbiddenPasswordResetRequired.Type, *errResponse.Type)
+
+		payload = test.GenericPayload{
+			"token":    passwordResetToken.Token,
+			"password": "correct horse battery staple",
+		}
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/forgot-password/complete", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		err = fixtures.User2.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, fixtures.User2.PasswordResetRequired)
+	})
+}
+
+func TestPostAdminUserForcePasswordResetNotLocalUser(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User1)
+
+		fixtures.User2.Password = null.NewString("", false)
+		_, err := fixtures.User2.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Password))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fixtures.User2.ID+"/force-password-reset", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrForbiddenNotLocalUser.Type, *response.Type)
+
+		err = fixtures.User2.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, fixtures.User2.PasswordResetRequired)
+	})
+}
@@ -0,0 +1,45 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	adminTypes "allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostAdminUserRevokeTokensRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.POST("/users/:id/revoke-tokens", postAdminUserRevokeTokensHandler(s))
+}
+
+func postAdminUserRevokeTokensHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := adminTypes.NewPostAdminUserRevokeTokensRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user, err := findUser(ctx, s.DB, params.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			return auth.RevokeUserTokens(ctx, tx, user.ID)
+		}); err != nil {
+			log.Debug().Err(err).Str("target_user_id", user.ID).Msg("Failed to revoke tokens of user")
+			return err
+		}
+
+		log.Debug().Str("target_user_id", user.ID).Msg("Successfully revoked all tokens of user")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,36 @@
+package admin_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAdminUserRevokeTokensSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User2)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/admin/users/"+fixtures.User1.ID+"/revoke-tokens", nil, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err := fixtures.User1AccessToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1RefreshToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1Session1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+
+		err = fixtures.User2AccessToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+	})
+}
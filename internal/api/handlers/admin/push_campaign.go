@@ -0,0 +1,92 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// findPushCampaign loads the push campaign with the given ID, returning httperrors.ErrNotFoundPushCampaignNotFound if it does not exist.
+func findPushCampaign(ctx context.Context, exec boil.ContextExecutor, id strfmt.UUID4) (*models.PushCampaign, error) {
+	log := util.LogFromContext(ctx)
+
+	campaign, err := models.FindPushCampaign(ctx, exec, id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Str("push_campaign_id", id.String()).Msg("Push campaign not found")
+			return nil, httperrors.ErrNotFoundPushCampaignNotFound
+		}
+
+		log.Debug().Err(err).Str("push_campaign_id", id.String()).Msg("Failed to load push campaign")
+		return nil, err
+	}
+
+	return campaign, nil
+}
+
+// pushMessageFromPayload converts the given payload to a push message.
+func pushMessageFromPayload(payload *types.PushMessage) push.Message {
+	msg := push.Message{
+		Data:        payload.Data,
+		CollapseKey: payload.CollapseKey,
+		Priority:    push.Priority(payload.Priority),
+	}
+
+	if payload.TTLSeconds != nil {
+		msg.TTL = time.Duration(*payload.TTLSeconds) * time.Second
+	}
+
+	if n := payload.Notification; n != nil {
+		msg.Notification = &push.Notification{
+			Title:        n.Title,
+			Body:         n.Body,
+			TitleKey:     n.TitleKey,
+			BodyKey:      n.BodyKey,
+			TemplateData: n.TemplateData,
+			ImageURL:     n.ImageURL,
+			Link:         n.Link,
+			Sound:        n.Sound,
+		}
+
+		if n.Badge != nil {
+			badge := int(*n.Badge)
+			msg.Notification.Badge = &badge
+		}
+	}
+
+	return msg
+}
+
+// pushCampaignToAdminPushCampaign converts the given push campaign to its representation for administrators, including its delivery statistics.
+func pushCampaignToAdminPushCampaign(campaign *models.PushCampaign) *types.AdminPushCampaign {
+	res := &types.AdminPushCampaign{
+		ID:             conv.UUID4(strfmt.UUID4(campaign.ID)),
+		Segment:        swag.String(campaign.Segment),
+		SegmentValue:   campaign.SegmentValue.Ptr(),
+		UsersTargeted:  swag.Int64(int64(campaign.UsersTargeted)),
+		TokensTargeted: swag.Int64(int64(campaign.TokensTargeted)),
+		Delivered:      swag.Int64(int64(campaign.DeliveredCount)),
+		InvalidToken:   swag.Int64(int64(campaign.InvalidTokenCount)),
+		Failed:         swag.Int64(int64(campaign.FailedCount)),
+		Pending:        swag.Int64(int64(push.CampaignPending(campaign))),
+		CreatedAt:      conv.DateTime(strfmt.DateTime(campaign.CreatedAt)),
+		UpdatedAt:      conv.DateTime(strfmt.DateTime(campaign.UpdatedAt)),
+	}
+
+	if campaign.EnqueuedAt.Valid {
+		res.EnqueuedAt = conv.DateTime(strfmt.DateTime(campaign.EnqueuedAt.Time))
+	}
+
+	return res
+}
@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	adminTypes "allaboutapps.dev/aw/go-starter/internal/types/admin"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PutAdminUserScopesRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Admin.PUT("/users/:id/scopes", putAdminUserScopesHandler(s))
+}
+
+func putAdminUserScopesHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := adminTypes.NewPutAdminUserScopesRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		var body types.PutAdminUserScopesPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		user, err := findUser(ctx, s.DB, params.ID)
+		if err != nil {
+			return err
+		}
+
+		scopes := make([]string, 0, len(body.Scopes))
+		for _, scope := range body.Scopes {
+			if !util.ContainsString(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+
+		user.Scopes = scopes
+		if _, err := user.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Scopes, models.UserColumns.UpdatedAt)); err != nil {
+			log.Debug().Err(err).Str("target_user_id", user.ID).Msg("Failed to update scopes of user")
+			return err
+		}
+
+		log.Debug().Str("target_user_id", user.ID).Strs("scopes", scopes).Msg("Successfully updated scopes of user")
+
+		return util.ValidateAndReturn(c, http.StatusOK, userToAdminUser(user))
+	}
+}
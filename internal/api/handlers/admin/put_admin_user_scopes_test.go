@@ -0,0 +1,60 @@
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPutAdminUserScopesSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User1)
+
+		payload := test.GenericPayload{
+			"scopes": []string{"cms", "app", "cms"},
+		}
+
+		res := test.PerformRequest(t, s, "PUT", "/api/v1/admin/users/"+fixtures.User2.ID+"/scopes", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.AdminUser
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, []string{"cms", "app"}, response.Scopes)
+
+		err := fixtures.User2.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cms", "app"}, []string(fixtures.User2.Scopes))
+	})
+}
+
+func TestPutAdminUserScopesInvalidScope(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		grantCMSScope(ctx, t, s, fixtures.User1)
+
+		payload := test.GenericPayload{
+			"scopes": []string{"app", "superuser"},
+		}
+
+		res := test.PerformRequest(t, s, "PUT", "/api/v1/admin/users/"+fixtures.User2.ID+"/scopes", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		err := fixtures.User2.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"app"}, []string(fixtures.User2.Scopes))
+	})
+}
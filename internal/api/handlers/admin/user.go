@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// findUser loads the user with the given ID, returning httperrors.ErrNotFoundUserNotFound if it does not exist.
+func findUser(ctx context.Context, exec boil.ContextExecutor, id strfmt.UUID4) (*models.User, error) {
+	log := util.LogFromContext(ctx)
+
+	user, err := models.FindUser(ctx, exec, id.String())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Str("target_user_id", id.String()).Msg("User not found")
+			return nil, httperrors.ErrNotFoundUserNotFound
+		}
+
+		log.Debug().Err(err).Str("target_user_id", id.String()).Msg("Failed to load user")
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// userToAdminUser converts the given user to its representation for administrators, omitting the user's password.
+func userToAdminUser(user *models.User) *types.AdminUser {
+	res := &types.AdminUser{
+		ID:                    conv.UUID4(strfmt.UUID4(user.ID)),
+		Username:              user.Username.String,
+		IsActive:              swag.Bool(user.IsActive),
+		Scopes:                user.Scopes,
+		EmailVerified:         swag.Bool(user.EmailVerifiedAt.Valid),
+		PasswordResetRequired: swag.Bool(user.PasswordResetRequired),
+		CreatedAt:             conv.DateTime(strfmt.DateTime(user.CreatedAt)),
+		UpdatedAt:             conv.DateTime(strfmt.DateTime(user.UpdatedAt)),
+	}
+
+	if user.LastAuthenticatedAt.Valid {
+		res.LastAuthenticatedAt = conv.DateTime(strfmt.DateTime(user.LastAuthenticatedAt.Time))
+	}
+
+	if user.DeletedAt.Valid {
+		res.DeletedAt = conv.DateTime(strfmt.DateTime(user.DeletedAt.Time))
+	}
+
+	return res
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// oauthGrant describes the OAuth client and scopes an access token was issued for.
+// Tokens issued for first-party logins carry no grant and are not restricted.
+type oauthGrant struct {
+	ClientID string
+	Scopes   []string
+}
+
+// issueAccessToken returns a new access token for the given user and session as well as its validity.
+// If the server is configured to issue JWT access tokens, a signed token is returned without persisting it,
+// otherwise an opaque access token is inserted into the database.
+func issueAccessToken(ctx context.Context, s *api.Server, exec boil.ContextExecutor, user *models.User, sessionID string) (string, time.Duration, error) {
+	return issueGrantedAccessToken(ctx, s, exec, user, sessionID, nil)
+}
+
+// issueGrantedAccessToken works like issueAccessToken, but restricts the access token to the scopes of the
+// given OAuth grant (if any). The session is optional, as tokens issued to OAuth clients acting on their
+// own behalf are not bound to one.
+func issueGrantedAccessToken(ctx context.Context, s *api.Server, exec boil.ContextExecutor, user *models.User, sessionID string, grant *oauthGrant) (string, time.Duration, error) {
+	if s.JWT != nil {
+		var clientID string
+		if grant != nil {
+			// JWTs carry the scopes of the user they were issued for, so we sign a copy restricted to the granted scopes
+			grantedUser := *user
+			grantedUser.Scopes = auth.IntersectScopes(user.Scopes, grant.Scopes)
+			user = &grantedUser
+			clientID = grant.ClientID
+		}
+
+		token, err := s.JWT.IssueClientAccessToken(user, sessionID, clientID)
+		if err != nil {
+			return "", 0, err
+		}
+
+		return token, s.JWT.AccessTokenValidity(), nil
+	}
+
+	accessToken := models.AccessToken{
+		ValidUntil:           time.Now().Add(s.Config.Auth.AccessTokenValidity),
+		UserID:               user.ID,
+		RefreshTokenFamilyID: null.NewString(sessionID, len(sessionID) > 0),
+	}
+
+	if grant != nil {
+		accessToken.OauthClientID = null.StringFrom(grant.ClientID)
+		accessToken.Scopes = types.StringArray(grant.Scopes)
+	}
+
+	if err := accessToken.Insert(ctx, exec, boil.Infer()); err != nil {
+		return "", 0, err
+	}
+
+	return accessToken.Token, s.Config.Auth.AccessTokenValidity, nil
+}
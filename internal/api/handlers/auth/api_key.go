@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+)
+
+// apiKeyToType converts the given API key to its API representation, omitting the key's hash.
+func apiKeyToType(apiKey *models.APIKey) *types.APIKey {
+	res := &types.APIKey{
+		ID:        conv.UUID4(strfmt.UUID4(apiKey.ID)),
+		Name:      swag.String(apiKey.Name),
+		Prefix:    swag.String(apiKey.Prefix),
+		Scopes:    apiKey.Scopes,
+		CreatedAt: conv.DateTime(strfmt.DateTime(apiKey.CreatedAt)),
+	}
+
+	if apiKey.ExpiresAt.Valid {
+		res.ExpiresAt = conv.DateTime(strfmt.DateTime(apiKey.ExpiresAt.Time))
+	}
+
+	if apiKey.LastUsedAt.Valid {
+		res.LastUsedAt = conv.DateTime(strfmt.DateTime(apiKey.LastUsedAt.Time))
+	}
+
+	return res
+}
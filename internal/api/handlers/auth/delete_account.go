@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"allaboutapps.dev/aw/go-starter/internal/util/hashing"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func DeleteAccountRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.DELETE("/account", deleteAccountHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{S: s, Mode: middleware.AuthModeSecure}))
+}
+
+func deleteAccountHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.DeleteAccountPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		// JWT access tokens only carry a subset of the user's data, load the stored record including the password hash
+		user, err := models.FindUser(ctx, s.DB, auth.UserFromEchoContext(c).ID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load user")
+			return err
+		}
+
+		// users without password (e.g. social login) can only confirm the deletion by having recently authenticated
+		if user.Password.Valid {
+			if body.Password == nil {
+				log.Debug().Msg("Password missing, rejecting account deletion of local user")
+				return echo.ErrUnauthorized
+			}
+
+			match, err := hashing.ComparePasswordAndHash(*body.Password, user.Password.String)
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to compare password with stored hash")
+				return err
+			}
+
+			if !match {
+				log.Debug().Msg("Provided password does not match stored hash")
+				return echo.ErrUnauthorized
+			}
+		}
+
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			return auth.DeleteUser(ctx, tx, user, s.Config.Auth.AccountDeletion.GracePeriod)
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to delete account")
+			return err
+		}
+
+		log.Debug().Dur("grace_period", s.Config.Auth.AccountDeletion.GracePeriod).Msg("Successfully deleted account")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,166 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestDeleteAccountSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"password": test.PlainTestUserPassword,
+		}
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/account", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err := fixtures.User1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1AppUserProfile.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1AccessToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1RefreshToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1PushToken.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+
+		// other users must not be affected
+		err = fixtures.User2.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+		err = fixtures.User2AccessToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteAccountWithGracePeriod(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.Auth.AccountDeletion.GracePeriod = time.Hour * 24 * 30
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"password": test.PlainTestUserPassword,
+		}
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/account", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err := fixtures.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, fixtures.User1.IsActive)
+		assert.True(t, fixtures.User1.DeletedAt.Valid)
+		assert.WithinDuration(t, time.Now(), fixtures.User1.DeletedAt.Time, time.Second*10)
+
+		err = fixtures.User1AccessToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1RefreshToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1AppUserProfile.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+
+		// users still within their grace period are not purged
+		purged, err := auth.PurgeDeletedUsers(ctx, s.DB, s.Config.Auth.AccountDeletion.GracePeriod)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), purged)
+
+		fixtures.User1.DeletedAt = null.TimeFrom(time.Now().Add(-s.Config.Auth.AccountDeletion.GracePeriod - time.Minute))
+		_, err = fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.DeletedAt))
+		require.NoError(t, err)
+
+		purged, err = auth.PurgeDeletedUsers(ctx, s.DB, s.Config.Auth.AccountDeletion.GracePeriod)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), purged)
+
+		err = fixtures.User1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1AppUserProfile.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1PushToken.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+}
+
+func TestDeleteAccountInvalidPassword(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"password": "not my password",
+		}
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/account", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		err := fixtures.User1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+		assert.True(t, fixtures.User1.IsActive)
+	})
+}
+
+func TestDeleteAccountMissingPassword(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/account", test.GenericPayload{}, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		err := fixtures.User1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteAccountUserWithoutPassword(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User2.Password = null.NewString("", false)
+		rowsAff, err := fixtures.User2.Update(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+		require.Equal(t, int64(1), rowsAff)
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/account", nil, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err = fixtures.User2.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+}
+
+func TestDeleteAccountUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		payload := test.GenericPayload{
+			"password": test.PlainTestUserPassword,
+		}
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/account", payload, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
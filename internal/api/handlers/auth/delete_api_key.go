@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	authTypes "allaboutapps.dev/aw/go-starter/internal/types/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func DeleteApiKeyRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.DELETE("/api-keys/:id", deleteApiKeyHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{S: s, Mode: middleware.AuthModeSecure}))
+}
+
+func deleteApiKeyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := authTypes.NewDeleteAPIKeyRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		apiKey, err := models.FindAPIKey(ctx, s.DB, params.ID.String())
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Str("api_key_id", params.ID.String()).Msg("API key not found")
+				return httperrors.ErrNotFoundAPIKeyNotFound
+			}
+
+			log.Debug().Err(err).Msg("Failed to load API key")
+			return err
+		}
+
+		// Besides their owner, API keys may be revoked by users permitted to do so (e.g. admins)
+		allowed, err := auth.Authorize(ctx, s.DB, auth.AnyPolicy(
+			auth.OwnerPolicy(apiKey.UserID),
+			auth.PermissionPolicy(auth.PermissionAPIKeysRevoke),
+		))
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to authorize revocation of API key")
+			return err
+		}
+
+		// Do not disclose the existence of API keys to users not allowed to revoke them
+		if !allowed {
+			log.Debug().Str("api_key_id", apiKey.ID).Msg("User is not allowed to revoke API key")
+			return httperrors.ErrNotFoundAPIKeyNotFound
+		}
+
+		if _, err := apiKey.Delete(ctx, s.DB); err != nil {
+			log.Debug().Err(err).Str("api_key_id", apiKey.ID).Msg("Failed to delete API key")
+			return err
+		}
+
+		log.Debug().Str("api_key_id", apiKey.ID).Msg("Successfully revoked API key")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
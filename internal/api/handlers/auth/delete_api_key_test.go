@@ -0,0 +1,97 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestDeleteApiKeySuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		apiKey, key := insertTestAPIKey(ctx, t, s, fixtures.User1, fixtures.User1.Scopes)
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/api-keys/"+apiKey.ID, nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err = apiKey.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/push/test", nil, test.HeadersWithConfigurableAuth(t, auth.APIKeyScheme, key))
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestDeleteApiKeyOfOtherUser(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		apiKey, _ := insertTestAPIKey(ctx, t, s, fixtures.User2, fixtures.User2.Scopes)
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/api-keys/"+apiKey.ID, nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrNotFoundAPIKeyNotFound.Type, *response.Type)
+
+		err = apiKey.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteApiKeyOfOtherUserAsAdmin(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		admin, err := models.Roles(models.RoleWhere.Name.EQ("admin")).One(ctx, s.DB)
+		require.NoError(t, err)
+
+		userRole := models.UserRole{
+			UserID: fixtures.User1.ID,
+			RoleID: admin.ID,
+		}
+		err = userRole.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		apiKey, _ := insertTestAPIKey(ctx, t, s, fixtures.User2, fixtures.User2.Scopes)
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/api-keys/"+apiKey.ID, nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err = apiKey.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+)
+
+func DeleteProfileAvatarRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.DELETE("/profile/avatar", deleteProfileAvatarHandler(s))
+}
+
+func deleteProfileAvatarHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		user := auth.UserFromEchoContext(c)
+
+		var previousFileName null.String
+		profile, err := updateProfile(c, s, user.ID, func(profile *models.AppUserProfile) []string {
+			if !profile.AvatarFileName.Valid {
+				return nil
+			}
+
+			previousFileName = profile.AvatarFileName
+			profile.AvatarFileName = null.String{}
+			profile.AvatarMimeType = null.String{}
+
+			return []string{models.AppUserProfileColumns.AvatarFileName, models.AppUserProfileColumns.AvatarMimeType}
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to delete avatar")
+			return err
+		}
+
+		if previousFileName.Valid {
+			if err := removeProfileAvatar(ctx, s, previousFileName.String); err != nil {
+				log.Error().Err(err).Str("file_name", previousFileName.String).Msg("Failed to remove avatar file")
+			}
+		}
+
+		log.Debug().Msg("Successfully deleted avatar")
+
+		return returnProfile(c, profile)
+	}
+}
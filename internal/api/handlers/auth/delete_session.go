@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	authTypes "allaboutapps.dev/aw/go-starter/internal/types/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func DeleteSessionRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.DELETE("/sessions/:id", deleteSessionHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{S: s, Mode: middleware.AuthModeSecure}))
+}
+
+func deleteSessionHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := authTypes.NewDeleteSessionRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromEchoContext(c)
+
+		session, err := user.Sessions(models.SessionWhere.ID.EQ(params.ID.String())).One(ctx, s.DB)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Str("session_id", params.ID.String()).Msg("Session not found")
+				return httperrors.ErrNotFoundSessionNotFound
+			}
+
+			log.Debug().Err(err).Msg("Failed to load session")
+			return err
+		}
+
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			return deleteSession(ctx, tx, session.ID)
+		}); err != nil {
+			log.Debug().Err(err).Str("session_id", session.ID).Msg("Failed to delete session")
+			return err
+		}
+
+		log.Debug().Str("session_id", session.ID).Msg("Successfully revoked session")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
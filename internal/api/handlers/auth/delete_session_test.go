@@ -0,0 +1,164 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestDeleteSessionSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		otherSession := models.Session{
+			ID:         "c1f3b6a2-5d0e-4f7a-8b39-2e6d4a9c1f05",
+			UserID:     fixtures.User1.ID,
+			LastSeenAt: time.Now(),
+		}
+		err = otherSession.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		otherRefreshToken := models.RefreshToken{
+			UserID:   fixtures.User1.ID,
+			FamilyID: otherSession.ID,
+		}
+		err = otherRefreshToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		otherAccessToken := models.AccessToken{
+			ValidUntil:           time.Now().Add(time.Hour),
+			UserID:               fixtures.User1.ID,
+			RefreshTokenFamilyID: null.StringFrom(otherSession.ID),
+		}
+		err = otherAccessToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/sessions/"+otherSession.ID, nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err = otherSession.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = otherRefreshToken.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = otherAccessToken.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+
+		err = fixtures.User1Session1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+		err = fixtures.User1AccessToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+		err = fixtures.User1RefreshToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteSessionJWT(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.Auth.TokenFormat = "jwt"
+	config.Auth.JWT.SigningMethod = "EdDSA"
+	config.Auth.JWT.SigningKeyID = "test"
+	config.Auth.JWT.Keys = map[string]string{"test": "V2i0J0bJMn8K6pXbG0vK9m1rW3yQ4uZ7cD2eF5hA8sE="}
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		otherSession := models.Session{
+			ID:         "c1f3b6a2-5d0e-4f7a-8b39-2e6d4a9c1f05",
+			UserID:     fixtures.User1.ID,
+			LastSeenAt: time.Now(),
+		}
+		err = otherSession.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		accessToken, err := s.JWT.IssueAccessToken(fixtures.User1, fixtures.User1Session1.ID)
+		require.NoError(t, err)
+		otherAccessToken, err := s.JWT.IssueAccessToken(fixtures.User1, otherSession.ID)
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, otherAccessToken))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/auth/sessions/"+otherSession.ID, nil, test.HeadersWithAuth(t, accessToken))
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		// JWTs issued for the revoked session are rejected before they expire
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, otherAccessToken))
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, accessToken))
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// as are JWTs of deactivated users
+		fixtures.User1.IsActive = false
+		_, err = fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.IsActive))
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, accessToken))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+	})
+}
+
+func TestDeleteSessionOfOtherUser(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User2.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User2.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/sessions/"+fixtures.User1Session1.ID, nil, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrNotFoundSessionNotFound.Code, *response.Code)
+		assert.Equal(t, *httperrors.ErrNotFoundSessionNotFound.Type, *response.Type)
+		assert.Equal(t, *httperrors.ErrNotFoundSessionNotFound.Title, *response.Title)
+
+		err = fixtures.User1Session1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+		err = fixtures.User1RefreshToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDeleteSessionInvalidID(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "DELETE", "/api/v1/auth/sessions/not-a-uuid", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+	})
+}
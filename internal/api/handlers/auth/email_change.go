@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"path"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// sendEmailChange creates a new email change token for the given user and session (if any) and sends the confirmation
+// link pointing to the frontend's email change endpoint to the new username.
+func sendEmailChange(ctx context.Context, s *api.Server, exec boil.ContextExecutor, user *models.User, sessionID string, newUsername string) error {
+	emailChangeToken := models.EmailChangeToken{
+		UserID:      user.ID,
+		SessionID:   null.NewString(sessionID, len(sessionID) > 0),
+		NewUsername: newUsername,
+		ValidUntil:  time.Now().Add(s.Config.Auth.EmailChangeTokenValidity),
+	}
+
+	if err := emailChangeToken.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	link, err := frontendTokenLink(s.Config.Frontend.BaseURL, s.Config.Frontend.EmailChangeEndpoint, emailChangeToken.Token)
+	if err != nil {
+		return err
+	}
+
+	return s.Mailer.SendEmailChange(ctx, newUsername, link)
+}
+
+// sendEmailChanged creates a new email change revert token for the given user and notifies the old username
+// about the change, including the revert link pointing to the frontend's email change revert endpoint.
+func sendEmailChanged(ctx context.Context, s *api.Server, exec boil.ContextExecutor, user *models.User, oldUsername string) error {
+	emailChangeRevertToken := models.EmailChangeRevertToken{
+		UserID:      user.ID,
+		OldUsername: oldUsername,
+		ValidUntil:  time.Now().Add(s.Config.Auth.EmailChangeRevertValidity),
+	}
+
+	if err := emailChangeRevertToken.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	link, err := frontendTokenLink(s.Config.Frontend.BaseURL, s.Config.Frontend.EmailChangeRevertEndpoint, emailChangeRevertToken.Token)
+	if err != nil {
+		return err
+	}
+
+	return s.Mailer.SendEmailChanged(ctx, oldUsername, user.Username.String, link)
+}
+
+// frontendTokenLink returns the link to the given frontend endpoint, passing the token as query parameter.
+func frontendTokenLink(baseURL string, endpoint string, token string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	u.Path = path.Join(u.Path, endpoint)
+
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+// isUsernameUniqueViolation reports whether the given error was caused by the unique constraint on users' usernames,
+// as the username might be taken concurrently between checking for existing users and updating a user.
+func isUsernameUniqueViolation(err error) bool {
+	// 23505 == unique_violation
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "users_username_key"
+}
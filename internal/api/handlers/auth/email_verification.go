@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// sendEmailVerification creates a new email verification token for the given user and sends the
+// verification link pointing to the frontend's email verification endpoint to the user's username.
+func sendEmailVerification(ctx context.Context, s *api.Server, exec boil.ContextExecutor, user *models.User) error {
+	emailVerificationToken := models.EmailVerificationToken{
+		UserID:     user.ID,
+		ValidUntil: time.Now().Add(s.Config.Auth.EmailVerificationTokenValidity),
+	}
+
+	if err := emailVerificationToken.Insert(ctx, exec, boil.Infer()); err != nil {
+		return err
+	}
+
+	u, err := url.Parse(s.Config.Frontend.BaseURL)
+	if err != nil {
+		return err
+	}
+
+	u.Path = path.Join(u.Path, s.Config.Frontend.EmailVerificationEndpoint)
+
+	q := u.Query()
+	q.Set("token", emailVerificationToken.Token)
+	u.RawQuery = q.Encode()
+
+	return s.Mailer.SendEmailVerification(ctx, user.Username.String, u.String())
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/labstack/echo/v4"
+)
+
+const accountExportFileName = "account-export.json"
+
+func GetAccountExportRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/account/export", getAccountExportHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{S: s, Mode: middleware.AuthModeSecure}))
+}
+
+func getAccountExportHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		user := auth.UserFromEchoContext(c)
+
+		export, err := auth.ExportUserData(ctx, s.DB, user.ID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to export user data")
+			return err
+		}
+
+		response := &types.GetAccountExportResponse{
+			ExportedAt: conv.DateTime(strfmt.DateTime(time.Now())),
+			User:       export.User,
+			Tables:     make(map[string][]interface{}, len(export.Tables)),
+		}
+
+		for table, rows := range export.Tables {
+			items := make([]interface{}, 0, len(rows))
+			for _, row := range rows {
+				items = append(items, row)
+			}
+
+			response.Tables[table] = items
+		}
+
+		c.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=\""+accountExportFileName+"\"")
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,64 @@
+package auth_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAccountExportSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/account/export", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Contains(t, res.Header().Get("Content-Disposition"), "attachment")
+
+		var response types.GetAccountExportResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		user, ok := response.User.(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, fixtures.User1.ID, user[models.UserColumns.ID])
+		assert.Equal(t, fixtures.User1.Username.String, user[models.UserColumns.Username])
+		assert.NotContains(t, user, models.UserColumns.Password)
+
+		require.Contains(t, response.Tables, models.TableNames.AppUserProfiles)
+		require.Len(t, response.Tables[models.TableNames.AppUserProfiles], 1)
+
+		require.Contains(t, response.Tables, models.TableNames.PushTokens)
+		assert.Len(t, response.Tables[models.TableNames.PushTokens], 2)
+
+		require.Contains(t, response.Tables, models.TableNames.AccessTokens)
+		require.NotEmpty(t, response.Tables[models.TableNames.AccessTokens])
+		for _, row := range response.Tables[models.TableNames.AccessTokens] {
+			accessToken, ok := row.(map[string]interface{})
+			require.True(t, ok)
+			assert.Equal(t, fixtures.User1.ID, accessToken[models.AccessTokenColumns.UserID])
+			assert.NotContains(t, accessToken, models.AccessTokenColumns.Token)
+		}
+
+		// rows of other users must never be exported
+		require.Contains(t, response.Tables, models.TableNames.Sessions)
+		for _, row := range response.Tables[models.TableNames.Sessions] {
+			session, ok := row.(map[string]interface{})
+			require.True(t, ok)
+			assert.Equal(t, fixtures.User1.ID, session[models.SessionColumns.UserID])
+		}
+	})
+}
+
+func TestGetAccountExportUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/account/export", nil, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
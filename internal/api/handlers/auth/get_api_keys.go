@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func GetApiKeysRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/api-keys", getApiKeysHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{S: s, Mode: middleware.AuthModeSecure}))
+}
+
+func getApiKeysHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		user := auth.UserFromEchoContext(c)
+
+		apiKeys, err := user.APIKeys(qm.OrderBy(models.APIKeyColumns.CreatedAt+" DESC")).All(ctx, s.DB)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load API keys")
+			return err
+		}
+
+		response := &types.GetAPIKeysResponse{
+			APIKeys: make([]*types.APIKey, 0, len(apiKeys)),
+		}
+
+		for _, apiKey := range apiKeys {
+			response.APIKeys = append(response.APIKeys, apiKeyToType(apiKey))
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
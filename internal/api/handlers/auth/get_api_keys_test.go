@@ -0,0 +1,65 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func insertTestAPIKey(ctx context.Context, t *testing.T, s *api.Server, user *models.User, scopes []string) (*models.APIKey, string) {
+	t.Helper()
+
+	key, prefix, hash, err := auth.GenerateAPIKey()
+	require.NoError(t, err)
+
+	apiKey := &models.APIKey{
+		UserID:  user.ID,
+		Name:    "Test key",
+		Prefix:  prefix,
+		KeyHash: hash,
+		Scopes:  scopes,
+	}
+	err = apiKey.Insert(ctx, s.DB, boil.Infer())
+	require.NoError(t, err)
+
+	return apiKey, key
+}
+
+func TestGetApiKeysSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		apiKey, _ := insertTestAPIKey(ctx, t, s, fixtures.User1, fixtures.User1.Scopes)
+		_, _ = insertTestAPIKey(ctx, t, s, fixtures.User2, fixtures.User2.Scopes)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/api-keys", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetAPIKeysResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		require.Len(t, response.APIKeys, 1)
+		assert.Equal(t, apiKey.ID, response.APIKeys[0].ID.String())
+		assert.Equal(t, apiKey.Name, *response.APIKeys[0].Name)
+		assert.Equal(t, apiKey.Prefix, *response.APIKeys[0].Prefix)
+		assert.Equal(t, apiKey.Scopes, response.APIKeys[0].Scopes)
+		assert.Nil(t, response.APIKeys[0].ExpiresAt)
+	})
+}
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	authTypes "allaboutapps.dev/aw/go-starter/internal/types/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func GetLegalDocumentsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/legal-documents", getLegalDocumentsHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{S: s, Mode: middleware.AuthModeTry}))
+}
+
+func getLegalDocumentsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := authTypes.NewGetLegalDocumentsRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		locale := s.I18n.ParseAcceptLanguage(c.Request().Header.Get("Accept-Language")).String()
+		if params.Locale != nil && len(*params.Locale) > 0 {
+			locale = *params.Locale
+		}
+
+		documents, err := auth.CurrentLegalDocuments(ctx, s.DB, locale, s.Config.I18n.DefaultLanguage.String())
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load current legal documents")
+			return err
+		}
+
+		// the user's acceptances are only included if authenticated, documents count as accepted if the
+		// user has accepted the same or a newer version of the document type in any locale
+		var acceptances models.LegalAcceptanceSlice
+		if user := auth.UserFromEchoContext(c); user != nil {
+			acceptances, err = models.LegalAcceptances(
+				models.LegalAcceptanceWhere.UserID.EQ(user.ID),
+				qm.Load(models.LegalAcceptanceRels.LegalDocument),
+			).All(ctx, s.DB)
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to load legal acceptances")
+				return err
+			}
+		}
+
+		response := &types.GetLegalDocumentsResponse{
+			Documents: make([]*types.LegalDocument, 0, len(documents)),
+		}
+
+		for _, document := range documents {
+			doc := &types.LegalDocument{
+				ID:          conv.UUID4(strfmt.UUID4(document.ID)),
+				Type:        swag.String(document.Type),
+				Version:     swag.Int64(int64(document.Version)),
+				Locale:      swag.String(document.Locale),
+				URL:         swag.String(document.URL),
+				Mandatory:   swag.Bool(document.Mandatory),
+				PublishedAt: conv.DateTime(strfmt.DateTime(document.PublishedAt)),
+			}
+
+			var acceptedAt time.Time
+			for _, acceptance := range acceptances {
+				accepted := acceptance.R.LegalDocument
+				if accepted.Type == document.Type && accepted.Version >= document.Version && acceptance.AcceptedAt.After(acceptedAt) {
+					acceptedAt = acceptance.AcceptedAt
+				}
+			}
+
+			if !acceptedAt.IsZero() {
+				doc.AcceptedAt = conv.DateTime(strfmt.DateTime(acceptedAt))
+			}
+
+			response.Documents = append(response.Documents, doc)
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,128 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func insertLegalDocument(t *testing.T, s *api.Server, docType string, version int, locale string, mandatory bool, publishedAt time.Time) *models.LegalDocument {
+	t.Helper()
+
+	document := &models.LegalDocument{
+		Type:        docType,
+		Version:     version,
+		Locale:      locale,
+		URL:         "https://example.com/legal/" + docType + "/" + locale,
+		Mandatory:   mandatory,
+		PublishedAt: publishedAt,
+	}
+	err := document.Insert(context.Background(), s.DB, boil.Infer())
+	require.NoError(t, err)
+
+	return document
+}
+
+func TestGetLegalDocumentsSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		past := time.Now().Add(-time.Hour)
+
+		insertLegalDocument(t, s, "terms_of_service", 1, "en", true, past.Add(-time.Hour))
+		tosV2En := insertLegalDocument(t, s, "terms_of_service", 2, "en", true, past)
+		tosV2De := insertLegalDocument(t, s, "terms_of_service", 2, "de", true, past)
+		insertLegalDocument(t, s, "terms_of_service", 3, "en", true, time.Now().Add(time.Hour))
+		privacyV1En := insertLegalDocument(t, s, "privacy_policy", 1, "en", false, past)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/legal-documents", nil, nil)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetLegalDocumentsResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		require.Len(t, response.Documents, 2)
+		assert.Equal(t, privacyV1En.ID, response.Documents[0].ID.String())
+		assert.False(t, *response.Documents[0].Mandatory)
+		assert.Nil(t, response.Documents[0].AcceptedAt)
+		assert.Equal(t, tosV2En.ID, response.Documents[1].ID.String())
+		assert.Equal(t, int64(2), *response.Documents[1].Version)
+		assert.Equal(t, "en", *response.Documents[1].Locale)
+		assert.Equal(t, tosV2En.URL, *response.Documents[1].URL)
+
+		// the requested locale is preferred, falling back to the default language if unavailable
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/legal-documents?locale=de", nil, nil)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+
+		require.Len(t, response.Documents, 2)
+		assert.Equal(t, privacyV1En.ID, response.Documents[0].ID.String())
+		assert.Equal(t, tosV2De.ID, response.Documents[1].ID.String())
+	})
+}
+
+func TestGetLegalDocumentsAccepted(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		past := time.Now().Add(-time.Hour)
+
+		tosV1 := insertLegalDocument(t, s, "terms_of_service", 1, "en", true, past.Add(-time.Hour))
+		insertLegalDocument(t, s, "terms_of_service", 2, "en", true, past)
+		privacyV1De := insertLegalDocument(t, s, "privacy_policy", 1, "de", true, past)
+		insertLegalDocument(t, s, "privacy_policy", 1, "en", true, past)
+
+		acceptedAt := time.Now().Add(-time.Minute).Truncate(time.Millisecond)
+		for _, document := range []*models.LegalDocument{tosV1, privacyV1De} {
+			acceptance := models.LegalAcceptance{
+				UserID:          fixtures.User1.ID,
+				LegalDocumentID: document.ID,
+				AcceptedAt:      acceptedAt,
+			}
+			err := acceptance.Insert(ctx, s.DB, boil.Infer())
+			require.NoError(t, err)
+		}
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/legal-documents", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetLegalDocumentsResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		require.Len(t, response.Documents, 2)
+
+		// acceptances count regardless of the locale accepted
+		assert.Equal(t, "privacy_policy", *response.Documents[0].Type)
+		require.NotNil(t, response.Documents[0].AcceptedAt)
+		assert.WithinDuration(t, acceptedAt, time.Time(*response.Documents[0].AcceptedAt), time.Millisecond)
+
+		// a newer version of the terms of service has been published since the user's acceptance
+		assert.Equal(t, "terms_of_service", *response.Documents[1].Type)
+		assert.Nil(t, response.Documents[1].AcceptedAt)
+	})
+}
+
+func TestGetLegalDocumentsEmpty(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/legal-documents", nil, nil)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetLegalDocumentsResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Empty(t, response.Documents)
+	})
+}
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetProfileRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/profile", getProfileHandler(s))
+}
+
+func getProfileHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		user := auth.UserFromEchoContext(c)
+
+		profile, err := findProfile(ctx, s, user.ID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load profile")
+			return err
+		}
+
+		return returnProfile(c, profile)
+	}
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/storage"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetProfileAvatarRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/profile/avatar", getProfileAvatarHandler(s))
+}
+
+func getProfileAvatarHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		user := auth.UserFromEchoContext(c)
+
+		profile, err := findProfile(ctx, s, user.ID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load profile")
+			return err
+		}
+
+		if !profile.AvatarFileName.Valid {
+			log.Debug().Msg("User has not uploaded an avatar")
+			return httperrors.ErrNotFoundAvatarNotFound
+		}
+
+		blob, _, err := s.Blobstore.Get(ctx, profileAvatarKey(profile.AvatarFileName.String))
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				log.Error().Str("file_name", profile.AvatarFileName.String).Msg("Avatar file referenced by profile does not exist")
+				return httperrors.ErrNotFoundAvatarNotFound
+			}
+
+			log.Debug().Err(err).Msg("Failed to load avatar file")
+			return err
+		}
+		defer blob.Close()
+
+		return c.Stream(http.StatusOK, profile.AvatarMimeType.String, blob)
+	}
+}
@@ -0,0 +1,72 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProfileSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/profile", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.NotEmpty(t, res.Header().Get("ETag"))
+
+		var response types.Profile
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Nil(t, response.DisplayName)
+		assert.Nil(t, response.GivenName)
+		assert.Nil(t, response.FamilyName)
+		assert.Nil(t, response.Locale)
+		assert.False(t, *response.HasAvatar)
+		require.NotNil(t, response.LegalAcceptedAt)
+		assert.WithinDuration(t, fixtures.User1AppUserProfile.LegalAcceptedAt.Time, time.Time(*response.LegalAcceptedAt), time.Millisecond)
+
+		// the entity tag only changes once the profile has been modified
+		etag := res.Header().Get("ETag")
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/profile", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, etag, res.Header().Get("ETag"))
+	})
+}
+
+func TestGetProfileNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		_, err := models.AppUserProfiles(models.AppUserProfileWhere.UserID.EQ(fixtures.User1.ID)).DeleteAll(ctx, s.DB)
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/profile", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrNotFoundProfileNotFound.Type, *response.Type)
+	})
+}
+
+func TestGetProfileUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/profile", nil, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func GetSessionsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.GET("/sessions", getSessionsHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{S: s, Mode: middleware.AuthModeSecure}))
+}
+
+func getSessionsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		user := auth.UserFromEchoContext(c)
+
+		currentID := auth.SessionIDFromEchoContext(c)
+
+		sessions, err := user.Sessions(qm.OrderBy(models.SessionColumns.LastSeenAt+" DESC")).All(ctx, s.DB)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load sessions")
+			return err
+		}
+
+		response := &types.GetSessionsResponse{
+			Sessions: make([]*types.Session, 0, len(sessions)),
+		}
+
+		for _, session := range sessions {
+			response.Sessions = append(response.Sessions, &types.Session{
+				ID:         conv.UUID4(strfmt.UUID4(session.ID)),
+				Current:    swag.Bool(session.ID == currentID),
+				DeviceName: session.DeviceName.String,
+				UserAgent:  session.UserAgent.String,
+				IPAddress:  session.IPAddress.String,
+				LastSeenAt: conv.DateTime(strfmt.DateTime(session.LastSeenAt)),
+				CreatedAt:  conv.DateTime(strfmt.DateTime(session.CreatedAt)),
+			})
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
@@ -0,0 +1,74 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestGetSessionsSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		otherSession := models.Session{
+			ID:         "c1f3b6a2-5d0e-4f7a-8b39-2e6d4a9c1f05",
+			UserID:     fixtures.User1.ID,
+			LastSeenAt: time.Now().Add(time.Hour * -24),
+		}
+		err = otherSession.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/sessions", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetSessionsResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		require.Len(t, response.Sessions, 2)
+
+		assert.Equal(t, fixtures.User1Session1.ID, response.Sessions[0].ID.String())
+		assert.True(t, *response.Sessions[0].Current)
+		assert.Equal(t, fixtures.User1Session1.DeviceName.String, response.Sessions[0].DeviceName)
+		assert.Equal(t, fixtures.User1Session1.UserAgent.String, response.Sessions[0].UserAgent)
+		assert.Equal(t, fixtures.User1Session1.IPAddress.String, response.Sessions[0].IPAddress)
+
+		assert.Equal(t, otherSession.ID, response.Sessions[1].ID.String())
+		assert.False(t, *response.Sessions[1].Current)
+		assert.Empty(t, response.Sessions[1].DeviceName)
+	})
+}
+
+func TestGetSessionsLastAuthenticatedAtExceeded(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/sessions", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *middleware.ErrUnauthorizedLastAuthenticatedAtExceeded.Code, *response.Code)
+		assert.Equal(t, *middleware.ErrUnauthorizedLastAuthenticatedAtExceeded.Type, *response.Type)
+		assert.Equal(t, *middleware.ErrUnauthorizedLastAuthenticatedAtExceeded.Title, *response.Title)
+	})
+}
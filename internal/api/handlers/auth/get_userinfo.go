@@ -7,6 +7,7 @@ import (
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/types"
 	"allaboutapps.dev/aw/go-starter/internal/util"
 	"github.com/go-openapi/strfmt"
@@ -21,18 +22,26 @@ func GetUserInfoRoute(s *api.Server) *echo.Route {
 func getUserInfoHandler(s *api.Server) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := c.Request().Context()
-		user := auth.UserFromContext(ctx)
 		log := util.LogFromContext(ctx)
 
+		// JWT access tokens only carry a subset of the user's data, load the stored record.
+		// Scopes are taken from the authenticated user, as tokens issued to OAuth clients are restricted to the scopes granted.
+		authenticatedUser := auth.UserFromContext(ctx)
+		user, err := models.FindUser(ctx, s.DB, authenticatedUser.ID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load user")
+			return err
+		}
+
 		response := &types.GetUserInfoResponse{
-			Sub:       swag.String(user.ID),
-			UpdatedAt: swag.Int64(user.UpdatedAt.Unix()),
-			Email:     strfmt.Email(user.Username.String),
-			Scopes:    user.Scopes,
+			Sub:           swag.String(user.ID),
+			UpdatedAt:     swag.Int64(user.UpdatedAt.Unix()),
+			Email:         strfmt.Email(user.Username.String),
+			EmailVerified: user.EmailVerifiedAt.Valid,
+			Scopes:        authenticatedUser.Scopes,
 		}
 
 		// if this user has an appUserProfile attached, add additional / modify props from there
-		var err error
 		appUserProfile, err := user.AppUserProfile().One(ctx, s.DB)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
@@ -43,6 +52,11 @@ func getUserInfoHandler(s *api.Server) echo.HandlerFunc {
 			return err
 		}
 
+		response.Name = appUserProfile.DisplayName.String
+		response.GivenName = appUserProfile.GivenName.String
+		response.FamilyName = appUserProfile.FamilyName.String
+		response.Locale = appUserProfile.Locale.String
+
 		if appUserProfile.UpdatedAt.After(user.UpdatedAt) {
 			response.UpdatedAt = swag.Int64(appUserProfile.UpdatedAt.Unix())
 		}
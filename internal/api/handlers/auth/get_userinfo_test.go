@@ -27,6 +27,7 @@ func TestGetUserInfo(t *testing.T) {
 
 		assert.Equal(t, fixtures.User1.ID, *response.Sub)
 		assert.Equal(t, strfmt.Email(fixtures.User1.Username.String), response.Email)
+		assert.True(t, response.EmailVerified)
 		test.Snapshoter.Skip([]string{"UpdatedAt"}).Save(t, response)
 
 		for _, scope := range fixtures.User1.Scopes {
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/lockout"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// checkLockout returns an error carrying a Retry-After header if attempts of the given scope are currently
+// blocked for the given username or the client's IP due to previous failures.
+func checkLockout(c echo.Context, s *api.Server, scope lockout.Scope, username string) error {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	retryAfter, err := s.Lockout.Check(ctx, scope, username, c.RealIP())
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to check for auth lockout")
+		return err
+	}
+
+	if retryAfter > 0 {
+		log.Debug().Str("scope", scope.String()).Dur("retry_after", retryAfter).Msg("Too many failed attempts, rejecting request")
+		return httperrors.NewHTTPErrorTooManyAttempts(retryAfter)
+	}
+
+	return nil
+}
+
+// registerFailedAttempt records a failed attempt of the given scope for the given username and the client's IP.
+// Failing to record the attempt is only logged, as the request is rejected anyway.
+func registerFailedAttempt(c echo.Context, s *api.Server, scope lockout.Scope, username string) {
+	ctx := c.Request().Context()
+
+	if err := s.Lockout.RegisterFailure(ctx, scope, username, c.RealIP()); err != nil {
+		util.LogFromContext(ctx).Error().Err(err).Str("scope", scope.String()).Msg("Failed to register failed attempt")
+	}
+}
@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	// recoveryCodeCount is the number of recovery codes generated when enabling two-factor authentication
+	recoveryCodeCount = 10
+	// recoveryCodeGroups and recoveryCodeGroupLength define the format of recovery codes, e.g. 7k2m-9xqa-p4ve-w8tz
+	recoveryCodeGroups      = 4
+	recoveryCodeGroupLength = 4
+	// maxMfaChallengeFailedAttempts is the number of invalid codes accepted before a MFA challenge is invalidated
+	maxMfaChallengeFailedAttempts = 5
+)
+
+// isMfaEnabled reports whether the given user has enabled two-factor authentication, requiring logins
+// to be completed with a second factor.
+func isMfaEnabled(ctx context.Context, exec boil.ContextExecutor, user *models.User) (bool, error) {
+	return user.TotpCredential(models.TotpCredentialWhere.ConfirmedAt.IsNotNull()).Exists(ctx, exec)
+}
+
+// startMfaChallenge inserts a new MFA challenge for the given user, returning the MFA token the
+// login has to be completed with using /api/v1/auth/login/mfa.
+func startMfaChallenge(ctx context.Context, s *api.Server, exec boil.ContextExecutor, user *models.User, deviceName string) (*types.PostLoginMfaRequiredResponse, error) {
+	challenge := models.MfaChallenge{
+		ValidUntil: time.Now().Add(s.Config.Auth.MFAChallengeValidity),
+		UserID:     user.ID,
+		DeviceName: null.NewString(deviceName, len(deviceName) > 0),
+	}
+
+	if err := challenge.Insert(ctx, exec, boil.Infer()); err != nil {
+		return nil, err
+	}
+
+	return &types.PostLoginMfaRequiredResponse{
+		MfaToken:  conv.UUID4(strfmt.UUID4(challenge.Token)),
+		MfaType:   swag.String(MfaTypeTotp),
+		ExpiresIn: swag.Int64(int64(s.Config.Auth.MFAChallengeValidity.Seconds())),
+	}, nil
+}
+
+// replaceRecoveryCodes deletes all existing recovery codes of the given user and generates a new set,
+// storing only their hashes. The plain recovery codes are returned and cannot be retrieved afterwards.
+func replaceRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, userID string) ([]string, error) {
+	if _, err := models.RecoveryCodes(models.RecoveryCodeWhere.UserID.EQ(userID)).DeleteAll(ctx, exec); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		raw, err := util.GenerateRandomString(recoveryCodeGroups*recoveryCodeGroupLength, []util.CharRange{util.CharRangeNumeric, util.CharRangeAlphaLowerCase}, "")
+		if err != nil {
+			return nil, err
+		}
+
+		groups := make([]string, 0, recoveryCodeGroups)
+		for j := 0; j < len(raw); j += recoveryCodeGroupLength {
+			groups = append(groups, raw[j:j+recoveryCodeGroupLength])
+		}
+
+		recoveryCode := models.RecoveryCode{
+			UserID:   userID,
+			CodeHash: hashRecoveryCode(raw),
+		}
+
+		if err := recoveryCode.Insert(ctx, exec, boil.Infer()); err != nil {
+			return nil, err
+		}
+
+		codes = append(codes, strings.Join(groups, "-"))
+	}
+
+	return codes, nil
+}
+
+// hashRecoveryCode returns the hex encoded SHA-256 hash of the given recovery code after normalizing it,
+// so codes are accepted regardless of casing and separators. As recovery codes are generated with
+// sufficient entropy, a fast hash without salt is adequate and allows looking codes up by their hash.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util/hashing"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var (
+	errOAuthClientInvalid = errors.New("OAuth client authentication failed")
+
+	// pkceCodeVerifierRegexp matches valid PKCE code verifiers as specified by RFC 7636 section 4.1
+	pkceCodeVerifierRegexp = regexp.MustCompile(`^[A-Za-z0-9\-._~]{43,128}$`)
+)
+
+// oauthError responds with an OAuth 2.0 error as specified by RFC 6749 section 5.2, as clients of our
+// token endpoint expect its standardized format instead of our usual HTTP errors.
+func oauthError(c echo.Context, code int, errorCode string, description string) error {
+	if code == http.StatusUnauthorized {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="oauth"`)
+	}
+
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+	return c.JSON(code, &types.OauthErrorResponse{
+		Error:            swag.String(errorCode),
+		ErrorDescription: description,
+	})
+}
+
+// findOAuthClient returns the OAuth client with the given ID, returning sql.ErrNoRows for malformed IDs as well.
+func findOAuthClient(ctx context.Context, exec boil.ContextExecutor, clientID string) (*models.OauthClient, error) {
+	if !strfmt.IsUUID(clientID) {
+		return nil, sql.ErrNoRows
+	}
+
+	return models.FindOauthClient(ctx, exec, clientID)
+}
+
+// authenticateOAuthClient authenticates the client of a token request using either HTTP Basic authentication or
+// the client_id and client_secret parameters. Public clients only provide their client ID, while confidential
+// clients must provide their secret as well.
+// Returns errOAuthClientInvalid if the client is unknown or could not be authenticated.
+func authenticateOAuthClient(ctx context.Context, exec boil.ContextExecutor, c echo.Context, clientID string, clientSecret string) (*models.OauthClient, error) {
+	if id, secret, ok := c.Request().BasicAuth(); ok {
+		clientID = id
+		clientSecret = secret
+	}
+
+	if len(clientID) == 0 {
+		return nil, errOAuthClientInvalid
+	}
+
+	client, err := findOAuthClient(ctx, exec, clientID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errOAuthClientInvalid
+		}
+
+		return nil, err
+	}
+
+	if !client.SecretHash.Valid {
+		// Public clients cannot keep a secret, so providing one is most likely a misconfiguration
+		if len(clientSecret) > 0 {
+			return nil, errOAuthClientInvalid
+		}
+
+		return client, nil
+	}
+
+	if len(clientSecret) == 0 {
+		return nil, errOAuthClientInvalid
+	}
+
+	match, err := hashing.ComparePasswordAndHash(clientSecret, client.SecretHash.String)
+	if err != nil {
+		return nil, err
+	}
+
+	if !match {
+		return nil, errOAuthClientInvalid
+	}
+
+	return client, nil
+}
+
+// oauthClientHasGrantType reports whether the given client is allowed to use the given grant type.
+func oauthClientHasGrantType(client *models.OauthClient, grantType auth.OAuthGrantType) bool {
+	for _, g := range client.GrantTypes {
+		if g == grantType.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// oauthClientHasRedirectURI reports whether the given redirect URI has been registered for the given client.
+// Redirect URIs are compared using simple string comparison as required by RFC 6749 section 3.1.2.
+func oauthClientHasRedirectURI(client *models.OauthClient, redirectURI string) bool {
+	for _, uri := range client.RedirectUris {
+		if uri == redirectURI {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseOAuthScopes returns the scopes requested by the given space separated scope parameter as well as whether
+// the client registered for all of them. If no scopes were requested, all scopes of the client are returned.
+func parseOAuthScopes(client *models.OauthClient, scope string) ([]string, bool) {
+	requested := strings.Fields(scope)
+	if len(requested) == 0 {
+		return client.Scopes, true
+	}
+
+	scopes := make([]string, 0, len(requested))
+	for _, r := range requested {
+		found := false
+		for _, s := range client.Scopes {
+			if r == s {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, false
+		}
+
+		scopes = append(scopes, r)
+	}
+
+	return auth.IntersectScopes(client.Scopes, scopes), true
+}
+
+// verifyPKCE reports whether the given code verifier matches the S256 code challenge of the authorization request.
+func verifyPKCE(codeVerifier string, codeChallenge string) bool {
+	if !pkceCodeVerifierRegexp.MatchString(codeVerifier) {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(codeVerifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(codeChallenge)) == 1
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+)
+
+func PatchProfileRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.PATCH("/profile", patchProfileHandler(s))
+}
+
+func patchProfileHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PatchProfilePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		user := auth.UserFromEchoContext(c)
+
+		profile, err := updateProfile(c, s, user.ID, func(profile *models.AppUserProfile) []string {
+			columns := []string{}
+
+			if body.DisplayName.Present {
+				profile.DisplayName = null.StringFromPtr(body.DisplayName.Ptr())
+				columns = append(columns, models.AppUserProfileColumns.DisplayName)
+			}
+			if body.GivenName.Present {
+				profile.GivenName = null.StringFromPtr(body.GivenName.Ptr())
+				columns = append(columns, models.AppUserProfileColumns.GivenName)
+			}
+			if body.FamilyName.Present {
+				profile.FamilyName = null.StringFromPtr(body.FamilyName.Ptr())
+				columns = append(columns, models.AppUserProfileColumns.FamilyName)
+			}
+			if body.Locale.Present {
+				profile.Locale = null.StringFromPtr(body.Locale.Ptr())
+				columns = append(columns, models.AppUserProfileColumns.Locale)
+			}
+
+			return columns
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to patch profile")
+			return err
+		}
+
+		log.Debug().Msg("Successfully patched profile")
+
+		return returnProfile(c, profile)
+	}
+}
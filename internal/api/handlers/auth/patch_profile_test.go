@@ -0,0 +1,159 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchProfileSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"display_name": "Max",
+			"given_name":   "Max",
+			"family_name":  "Mustermann",
+		}
+
+		headers := test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token)
+		headers.Set("If-Match", "*")
+
+		res := test.PerformRequest(t, s, "PATCH", "/api/v1/auth/profile", payload, headers)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.Profile
+		test.ParseResponseAndValidate(t, res, &response)
+
+		require.NotNil(t, response.DisplayName)
+		assert.Equal(t, "Max", *response.DisplayName)
+		require.NotNil(t, response.GivenName)
+		assert.Equal(t, "Max", *response.GivenName)
+		require.NotNil(t, response.FamilyName)
+		assert.Equal(t, "Mustermann", *response.FamilyName)
+		assert.Nil(t, response.Locale)
+
+		// fields explicitly set to null are cleared, fields omitted are left unchanged
+		payload = test.GenericPayload{
+			"display_name": nil,
+			"locale":       "de",
+		}
+
+		headers.Set("If-Match", res.Header().Get("ETag"))
+		res = test.PerformRequest(t, s, "PATCH", "/api/v1/auth/profile", payload, headers)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Nil(t, response.DisplayName)
+		require.NotNil(t, response.GivenName)
+		assert.Equal(t, "Max", *response.GivenName)
+		require.NotNil(t, response.Locale)
+		assert.Equal(t, "de", *response.Locale)
+
+		profile, err := models.FindAppUserProfile(ctx, s.DB, fixtures.User1.ID)
+		require.NoError(t, err)
+		assert.False(t, profile.DisplayName.Valid)
+		assert.Equal(t, "Mustermann", profile.FamilyName.String)
+		assert.Equal(t, "de", profile.Locale.String)
+
+		// the entity tag returned matches the one of the stored profile
+		etag := res.Header().Get("ETag")
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/profile", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, etag, res.Header().Get("ETag"))
+	})
+}
+
+func TestPatchProfileIfMatch(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/profile", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		etag := res.Header().Get("ETag")
+		require.NotEmpty(t, etag)
+
+		headers := test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token)
+		headers.Set("If-Match", etag)
+
+		// first device updates the profile using the entity tag retrieved
+		res = test.PerformRequest(t, s, "PATCH", "/api/v1/auth/profile", test.GenericPayload{"display_name": "Max"}, headers)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.NotEqual(t, etag, res.Header().Get("ETag"))
+
+		// second device still holds the previous entity tag and must not overwrite the first device's changes
+		res = test.PerformRequest(t, s, "PATCH", "/api/v1/auth/profile", test.GenericPayload{"display_name": "Moritz"}, headers)
+
+		assert.Equal(t, http.StatusPreconditionFailed, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrPreconditionFailedProfileModified.Type, *response.Type)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/profile", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var profile types.Profile
+		test.ParseResponseAndValidate(t, res, &profile)
+		require.NotNil(t, profile.DisplayName)
+		assert.Equal(t, "Max", *profile.DisplayName)
+	})
+}
+
+func TestPatchProfileIfMatchMissing(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		res := test.PerformRequest(t, s, "PATCH", "/api/v1/auth/profile", test.GenericPayload{"display_name": "Max"}, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusPreconditionRequired, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrPreconditionRequiredIfMatchMissing.Type, *response.Type)
+
+		profile, err := models.FindAppUserProfile(ctx, s.DB, fixtures.User1.ID)
+		require.NoError(t, err)
+		assert.False(t, profile.DisplayName.Valid)
+	})
+}
+
+func TestPatchProfileNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		_, err := models.AppUserProfiles(models.AppUserProfileWhere.UserID.EQ(fixtures.User1.ID)).DeleteAll(ctx, s.DB)
+		require.NoError(t, err)
+
+		headers := test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token)
+		headers.Set("If-Match", "*")
+
+		res := test.PerformRequest(t, s, "PATCH", "/api/v1/auth/profile", test.GenericPayload{"display_name": "Max"}, headers)
+
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrNotFoundProfileNotFound.Type, *response.Type)
+	})
+}
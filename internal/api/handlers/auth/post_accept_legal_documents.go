@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostAcceptLegalDocumentsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/legal-documents/accept", postAcceptLegalDocumentsHandler(s))
+}
+
+func postAcceptLegalDocumentsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostAcceptLegalDocumentsPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		user := auth.UserFromEchoContext(c)
+
+		documentIDs := make([]string, 0, len(body.DocumentIds))
+		for _, id := range body.DocumentIds {
+			if !util.ContainsString(documentIDs, id.String()) {
+				documentIDs = append(documentIDs, id.String())
+			}
+		}
+
+		// unpublished documents cannot be accepted yet
+		documents, err := models.LegalDocuments(
+			models.LegalDocumentWhere.ID.IN(documentIDs),
+			models.LegalDocumentWhere.PublishedAt.LTE(time.Now()),
+		).All(ctx, s.DB)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load legal documents")
+			return err
+		}
+
+		if len(documents) != len(documentIDs) {
+			log.Debug().Strs("document_ids", documentIDs).Int("found", len(documents)).Msg("Legal documents not found")
+			return httperrors.ErrNotFoundLegalDocumentNotFound
+		}
+
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			return auth.AcceptLegalDocuments(ctx, tx, user.ID, documents)
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to accept legal documents")
+			return err
+		}
+
+		log.Debug().Strs("document_ids", documentIDs).Msg("Successfully accepted legal documents")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,117 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostAcceptLegalDocumentsSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		tos := insertLegalDocument(t, s, "terms_of_service", 1, "en", true, time.Now().Add(-time.Hour))
+		privacy := insertLegalDocument(t, s, "privacy_policy", 1, "en", true, time.Now().Add(-time.Hour))
+
+		pending, err := auth.PendingLegalDocumentTypes(ctx, s.DB, fixtures.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"privacy_policy", "terms_of_service"}, pending)
+
+		payload := test.GenericPayload{
+			"document_ids": []string{tos.ID, privacy.ID, tos.ID},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/legal-documents/accept", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		acceptances, err := models.LegalAcceptances(models.LegalAcceptanceWhere.UserID.EQ(fixtures.User1.ID)).All(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Len(t, acceptances, 2)
+
+		pending, err = auth.PendingLegalDocumentTypes(ctx, s.DB, fixtures.User1.ID)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+
+		err = fixtures.User1AppUserProfile.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), fixtures.User1AppUserProfile.LegalAcceptedAt.Time, time.Minute)
+
+		// accepting documents again keeps the original acceptance
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/legal-documents/accept", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		cnt, err := models.LegalAcceptances(models.LegalAcceptanceWhere.UserID.EQ(fixtures.User1.ID)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), cnt)
+
+		// publishing a new mandatory version requires re-acceptance, while optional versions do not
+		insertLegalDocument(t, s, "privacy_policy", 2, "en", false, time.Now().Add(-time.Minute))
+		insertLegalDocument(t, s, "terms_of_service", 2, "de", true, time.Now().Add(-time.Minute))
+
+		pending, err = auth.PendingLegalDocumentTypes(ctx, s.DB, fixtures.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"terms_of_service"}, pending)
+	})
+}
+
+func TestPostAcceptLegalDocumentsNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		tos := insertLegalDocument(t, s, "terms_of_service", 1, "en", true, time.Now().Add(-time.Hour))
+		unpublished := insertLegalDocument(t, s, "terms_of_service", 2, "en", true, time.Now().Add(time.Hour))
+
+		tests := []struct {
+			name        string
+			documentIDs []string
+		}{
+			{name: "Unknown", documentIDs: []string{tos.ID, "a3e5c8d1-2b4f-4e6a-9c7d-0f1e2d3c4b5a"}},
+			{name: "Unpublished", documentIDs: []string{unpublished.ID}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				payload := test.GenericPayload{
+					"document_ids": tt.documentIDs,
+				}
+
+				res := test.PerformRequest(t, s, "POST", "/api/v1/auth/legal-documents/accept", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+				assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+				var response httperrors.HTTPError
+				test.ParseResponseAndValidate(t, res, &response)
+				assert.Equal(t, *httperrors.ErrNotFoundLegalDocumentNotFound.Type, *response.Type)
+			})
+		}
+
+		cnt, err := models.LegalAcceptances().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+	})
+}
+
+func TestPostAcceptLegalDocumentsUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		payload := test.GenericPayload{
+			"document_ids": []string{"a3e5c8d1-2b4f-4e6a-9c7d-0f1e2d3c4b5a"},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/legal-documents/accept", payload, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"allaboutapps.dev/aw/go-starter/internal/util/hashing"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostChangeEmailRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/change-email", postChangeEmailHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{S: s, Mode: middleware.AuthModeSecure}))
+}
+
+func postChangeEmailHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostChangeEmailPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		// JWT access tokens only carry a subset of the user's data, load the stored record including the password hash
+		user, err := models.FindUser(ctx, s.DB, auth.UserFromEchoContext(c).ID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load user")
+			return err
+		}
+
+		if !user.Password.Valid {
+			log.Debug().Msg("User is missing password, forbidding email change")
+			return httperrors.ErrForbiddenNotLocalUser
+		}
+
+		match, err := hashing.ComparePasswordAndHash(*body.CurrentPassword, user.Password.String)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to compare password with stored hash")
+			return err
+		}
+
+		if !match {
+			log.Debug().Msg("Provided password does not match stored hash")
+			return echo.ErrUnauthorized
+		}
+
+		// enforce lowercase usernames, trim whitespaces
+		username := util.ToUsernameFormat(body.Username.String())
+
+		exists, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom(username))).Exists(ctx, s.DB)
+		if err != nil {
+			log.Debug().Err(err).Str("username", username).Msg("Failed to check whether user exists")
+			return err
+		}
+
+		if exists {
+			log.Debug().Str("username", username).Msg("User with given username already exists")
+			return httperrors.ErrConflictUserAlreadyExists
+		}
+
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			// only the most recently requested email change can be confirmed
+			if _, err := user.EmailChangeTokens().DeleteAll(ctx, tx); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete existing email change tokens")
+				return err
+			}
+
+			return sendEmailChange(ctx, s, tx, user, auth.SessionIDFromEchoContext(c), username)
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to request email change")
+			return err
+		}
+
+		log.Debug().Msg("Successfully requested email change")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func PostChangeEmailConfirmRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/change-email/confirm", postChangeEmailConfirmHandler(s))
+}
+
+func postChangeEmailConfirmHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostChangeEmailConfirmPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		emailChangeToken, err := models.EmailChangeTokens(
+			models.EmailChangeTokenWhere.Token.EQ(body.Token.String()),
+			qm.Load(models.EmailChangeTokenRels.User),
+		).One(ctx, s.DB)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("Email change token not found")
+				return httperrors.ErrNotFoundTokenNotFound
+			}
+
+			log.Debug().Err(err).Msg("Failed to load email change token")
+			return err
+		}
+
+		user := emailChangeToken.R.User
+
+		if time.Now().After(emailChangeToken.ValidUntil) {
+			log.Debug().
+				Str("user_id", user.ID).
+				Time("valid_until", emailChangeToken.ValidUntil).
+				Msg("Email change token is no longer valid, rejecting email change")
+			return httperrors.ErrConflictTokenExpired
+		}
+
+		if !user.IsActive {
+			log.Debug().Str("user_id", user.ID).Msg("User is deactivated, rejecting email change")
+			return middleware.ErrForbiddenUserDeactivated
+		}
+
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			oldUsername := user.Username.String
+
+			// the new username might have been taken since the email change was requested
+			exists, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom(emailChangeToken.NewUsername))).Exists(ctx, tx)
+			if err != nil {
+				log.Debug().Err(err).Str("username", emailChangeToken.NewUsername).Msg("Failed to check whether user exists")
+				return err
+			}
+
+			if exists {
+				log.Debug().Str("username", emailChangeToken.NewUsername).Msg("User with given username already exists")
+				return httperrors.ErrConflictUserAlreadyExists
+			}
+
+			// confirming the link proves ownership of the new email address
+			user.Username = null.StringFrom(emailChangeToken.NewUsername)
+			user.EmailVerifiedAt = null.TimeFrom(time.Now())
+			if _, err := user.Update(ctx, tx, boil.Whitelist(models.UserColumns.Username, models.UserColumns.EmailVerifiedAt, models.UserColumns.UpdatedAt)); err != nil {
+				// the username might have been taken concurrently after checking above
+				if isUsernameUniqueViolation(err) {
+					log.Debug().Err(err).Str("username", emailChangeToken.NewUsername).Msg("User with given username already exists")
+					return httperrors.ErrConflictUserAlreadyExists
+				}
+
+				log.Debug().Err(err).Msg("Failed to update user's username")
+				return err
+			}
+
+			if _, err := user.EmailChangeTokens().DeleteAll(ctx, tx); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete email change tokens")
+				return err
+			}
+
+			if _, err := user.EmailVerificationTokens().DeleteAll(ctx, tx); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete email verification tokens")
+				return err
+			}
+
+			// sign the user out everywhere except the session the email change was requested from, like changing the password does
+			if err := deleteOtherSessions(ctx, tx, user.ID, emailChangeToken.SessionID.String); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete other sessions")
+				return err
+			}
+
+			return sendEmailChanged(ctx, s, tx, user, oldUsername)
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to confirm email change")
+			return err
+		}
+
+		log.Debug().Str("user_id", user.ID).Msg("Successfully changed email address")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,213 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestPostChangeEmailConfirmSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		emailChangeToken := models.EmailChangeToken{
+			UserID:      fixtures.User1.ID,
+			SessionID:   null.StringFrom(fixtures.User1Session1.ID),
+			NewUsername: "new@example.com",
+			ValidUntil:  time.Now().Add(time.Hour),
+		}
+		err := emailChangeToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		otherSession := models.Session{
+			ID:         "c1f3b6a2-5d0e-4f7a-8b39-2e6d4a9c1f05",
+			UserID:     fixtures.User1.ID,
+			LastSeenAt: time.Now(),
+		}
+		err = otherSession.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		otherRefreshToken := models.RefreshToken{
+			UserID:   fixtures.User1.ID,
+			FamilyID: otherSession.ID,
+		}
+		err = otherRefreshToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		otherAccessToken := models.AccessToken{
+			ValidUntil:           time.Now().Add(time.Hour),
+			UserID:               fixtures.User1.ID,
+			RefreshTokenFamilyID: null.StringFrom(otherSession.ID),
+		}
+		err = otherAccessToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"token": emailChangeToken.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/confirm", payload, nil)
+
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err = fixtures.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, "new@example.com", fixtures.User1.Username.String)
+		assert.True(t, fixtures.User1.EmailVerifiedAt.Valid)
+
+		err = emailChangeToken.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+
+		emailChangeRevertToken, err := fixtures.User1.EmailChangeRevertTokens().One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, "user1@example.com", emailChangeRevertToken.OldUsername)
+		assert.WithinDuration(t, time.Now().Add(s.Config.Auth.EmailChangeRevertValidity), emailChangeRevertToken.ValidUntil, time.Second*10)
+
+		mail := getLastSentMail(t, s.Mailer)
+		require.NotNil(t, mail)
+		assert.Equal(t, "user1@example.com", mail.To[0])
+		assert.Contains(t, string(mail.HTML), "new@example.com")
+		assert.Contains(t, string(mail.HTML), fmt.Sprintf("http://localhost:3000/change-email/revert?token=%s", emailChangeRevertToken.Token))
+
+		// the session the change was requested from remains signed in
+		err = fixtures.User1AccessToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+		err = fixtures.User1RefreshToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+		err = fixtures.User1Session1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+
+		// while all other sessions are signed out
+		err = otherSession.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = otherRefreshToken.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = otherAccessToken.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+}
+
+func TestPostChangeEmailConfirmWithoutSession(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		// the session the change was requested from might have been signed out since
+		emailChangeToken := models.EmailChangeToken{
+			UserID:      fixtures.User1.ID,
+			NewUsername: "new@example.com",
+			ValidUntil:  time.Now().Add(time.Hour),
+		}
+		err := emailChangeToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"token": emailChangeToken.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/confirm", payload, nil)
+
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err = fixtures.User1AccessToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1RefreshToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1Session1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+
+		// other users are not affected
+		err = fixtures.User2AccessToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+	})
+}
+
+func TestPostChangeEmailConfirmTokenExpired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		emailChangeToken := models.EmailChangeToken{
+			UserID:      fixtures.User1.ID,
+			NewUsername: "new@example.com",
+			ValidUntil:  time.Now().Add(-time.Minute),
+		}
+		err := emailChangeToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"token": emailChangeToken.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/confirm", payload, nil)
+
+		assert.Equal(t, http.StatusConflict, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrConflictTokenExpired.Type, *response.Type)
+
+		err = fixtures.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, "user1@example.com", fixtures.User1.Username.String)
+	})
+}
+
+func TestPostChangeEmailConfirmUsernameTaken(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		emailChangeToken := models.EmailChangeToken{
+			UserID:      fixtures.User1.ID,
+			NewUsername: fixtures.User2.Username.String,
+			ValidUntil:  time.Now().Add(time.Hour),
+		}
+		err := emailChangeToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"token": emailChangeToken.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/confirm", payload, nil)
+
+		assert.Equal(t, http.StatusConflict, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrConflictUserAlreadyExists.Type, *response.Type)
+	})
+}
+
+func TestPostChangeEmailConfirmTokenNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		payload := test.GenericPayload{
+			"token": "3b9e1f5c-2a7d-4c8e-9f60-1d4b7a2e8c35",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/confirm", payload, nil)
+
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrNotFoundTokenNotFound.Type, *response.Type)
+	})
+}
@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func PostChangeEmailRevertRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/change-email/revert", postChangeEmailRevertHandler(s))
+}
+
+func postChangeEmailRevertHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostChangeEmailRevertPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		emailChangeRevertToken, err := models.EmailChangeRevertTokens(
+			models.EmailChangeRevertTokenWhere.Token.EQ(body.Token.String()),
+			qm.Load(models.EmailChangeRevertTokenRels.User),
+		).One(ctx, s.DB)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("Email change revert token not found")
+				return httperrors.ErrNotFoundTokenNotFound
+			}
+
+			log.Debug().Err(err).Msg("Failed to load email change revert token")
+			return err
+		}
+
+		user := emailChangeRevertToken.R.User
+
+		if time.Now().After(emailChangeRevertToken.ValidUntil) {
+			log.Debug().
+				Str("user_id", user.ID).
+				Time("valid_until", emailChangeRevertToken.ValidUntil).
+				Msg("Email change revert token is no longer valid, rejecting email change revert")
+			return httperrors.ErrConflictTokenExpired
+		}
+
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			// the old username might have been taken since the email change was confirmed
+			exists, err := models.Users(
+				models.UserWhere.Username.EQ(null.StringFrom(emailChangeRevertToken.OldUsername)),
+				models.UserWhere.ID.NEQ(user.ID),
+			).Exists(ctx, tx)
+			if err != nil {
+				log.Debug().Err(err).Str("username", emailChangeRevertToken.OldUsername).Msg("Failed to check whether user exists")
+				return err
+			}
+
+			if exists {
+				log.Debug().Str("username", emailChangeRevertToken.OldUsername).Msg("User with given username already exists")
+				return httperrors.ErrConflictUserAlreadyExists
+			}
+
+			// receiving the link proves ownership of the old email address
+			user.Username = null.StringFrom(emailChangeRevertToken.OldUsername)
+			user.EmailVerifiedAt = null.TimeFrom(time.Now())
+			if _, err := user.Update(ctx, tx, boil.Whitelist(models.UserColumns.Username, models.UserColumns.EmailVerifiedAt, models.UserColumns.UpdatedAt)); err != nil {
+				// the username might have been taken concurrently after checking above
+				if isUsernameUniqueViolation(err) {
+					log.Debug().Err(err).Str("username", emailChangeRevertToken.OldUsername).Msg("User with given username already exists")
+					return httperrors.ErrConflictUserAlreadyExists
+				}
+
+				log.Debug().Err(err).Msg("Failed to update user's username")
+				return err
+			}
+
+			if _, err := user.EmailChangeRevertTokens().DeleteAll(ctx, tx); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete email change revert tokens")
+				return err
+			}
+
+			if _, err := user.EmailChangeTokens().DeleteAll(ctx, tx); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete email change tokens")
+				return err
+			}
+
+			// the email change might not have been authorized by the user, sign them out everywhere
+			return auth.RevokeUserTokens(ctx, tx, user.ID)
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to revert email change")
+			return err
+		}
+
+		log.Debug().Str("user_id", user.ID).Msg("Successfully reverted email change")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,118 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestPostChangeEmailRevertSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.Username = null.StringFrom("attacker@example.com")
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.Username))
+		require.NoError(t, err)
+
+		emailChangeRevertToken := models.EmailChangeRevertToken{
+			UserID:      fixtures.User1.ID,
+			OldUsername: "user1@example.com",
+			ValidUntil:  time.Now().Add(time.Hour),
+		}
+		err = emailChangeRevertToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"token": emailChangeRevertToken.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/revert", payload, nil)
+
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err = fixtures.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, "user1@example.com", fixtures.User1.Username.String)
+
+		err = emailChangeRevertToken.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1AccessToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1RefreshToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+}
+
+func TestPostChangeEmailRevertTokenExpired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		emailChangeRevertToken := models.EmailChangeRevertToken{
+			UserID:      fixtures.User1.ID,
+			OldUsername: "old@example.com",
+			ValidUntil:  time.Now().Add(-time.Minute),
+		}
+		err := emailChangeRevertToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"token": emailChangeRevertToken.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/revert", payload, nil)
+
+		assert.Equal(t, http.StatusConflict, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrConflictTokenExpired.Type, *response.Type)
+
+		err = fixtures.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, "user1@example.com", fixtures.User1.Username.String)
+		err = fixtures.User1AccessToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+	})
+}
+
+func TestPostChangeEmailRevertUsernameTaken(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		emailChangeRevertToken := models.EmailChangeRevertToken{
+			UserID:      fixtures.User1.ID,
+			OldUsername: fixtures.User2.Username.String,
+			ValidUntil:  time.Now().Add(time.Hour),
+		}
+		err := emailChangeRevertToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"token": emailChangeRevertToken.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email/revert", payload, nil)
+
+		assert.Equal(t, http.StatusConflict, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrConflictUserAlreadyExists.Type, *response.Type)
+	})
+}
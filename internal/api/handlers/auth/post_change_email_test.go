@@ -0,0 +1,181 @@
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestPostChangeEmailSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"currentPassword": test.PlainTestUserPassword,
+			"username":        " New@Example.com ",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		emailChangeToken, err := fixtures.User1.EmailChangeTokens().One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, "new@example.com", emailChangeToken.NewUsername)
+		assert.Equal(t, fixtures.User1Session1.ID, emailChangeToken.SessionID.String)
+
+		mail := getLastSentMail(t, s.Mailer)
+		require.NotNil(t, mail)
+		assert.Equal(t, "new@example.com", mail.To[0])
+		assert.Contains(t, string(mail.HTML), fmt.Sprintf("http://localhost:3000/change-email?token=%s", emailChangeToken.Token))
+
+		// the username is only changed once the new email address has been confirmed
+		err = fixtures.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, "user1@example.com", fixtures.User1.Username.String)
+	})
+}
+
+func TestPostChangeEmailReplacesPreviousRequest(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		for _, username := range []string{"first@example.com", "second@example.com"} {
+			payload := test.GenericPayload{
+				"currentPassword": test.PlainTestUserPassword,
+				"username":        username,
+			}
+
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+			require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+		}
+
+		emailChangeTokens, err := fixtures.User1.EmailChangeTokens().All(ctx, s.DB)
+		require.NoError(t, err)
+		require.Len(t, emailChangeTokens, 1)
+		assert.Equal(t, "second@example.com", emailChangeTokens[0].NewUsername)
+	})
+}
+
+func TestPostChangeEmailAlreadyExists(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"currentPassword": test.PlainTestUserPassword,
+			"username":        fixtures.User2.Username.String,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusConflict, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrConflictUserAlreadyExists.Type, *response.Type)
+
+		cnt, err := fixtures.User1.EmailChangeTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+
+		mail := getLastSentMail(t, s.Mailer)
+		assert.Nil(t, mail)
+	})
+}
+
+func TestPostChangeEmailUserWithoutPassword(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User2.Password = null.NewString("", false)
+		_, err := fixtures.User2.Update(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"currentPassword": test.PlainTestUserPassword,
+			"username":        "new@example.com",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", payload, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrForbiddenNotLocalUser.Type, *response.Type)
+	})
+}
+
+func TestPostChangeEmailInvalidPassword(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"currentPassword": "not my password",
+			"username":        "new@example.com",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		cnt, err := fixtures.User1.EmailChangeTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+
+		mail := getLastSentMail(t, s.Mailer)
+		assert.Nil(t, mail)
+
+		// the current password is required
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", test.GenericPayload{
+			"username": "new@example.com",
+		}, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+	})
+}
+
+func TestPostChangeEmailInvalidUsername(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"currentPassword": test.PlainTestUserPassword,
+			"username":        "not an email",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+	})
+}
+
+func TestPostChangeEmailUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		payload := test.GenericPayload{
+			"currentPassword": test.PlainTestUserPassword,
+			"username":        "new@example.com",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/change-email", payload, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
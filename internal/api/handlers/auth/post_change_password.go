@@ -2,7 +2,6 @@ package auth
 
 import (
 	"net/http"
-	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/auth"
@@ -35,7 +34,13 @@ func postChangePasswordHandler(s *api.Server) echo.HandlerFunc {
 			return err
 		}
 
-		user := auth.UserFromEchoContext(c)
+		// JWT access tokens only carry a subset of the user's data, load the stored record including the password hash
+		user, err := models.FindUser(ctx, s.DB, auth.UserFromEchoContext(c).ID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load user")
+			return err
+		}
+
 		if !user.IsActive {
 			log.Debug().Msg("User is deactivated, rejecting password change")
 			return middleware.ErrForbiddenUserDeactivated
@@ -65,7 +70,6 @@ func postChangePasswordHandler(s *api.Server) echo.HandlerFunc {
 
 		response := &types.PostLoginResponse{
 			TokenType: swag.String(TokenTypeBearer),
-			ExpiresIn: swag.Int64(int64(s.Config.Auth.AccessTokenValidity.Seconds())),
 		}
 
 		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
@@ -86,13 +90,8 @@ func postChangePasswordHandler(s *api.Server) echo.HandlerFunc {
 				return err
 			}
 
-			accessToken := models.AccessToken{
-				ValidUntil: time.Now().Add(s.Config.Auth.AccessTokenValidity),
-				UserID:     user.ID,
-			}
-
-			if err := accessToken.Insert(ctx, tx, boil.Infer()); err != nil {
-				log.Debug().Err(err).Msg("Failed to insert access token")
+			if _, err := user.Sessions().DeleteAll(ctx, tx); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete existing sessions")
 				return err
 			}
 
@@ -105,7 +104,19 @@ func postChangePasswordHandler(s *api.Server) echo.HandlerFunc {
 				return err
 			}
 
-			response.AccessToken = conv.UUID4(strfmt.UUID4(accessToken.Token))
+			accessToken, validity, err := issueAccessToken(ctx, s, tx, user, refreshToken.FamilyID)
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to issue access token")
+				return err
+			}
+
+			if err := insertSession(ctx, tx, c, user.ID, refreshToken.FamilyID, ""); err != nil {
+				log.Debug().Err(err).Msg("Failed to insert session")
+				return err
+			}
+
+			response.AccessToken = swag.String(accessToken)
+			response.ExpiresIn = swag.Int64(int64(validity.Seconds()))
 			response.RefreshToken = conv.UUID4(strfmt.UUID4(refreshToken.Token))
 
 			return nil
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"allaboutapps.dev/aw/go-starter/internal/util/totp"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostConfirmTotpRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/mfa/totp/confirm", postConfirmTotpHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{S: s, Mode: middleware.AuthModeSecure}))
+}
+
+func postConfirmTotpHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostConfirmTotpPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		user := auth.UserFromEchoContext(c)
+
+		credential, err := models.FindTotpCredential(ctx, s.DB, user.ID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Msg("User has not enrolled TOTP")
+				return httperrors.ErrNotFoundTotpNotEnrolled
+			}
+
+			log.Debug().Err(err).Msg("Failed to load TOTP credential")
+			return err
+		}
+
+		if credential.ConfirmedAt.Valid {
+			log.Debug().Msg("User has already enabled TOTP, rejecting confirmation")
+			return httperrors.ErrConflictTotpAlreadyEnabled
+		}
+
+		step, valid, err := totp.Validate(credential.Secret, *body.Code, time.Now(), credential.LastUsedStep)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to validate TOTP code")
+			return err
+		}
+
+		if !valid {
+			log.Debug().Msg("Provided TOTP code is invalid")
+			return httperrors.ErrBadRequestInvalidTotpCode
+		}
+
+		response := &types.PostConfirmTotpResponse{}
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			credential.ConfirmedAt = null.TimeFrom(time.Now())
+			credential.LastUsedStep = step
+			if _, err := credential.Update(ctx, tx, boil.Infer()); err != nil {
+				log.Debug().Err(err).Msg("Failed to confirm TOTP credential")
+				return err
+			}
+
+			response.RecoveryCodes, err = replaceRecoveryCodes(ctx, tx, user.ID)
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to generate recovery codes")
+				return err
+			}
+
+			return nil
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to enable TOTP")
+			return err
+		}
+
+		log.Debug().Msg("Successfully enabled TOTP, returning recovery codes")
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
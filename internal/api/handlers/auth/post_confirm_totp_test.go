@@ -0,0 +1,163 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestPostConfirmTotpSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		credential := models.TotpCredential{
+			UserID: fixtures.User1.ID,
+			Secret: testTotpSecret,
+		}
+		err = credential.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		code, err := totp.GenerateCode(testTotpSecret, time.Now())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"code": code,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/mfa/totp/confirm", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostConfirmTotpResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Len(t, response.RecoveryCodes, 10)
+
+		err = credential.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, credential.ConfirmedAt.Valid)
+		assert.Equal(t, totp.Step(time.Now()), credential.LastUsedStep)
+
+		recoveryCodes, err := fixtures.User1.RecoveryCodes().All(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Len(t, recoveryCodes, 10)
+		for _, recoveryCode := range recoveryCodes {
+			assert.NotContains(t, response.RecoveryCodes, recoveryCode.CodeHash)
+		}
+
+		// recovery codes returned can be used to complete a login
+		payload = test.GenericPayload{
+			"mfa_token": loginWithMfa(t, s, fixtures.User1),
+			"code":      response.RecoveryCodes[0],
+		}
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", payload, nil)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostConfirmTotpInvalidCode(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		credential := models.TotpCredential{
+			UserID: fixtures.User1.ID,
+			Secret: testTotpSecret,
+		}
+		err = credential.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		code, err := totp.GenerateCode(testTotpSecret, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"code": code,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/mfa/totp/confirm", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrBadRequestInvalidTotpCode.Type, *response.Type)
+
+		err = credential.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, credential.ConfirmedAt.Valid)
+
+		cnt, err := fixtures.User1.RecoveryCodes().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+	})
+}
+
+func TestPostConfirmTotpNotEnrolled(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"code": "123456",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/mfa/totp/confirm", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrNotFoundTotpNotEnrolled.Type, *response.Type)
+	})
+}
+
+func TestPostConfirmTotpAlreadyEnabled(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		enableTestTotp(ctx, t, s, fixtures.User1)
+
+		code, err := totp.GenerateCode(testTotpSecret, time.Now())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"code": code,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/mfa/totp/confirm", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusConflict, res.Result().StatusCode)
+	})
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostCreateApiKeyRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/api-keys", postCreateApiKeyHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{S: s, Mode: middleware.AuthModeSecure}))
+}
+
+func postCreateApiKeyHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostCreateAPIKeyPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		user := auth.UserFromEchoContext(c)
+
+		// API keys may not be granted more scopes than the credentials used to create them
+		userScopes := auth.ScopesFromEchoContext(c)
+
+		scopes := userScopes
+		if len(body.Scopes) > 0 {
+			for _, scope := range body.Scopes {
+				if !util.ContainsString(userScopes, scope) {
+					log.Debug().Str("scope", scope).Strs("user_scopes", userScopes).Msg("Requested scope is not granted to user, rejecting API key creation")
+					return httperrors.ErrBadRequestInvalidScopes
+				}
+			}
+
+			scopes = auth.IntersectScopes(userScopes, body.Scopes)
+		}
+
+		key, prefix, hash, err := auth.GenerateAPIKey()
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to generate API key")
+			return err
+		}
+
+		apiKey := models.APIKey{
+			UserID:  user.ID,
+			Name:    *body.Name,
+			Prefix:  prefix,
+			KeyHash: hash,
+			Scopes:  scopes,
+		}
+
+		if body.ExpiresIn > 0 {
+			apiKey.ExpiresAt = null.TimeFrom(time.Now().Add(time.Duration(body.ExpiresIn) * time.Second))
+		}
+
+		if err := apiKey.Insert(ctx, s.DB, boil.Infer()); err != nil {
+			log.Debug().Err(err).Msg("Failed to insert API key")
+			return err
+		}
+
+		log.Debug().Str("api_key_id", apiKey.ID).Strs("scopes", scopes).Msg("Successfully created API key")
+
+		return util.ValidateAndReturn(c, http.StatusCreated, &types.PostCreateAPIKeyResponse{
+			APIKey: apiKeyToType(&apiKey),
+			Key:    swag.String(key),
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestPostCreateApiKeySuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"name":       "CI pipeline",
+			"expires_in": 3600,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/api-keys", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+		var response types.PostCreateAPIKeyResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, "CI pipeline", *response.APIKey.Name)
+		assert.Equal(t, fixtures.User1.Scopes, response.APIKey.Scopes)
+		require.NotNil(t, response.APIKey.ExpiresAt)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), time.Time(*response.APIKey.ExpiresAt), time.Minute)
+		assert.Nil(t, response.APIKey.LastUsedAt)
+		assert.True(t, strings.HasPrefix(*response.Key, *response.APIKey.Prefix))
+
+		apiKey, err := models.FindAPIKey(ctx, s.DB, response.APIKey.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, fixtures.User1.ID, apiKey.UserID)
+		assert.Equal(t, *response.APIKey.Prefix, apiKey.Prefix)
+		assert.NotEqual(t, *response.Key, apiKey.KeyHash)
+		assert.True(t, auth.CompareAPIKeyAndHash(*response.Key, apiKey.KeyHash))
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/push/test", nil, test.HeadersWithConfigurableAuth(t, auth.APIKeyScheme, *response.Key))
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		err = apiKey.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, apiKey.LastUsedAt.Valid)
+	})
+}
+
+func TestPostCreateApiKeyInvalidScopes(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"name":   "CI pipeline",
+			"scopes": []string{"app", "cms"},
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/api-keys", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrBadRequestInvalidScopes.Type, *response.Type)
+
+		cnt, err := fixtures.User1.APIKeys().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+	})
+}
+
+func TestPostCreateApiKeyUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		payload := test.GenericPayload{
+			"name": "CI pipeline",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/api-keys", payload, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/totp"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostEnrollTotpRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/mfa/totp", postEnrollTotpHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{S: s, Mode: middleware.AuthModeSecure}))
+}
+
+func postEnrollTotpHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		// JWT access tokens only carry a subset of the user, the username is required for the key URI
+		user, err := models.FindUser(ctx, s.DB, auth.UserFromEchoContext(c).ID)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to load user")
+			return err
+		}
+
+		if !user.Username.Valid {
+			log.Debug().Msg("User is missing username, forbidding TOTP enrollment")
+			return httperrors.ErrForbiddenNotLocalUser
+		}
+
+		credential, err := user.TotpCredential().One(ctx, s.DB)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Err(err).Msg("Failed to load TOTP credential")
+			return err
+		}
+
+		if credential != nil && credential.ConfirmedAt.Valid {
+			log.Debug().Msg("User has already enabled TOTP, rejecting enrollment")
+			return httperrors.ErrConflictTotpAlreadyEnabled
+		}
+
+		secret, err := totp.GenerateSecret()
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to generate TOTP secret")
+			return err
+		}
+
+		if credential != nil {
+			credential.Secret = secret
+			if _, err := credential.Update(ctx, s.DB, boil.Whitelist(models.TotpCredentialColumns.Secret, models.TotpCredentialColumns.UpdatedAt)); err != nil {
+				log.Debug().Err(err).Msg("Failed to update TOTP credential")
+				return err
+			}
+		} else {
+			credential = &models.TotpCredential{
+				UserID: user.ID,
+				Secret: secret,
+			}
+
+			if err := credential.Insert(ctx, s.DB, boil.Infer()); err != nil {
+				log.Debug().Err(err).Msg("Failed to insert TOTP credential")
+				return err
+			}
+		}
+
+		log.Debug().Msg("Successfully enrolled TOTP, awaiting confirmation")
+
+		return util.ValidateAndReturn(c, http.StatusOK, &types.PostEnrollTotpResponse{
+			Secret:     swag.String(secret),
+			OtpauthURI: swag.String(totp.URI(secret, s.Config.Auth.TOTPIssuer, user.Username.String)),
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestPostEnrollTotpSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/mfa/totp", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostEnrollTotpResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.NotEmpty(t, *response.Secret)
+
+		uri, err := url.Parse(*response.OtpauthURI)
+		require.NoError(t, err)
+		assert.Equal(t, "otpauth", uri.Scheme)
+		assert.Equal(t, *response.Secret, uri.Query().Get("secret"))
+		assert.Equal(t, s.Config.Auth.TOTPIssuer, uri.Query().Get("issuer"))
+		assert.Equal(t, "/"+s.Config.Auth.TOTPIssuer+":"+fixtures.User1.Username.String, uri.Path)
+
+		credential, err := models.FindTotpCredential(ctx, s.DB, fixtures.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, *response.Secret, credential.Secret)
+		assert.False(t, credential.ConfirmedAt.Valid)
+
+		// enrolling again before confirmation replaces the secret
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/mfa/totp", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response2 types.PostEnrollTotpResponse
+		test.ParseResponseAndValidate(t, res, &response2)
+		assert.NotEqual(t, *response.Secret, *response2.Secret)
+
+		err = credential.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, *response2.Secret, credential.Secret)
+	})
+}
+
+func TestPostEnrollTotpAlreadyEnabled(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		credential, _ := enableTestTotp(ctx, t, s, fixtures.User1)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/mfa/totp", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusConflict, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrConflictTotpAlreadyEnabled.Type, *response.Type)
+
+		err = credential.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, testTotpSecret, credential.Secret)
+	})
+}
+
+func TestPostEnrollTotpRequiresRecentAuthentication(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/mfa/totp", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
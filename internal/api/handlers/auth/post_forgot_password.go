@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/lockout"
 	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/types"
 	"allaboutapps.dev/aw/go-starter/internal/util"
@@ -36,6 +37,13 @@ func postForgotPasswordHandler(s *api.Server) echo.HandlerFunc {
 
 		log := util.LogFromContext(ctx).With().Str("username", username).Logger()
 
+		if err := checkLockout(c, s, lockout.ScopeForgotPassword, username); err != nil {
+			return err
+		}
+
+		// every request counts as an attempt, as its outcome must not reveal whether the user exists
+		registerFailedAttempt(c, s, lockout.ScopeForgotPassword, username)
+
 		user, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom(username))).One(ctx, s.DB)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
 	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
 	"allaboutapps.dev/aw/go-starter/internal/models"
@@ -79,43 +80,39 @@ func postForgotPasswordCompleteHandler(s *api.Server) echo.HandlerFunc {
 
 		response := &types.PostLoginResponse{
 			TokenType: swag.String(TokenTypeBearer),
-			ExpiresIn: swag.Int64(int64(s.Config.Auth.AccessTokenValidity.Seconds())),
 		}
 
 		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
 			user.Password = null.StringFrom(hash)
+			user.PasswordResetRequired = false
 
-			if _, err := user.Update(ctx, tx, boil.Whitelist(models.UserColumns.Password)); err != nil {
+			if _, err := user.Update(ctx, tx, boil.Whitelist(models.UserColumns.Password, models.UserColumns.PasswordResetRequired)); err != nil {
 				log.Debug().Str("user_id", user.ID).Err(err).Msg("Failed to update user")
 				return err
 			}
 
-			if _, err := user.AccessTokens().DeleteAll(ctx, tx); err != nil {
-				log.Debug().Str("user_id", user.ID).Err(err).Msg("Failed to delete existing access tokens")
+			if err := auth.RevokeUserTokens(ctx, tx, user.ID); err != nil {
+				log.Debug().Str("user_id", user.ID).Err(err).Msg("Failed to revoke existing tokens")
 				return err
 			}
 
-			if _, err := user.RefreshTokens().DeleteAll(ctx, tx); err != nil {
-				log.Debug().Str("user_id", user.ID).Err(err).Msg("Failed to delete existing refresh tokens")
-				return err
-			}
-
-			accessToken := models.AccessToken{
-				ValidUntil: time.Now().Add(s.Config.Auth.AccessTokenValidity),
-				UserID:     user.ID,
+			refreshToken := models.RefreshToken{
+				UserID: user.ID,
 			}
 
-			if err := accessToken.Insert(ctx, tx, boil.Infer()); err != nil {
-				log.Debug().Str("user_id", user.ID).Err(err).Msg("Failed to insert access token")
+			if err := refreshToken.Insert(ctx, tx, boil.Infer()); err != nil {
+				log.Debug().Str("user_id", user.ID).Err(err).Msg("Failed to insert refresh token")
 				return err
 			}
 
-			refreshToken := models.RefreshToken{
-				UserID: user.ID,
+			accessToken, validity, err := issueAccessToken(ctx, s, tx, user, refreshToken.FamilyID)
+			if err != nil {
+				log.Debug().Str("user_id", user.ID).Err(err).Msg("Failed to issue access token")
+				return err
 			}
 
-			if err := refreshToken.Insert(ctx, tx, boil.Infer()); err != nil {
-				log.Debug().Str("user_id", user.ID).Err(err).Msg("Failed to insert refresh token")
+			if err := insertSession(ctx, tx, c, user.ID, refreshToken.FamilyID, ""); err != nil {
+				log.Debug().Str("user_id", user.ID).Err(err).Msg("Failed to insert session")
 				return err
 			}
 
@@ -124,7 +121,8 @@ func postForgotPasswordCompleteHandler(s *api.Server) echo.HandlerFunc {
 				return err
 			}
 
-			response.AccessToken = conv.UUID4(strfmt.UUID4(accessToken.Token))
+			response.AccessToken = swag.String(accessToken)
+			response.ExpiresIn = swag.Int64(int64(validity.Seconds()))
 			response.RefreshToken = conv.UUID4(strfmt.UUID4(refreshToken.Token))
 
 			return nil
@@ -9,11 +9,13 @@ import (
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
 	"allaboutapps.dev/aw/go-starter/internal/mailer"
 	"allaboutapps.dev/aw/go-starter/internal/mailer/transport"
 	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"github.com/jordan-wright/email"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/volatiletech/null/v8"
@@ -239,3 +241,35 @@ func TestPostForgotPasswordSuccessLowercaseTrimWhitespaces(t *testing.T) {
 		assert.Contains(t, string(mail.HTML), fmt.Sprintf("http://localhost:3000/set-new-password?token=%s", passwordResetToken.Token))
 	})
 }
+
+func TestPostForgotPasswordLockout(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.Auth.Lockout.Username.FreeAttempts = 1
+	config.Auth.Lockout.Username.MaxAttempts = 2
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		fixtures := test.Fixtures()
+		payload := test.GenericPayload{
+			"username": fixtures.User1.Username,
+		}
+
+		for i := 0; i < 2; i++ {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/forgot-password", payload, nil)
+			assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/forgot-password", payload, nil)
+		assert.Equal(t, http.StatusTooManyRequests, res.Result().StatusCode)
+		assert.NotEmpty(t, res.Header().Get(echo.HeaderRetryAfter))
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrTooManyRequestsTooManyAttempts.Type, *response.Type)
+
+		// forgot password attempts do not lock the login
+		payload["password"] = test.PlainTestUserPassword
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
@@ -1,13 +1,16 @@
 package auth
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
 	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
 	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/lockout"
 	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/types"
 	"allaboutapps.dev/aw/go-starter/internal/util"
@@ -23,6 +26,7 @@ import (
 
 const (
 	TokenTypeBearer = "bearer"
+	MfaTypeTotp     = "totp"
 )
 
 func PostLoginRoute(s *api.Server) *echo.Route {
@@ -42,6 +46,10 @@ func postLoginHandler(s *api.Server) echo.HandlerFunc {
 		// enforce lowercase usernames, trim whitespaces
 		username := util.ToUsernameFormat(body.Username.String())
 
+		if err := checkLockout(c, s, lockout.ScopeLogin, username); err != nil {
+			return err
+		}
+
 		user, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom(username))).One(ctx, s.DB)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
@@ -50,6 +58,7 @@ func postLoginHandler(s *api.Server) echo.HandlerFunc {
 				log.Debug().Err(err).Msg("Failed to load user")
 			}
 
+			registerFailedAttempt(c, s, lockout.ScopeLogin, username)
 			return echo.ErrUnauthorized
 		}
 
@@ -60,62 +69,102 @@ func postLoginHandler(s *api.Server) echo.HandlerFunc {
 
 		if !user.Password.Valid {
 			log.Debug().Msg("User is missing password, forbidding authentication")
+			registerFailedAttempt(c, s, lockout.ScopeLogin, username)
 			return echo.ErrUnauthorized
 		}
 
 		match, err := hashing.ComparePasswordAndHash(*body.Password, user.Password.String)
 		if err != nil {
 			log.Debug().Err(err).Msg("Failed to compare password with stored hash")
+			registerFailedAttempt(c, s, lockout.ScopeLogin, username)
 			return echo.ErrUnauthorized
 		}
 
 		if !match {
 			log.Debug().Msg("Provided password does not match stored hash")
+			registerFailedAttempt(c, s, lockout.ScopeLogin, username)
 			return echo.ErrUnauthorized
 		}
 
-		response := &types.PostLoginResponse{
-			TokenType: swag.String(TokenTypeBearer),
-			ExpiresIn: swag.Int64(int64(s.Config.Auth.AccessTokenValidity.Seconds())),
+		if user.PasswordResetRequired {
+			log.Debug().Msg("User is required to reset their password, rejecting authentication")
+			return httperrors.ErrForbiddenPasswordResetRequired
 		}
 
-		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
-			accessToken := models.AccessToken{
-				ValidUntil: time.Now().Add(s.Config.Auth.AccessTokenValidity),
-				UserID:     user.ID,
-			}
-
-			if err := accessToken.Insert(ctx, tx, boil.Infer()); err != nil {
-				log.Debug().Err(err).Msg("Failed to insert access token")
-				return err
-			}
-
-			refreshToken := models.RefreshToken{
-				UserID: user.ID,
-			}
+		mfaEnabled, err := isMfaEnabled(ctx, s.DB, user)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to check whether user has enabled two-factor authentication")
+			return err
+		}
 
-			if err := refreshToken.Insert(ctx, tx, boil.Infer()); err != nil {
-				log.Debug().Err(err).Msg("Failed to insert refresh token")
+		// Failed attempts are only reset once fully authenticated, so guessing second factors remains limited
+		if mfaEnabled {
+			mfaResponse, err := startMfaChallenge(ctx, s, s.DB, user, body.DeviceName)
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to start MFA challenge")
 				return err
 			}
 
-			user.LastAuthenticatedAt = null.TimeFrom(time.Now())
-			if _, err := user.Update(ctx, tx, boil.Infer()); err != nil {
-				log.Debug().Err(err).Msg("Failed to update user's last authenticated at timestamp")
-				return err
-			}
+			log.Debug().Msg("User has enabled two-factor authentication, returning MFA token")
 
-			response.AccessToken = conv.UUID4(strfmt.UUID4(accessToken.Token))
-			response.RefreshToken = conv.UUID4(strfmt.UUID4(refreshToken.Token))
+			return util.ValidateAndReturn(c, http.StatusAccepted, mfaResponse)
+		}
 
-			return nil
+		var response *types.PostLoginResponse
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			response, err = authenticateUser(ctx, s, tx, c, user, body.DeviceName)
+			return err
 		}); err != nil {
 			log.Debug().Err(err).Msg("Failed to authenticate user")
 			return err
 		}
 
+		// The user has already been authenticated, failing to reset their failed attempts must not fail the login
+		if err := s.Lockout.Reset(ctx, lockout.ScopeLogin, username); err != nil {
+			log.Error().Err(err).Msg("Failed to reset failed login attempts")
+		}
+
 		log.Debug().Msg("Successfully authenticated user, returning new set of access and refresh tokens")
 
 		return util.ValidateAndReturn(c, http.StatusOK, response)
 	}
 }
+
+// authenticateUser updates the user's last authenticated at timestamp and starts a new session,
+// returning a new set of access and refresh tokens for it.
+func authenticateUser(ctx context.Context, s *api.Server, exec boil.ContextExecutor, c echo.Context, user *models.User, deviceName string) (*types.PostLoginResponse, error) {
+	log := util.LogFromContext(ctx)
+
+	user.LastAuthenticatedAt = null.TimeFrom(time.Now())
+	if _, err := user.Update(ctx, exec, boil.Infer()); err != nil {
+		log.Debug().Err(err).Msg("Failed to update user's last authenticated at timestamp")
+		return nil, err
+	}
+
+	refreshToken := models.RefreshToken{
+		UserID: user.ID,
+	}
+
+	if err := refreshToken.Insert(ctx, exec, boil.Infer()); err != nil {
+		log.Debug().Err(err).Msg("Failed to insert refresh token")
+		return nil, err
+	}
+
+	accessToken, validity, err := issueAccessToken(ctx, s, exec, user, refreshToken.FamilyID)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to issue access token")
+		return nil, err
+	}
+
+	if err := insertSession(ctx, exec, c, user.ID, refreshToken.FamilyID, deviceName); err != nil {
+		log.Debug().Err(err).Msg("Failed to insert session")
+		return nil, err
+	}
+
+	return &types.PostLoginResponse{
+		AccessToken:  swag.String(accessToken),
+		ExpiresIn:    swag.Int64(int64(validity.Seconds())),
+		RefreshToken: conv.UUID4(strfmt.UUID4(refreshToken.Token)),
+		TokenType:    swag.String(TokenTypeBearer),
+	}, nil
+}
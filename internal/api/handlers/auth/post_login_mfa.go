@@ -0,0 +1,186 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/lockout"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"allaboutapps.dev/aw/go-starter/internal/util/totp"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func PostLoginMfaRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/login/mfa", postLoginMfaHandler(s))
+}
+
+func postLoginMfaHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostLoginMfaPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		challenge, err := models.MfaChallenges(
+			models.MfaChallengeWhere.Token.EQ(body.MfaToken.String()),
+			qm.Load(models.MfaChallengeRels.User),
+		).One(ctx, s.DB)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("MFA challenge not found")
+			} else {
+				log.Debug().Err(err).Msg("Failed to load MFA challenge")
+			}
+
+			return echo.ErrUnauthorized
+		}
+
+		if time.Now().After(challenge.ValidUntil) {
+			log.Debug().Time("valid_until", challenge.ValidUntil).Msg("MFA challenge has expired")
+
+			if _, err := challenge.Delete(ctx, s.DB); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete expired MFA challenge")
+			}
+
+			return echo.ErrUnauthorized
+		}
+
+		user := challenge.R.User
+		if !user.IsActive {
+			log.Debug().Msg("User is deactivated, rejecting authentication")
+			return middleware.ErrForbiddenUserDeactivated
+		}
+
+		// Invalid codes count as failed login attempts, so starting new challenges does not allow for unlimited guesses
+		username := user.Username.String
+		if err := checkLockout(c, s, lockout.ScopeLogin, username); err != nil {
+			return err
+		}
+
+		var response *types.PostLoginResponse
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			valid, err := verifySecondFactor(ctx, tx, user.ID, *body.Code)
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to verify second factor")
+				return err
+			}
+
+			if !valid {
+				return echo.ErrUnauthorized
+			}
+
+			if _, err := challenge.Delete(ctx, tx); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete MFA challenge")
+				return err
+			}
+
+			response, err = authenticateUser(ctx, s, tx, c, user, challenge.DeviceName.String)
+			return err
+		}); err != nil {
+			if errors.Is(err, echo.ErrUnauthorized) {
+				log.Debug().Int("failed_attempts", challenge.FailedAttempts+1).Msg("Provided code is invalid")
+				recordFailedMfaAttempt(ctx, s, challenge)
+				registerFailedAttempt(c, s, lockout.ScopeLogin, username)
+				return err
+			}
+
+			log.Debug().Err(err).Msg("Failed to authenticate user")
+			return err
+		}
+
+		// The user has already been authenticated, failing to reset their failed attempts must not fail the login
+		if err := s.Lockout.Reset(ctx, lockout.ScopeLogin, username); err != nil {
+			log.Error().Err(err).Msg("Failed to reset failed login attempts")
+		}
+
+		log.Debug().Msg("Successfully authenticated user with second factor, returning new set of access and refresh tokens")
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// verifySecondFactor checks the provided code against the user's confirmed TOTP credential, falling back
+// to the user's unused recovery codes. Used TOTP time steps and recovery codes are consumed, preventing reuse.
+func verifySecondFactor(ctx context.Context, exec boil.ContextExecutor, userID string, code string) (bool, error) {
+	credential, err := models.TotpCredentials(
+		models.TotpCredentialWhere.UserID.EQ(userID),
+		models.TotpCredentialWhere.ConfirmedAt.IsNotNull(),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	step, valid, err := totp.Validate(credential.Secret, code, time.Now(), credential.LastUsedStep)
+	if err != nil {
+		return false, err
+	}
+
+	if valid {
+		// only advance the last used step if no concurrent request used the same (or a later) code
+		rowsAff, err := models.TotpCredentials(
+			models.TotpCredentialWhere.UserID.EQ(userID),
+			models.TotpCredentialWhere.LastUsedStep.LT(step),
+		).UpdateAll(ctx, exec, models.M{
+			models.TotpCredentialColumns.LastUsedStep: step,
+			models.TotpCredentialColumns.UpdatedAt:    time.Now(),
+		})
+		if err != nil {
+			return false, err
+		}
+
+		return rowsAff == 1, nil
+	}
+
+	rowsAff, err := models.RecoveryCodes(
+		models.RecoveryCodeWhere.UserID.EQ(userID),
+		models.RecoveryCodeWhere.CodeHash.EQ(hashRecoveryCode(code)),
+		models.RecoveryCodeWhere.UsedAt.IsNull(),
+	).UpdateAll(ctx, exec, models.M{
+		models.RecoveryCodeColumns.UsedAt:    null.TimeFrom(time.Now()),
+		models.RecoveryCodeColumns.UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAff == 1, nil
+}
+
+// recordFailedMfaAttempt increments the failed attempts of the given MFA challenge, deleting it once the
+// maximum number of attempts has been reached, requiring the user to log in with their password again.
+func recordFailedMfaAttempt(ctx context.Context, s *api.Server, challenge *models.MfaChallenge) {
+	log := util.LogFromContext(ctx)
+
+	challenge.FailedAttempts++
+	if challenge.FailedAttempts >= maxMfaChallengeFailedAttempts {
+		log.Debug().Msg("Maximum number of failed attempts reached, deleting MFA challenge")
+
+		if _, err := challenge.Delete(ctx, s.DB); err != nil {
+			log.Debug().Err(err).Msg("Failed to delete MFA challenge")
+		}
+
+		return
+	}
+
+	if _, err := challenge.Update(ctx, s.DB, boil.Whitelist(models.MfaChallengeColumns.FailedAttempts, models.MfaChallengeColumns.UpdatedAt)); err != nil {
+		log.Debug().Err(err).Msg("Failed to update failed attempts of MFA challenge")
+	}
+}
@@ -0,0 +1,431 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/handlers/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	testTotpSecret   = "JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"
+	testRecoveryCode = "7k2m-9xqa-p4ve-w8tz"
+)
+
+// enableTestTotp enables TOTP two-factor authentication for the given user, including a single recovery code.
+func enableTestTotp(ctx context.Context, t *testing.T, s *api.Server, user *models.User) (*models.TotpCredential, *models.RecoveryCode) {
+	t.Helper()
+
+	credential := models.TotpCredential{
+		UserID:      user.ID,
+		Secret:      testTotpSecret,
+		ConfirmedAt: null.TimeFrom(time.Now()),
+	}
+	err := credential.Insert(ctx, s.DB, boil.Infer())
+	require.NoError(t, err)
+
+	hash := sha256.Sum256([]byte("7k2m9xqap4vew8tz"))
+	recoveryCode := models.RecoveryCode{
+		UserID:   user.ID,
+		CodeHash: hex.EncodeToString(hash[:]),
+	}
+	err = recoveryCode.Insert(ctx, s.DB, boil.Infer())
+	require.NoError(t, err)
+
+	return &credential, &recoveryCode
+}
+
+// loginWithMfa performs the password login of the given user, returning the MFA token received.
+func loginWithMfa(t *testing.T, s *api.Server, user *models.User) string {
+	t.Helper()
+
+	payload := test.GenericPayload{
+		"username":    user.Username,
+		"password":    test.PlainTestUserPassword,
+		"device_name": "iPhone 15",
+	}
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+	require.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+	var response types.PostLoginMfaRequiredResponse
+	test.ParseResponseAndValidate(t, res, &response)
+
+	return response.MfaToken.String()
+}
+
+func TestPostLoginMfaRequired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		enableTestTotp(ctx, t, s, fixtures.User1)
+
+		payload := test.GenericPayload{
+			"username": fixtures.User1.Username,
+			"password": test.PlainTestUserPassword,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+
+		assert.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+		var response types.PostLoginMfaRequiredResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, auth.MfaTypeTotp, *response.MfaType)
+		assert.Equal(t, int64(s.Config.Auth.MFAChallengeValidity.Seconds()), *response.ExpiresIn)
+
+		challenge, err := models.FindMfaChallenge(ctx, s.DB, response.MfaToken.String())
+		require.NoError(t, err)
+		assert.Equal(t, fixtures.User1.ID, challenge.UserID)
+
+		// no tokens are issued and the user is not considered authenticated before completing the second step
+		err = fixtures.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, fixtures.User1.LastAuthenticatedAt.Valid)
+
+		cnt, err := fixtures.User1.RefreshTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+	})
+}
+
+func TestPostLoginMfaUnconfirmedTotp(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		credential := models.TotpCredential{
+			UserID: fixtures.User1.ID,
+			Secret: testTotpSecret,
+		}
+		err := credential.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"username": fixtures.User1.Username,
+			"password": test.PlainTestUserPassword,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.NotEmpty(t, response.AccessToken)
+	})
+}
+
+func TestPostLoginMfaSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		credential, _ := enableTestTotp(ctx, t, s, fixtures.User1)
+
+		mfaToken := loginWithMfa(t, s, fixtures.User1)
+
+		code, err := totp.GenerateCode(testTotpSecret, time.Now())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"mfa_token": mfaToken,
+			"code":      code,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", payload, nil)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.NotEmpty(t, response.AccessToken)
+		assert.NotEmpty(t, response.RefreshToken)
+		assert.Equal(t, auth.TokenTypeBearer, *response.TokenType)
+
+		err = fixtures.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), fixtures.User1.LastAuthenticatedAt.Time, time.Second*10)
+
+		refreshToken, err := models.FindRefreshToken(ctx, s.DB, response.RefreshToken.String())
+		require.NoError(t, err)
+		session, err := models.FindSession(ctx, s.DB, refreshToken.FamilyID)
+		require.NoError(t, err)
+		assert.Equal(t, "iPhone 15", session.DeviceName.String)
+
+		err = credential.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Greater(t, credential.LastUsedStep, int64(0))
+
+		exists, err := models.MfaChallengeExists(ctx, s.DB, mfaToken)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		// the same code cannot be replayed, even with a new MFA token
+		mfaToken = loginWithMfa(t, s, fixtures.User1)
+		payload["mfa_token"] = mfaToken
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", payload, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestPostLoginMfaRecoveryCode(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		_, recoveryCode := enableTestTotp(ctx, t, s, fixtures.User1)
+
+		payload := test.GenericPayload{
+			"mfa_token": loginWithMfa(t, s, fixtures.User1),
+			"code":      "7K2M-9XQA-P4VE-W8TZ",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", payload, nil)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.NotEmpty(t, response.AccessToken)
+
+		err := recoveryCode.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, recoveryCode.UsedAt.Valid)
+
+		// recovery codes are single-use
+		payload = test.GenericPayload{
+			"mfa_token": loginWithMfa(t, s, fixtures.User1),
+			"code":      testRecoveryCode,
+		}
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", payload, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestPostLoginMfaInvalidCode(t *testing.T) {
+	// invalid codes count towards the login lockout as well, see TestPostLoginMfaLockout
+	config := config.DefaultServiceConfigFromEnv()
+	config.Auth.Lockout.Username.FreeAttempts = 10
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		enableTestTotp(ctx, t, s, fixtures.User1)
+
+		mfaToken := loginWithMfa(t, s, fixtures.User1)
+
+		code, err := totp.GenerateCode(testTotpSecret, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"mfa_token": mfaToken,
+			"code":      code,
+		}
+
+		for i := 1; i < 5; i++ {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", payload, nil)
+			assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+			challenge, err := models.FindMfaChallenge(ctx, s.DB, mfaToken)
+			require.NoError(t, err)
+			assert.Equal(t, i, challenge.FailedAttempts)
+		}
+
+		// the MFA token is invalidated after too many failed attempts, even if a valid code is provided afterwards
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", payload, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		_, err = models.FindMfaChallenge(ctx, s.DB, mfaToken)
+		assert.Equal(t, sql.ErrNoRows, err)
+
+		code, err = totp.GenerateCode(testTotpSecret, time.Now())
+		require.NoError(t, err)
+		payload["code"] = code
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", payload, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestPostLoginMfaLockout(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.Auth.Lockout.BaseDelay = 0
+	config.Auth.Lockout.Username.MaxAttempts = 3
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		enableTestTotp(ctx, t, s, fixtures.User1)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": fixtures.User1.Username,
+			"password": "not my password",
+		}, nil)
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		code, err := totp.GenerateCode(testTotpSecret, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		// providing the correct password does not reset the failed attempts, starting new challenges thus does not
+		// allow for more guesses than the lockout permits
+		mfaToken := loginWithMfa(t, s, fixtures.User1)
+
+		for i := 0; i < 2; i++ {
+			res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", test.GenericPayload{
+				"mfa_token": loginWithMfa(t, s, fixtures.User1),
+				"code":      code,
+			}, nil)
+			require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+		}
+
+		// the username is locked, even valid codes of challenges started before are rejected
+		code, err = totp.GenerateCode(testTotpSecret, time.Now())
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", test.GenericPayload{
+			"mfa_token": mfaToken,
+			"code":      code,
+		}, nil)
+		assert.Equal(t, http.StatusTooManyRequests, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": fixtures.User1.Username,
+			"password": test.PlainTestUserPassword,
+		}, nil)
+		assert.Equal(t, http.StatusTooManyRequests, res.Result().StatusCode)
+	})
+}
+
+func TestPostLoginMfaSuccessResetsLockout(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		enableTestTotp(ctx, t, s, fixtures.User1)
+
+		invalidCode, err := totp.GenerateCode(testTotpSecret, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		mfaToken := loginWithMfa(t, s, fixtures.User1)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", test.GenericPayload{
+			"mfa_token": mfaToken,
+			"code":      invalidCode,
+		}, nil)
+		require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		key := fmt.Sprintf("login:username:%s", fixtures.User1.Username.String)
+		exists, err := models.AuthAttempts(models.AuthAttemptWhere.Key.EQ(key)).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		code, err := totp.GenerateCode(testTotpSecret, time.Now())
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", test.GenericPayload{
+			"mfa_token": mfaToken,
+			"code":      code,
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		exists, err = models.AuthAttempts(models.AuthAttemptWhere.Key.EQ(key)).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestPostLoginMfaExpiredToken(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		enableTestTotp(ctx, t, s, fixtures.User1)
+
+		challenge := models.MfaChallenge{
+			ValidUntil: time.Now().Add(-time.Minute),
+			UserID:     fixtures.User1.ID,
+		}
+		err := challenge.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		code, err := totp.GenerateCode(testTotpSecret, time.Now())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"mfa_token": challenge.Token,
+			"code":      code,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", payload, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		err = challenge.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+	})
+}
+
+func TestPostLoginMfaUnknownToken(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		payload := test.GenericPayload{
+			"mfa_token": "5f3b2e0c-9a47-4c1e-8c61-2b4f0d9f7a13",
+			"code":      "123456",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", payload, nil)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestPostLoginMfaDeactivatedUser(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		enableTestTotp(ctx, t, s, fixtures.User1)
+
+		mfaToken := loginWithMfa(t, s, fixtures.User1)
+
+		fixtures.User1.IsActive = false
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.IsActive))
+		require.NoError(t, err)
+
+		code, err := totp.GenerateCode(testTotpSecret, time.Now())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"mfa_token": mfaToken,
+			"code":      code,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/mfa", payload, nil)
+
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *middleware.ErrForbiddenUserDeactivated.Type, *response.Type)
+	})
+}
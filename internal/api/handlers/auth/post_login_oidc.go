@@ -0,0 +1,182 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/oidc"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func PostLoginOidcRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/login/oidc", postLoginOidcHandler(s))
+}
+
+func postLoginOidcHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		var body types.PostLoginOidcPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		claims, provider, err := s.OIDC.Verify(ctx, *body.IDToken, body.Nonce)
+		if err != nil {
+			util.LogFromContext(ctx).Debug().Err(err).Msg("Failed to verify ID token")
+			return httperrors.ErrUnauthorizedInvalidIDToken
+		}
+
+		log := util.LogFromContext(ctx).With().Str("oidc_provider", provider.Name()).Str("oidc_subject", claims.Subject).Logger()
+
+		var response *types.PostLoginResponse
+		var mfaResponse *types.PostLoginMfaRequiredResponse
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			user, err := findOrCreateOidcUser(ctx, s, tx, provider.Issuer(), claims)
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to find or create user for ID token")
+				return err
+			}
+
+			if !user.IsActive {
+				log.Debug().Msg("User is deactivated, rejecting authentication")
+				return middleware.ErrForbiddenUserDeactivated
+			}
+
+			mfaEnabled, err := isMfaEnabled(ctx, tx, user)
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to check whether user has enabled two-factor authentication")
+				return err
+			}
+
+			if mfaEnabled {
+				mfaResponse, err = startMfaChallenge(ctx, s, tx, user, body.DeviceName)
+				return err
+			}
+
+			response, err = authenticateUser(ctx, s, tx, c, user, body.DeviceName)
+			return err
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to authenticate user using ID token")
+			return err
+		}
+
+		if mfaResponse != nil {
+			log.Debug().Msg("User has enabled two-factor authentication, returning MFA token")
+			return util.ValidateAndReturn(c, http.StatusAccepted, mfaResponse)
+		}
+
+		log.Debug().Msg("Successfully authenticated user using ID token, returning new set of access and refresh tokens")
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// findOrCreateOidcUser returns the user linked to the external identity described by the given issuer and claims.
+// Unknown identities are linked to the user with the same email address, if both the provider and the user have
+// verified it (otherwise whoever registered an address first could take over the account), or to a newly created user
+// without password. Users created with an email address not verified by the provider are sent a verification link.
+func findOrCreateOidcUser(ctx context.Context, s *api.Server, exec boil.ContextExecutor, issuer string, claims *oidc.Claims) (*models.User, error) {
+	log := util.LogFromContext(ctx)
+
+	// enforce lowercase usernames, trim whitespaces
+	email := util.ToUsernameFormat(claims.Email)
+	emailVerified := len(email) > 0 && bool(claims.EmailVerified)
+
+	identity, err := models.Identities(
+		models.IdentityWhere.Issuer.EQ(issuer),
+		models.IdentityWhere.Subject.EQ(claims.Subject),
+		qm.Load(models.IdentityRels.User),
+	).One(ctx, exec)
+	if err == nil {
+		identity.Email = null.NewString(email, len(email) > 0)
+		identity.LastLoginAt = time.Now()
+		if _, err := identity.Update(ctx, exec, boil.Infer()); err != nil {
+			log.Debug().Err(err).Msg("Failed to update identity")
+			return nil, err
+		}
+
+		return identity.R.User, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		log.Debug().Err(err).Msg("Failed to load identity")
+		return nil, err
+	}
+
+	var user *models.User
+	if len(email) > 0 {
+		user, err = models.Users(models.UserWhere.Username.EQ(null.StringFrom(email))).One(ctx, exec)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Err(err).Msg("Failed to load user by email")
+			return nil, err
+		}
+	}
+
+	if user != nil {
+		if !emailVerified || !user.EmailVerifiedAt.Valid {
+			log.Debug().Str("user_id", user.ID).Msg("User with email of ID token already exists, but email is not verified, refusing to link identity")
+			return nil, httperrors.ErrConflictUserAlreadyExists
+		}
+
+		log.Debug().Str("user_id", user.ID).Msg("Linking identity to existing user with same verified email")
+	} else {
+		user = &models.User{
+			Username:        null.NewString(email, len(email) > 0),
+			Password:        null.NewString("", false),
+			IsActive:        true,
+			Scopes:          s.Config.Auth.DefaultUserScopes,
+			EmailVerifiedAt: null.NewTime(time.Now(), emailVerified),
+		}
+
+		if err := user.Insert(ctx, exec, boil.Infer()); err != nil {
+			log.Debug().Err(err).Msg("Failed to insert user")
+			return nil, err
+		}
+
+		appUserProfile := models.AppUserProfile{
+			UserID: user.ID,
+		}
+
+		if err := appUserProfile.Insert(ctx, exec, boil.Infer()); err != nil {
+			log.Debug().Err(err).Msg("Failed to insert app user profile")
+			return nil, err
+		}
+
+		if len(email) > 0 && !emailVerified {
+			if err := sendEmailVerification(ctx, s, exec, user); err != nil {
+				log.Debug().Err(err).Msg("Failed to send email verification")
+				return nil, err
+			}
+		}
+
+		log.Debug().Str("user_id", user.ID).Msg("Created new user for identity")
+	}
+
+	identity = &models.Identity{
+		UserID:      user.ID,
+		Issuer:      issuer,
+		Subject:     claims.Subject,
+		Email:       null.NewString(email, len(email) > 0),
+		LastLoginAt: time.Now(),
+	}
+
+	if err := identity.Insert(ctx, exec, boil.Infer()); err != nil {
+		log.Debug().Err(err).Msg("Failed to insert identity")
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,293 @@
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/handlers/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func withOidcTestServer(t *testing.T, closure func(s *api.Server, issuer *test.FakeOIDCIssuer)) {
+	t.Helper()
+
+	issuer := test.NewFakeOIDCIssuer(t)
+
+	config := config.DefaultServiceConfigFromEnv()
+	config.Auth.OIDC.Generic = issuer.Config()
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		closure(s, issuer)
+	})
+}
+
+func TestPostLoginOidcNewUser(t *testing.T) {
+	withOidcTestServer(t, func(s *api.Server, issuer *test.FakeOIDCIssuer) {
+		ctx := context.Background()
+		payload := test.GenericPayload{
+			"id_token":    issuer.IDToken(t, "subject-1", "New.User@example.com", jwt.MapClaims{"nonce": "n-0S6_WzA2Mj"}),
+			"nonce":       "n-0S6_WzA2Mj",
+			"device_name": "Pixel 7",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/oidc", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.NotEmpty(t, response.AccessToken)
+		assert.NotEmpty(t, response.RefreshToken)
+		assert.Equal(t, "bearer", *response.TokenType)
+
+		identity, err := models.Identities(models.IdentityWhere.Subject.EQ("subject-1")).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, issuer.Issuer(), identity.Issuer)
+		assert.Equal(t, "new.user@example.com", identity.Email.String)
+
+		user, err := identity.User().One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, "new.user@example.com", user.Username.String)
+		assert.False(t, user.Password.Valid)
+		assert.True(t, user.IsActive)
+		assert.True(t, user.EmailVerifiedAt.Valid)
+		assert.True(t, user.LastAuthenticatedAt.Valid)
+		assert.ElementsMatch(t, s.Config.Auth.DefaultUserScopes, user.Scopes)
+
+		exists, err := user.AppUserProfile().Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		session, err := user.Sessions().One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, "Pixel 7", session.DeviceName.String)
+
+		// subsequent logins use the linked identity
+		payload = test.GenericPayload{
+			"id_token": issuer.IDToken(t, "subject-1", "new.user@example.com", nil),
+		}
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login/oidc", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		cnt, err := models.Users(models.UserWhere.Username.EQ(user.Username)).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+
+		cnt, err = user.Identities().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+	})
+}
+
+func TestPostLoginOidcNewUserUnverifiedEmail(t *testing.T) {
+	withOidcTestServer(t, func(s *api.Server, issuer *test.FakeOIDCIssuer) {
+		ctx := context.Background()
+		payload := test.GenericPayload{
+			"id_token": issuer.IDToken(t, "subject-1", "new.user@example.com", jwt.MapClaims{"email_verified": false}),
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/oidc", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		user, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom("new.user@example.com"))).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, user.EmailVerifiedAt.Valid)
+
+		emailVerificationToken, err := user.EmailVerificationTokens().One(ctx, s.DB)
+		require.NoError(t, err)
+
+		mail := getLastSentMail(t, s.Mailer)
+		require.NotNil(t, mail)
+		assert.Equal(t, []string{"new.user@example.com"}, mail.To)
+		assert.Contains(t, string(mail.HTML), fmt.Sprintf("http://localhost:3000/verify-email?token=%s", emailVerificationToken.Token))
+	})
+}
+
+func TestPostLoginOidcNewUserWithoutEmail(t *testing.T) {
+	withOidcTestServer(t, func(s *api.Server, issuer *test.FakeOIDCIssuer) {
+		ctx := context.Background()
+		payload := test.GenericPayload{
+			"id_token": issuer.IDToken(t, "subject-1", "", jwt.MapClaims{"email_verified": false}),
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/oidc", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		identity, err := models.Identities(models.IdentityWhere.Subject.EQ("subject-1"), qm.Load(models.IdentityRels.User)).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, identity.Email.Valid)
+		assert.False(t, identity.R.User.Username.Valid)
+		assert.False(t, identity.R.User.EmailVerifiedAt.Valid)
+	})
+}
+
+func TestPostLoginOidcLinkExistingUser(t *testing.T) {
+	withOidcTestServer(t, func(s *api.Server, issuer *test.FakeOIDCIssuer) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		payload := test.GenericPayload{
+			"id_token": issuer.IDToken(t, "subject-1", fixtures.User1.Username.String, nil),
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/oidc", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		identity, err := models.Identities(models.IdentityWhere.Subject.EQ("subject-1")).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, fixtures.User1.ID, identity.UserID)
+
+		// the local password remains usable
+		err = fixtures.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, fixtures.User1.Password.Valid)
+	})
+}
+
+func TestPostLoginOidcExistingUserUnverifiedEmail(t *testing.T) {
+	withOidcTestServer(t, func(s *api.Server, issuer *test.FakeOIDCIssuer) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		// the provider has not verified the email
+		payload := test.GenericPayload{
+			"id_token": issuer.IDToken(t, "subject-1", fixtures.User1.Username.String, jwt.MapClaims{"email_verified": false}),
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/oidc", payload, nil)
+		assert.Equal(t, http.StatusConflict, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrConflictUserAlreadyExists.Type, *response.Type)
+
+		// the user has not verified the email
+		fixtures.User2.EmailVerifiedAt = null.NewTime(time.Time{}, false)
+		_, err := fixtures.User2.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.EmailVerifiedAt))
+		require.NoError(t, err)
+
+		payload = test.GenericPayload{
+			"id_token": issuer.IDToken(t, "subject-2", fixtures.User2.Username.String, nil),
+		}
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login/oidc", payload, nil)
+		assert.Equal(t, http.StatusConflict, res.Result().StatusCode)
+
+		cnt, err := models.Identities().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+	})
+}
+
+func TestPostLoginOidcDeactivatedUser(t *testing.T) {
+	withOidcTestServer(t, func(s *api.Server, issuer *test.FakeOIDCIssuer) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		identity := models.Identity{
+			UserID:      fixtures.UserDeactivated.ID,
+			Issuer:      issuer.Issuer(),
+			Subject:     "subject-1",
+			LastLoginAt: time.Now(),
+		}
+
+		err := identity.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"id_token": issuer.IDToken(t, "subject-1", fixtures.UserDeactivated.Username.String, nil),
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/oidc", payload, nil)
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *middleware.ErrForbiddenUserDeactivated.Type, *response.Type)
+	})
+}
+
+func TestPostLoginOidcMfaRequired(t *testing.T) {
+	withOidcTestServer(t, func(s *api.Server, issuer *test.FakeOIDCIssuer) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		enableTestTotp(ctx, t, s, fixtures.User1)
+
+		payload := test.GenericPayload{
+			"id_token": issuer.IDToken(t, "subject-1", fixtures.User1.Username.String, nil),
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/oidc", payload, nil)
+		require.Equal(t, http.StatusAccepted, res.Result().StatusCode)
+
+		var response types.PostLoginMfaRequiredResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.NotEmpty(t, response.MfaToken)
+		assert.Equal(t, auth.MfaTypeTotp, *response.MfaType)
+	})
+}
+
+func TestPostLoginOidcInvalidToken(t *testing.T) {
+	withOidcTestServer(t, func(s *api.Server, issuer *test.FakeOIDCIssuer) {
+		otherIssuer := test.NewFakeOIDCIssuer(t)
+
+		tokens := []string{
+			otherIssuer.IDToken(t, "subject-1", "user@example.com", nil),
+			otherIssuer.IDToken(t, "subject-1", "user@example.com", jwt.MapClaims{"iss": issuer.Issuer()}),
+			issuer.IDToken(t, "subject-1", "user@example.com", jwt.MapClaims{"aud": "other-client"}),
+			issuer.IDToken(t, "subject-1", "user@example.com", jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()}),
+			"not-a-jwt",
+		}
+
+		for _, token := range tokens {
+			payload := test.GenericPayload{
+				"id_token": token,
+			}
+
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/oidc", payload, nil)
+			assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+			var response httperrors.HTTPError
+			test.ParseResponseAndValidate(t, res, &response)
+
+			assert.Equal(t, *httperrors.ErrUnauthorizedInvalidIDToken.Type, *response.Type)
+		}
+
+		// nonce must match if provided
+		payload := test.GenericPayload{
+			"id_token": issuer.IDToken(t, "subject-1", "user@example.com", jwt.MapClaims{"nonce": "other"}),
+			"nonce":    "n-0S6_WzA2Mj",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/oidc", payload, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestPostLoginOidcMissingToken(t *testing.T) {
+	withOidcTestServer(t, func(s *api.Server, _ *test.FakeOIDCIssuer) {
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login/oidc", test.GenericPayload{}, nil)
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+	})
+}
@@ -6,13 +6,17 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/handlers/auth"
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
 	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/volatiletech/null/v8"
@@ -43,6 +47,83 @@ func TestPostLoginSuccess(t *testing.T) {
 	})
 }
 
+func TestPostLoginSuccessCreatesSession(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		payload := test.GenericPayload{
+			"username":    fixtures.User1.Username,
+			"password":    test.PlainTestUserPassword,
+			"device_name": "iPhone 15",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		refreshToken, err := models.FindRefreshToken(ctx, s.DB, response.RefreshToken.String())
+		require.NoError(t, err)
+
+		session, err := models.FindSession(ctx, s.DB, refreshToken.FamilyID)
+		require.NoError(t, err)
+		assert.Equal(t, fixtures.User1.ID, session.UserID)
+		assert.Equal(t, null.StringFrom("iPhone 15"), session.DeviceName)
+		assert.WithinDuration(t, time.Now(), session.LastSeenAt, time.Second*10)
+	})
+}
+
+func TestPostLoginSuccessJWT(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.Auth.TokenFormat = "jwt"
+	config.Auth.JWT.SigningMethod = "EdDSA"
+	config.Auth.JWT.SigningKeyID = "test"
+	config.Auth.JWT.Keys = map[string]string{"test": "V2i0J0bJMn8K6pXbG0vK9m1rW3yQ4uZ7cD2eF5hA8sE="}
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		payload := test.GenericPayload{
+			"username": fixtures.User1.Username,
+			"password": test.PlainTestUserPassword,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, 2, strings.Count(*response.AccessToken, "."))
+		assert.Equal(t, int64(s.Config.Auth.JWT.AccessTokenValidity.Seconds()), *response.ExpiresIn)
+
+		// JWT access tokens are not persisted, the refresh token stays opaque
+		cnt, err := fixtures.User1.AccessTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), cnt)
+
+		_, err = models.FindRefreshToken(ctx, s.DB, response.RefreshToken.String())
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/sessions", nil, test.HeadersWithAuth(t, *response.AccessToken))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var sessionsResponse types.GetSessionsResponse
+		test.ParseResponseAndValidate(t, res, &sessionsResponse)
+
+		require.Len(t, sessionsResponse.Sessions, 2)
+		assert.True(t, *sessionsResponse.Sessions[0].Current)
+		assert.False(t, *sessionsResponse.Sessions[1].Current)
+
+		// opaque access tokens issued before switching the token format remain valid
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
 func TestPostLoginInvalidCredentials(t *testing.T) {
 	test.WithTestServer(t, func(s *api.Server) {
 		fixtures := test.Fixtures()
@@ -301,3 +382,68 @@ func TestPostLoginSuccessLowercaseTrimWhitespaces(t *testing.T) {
 		assert.Equal(t, auth.TokenTypeBearer, *response.TokenType)
 	})
 }
+
+func TestPostLoginLockout(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.Auth.Lockout.Username.FreeAttempts = 1
+	config.Auth.Lockout.Username.MaxAttempts = 3
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		fixtures := test.Fixtures()
+		payload := test.GenericPayload{
+			"username": fixtures.User1.Username,
+			"password": "not my password",
+		}
+
+		for i := 0; i < 3; i++ {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+			assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+		}
+
+		// the correct password is rejected as well while the username is locked
+		payload["password"] = test.PlainTestUserPassword
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		assert.Equal(t, http.StatusTooManyRequests, res.Result().StatusCode)
+		assert.Equal(t, fmt.Sprintf("%d", int(config.Auth.Lockout.LockDuration.Seconds())), res.Header().Get(echo.HeaderRetryAfter))
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrTooManyRequestsTooManyAttempts.Type, *response.Type)
+
+		// other users are not affected
+		payload = test.GenericPayload{
+			"username": fixtures.User2.Username,
+			"password": test.PlainTestUserPassword,
+		}
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestPostLoginLockoutBackoffResetOnSuccess(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.Auth.Lockout.Username.FreeAttempts = 2
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		payload := test.GenericPayload{
+			"username": fixtures.User1.Username,
+			"password": "not my password",
+		}
+
+		for i := 0; i < 2; i++ {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+			assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+		}
+
+		payload["password"] = test.PlainTestUserPassword
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		exists, err := models.AuthAttempts(models.AuthAttemptWhere.Key.EQ(fmt.Sprintf("login:username:%s", fixtures.User1.Username.String))).Exists(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
@@ -49,8 +49,14 @@ func postLogoutHandler(s *api.Server) echo.HandlerFunc {
 					return err
 				}
 
-				if _, err := refreshToken.Delete(ctx, tx); err != nil {
-					log.Debug().Err(err).Msg("Failed to delete refresh token")
+				// Previously rotated refresh tokens are only kept around for reuse detection, drop the whole family
+				if _, err := models.RefreshTokens(models.RefreshTokenWhere.FamilyID.EQ(refreshToken.FamilyID)).DeleteAll(ctx, tx); err != nil {
+					log.Debug().Err(err).Msg("Failed to delete refresh token family")
+					return err
+				}
+
+				if _, err := models.Sessions(models.SessionWhere.ID.EQ(refreshToken.FamilyID)).DeleteAll(ctx, tx); err != nil {
+					log.Debug().Err(err).Msg("Failed to delete session")
 					return err
 				}
 			}
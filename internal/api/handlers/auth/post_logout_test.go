@@ -47,6 +47,9 @@ func TestPostLogoutSuccessWithRefreshToken(t *testing.T) {
 
 		err = fixtures.User1RefreshToken1.Reload(ctx, s.DB)
 		assert.Equal(t, sql.ErrNoRows, err)
+
+		err = fixtures.User1Session1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
 	})
 }
 
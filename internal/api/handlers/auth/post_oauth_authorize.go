@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostOauthAuthorizeRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/oauth/authorize", postOauthAuthorizeHandler(s))
+}
+
+func postOauthAuthorizeHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostOauthAuthorizePayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		client, err := findOAuthClient(ctx, s.DB, body.ClientID.String())
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Str("client_id", body.ClientID.String()).Msg("OAuth client not found")
+				return httperrors.ErrNotFoundOAuthClientNotFound
+			}
+
+			log.Debug().Err(err).Msg("Failed to load OAuth client")
+			return err
+		}
+
+		// Errors are only reported back to the client via its redirect URI once the URI has been verified,
+		// otherwise the user agent could be redirected to an arbitrary location
+		if !oauthClientHasRedirectURI(client, *body.RedirectURI) {
+			log.Debug().Str("client_id", client.ID).Str("redirect_uri", *body.RedirectURI).Msg("Redirect URI is not registered for OAuth client")
+			return httperrors.ErrBadRequestInvalidRedirectURI
+		}
+
+		redirectURI, err := url.Parse(*body.RedirectURI)
+		if err != nil {
+			log.Debug().Err(err).Str("redirect_uri", *body.RedirectURI).Msg("Failed to parse registered redirect URI")
+			return httperrors.ErrBadRequestInvalidRedirectURI
+		}
+
+		respond := func(params url.Values) error {
+			query := redirectURI.Query()
+			for key, values := range params {
+				query[key] = values
+			}
+
+			if len(body.State) > 0 {
+				query.Set("state", body.State)
+			}
+
+			redirectURI.RawQuery = query.Encode()
+
+			return util.ValidateAndReturn(c, http.StatusOK, &types.PostOauthAuthorizeResponse{
+				RedirectURI: swag.String(redirectURI.String()),
+			})
+		}
+
+		if !oauthClientHasGrantType(client, auth.OAuthGrantTypeAuthorizationCode) {
+			log.Debug().Str("client_id", client.ID).Msg("OAuth client is not allowed to use authorization code grant")
+			return respond(url.Values{
+				"error":             {types.OauthErrorResponseErrorUnauthorizedClient},
+				"error_description": {"Client is not allowed to use the authorization code grant"},
+			})
+		}
+
+		user := auth.UserFromContext(ctx)
+
+		// Clients may only be granted scopes they registered for and the user holds
+		scopes, ok := parseOAuthScopes(client, body.Scope)
+		if ok {
+			scopes = auth.IntersectScopes(scopes, user.Scopes)
+		}
+
+		if !ok || len(scopes) == 0 {
+			log.Debug().Str("client_id", client.ID).Str("scope", body.Scope).Msg("Requested scopes cannot be granted to OAuth client")
+			return respond(url.Values{
+				"error":             {types.OauthErrorResponseErrorInvalidScope},
+				"error_description": {"Requested scopes are invalid or cannot be granted"},
+			})
+		}
+
+		code := models.OauthAuthorizationCode{
+			OauthClientID:       client.ID,
+			UserID:              user.ID,
+			RedirectURI:         *body.RedirectURI,
+			Scopes:              scopes,
+			CodeChallenge:       *body.CodeChallenge,
+			CodeChallengeMethod: *body.CodeChallengeMethod,
+			ValidUntil:          time.Now().Add(s.Config.Auth.OAuth.AuthorizationCodeValidity),
+		}
+
+		if err := code.Insert(ctx, s.DB, boil.Infer()); err != nil {
+			log.Debug().Err(err).Msg("Failed to insert OAuth authorization code")
+			return err
+		}
+
+		log.Debug().Str("client_id", client.ID).Strs("scopes", scopes).Msg("Successfully authorized OAuth client, returning authorization code")
+
+		return respond(url.Values{
+			"code": {code.Code},
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util/hashing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	// code verifier and challenge taken from RFC 7636 appendix B
+	testOAuthCodeVerifier  = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	testOAuthCodeChallenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+	testOAuthRedirectURI   = "https://partner.example.com/callback"
+	testOAuthClientSecret  = "2c1d0e4b8f7a4e9c9b3d6a1f5e8c7b2a"
+)
+
+// insertTestOAuthClient inserts a confidential OAuth client allowed to use all grant types, acting on behalf of User2
+// using the client_credentials grant.
+func insertTestOAuthClient(t *testing.T, s *api.Server) *models.OauthClient {
+	t.Helper()
+
+	hash, err := hashing.HashPassword(testOAuthClientSecret, hashing.DefaultArgon2Params)
+	require.NoError(t, err)
+
+	client := &models.OauthClient{
+		Name:         "Partner",
+		SecretHash:   null.StringFrom(hash),
+		RedirectUris: []string{testOAuthRedirectURI},
+		GrantTypes:   []string{"authorization_code", "refresh_token", "client_credentials"},
+		Scopes:       []string{"app"},
+		UserID:       null.StringFrom(test.Fixtures().User2.ID),
+	}
+
+	err = client.Insert(context.Background(), s.DB, boil.Infer())
+	require.NoError(t, err)
+
+	return client
+}
+
+// authorizeTestOAuthClient authorizes the given client on behalf of User1, returning the authorization code issued.
+func authorizeTestOAuthClient(t *testing.T, s *api.Server, client *models.OauthClient) string {
+	t.Helper()
+
+	payload := test.GenericPayload{
+		"client_id":             client.ID,
+		"redirect_uri":          testOAuthRedirectURI,
+		"response_type":         "code",
+		"code_challenge":        testOAuthCodeChallenge,
+		"code_challenge_method": "S256",
+		"state":                 "af0ifjsldkj",
+	}
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/auth/oauth/authorize", payload, test.HeadersWithAuth(t, test.Fixtures().User1AccessToken1.Token))
+	require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+	var response types.PostOauthAuthorizeResponse
+	test.ParseResponseAndValidate(t, res, &response)
+
+	redirectURI, err := url.Parse(*response.RedirectURI)
+	require.NoError(t, err)
+	require.Empty(t, redirectURI.Query().Get("error"))
+	assert.Equal(t, "af0ifjsldkj", redirectURI.Query().Get("state"))
+
+	return redirectURI.Query().Get("code")
+}
+
+func TestPostOauthAuthorizeSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		client := insertTestOAuthClient(t, s)
+
+		code := authorizeTestOAuthClient(t, s, client)
+		require.NotEmpty(t, code)
+
+		authCode, err := models.FindOauthAuthorizationCode(ctx, s.DB, code)
+		require.NoError(t, err)
+		assert.Equal(t, client.ID, authCode.OauthClientID)
+		assert.Equal(t, fixtures.User1.ID, authCode.UserID)
+		assert.Equal(t, testOAuthRedirectURI, authCode.RedirectURI)
+		assert.Equal(t, testOAuthCodeChallenge, authCode.CodeChallenge)
+		assert.ElementsMatch(t, []string{"app"}, authCode.Scopes)
+	})
+}
+
+func TestPostOauthAuthorizeInvalidScope(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+		client := insertTestOAuthClient(t, s)
+
+		payload := test.GenericPayload{
+			"client_id":             client.ID,
+			"redirect_uri":          testOAuthRedirectURI,
+			"response_type":         "code",
+			"scope":                 "app cms",
+			"code_challenge":        testOAuthCodeChallenge,
+			"code_challenge_method": "S256",
+			"state":                 "af0ifjsldkj",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/oauth/authorize", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostOauthAuthorizeResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		redirectURI, err := url.Parse(*response.RedirectURI)
+		require.NoError(t, err)
+		assert.Equal(t, "invalid_scope", redirectURI.Query().Get("error"))
+		assert.Equal(t, "af0ifjsldkj", redirectURI.Query().Get("state"))
+		assert.Empty(t, redirectURI.Query().Get("code"))
+	})
+}
+
+func TestPostOauthAuthorizeInvalidRedirectURI(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+		client := insertTestOAuthClient(t, s)
+
+		payload := test.GenericPayload{
+			"client_id":             client.ID,
+			"redirect_uri":          "https://attacker.example.com/callback",
+			"response_type":         "code",
+			"code_challenge":        testOAuthCodeChallenge,
+			"code_challenge_method": "S256",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/oauth/authorize", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrBadRequestInvalidRedirectURI.Type, *response.Type)
+	})
+}
+
+func TestPostOauthAuthorizeUnknownClient(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"client_id":             "7a9a4bd6-3cb6-4b7e-8b63-1b9d0f3e6a21",
+			"redirect_uri":          testOAuthRedirectURI,
+			"response_type":         "code",
+			"code_challenge":        testOAuthCodeChallenge,
+			"code_challenge_method": "S256",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/oauth/authorize", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *httperrors.ErrNotFoundOAuthClientNotFound.Type, *response.Type)
+	})
+}
+
+func TestPostOauthAuthorizePlainCodeChallengeMethod(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+		client := insertTestOAuthClient(t, s)
+
+		payload := test.GenericPayload{
+			"client_id":             client.ID,
+			"redirect_uri":          testOAuthRedirectURI,
+			"response_type":         "code",
+			"code_challenge":        testOAuthCodeVerifier,
+			"code_challenge_method": "plain",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/oauth/authorize", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+	})
+}
+
+func TestPostOauthAuthorizeUnauthenticated(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		client := insertTestOAuthClient(t, s)
+
+		payload := test.GenericPayload{
+			"client_id":             client.ID,
+			"redirect_uri":          testOAuthRedirectURI,
+			"response_type":         "code",
+			"code_challenge":        testOAuthCodeChallenge,
+			"code_challenge_method": "S256",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/oauth/authorize", payload, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
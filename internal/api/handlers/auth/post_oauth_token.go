@@ -0,0 +1,280 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	authTypes "allaboutapps.dev/aw/go-starter/internal/types/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// oauthTokenError is returned while processing a token request, responded to with the given OAuth 2.0 error code.
+type oauthTokenError struct {
+	Code        string
+	Description string
+}
+
+func (e *oauthTokenError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+func PostOauthTokenRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/oauth/token", postOauthTokenHandler(s))
+}
+
+func postOauthTokenHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := authTypes.NewPostOauthTokenRouteParams()
+		if err := util.BindAndValidateBody(c, &params); err != nil {
+			log.Debug().Err(err).Msg("Failed to bind OAuth token request")
+
+			if len(params.GrantType) > 0 && !isSupportedOAuthGrantType(params.GrantType) {
+				return oauthError(c, http.StatusBadRequest, types.OauthErrorResponseErrorUnsupportedGrantType, "Grant type is not supported")
+			}
+
+			return oauthError(c, http.StatusBadRequest, types.OauthErrorResponseErrorInvalidRequest, "Token request is malformed")
+		}
+
+		client, err := authenticateOAuthClient(ctx, s.DB, c, swag.StringValue(params.ClientID), swag.StringValue(params.ClientSecret))
+		if err != nil {
+			if errors.Is(err, errOAuthClientInvalid) {
+				log.Debug().Msg("Failed to authenticate OAuth client")
+				return oauthError(c, http.StatusUnauthorized, types.OauthErrorResponseErrorInvalidClient, "Client authentication failed")
+			}
+
+			log.Debug().Err(err).Msg("Failed to load OAuth client")
+			return err
+		}
+
+		grantType := auth.OAuthGrantType(params.GrantType)
+		if !oauthClientHasGrantType(client, grantType) {
+			log.Debug().Str("client_id", client.ID).Str("grant_type", grantType.String()).Msg("OAuth client is not allowed to use grant type")
+			return oauthError(c, http.StatusBadRequest, types.OauthErrorResponseErrorUnauthorizedClient, "Client is not allowed to use the grant type")
+		}
+
+		var response *types.PostOauthTokenResponse
+		switch grantType {
+		case auth.OAuthGrantTypeAuthorizationCode:
+			response, err = exchangeOAuthAuthorizationCode(ctx, s, c, client, params)
+		case auth.OAuthGrantTypeRefreshToken:
+			response, err = refreshOAuthTokens(ctx, s, c, client, params)
+		case auth.OAuthGrantTypeClientCredentials:
+			response, err = issueOAuthClientCredentialsToken(ctx, s, client, params)
+		}
+		if err != nil {
+			var tokenErr *oauthTokenError
+			if errors.As(err, &tokenErr) {
+				log.Debug().Err(err).Str("client_id", client.ID).Str("grant_type", grantType.String()).Msg("Rejecting OAuth token request")
+				return oauthError(c, http.StatusBadRequest, tokenErr.Code, tokenErr.Description)
+			}
+
+			log.Debug().Err(err).Msg("Failed to issue OAuth tokens")
+			return err
+		}
+
+		log.Debug().Str("client_id", client.ID).Str("grant_type", grantType.String()).Msg("Successfully issued OAuth tokens")
+
+		c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+// exchangeOAuthAuthorizationCode handles the authorization_code grant, exchanging a single-use authorization code
+// for access and refresh tokens after verifying the PKCE code verifier.
+func exchangeOAuthAuthorizationCode(ctx context.Context, s *api.Server, c echo.Context, client *models.OauthClient, params authTypes.PostOauthTokenRouteParams) (*types.PostOauthTokenResponse, error) {
+	if params.Code == nil || params.RedirectURI == nil || params.CodeVerifier == nil {
+		return nil, &oauthTokenError{Code: types.OauthErrorResponseErrorInvalidRequest, Description: "Code, redirect URI and code verifier are required"}
+	}
+
+	errInvalidCode := &oauthTokenError{Code: types.OauthErrorResponseErrorInvalidGrant, Description: "Authorization code is invalid or expired"}
+
+	if !strfmt.IsUUID(*params.Code) {
+		return nil, errInvalidCode
+	}
+
+	code, err := models.FindOauthAuthorizationCode(ctx, s.DB, *params.Code)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errInvalidCode
+		}
+
+		return nil, err
+	}
+
+	// Authorization codes are consumed on first use, even if the token request turns out to be invalid
+	rowsAff, err := code.Delete(ctx, s.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsAff == 0 {
+		// Concurrent request exchanged the code in the meantime
+		return nil, errInvalidCode
+	}
+
+	if code.OauthClientID != client.ID ||
+		code.RedirectURI != *params.RedirectURI ||
+		time.Now().After(code.ValidUntil) {
+		return nil, errInvalidCode
+	}
+
+	if code.CodeChallengeMethod != auth.OAuthCodeChallengeMethodS256 || !verifyPKCE(*params.CodeVerifier, code.CodeChallenge) {
+		return nil, &oauthTokenError{Code: types.OauthErrorResponseErrorInvalidGrant, Description: "Code verifier does not match code challenge"}
+	}
+
+	user, err := models.FindUser(ctx, s.DB, code.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsActive {
+		return nil, &oauthTokenError{Code: types.OauthErrorResponseErrorInvalidGrant, Description: "User is deactivated"}
+	}
+
+	grant := &oauthGrant{
+		ClientID: client.ID,
+		Scopes:   code.Scopes,
+	}
+
+	var response *types.PostOauthTokenResponse
+	if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+		refreshToken := models.RefreshToken{
+			UserID:        user.ID,
+			OauthClientID: null.StringFrom(client.ID),
+			Scopes:        grant.Scopes,
+		}
+
+		if err := refreshToken.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+
+		accessToken, validity, err := issueGrantedAccessToken(ctx, s, tx, user, refreshToken.FamilyID, grant)
+		if err != nil {
+			return err
+		}
+
+		// Clients are listed in the user's sessions, allowing their access to be revoked
+		if err := insertSession(ctx, tx, c, user.ID, refreshToken.FamilyID, client.Name); err != nil {
+			return err
+		}
+
+		response = &types.PostOauthTokenResponse{
+			AccessToken:  swag.String(accessToken),
+			ExpiresIn:    swag.Int64(int64(validity.Seconds())),
+			RefreshToken: strfmt.UUID4(refreshToken.Token),
+			Scope:        swag.String(strings.Join(grant.Scopes, " ")),
+			TokenType:    swag.String(TokenTypeBearer),
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// refreshOAuthTokens handles the refresh_token grant, rotating a refresh token previously issued to the client.
+func refreshOAuthTokens(ctx context.Context, s *api.Server, c echo.Context, client *models.OauthClient, params authTypes.PostOauthTokenRouteParams) (*types.PostOauthTokenResponse, error) {
+	if params.RefreshToken == nil {
+		return nil, &oauthTokenError{Code: types.OauthErrorResponseErrorInvalidRequest, Description: "Refresh token is required"}
+	}
+
+	errInvalidRefreshToken := &oauthTokenError{Code: types.OauthErrorResponseErrorInvalidGrant, Description: "Refresh token is invalid or expired"}
+
+	if !strfmt.IsUUID(*params.RefreshToken) {
+		return nil, errInvalidRefreshToken
+	}
+
+	res, grant, err := rotateRefreshToken(ctx, s, c, *params.RefreshToken, client.ID)
+	if err != nil {
+		if errors.Is(err, errRefreshTokenInvalid) {
+			return nil, errInvalidRefreshToken
+		}
+
+		if errors.Is(err, middleware.ErrForbiddenUserDeactivated) {
+			return nil, &oauthTokenError{Code: types.OauthErrorResponseErrorInvalidGrant, Description: "User is deactivated"}
+		}
+
+		return nil, err
+	}
+
+	return &types.PostOauthTokenResponse{
+		AccessToken:  res.AccessToken,
+		ExpiresIn:    res.ExpiresIn,
+		RefreshToken: *res.RefreshToken,
+		Scope:        swag.String(strings.Join(grant.Scopes, " ")),
+		TokenType:    res.TokenType,
+	}, nil
+}
+
+// issueOAuthClientCredentialsToken handles the client_credentials grant, issuing an access token acting on behalf
+// of the client's service user. No refresh token is issued, clients simply request a new access token instead.
+func issueOAuthClientCredentialsToken(ctx context.Context, s *api.Server, client *models.OauthClient, params authTypes.PostOauthTokenRouteParams) (*types.PostOauthTokenResponse, error) {
+	if !client.UserID.Valid {
+		return nil, &oauthTokenError{Code: types.OauthErrorResponseErrorUnauthorizedClient, Description: "Client has no service user configured"}
+	}
+
+	user, err := models.FindUser(ctx, s.DB, client.UserID.String)
+	if err != nil {
+		return nil, err
+	}
+
+	if !user.IsActive {
+		return nil, &oauthTokenError{Code: types.OauthErrorResponseErrorUnauthorizedClient, Description: "Client's service user is deactivated"}
+	}
+
+	scopes, ok := parseOAuthScopes(client, swag.StringValue(params.Scope))
+	if ok {
+		scopes = auth.IntersectScopes(scopes, user.Scopes)
+	}
+
+	if !ok || len(scopes) == 0 {
+		return nil, &oauthTokenError{Code: types.OauthErrorResponseErrorInvalidScope, Description: "Requested scopes are invalid or cannot be granted"}
+	}
+
+	accessToken, validity, err := issueGrantedAccessToken(ctx, s, s.DB, user, "", &oauthGrant{
+		ClientID: client.ID,
+		Scopes:   scopes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PostOauthTokenResponse{
+		AccessToken: swag.String(accessToken),
+		ExpiresIn:   swag.Int64(int64(validity.Seconds())),
+		Scope:       swag.String(strings.Join(scopes, " ")),
+		TokenType:   swag.String(TokenTypeBearer),
+	}, nil
+}
+
+// isSupportedOAuthGrantType reports whether the given grant type is supported by our token endpoint.
+func isSupportedOAuthGrantType(grantType string) bool {
+	for _, g := range auth.OAuthGrantTypes() {
+		if g.String() == grantType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,398 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func performOAuthTokenRequest(t *testing.T, s *api.Server, form url.Values, client *models.OauthClient) *httptest.ResponseRecorder {
+	t.Helper()
+
+	headers := http.Header{}
+	headers.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	if client != nil {
+		req := httptest.NewRequest("POST", "/", nil)
+		req.SetBasicAuth(client.ID, testOAuthClientSecret)
+		headers.Set(echo.HeaderAuthorization, req.Header.Get(echo.HeaderAuthorization))
+	}
+
+	return test.PerformRequestWithRawBody(t, s, "POST", "/api/v1/auth/oauth/token", strings.NewReader(form.Encode()), headers, nil)
+}
+
+func requireOAuthError(t *testing.T, res *httptest.ResponseRecorder, statusCode int, errorCode string) {
+	t.Helper()
+
+	require.Equal(t, statusCode, res.Result().StatusCode)
+
+	var response types.OauthErrorResponse
+	test.ParseResponseAndValidate(t, res, &response)
+
+	assert.Equal(t, errorCode, *response.Error)
+}
+
+func TestPostOauthTokenAuthorizationCode(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		client := insertTestOAuthClient(t, s)
+
+		code := authorizeTestOAuthClient(t, s, client)
+
+		form := url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {code},
+			"redirect_uri":  {testOAuthRedirectURI},
+			"code_verifier": {testOAuthCodeVerifier},
+		}
+
+		res := performOAuthTokenRequest(t, s, form, client)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, "no-store", res.Header().Get(echo.HeaderCacheControl))
+
+		var response types.PostOauthTokenResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.NotEmpty(t, response.AccessToken)
+		assert.NotEmpty(t, response.RefreshToken)
+		assert.Equal(t, "bearer", *response.TokenType)
+		assert.Equal(t, "app", *response.Scope)
+
+		accessToken, err := models.FindAccessToken(ctx, s.DB, *response.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, fixtures.User1.ID, accessToken.UserID)
+		assert.Equal(t, client.ID, accessToken.OauthClientID.String)
+		assert.ElementsMatch(t, []string{"app"}, accessToken.Scopes)
+
+		session, err := models.Sessions(models.SessionWhere.ID.EQ(accessToken.RefreshTokenFamilyID.String)).One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, client.Name, session.DeviceName.String)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, *response.AccessToken))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var userInfo types.GetUserInfoResponse
+		test.ParseResponseAndValidate(t, res, &userInfo)
+
+		assert.Equal(t, fixtures.User1.ID, *userInfo.Sub)
+		assert.ElementsMatch(t, []string{"app"}, userInfo.Scopes)
+
+		// authorization codes are single-use
+		res = performOAuthTokenRequest(t, s, form, client)
+		requireOAuthError(t, res, http.StatusBadRequest, "invalid_grant")
+
+		// refresh tokens issued to the client can be rotated by the client
+		res = performOAuthTokenRequest(t, s, url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {response.RefreshToken.String()},
+		}, client)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var refreshResponse types.PostOauthTokenResponse
+		test.ParseResponseAndValidate(t, res, &refreshResponse)
+
+		assert.NotEqual(t, *response.AccessToken, *refreshResponse.AccessToken)
+		assert.NotEqual(t, response.RefreshToken, refreshResponse.RefreshToken)
+		assert.Equal(t, "app", *refreshResponse.Scope)
+
+		refreshToken, err := models.FindRefreshToken(ctx, s.DB, refreshResponse.RefreshToken.String())
+		require.NoError(t, err)
+		assert.Equal(t, client.ID, refreshToken.OauthClientID.String)
+		assert.Equal(t, accessToken.RefreshTokenFamilyID.String, refreshToken.FamilyID)
+
+		// but not via the first-party refresh endpoint
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/refresh", test.GenericPayload{
+			"refresh_token": refreshResponse.RefreshToken.String(),
+		}, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestPostOauthTokenAuthorizationCodeInvalidVerifier(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		client := insertTestOAuthClient(t, s)
+
+		code := authorizeTestOAuthClient(t, s, client)
+
+		res := performOAuthTokenRequest(t, s, url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {code},
+			"redirect_uri":  {testOAuthRedirectURI},
+			"code_verifier": {"Q6Zlr2d9ZqFvNjFm7x4pLk3wTcR8sYb1uH0aEiGoJnV"},
+		}, client)
+		requireOAuthError(t, res, http.StatusBadRequest, "invalid_grant")
+
+		// the code has been consumed nevertheless
+		exists, err := models.OauthAuthorizationCodeExists(ctx, s.DB, code)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		count, err := models.AccessTokens(models.AccessTokenWhere.OauthClientID.EQ(null.StringFrom(client.ID))).Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
+
+func TestPostOauthTokenAuthorizationCodeRedirectURIMismatch(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		client := insertTestOAuthClient(t, s)
+
+		code := authorizeTestOAuthClient(t, s, client)
+
+		res := performOAuthTokenRequest(t, s, url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {code},
+			"redirect_uri":  {"https://partner.example.com/other"},
+			"code_verifier": {testOAuthCodeVerifier},
+		}, client)
+		requireOAuthError(t, res, http.StatusBadRequest, "invalid_grant")
+	})
+}
+
+func TestPostOauthTokenPublicClient(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		client := insertTestOAuthClient(t, s)
+
+		client.SecretHash = null.NewString("", false)
+		_, err := client.Update(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		code := authorizeTestOAuthClient(t, s, client)
+
+		res := performOAuthTokenRequest(t, s, url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {code},
+			"redirect_uri":  {testOAuthRedirectURI},
+			"code_verifier": {testOAuthCodeVerifier},
+			"client_id":     {client.ID},
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostOauthTokenResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.NotEmpty(t, response.AccessToken)
+	})
+}
+
+func TestPostOauthTokenClientCredentials(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		client := insertTestOAuthClient(t, s)
+
+		res := performOAuthTokenRequest(t, s, url.Values{
+			"grant_type": {"client_credentials"},
+			"scope":      {"app"},
+		}, client)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostOauthTokenResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.NotEmpty(t, response.AccessToken)
+		assert.Empty(t, response.RefreshToken)
+		assert.Equal(t, "app", *response.Scope)
+
+		accessToken, err := models.FindAccessToken(ctx, s.DB, *response.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, fixtures.User2.ID, accessToken.UserID)
+		assert.False(t, accessToken.RefreshTokenFamilyID.Valid)
+
+		// client credentials may also be provided as form parameters
+		res = performOAuthTokenRequest(t, s, url.Values{
+			"grant_type":    {"client_credentials"},
+			"client_id":     {client.ID},
+			"client_secret": {testOAuthClientSecret},
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		res = performOAuthTokenRequest(t, s, url.Values{
+			"grant_type": {"client_credentials"},
+			"scope":      {"cms"},
+		}, client)
+		requireOAuthError(t, res, http.StatusBadRequest, "invalid_scope")
+	})
+}
+
+func TestPostOauthTokenInvalidClient(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		client := insertTestOAuthClient(t, s)
+
+		res := performOAuthTokenRequest(t, s, url.Values{
+			"grant_type":    {"client_credentials"},
+			"client_id":     {client.ID},
+			"client_secret": {"wrong"},
+		}, nil)
+		requireOAuthError(t, res, http.StatusUnauthorized, "invalid_client")
+		assert.NotEmpty(t, res.Header().Get(echo.HeaderWWWAuthenticate))
+
+		res = performOAuthTokenRequest(t, s, url.Values{
+			"grant_type": {"client_credentials"},
+			"client_id":  {"not-a-uuid"},
+		}, nil)
+		requireOAuthError(t, res, http.StatusUnauthorized, "invalid_client")
+
+		res = performOAuthTokenRequest(t, s, url.Values{
+			"grant_type": {"client_credentials"},
+		}, nil)
+		requireOAuthError(t, res, http.StatusUnauthorized, "invalid_client")
+	})
+}
+
+func TestPostOauthTokenUnsupportedGrantType(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		client := insertTestOAuthClient(t, s)
+
+		res := performOAuthTokenRequest(t, s, url.Values{
+			"grant_type": {"password"},
+			"username":   {"user1@example.com"},
+			"password":   {test.PlainTestUserPassword},
+		}, client)
+		requireOAuthError(t, res, http.StatusBadRequest, "unsupported_grant_type")
+
+		res = performOAuthTokenRequest(t, s, url.Values{}, client)
+		requireOAuthError(t, res, http.StatusBadRequest, "invalid_request")
+	})
+}
+
+func TestPostOauthTokenUnauthorizedClient(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		client := insertTestOAuthClient(t, s)
+
+		client.GrantTypes = []string{"authorization_code"}
+		_, err := client.Update(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		res := performOAuthTokenRequest(t, s, url.Values{
+			"grant_type": {"client_credentials"},
+		}, client)
+		requireOAuthError(t, res, http.StatusBadRequest, "unauthorized_client")
+	})
+}
+
+func TestPostOauthTokenRefreshTokenOfOtherClient(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+		client := insertTestOAuthClient(t, s)
+
+		// first-party refresh tokens cannot be used by OAuth clients
+		res := performOAuthTokenRequest(t, s, url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {fixtures.User1RefreshToken1.Token},
+		}, client)
+		requireOAuthError(t, res, http.StatusBadRequest, "invalid_grant")
+	})
+}
+
+func TestOAuthAccessTokenScopeRestriction(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		client := insertTestOAuthClient(t, s)
+
+		accessToken := models.AccessToken{
+			ValidUntil:    fixtures.User1AccessToken1.ValidUntil,
+			UserID:        fixtures.User1.ID,
+			OauthClientID: null.StringFrom(client.ID),
+			Scopes:        []string{"cms"},
+		}
+
+		err := accessToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		// the user holds the app scope, but it has not been granted to the client
+		res := test.PerformRequest(t, s, "GET", "/api/v1/push/test", nil, test.HeadersWithAuth(t, accessToken.Token))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+	})
+}
+
+// requireFirstPartyOnly asserts the given access token issued to an OAuth client may access the user's info,
+// but none of the other endpoints of the auth group.
+func requireFirstPartyOnly(t *testing.T, s *api.Server, accessToken string) {
+	t.Helper()
+
+	res := test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, accessToken))
+	require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+	for _, path := range []string{"/api/v1/auth/change-email", "/api/v1/auth/api-keys", "/api/v1/auth/oauth/authorize"} {
+		res := test.PerformRequest(t, s, "POST", path, test.GenericPayload{}, test.HeadersWithAuth(t, accessToken))
+		require.Equal(t, http.StatusForbidden, res.Result().StatusCode, path)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *middleware.ErrForbiddenRestrictedCredentials.Type, *response.Type, path)
+	}
+}
+
+func TestOAuthAccessTokenFirstPartyOnly(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		client := insertTestOAuthClient(t, s)
+
+		res := performOAuthTokenRequest(t, s, url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {authorizeTestOAuthClient(t, s, client)},
+			"redirect_uri":  {testOAuthRedirectURI},
+			"code_verifier": {testOAuthCodeVerifier},
+		}, client)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostOauthTokenResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		require.False(t, auth.IsJWT(*response.AccessToken))
+		requireFirstPartyOnly(t, s, *response.AccessToken)
+	})
+}
+
+func TestOAuthAccessTokenFirstPartyOnlyJWT(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.Auth.TokenFormat = "jwt"
+	config.Auth.JWT.SigningMethod = "EdDSA"
+	config.Auth.JWT.SigningKeyID = "test"
+	config.Auth.JWT.Keys = map[string]string{"test": "V2i0J0bJMn8K6pXbG0vK9m1rW3yQ4uZ7cD2eF5hA8sE="}
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		client := insertTestOAuthClient(t, s)
+
+		res := performOAuthTokenRequest(t, s, url.Values{
+			"grant_type":    {"authorization_code"},
+			"code":          {authorizeTestOAuthClient(t, s, client)},
+			"redirect_uri":  {testOAuthRedirectURI},
+			"code_verifier": {testOAuthCodeVerifier},
+		}, client)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostOauthTokenResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		claims := auth.JWTClaims{}
+		_, _, err := jwt.NewParser().ParseUnverified(*response.AccessToken, &claims)
+		require.NoError(t, err)
+		require.Equal(t, client.ID, claims.ClientID)
+
+		requireFirstPartyOnly(t, s, *response.AccessToken)
+	})
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -16,10 +17,15 @@ import (
 	"github.com/go-openapi/strfmt/conv"
 	"github.com/go-openapi/swag"
 	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
+var (
+	errRefreshTokenInvalid = errors.New("refresh token is invalid")
+)
+
 func PostRefreshRoute(s *api.Server) *echo.Route {
 	return s.Router.APIV1Auth.POST("/refresh", postRefreshHandler(s))
 }
@@ -34,68 +40,180 @@ func postRefreshHandler(s *api.Server) echo.HandlerFunc {
 			return err
 		}
 
-		oldRefreshToken, err := models.RefreshTokens(
-			models.RefreshTokenWhere.Token.EQ(body.RefreshToken.String()),
-			qm.Load(models.RefreshTokenRels.User),
-		).One(ctx, s.DB)
+		response, _, err := rotateRefreshToken(ctx, s, c, body.RefreshToken.String(), "")
 		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				log.Debug().Err(err).Msg("Refresh token not found")
+			if errors.Is(err, errRefreshTokenInvalid) {
 				return echo.ErrUnauthorized
 			}
 
-			log.Debug().Err(err).Msg("Failed to load refresh token")
 			return err
 		}
 
-		user := oldRefreshToken.R.User
+		log.Debug().Msg("Successfully refreshed tokens, returning new set of access and refresh tokens")
 
-		if !user.IsActive {
-			log.Debug().Msg("User is deactivated, rejecting token refresh")
-			return middleware.ErrForbiddenUserDeactivated
-		}
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
 
-		response := &types.PostLoginResponse{
-			TokenType: swag.String(TokenTypeBearer),
-			ExpiresIn: swag.Int64(int64(s.Config.Auth.AccessTokenValidity.Seconds())),
+// rotateRefreshToken exchanges the given refresh token for a new set of access and refresh tokens, marking the
+// old refresh token as rotated. Refresh tokens are only accepted from the OAuth client they were issued to, clientID
+// is empty for first-party clients. The OAuth grant of the refresh token (if any) is carried over to the new tokens
+// and returned as well.
+// Returns errRefreshTokenInvalid if the refresh token is unknown, has been revoked or was already used.
+func rotateRefreshToken(ctx context.Context, s *api.Server, c echo.Context, token string, clientID string) (*types.PostLoginResponse, *oauthGrant, error) {
+	log := util.LogFromContext(ctx)
+
+	oldRefreshToken, err := models.RefreshTokens(
+		models.RefreshTokenWhere.Token.EQ(token),
+		qm.Load(models.RefreshTokenRels.User),
+	).One(ctx, s.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug().Err(err).Msg("Refresh token not found")
+			return nil, nil, errRefreshTokenInvalid
 		}
 
+		log.Debug().Err(err).Msg("Failed to load refresh token")
+		return nil, nil, err
+	}
+
+	user := oldRefreshToken.R.User
+
+	if oldRefreshToken.OauthClientID.String != clientID {
+		log.Debug().
+			Str("user_id", user.ID).
+			Str("oauth_client_id", oldRefreshToken.OauthClientID.String).
+			Str("client_id", clientID).
+			Msg("Refresh token was issued to another client, rejecting token refresh")
+		return nil, nil, errRefreshTokenInvalid
+	}
+
+	if oldRefreshToken.RevokedAt.Valid {
+		log.Debug().
+			Str("user_id", user.ID).
+			Str("family_id", oldRefreshToken.FamilyID).
+			Time("revoked_at", oldRefreshToken.RevokedAt.Time).
+			Msg("Refresh token family has been revoked, rejecting token refresh")
+		return nil, nil, errRefreshTokenInvalid
+	}
+
+	if oldRefreshToken.RotatedAt.Valid {
+		// The refresh token has already been exchanged before, so either the legitimate client or an attacker
+		// is replaying it. As we cannot tell which one it is, the whole token family is revoked.
+		log.Warn().
+			Str("security_event", "refresh_token_reuse").
+			Str("user_id", user.ID).
+			Str("family_id", oldRefreshToken.FamilyID).
+			Time("rotated_at", oldRefreshToken.RotatedAt.Time).
+			Str("ip", c.RealIP()).
+			Str("user_agent", c.Request().UserAgent()).
+			Msg("Refresh token reuse detected, revoking token family")
+
 		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
-			accessToken := models.AccessToken{
-				ValidUntil: time.Now().Add(s.Config.Auth.AccessTokenValidity),
-				UserID:     user.ID,
-			}
+			return revokeRefreshTokenFamily(ctx, tx, oldRefreshToken.FamilyID)
+		}); err != nil {
+			log.Debug().Err(err).Str("family_id", oldRefreshToken.FamilyID).Msg("Failed to revoke refresh token family")
+			return nil, nil, err
+		}
 
-			if err := accessToken.Insert(ctx, tx, boil.Infer()); err != nil {
-				log.Debug().Err(err).Msg("Failed to insert access token")
-				return err
-			}
+		return nil, nil, errRefreshTokenInvalid
+	}
 
-			refreshToken := models.RefreshToken{
-				UserID: user.ID,
-			}
+	if !user.IsActive {
+		log.Debug().Msg("User is deactivated, rejecting token refresh")
+		return nil, nil, middleware.ErrForbiddenUserDeactivated
+	}
 
-			if err := refreshToken.Insert(ctx, tx, boil.Infer()); err != nil {
-				log.Debug().Err(err).Msg("Failed to insert refresh token")
-				return err
-			}
+	var grant *oauthGrant
+	if oldRefreshToken.OauthClientID.Valid {
+		grant = &oauthGrant{
+			ClientID: oldRefreshToken.OauthClientID.String,
+			Scopes:   oldRefreshToken.Scopes,
+		}
+	}
 
-			if _, err := oldRefreshToken.Delete(ctx, tx); err != nil {
-				log.Debug().Err(err).Msg("Failed to delete old refresh token")
-				return err
-			}
+	response := &types.PostLoginResponse{
+		TokenType: swag.String(TokenTypeBearer),
+	}
 
-			response.AccessToken = conv.UUID4(strfmt.UUID4(accessToken.Token))
-			response.RefreshToken = conv.UUID4(strfmt.UUID4(refreshToken.Token))
+	if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+		// Mark the old refresh token as rotated, guarding against concurrent refreshes using the same token
+		rowsAff, err := models.RefreshTokens(
+			models.RefreshTokenWhere.Token.EQ(oldRefreshToken.Token),
+			models.RefreshTokenWhere.RotatedAt.IsNull(),
+			models.RefreshTokenWhere.RevokedAt.IsNull(),
+		).UpdateAll(ctx, tx, models.M{
+			models.RefreshTokenColumns.RotatedAt: null.TimeFrom(time.Now()),
+			models.RefreshTokenColumns.UpdatedAt: time.Now(),
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to mark old refresh token as rotated")
+			return err
+		}
 
-			return nil
-		}); err != nil {
-			log.Debug().Err(err).Msg("Failed to refresh tokens")
+		if rowsAff == 0 {
+			log.Debug().Str("family_id", oldRefreshToken.FamilyID).Msg("Refresh token was rotated concurrently, rejecting token refresh")
+			return errRefreshTokenInvalid
+		}
+
+		refreshToken := models.RefreshToken{
+			UserID:        user.ID,
+			FamilyID:      oldRefreshToken.FamilyID,
+			OauthClientID: oldRefreshToken.OauthClientID,
+			Scopes:        oldRefreshToken.Scopes,
+		}
+
+		if err := refreshToken.Insert(ctx, tx, boil.Infer()); err != nil {
+			log.Debug().Err(err).Msg("Failed to insert refresh token")
 			return err
 		}
 
-		log.Debug().Msg("Successfully refreshed tokens, returning new set of access and refresh tokens")
+		accessToken, validity, err := issueGrantedAccessToken(ctx, s, tx, user, refreshToken.FamilyID, grant)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to issue access token")
+			return err
+		}
 
-		return util.ValidateAndReturn(c, http.StatusOK, response)
+		if err := touchSession(ctx, tx, c, refreshToken.FamilyID); err != nil {
+			log.Debug().Err(err).Msg("Failed to update session")
+			return err
+		}
+
+		response.AccessToken = swag.String(accessToken)
+		response.ExpiresIn = swag.Int64(int64(validity.Seconds()))
+		response.RefreshToken = conv.UUID4(strfmt.UUID4(refreshToken.Token))
+
+		return nil
+	}); err != nil {
+		log.Debug().Err(err).Msg("Failed to refresh tokens")
+		return nil, nil, err
 	}
+
+	return response, grant, nil
+}
+
+// revokeRefreshTokenFamily marks all refresh tokens of the given family as revoked and deletes every
+// access token issued from it as well as the session the family belongs to.
+func revokeRefreshTokenFamily(ctx context.Context, exec boil.ContextExecutor, familyID string) error {
+	if _, err := models.RefreshTokens(
+		models.RefreshTokenWhere.FamilyID.EQ(familyID),
+		models.RefreshTokenWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, exec, models.M{
+		models.RefreshTokenColumns.RevokedAt: null.TimeFrom(time.Now()),
+		models.RefreshTokenColumns.UpdatedAt: time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	if _, err := models.AccessTokens(
+		models.AccessTokenWhere.RefreshTokenFamilyID.EQ(null.StringFrom(familyID)),
+	).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	if _, err := models.Sessions(models.SessionWhere.ID.EQ(familyID)).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	return nil
 }
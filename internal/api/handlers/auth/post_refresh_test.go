@@ -5,14 +5,19 @@ import (
 	"database/sql"
 	"net/http"
 	"testing"
+	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/handlers/auth"
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
 	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"allaboutapps.dev/aw/go-starter/internal/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 func TestPostRefreshSuccess(t *testing.T) {
@@ -38,7 +43,93 @@ func TestPostRefreshSuccess(t *testing.T) {
 		assert.Equal(t, auth.TokenTypeBearer, *response.TokenType)
 
 		err := fixtures.User1RefreshToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, fixtures.User1RefreshToken1.RotatedAt.Valid)
+		assert.False(t, fixtures.User1RefreshToken1.RevokedAt.Valid)
+
+		refreshToken, err := models.FindRefreshToken(ctx, s.DB, response.RefreshToken.String())
+		require.NoError(t, err)
+		assert.Equal(t, fixtures.User1RefreshToken1.FamilyID, refreshToken.FamilyID)
+		assert.False(t, refreshToken.RotatedAt.Valid)
+
+		accessToken, err := models.FindAccessToken(ctx, s.DB, *response.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, null.StringFrom(refreshToken.FamilyID), accessToken.RefreshTokenFamilyID)
+
+		err = fixtures.User1Session1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, fixtures.User1Session1.LastSeenAt.After(time.Now().Add(time.Minute*-1)))
+	})
+}
+
+func TestPostRefreshReuseDetected(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		payload := test.GenericPayload{
+			"refresh_token": fixtures.User1RefreshToken1.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/refresh", payload, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.PostLoginResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		// replay the already rotated refresh token
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/refresh", payload, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		err := fixtures.User1RefreshToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, fixtures.User1RefreshToken1.RevokedAt.Valid)
+
+		refreshToken, err := models.FindRefreshToken(ctx, s.DB, response.RefreshToken.String())
+		require.NoError(t, err)
+		assert.True(t, refreshToken.RevokedAt.Valid)
+
+		_, err = models.FindAccessToken(ctx, s.DB, *response.AccessToken)
+		assert.Equal(t, sql.ErrNoRows, err)
+
+		// the latest refresh token of the family must no longer be usable either
+		payload = test.GenericPayload{
+			"refresh_token": response.RefreshToken.String(),
+		}
+
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/refresh", payload, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		// the session itself, including access tokens issued before the rotation, is terminated
+		err = fixtures.User1AccessToken1.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = fixtures.User1Session1.Reload(ctx, s.DB)
 		assert.Equal(t, sql.ErrNoRows, err)
+
+		// other users stay untouched
+		err = fixtures.User2AccessToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+	})
+}
+
+func TestPostRefreshRevokedFamily(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1RefreshToken1.RevokedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1RefreshToken1.Update(ctx, s.DB, boil.Whitelist(models.RefreshTokenColumns.RevokedAt))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"refresh_token": fixtures.User1RefreshToken1.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/refresh", payload, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		err = fixtures.User1RefreshToken1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, fixtures.User1RefreshToken1.RotatedAt.Valid)
 	})
 }
 
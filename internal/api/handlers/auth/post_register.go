@@ -55,7 +55,6 @@ func postRegisterHandler(s *api.Server) echo.HandlerFunc {
 
 		response := &types.PostLoginResponse{
 			TokenType: swag.String(TokenTypeBearer),
-			ExpiresIn: swag.Int64(int64(s.Config.Auth.AccessTokenValidity.Seconds())),
 		}
 
 		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
@@ -81,13 +80,8 @@ func postRegisterHandler(s *api.Server) echo.HandlerFunc {
 				return err
 			}
 
-			accessToken := models.AccessToken{
-				ValidUntil: time.Now().Add(s.Config.Auth.AccessTokenValidity),
-				UserID:     user.ID,
-			}
-
-			if err := accessToken.Insert(ctx, tx, boil.Infer()); err != nil {
-				log.Debug().Err(err).Msg("Failed to insert access token")
+			if err := sendEmailVerification(ctx, s, tx, user); err != nil {
+				log.Debug().Err(err).Msg("Failed to send email verification")
 				return err
 			}
 
@@ -100,7 +94,19 @@ func postRegisterHandler(s *api.Server) echo.HandlerFunc {
 				return err
 			}
 
-			response.AccessToken = conv.UUID4(strfmt.UUID4(accessToken.Token))
+			accessToken, validity, err := issueAccessToken(ctx, s, tx, user, refreshToken.FamilyID)
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to issue access token")
+				return err
+			}
+
+			if err := insertSession(ctx, tx, c, user.ID, refreshToken.FamilyID, body.DeviceName); err != nil {
+				log.Debug().Err(err).Msg("Failed to insert session")
+				return err
+			}
+
+			response.AccessToken = swag.String(accessToken)
+			response.ExpiresIn = swag.Int64(int64(validity.Seconds()))
 			response.RefreshToken = conv.UUID4(strfmt.UUID4(refreshToken.Token))
 
 			return nil
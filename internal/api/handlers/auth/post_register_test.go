@@ -2,6 +2,7 @@ package auth_test
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
@@ -57,10 +58,20 @@ func TestPostRegisterSuccess(t *testing.T) {
 		assert.Equal(t, false, user.R.AppUserProfile.LegalAcceptedAt.Valid)
 
 		assert.Len(t, user.R.AccessTokens, 1)
-		assert.Equal(t, strfmt.UUID4(user.R.AccessTokens[0].Token), *response.AccessToken)
+		assert.Equal(t, user.R.AccessTokens[0].Token, *response.AccessToken)
 		assert.Len(t, user.R.RefreshTokens, 1)
 		assert.Equal(t, strfmt.UUID4(user.R.RefreshTokens[0].Token), *response.RefreshToken)
 
+		assert.False(t, user.EmailVerifiedAt.Valid)
+		emailVerificationToken, err := user.EmailVerificationTokens().One(ctx, s.DB)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(s.Config.Auth.EmailVerificationTokenValidity), emailVerificationToken.ValidUntil, time.Second*10)
+
+		mail := getLastSentMail(t, s.Mailer)
+		require.NotNil(t, mail)
+		assert.Equal(t, username, mail.To[0])
+		assert.Contains(t, string(mail.HTML), fmt.Sprintf("http://localhost:3000/verify-email?token=%s", emailVerificationToken.Token))
+
 		res2 := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
 
 		assert.Equal(t, http.StatusOK, res2.Result().StatusCode)
@@ -290,7 +301,7 @@ func TestPostRegisterSuccessLowercaseTrimWhitespaces(t *testing.T) {
 		assert.Equal(t, false, user.R.AppUserProfile.LegalAcceptedAt.Valid)
 
 		assert.Len(t, user.R.AccessTokens, 1)
-		assert.Equal(t, strfmt.UUID4(user.R.AccessTokens[0].Token), *response.AccessToken)
+		assert.Equal(t, user.R.AccessTokens[0].Token, *response.AccessToken)
 		assert.Len(t, user.R.RefreshTokens, 1)
 		assert.Equal(t, strfmt.UUID4(user.R.RefreshTokens[0].Token), *response.RefreshToken)
 
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostResendVerificationRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/resend-verification", postResendVerificationHandler(s))
+}
+
+func postResendVerificationHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		var body types.PostResendVerificationPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		// enforce lowercase usernames, trim whitespaces
+		username := util.ToUsernameFormat(body.Username.String())
+
+		log := util.LogFromContext(ctx).With().Str("username", username).Logger()
+
+		user, err := models.Users(models.UserWhere.Username.EQ(null.StringFrom(username))).One(ctx, s.DB)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("User not found")
+				return c.NoContent(http.StatusNoContent)
+			}
+
+			log.Debug().Err(err).Msg("Failed to load user")
+			return err
+		}
+
+		if !user.IsActive {
+			log.Debug().Msg("User is deactivated, rejecting email verification")
+			return c.NoContent(http.StatusNoContent)
+		}
+
+		if user.EmailVerifiedAt.Valid {
+			log.Debug().Msg("User has already verified their email address, skipping email verification")
+			return c.NoContent(http.StatusNoContent)
+		}
+
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			return sendEmailVerification(ctx, s, tx, user)
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to resend email verification")
+			return err
+		}
+
+		log.Debug().Msg("Successfully resent email verification")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
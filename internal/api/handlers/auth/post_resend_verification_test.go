@@ -0,0 +1,104 @@
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestPostResendVerificationSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.EmailVerifiedAt = null.Time{}
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.EmailVerifiedAt))
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"username": fixtures.User1.Username,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/resend-verification", payload, nil)
+
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		emailVerificationToken, err := fixtures.User1.EmailVerificationTokens().One(ctx, s.DB)
+		require.NoError(t, err)
+
+		mail := getLastSentMail(t, s.Mailer)
+		require.NotNil(t, mail)
+		assert.Equal(t, fixtures.User1.Username.String, mail.To[0])
+		assert.Contains(t, string(mail.HTML), fmt.Sprintf("http://localhost:3000/verify-email?token=%s", emailVerificationToken.Token))
+	})
+}
+
+func TestPostResendVerificationAlreadyVerified(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"username": fixtures.User1.Username,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/resend-verification", payload, nil)
+
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		cnt, err := models.EmailVerificationTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+
+		mail := getLastSentMail(t, s.Mailer)
+		assert.Nil(t, mail)
+	})
+}
+
+func TestPostResendVerificationUnknownUser(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		payload := test.GenericPayload{
+			"username": "definitelydoesnotexist@example.com",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/resend-verification", payload, nil)
+
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		cnt, err := models.EmailVerificationTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+
+		mail := getLastSentMail(t, s.Mailer)
+		assert.Nil(t, mail)
+	})
+}
+
+func TestPostResendVerificationDeactivatedUser(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"username": fixtures.UserDeactivated.Username,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/resend-verification", payload, nil)
+
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		cnt, err := models.EmailVerificationTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+	})
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func PostRevokeOtherSessionsRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/sessions/revoke-others", postRevokeOtherSessionsHandler(s), middleware.AuthWithConfig(middleware.AuthConfig{S: s, Mode: middleware.AuthModeSecure}))
+}
+
+func postRevokeOtherSessionsHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		user := auth.UserFromEchoContext(c)
+		token := auth.AccessTokenFromEchoContext(c)
+
+		currentID := auth.SessionIDFromEchoContext(c)
+
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			// Tokens issued before sessions were introduced do not belong to any family, so everything
+			// except the access token used for this request (and its family, if any) is destroyed.
+			if _, err := user.AccessTokens(
+				models.AccessTokenWhere.Token.NEQ(*token),
+			).DeleteAll(ctx, tx); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete other access tokens")
+				return err
+			}
+
+			refreshTokenMods := []qm.QueryMod{}
+			sessionMods := []qm.QueryMod{}
+			if len(currentID) > 0 {
+				refreshTokenMods = append(refreshTokenMods, models.RefreshTokenWhere.FamilyID.NEQ(currentID))
+				sessionMods = append(sessionMods, models.SessionWhere.ID.NEQ(currentID))
+			}
+
+			if _, err := user.RefreshTokens(refreshTokenMods...).DeleteAll(ctx, tx); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete other refresh tokens")
+				return err
+			}
+
+			if _, err := user.Sessions(sessionMods...).DeleteAll(ctx, tx); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete other sessions")
+				return err
+			}
+
+			return nil
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to revoke other sessions")
+			return err
+		}
+
+		log.Debug().Msg("Successfully revoked all other sessions of user")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
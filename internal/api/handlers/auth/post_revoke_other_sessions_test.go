@@ -0,0 +1,75 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestPostRevokeOtherSessionsSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.LastAuthenticatedAt = null.TimeFrom(time.Now())
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.LastAuthenticatedAt))
+		require.NoError(t, err)
+
+		otherSession := models.Session{
+			ID:         "c1f3b6a2-5d0e-4f7a-8b39-2e6d4a9c1f05",
+			UserID:     fixtures.User1.ID,
+			LastSeenAt: time.Now(),
+		}
+		err = otherSession.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		otherRefreshToken := models.RefreshToken{
+			UserID:   fixtures.User1.ID,
+			FamilyID: otherSession.ID,
+		}
+		err = otherRefreshToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		otherAccessToken := models.AccessToken{
+			ValidUntil:           time.Now().Add(time.Hour),
+			UserID:               fixtures.User1.ID,
+			RefreshTokenFamilyID: null.StringFrom(otherSession.ID),
+		}
+		err = otherAccessToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/sessions/revoke-others", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err = otherSession.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = otherRefreshToken.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+		err = otherAccessToken.Reload(ctx, s.DB)
+		assert.Equal(t, sql.ErrNoRows, err)
+
+		err = fixtures.User1Session1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+		err = fixtures.User1AccessToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+		err = fixtures.User1RefreshToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+
+		// sessions of other users must not be affected
+		err = fixtures.User2AccessToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+		err = fixtures.User2RefreshToken1.Reload(ctx, s.DB)
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func PostVerifyEmailRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.POST("/verify-email", postVerifyEmailHandler(s))
+}
+
+func postVerifyEmailHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostVerifyEmailPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		emailVerificationToken, err := models.EmailVerificationTokens(
+			models.EmailVerificationTokenWhere.Token.EQ(body.Token.String()),
+			qm.Load(models.EmailVerificationTokenRels.User),
+		).One(ctx, s.DB)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Err(err).Msg("Email verification token not found")
+				return httperrors.ErrNotFoundTokenNotFound
+			}
+
+			log.Debug().Err(err).Msg("Failed to load email verification token")
+			return err
+		}
+
+		user := emailVerificationToken.R.User
+
+		if time.Now().After(emailVerificationToken.ValidUntil) {
+			log.Debug().
+				Str("user_id", user.ID).
+				Time("valid_until", emailVerificationToken.ValidUntil).
+				Msg("Email verification token is no longer valid, rejecting email verification")
+			return httperrors.ErrConflictTokenExpired
+		}
+
+		if !user.IsActive {
+			log.Debug().Str("user_id", user.ID).Msg("User is deactivated, rejecting email verification")
+			return middleware.ErrForbiddenUserDeactivated
+		}
+
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			if !user.EmailVerifiedAt.Valid {
+				user.EmailVerifiedAt = null.TimeFrom(time.Now())
+				if _, err := user.Update(ctx, tx, boil.Infer()); err != nil {
+					log.Debug().Err(err).Msg("Failed to update user's email verified at timestamp")
+					return err
+				}
+			}
+
+			if _, err := user.EmailVerificationTokens().DeleteAll(ctx, tx); err != nil {
+				log.Debug().Err(err).Msg("Failed to delete email verification tokens")
+				return err
+			}
+
+			return nil
+		}); err != nil {
+			log.Debug().Err(err).Msg("Failed to verify email address")
+			return err
+		}
+
+		log.Debug().Str("user_id", user.ID).Msg("Successfully verified email address")
+
+		return c.NoContent(http.StatusNoContent)
+	}
+}
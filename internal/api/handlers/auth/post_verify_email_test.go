@@ -0,0 +1,141 @@
+package auth_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestPostVerifyEmailSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.EmailVerifiedAt = null.Time{}
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.EmailVerifiedAt))
+		require.NoError(t, err)
+
+		emailVerificationToken := models.EmailVerificationToken{
+			UserID:     fixtures.User1.ID,
+			ValidUntil: time.Now().Add(time.Hour),
+		}
+		err = emailVerificationToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		otherEmailVerificationToken := models.EmailVerificationToken{
+			UserID:     fixtures.User1.ID,
+			ValidUntil: time.Now().Add(time.Hour),
+		}
+		err = otherEmailVerificationToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"token": emailVerificationToken.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/verify-email", payload, nil)
+
+		assert.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+
+		err = fixtures.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.True(t, fixtures.User1.EmailVerifiedAt.Valid)
+		assert.WithinDuration(t, time.Now(), fixtures.User1.EmailVerifiedAt.Time, time.Second*10)
+
+		cnt, err := fixtures.User1.EmailVerificationTokens().Count(ctx, s.DB)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), cnt)
+
+		// tokens cannot be used twice
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/verify-email", payload, nil)
+
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+	})
+}
+
+func TestPostVerifyEmailTokenExpired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		fixtures.User1.EmailVerifiedAt = null.Time{}
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.EmailVerifiedAt))
+		require.NoError(t, err)
+
+		emailVerificationToken := models.EmailVerificationToken{
+			UserID:     fixtures.User1.ID,
+			ValidUntil: time.Now().Add(-time.Minute),
+		}
+		err = emailVerificationToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"token": emailVerificationToken.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/verify-email", payload, nil)
+
+		assert.Equal(t, http.StatusConflict, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrConflictTokenExpired.Type, *response.Type)
+
+		err = fixtures.User1.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.False(t, fixtures.User1.EmailVerifiedAt.Valid)
+	})
+}
+
+func TestPostVerifyEmailUnknownToken(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		payload := test.GenericPayload{
+			"token": "3b9e1f5c-2a7d-4c8e-9f60-1d4b7a2e8c35",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/verify-email", payload, nil)
+
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrNotFoundTokenNotFound.Type, *response.Type)
+	})
+}
+
+func TestPostVerifyEmailDeactivatedUser(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		emailVerificationToken := models.EmailVerificationToken{
+			UserID:     fixtures.UserDeactivated.ID,
+			ValidUntil: time.Now().Add(time.Hour),
+		}
+		err := emailVerificationToken.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload := test.GenericPayload{
+			"token": emailVerificationToken.Token,
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/verify-email", payload, nil)
+
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *middleware.ErrForbiddenUserDeactivated.Type, *response.Type)
+	})
+}
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// profileAvatarsDir is the blobstore directory avatars are stored in
+	profileAvatarsDir = "avatars"
+)
+
+var (
+	profileAvatarMIMETypes = []string{"image/jpeg", "image/png", "image/webp"}
+)
+
+// findProfile loads the app user profile of the given user, returning ErrNotFoundProfileNotFound if the user
+// does not have a profile (e.g. users only having the cms scope).
+func findProfile(ctx context.Context, s *api.Server, userID string) (*models.AppUserProfile, error) {
+	profile, err := models.FindAppUserProfile(ctx, s.DB, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrNotFoundProfileNotFound
+		}
+
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// requireProfileIfMatch rejects requests modifying the profile without providing its entity tag via If-Match,
+// as clients would otherwise silently overwrite concurrent modifications.
+func requireProfileIfMatch(c echo.Context) error {
+	if !util.HasIfMatch(c) {
+		util.LogFromEchoContext(c).Debug().Msg("Request is missing If-Match header, rejecting profile modification")
+		return httperrors.ErrPreconditionRequiredIfMatchMissing
+	}
+
+	return nil
+}
+
+// updateProfile locks the app user profile of the given user, verifies it has not been modified since the client
+// retrieved it (required If-Match header) and applies the given update. The update returns the columns modified, the profile
+// is only updated if any have been. The profile is returned as stored, allowing to derive its new entity tag.
+func updateProfile(c echo.Context, s *api.Server, userID string, update func(profile *models.AppUserProfile) []string) (*models.AppUserProfile, error) {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	if err := requireProfileIfMatch(c); err != nil {
+		return nil, err
+	}
+
+	var profile *models.AppUserProfile
+	if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+		// lock the profile, so concurrent modifications cannot slip in between checking and updating it
+		var err error
+		profile, err = models.AppUserProfiles(
+			models.AppUserProfileWhere.UserID.EQ(userID),
+			qm.For("UPDATE"),
+		).One(ctx, tx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return httperrors.ErrNotFoundProfileNotFound
+			}
+
+			log.Debug().Err(err).Msg("Failed to load profile")
+			return err
+		}
+
+		if !util.CheckIfMatch(c, profileETag(profile)) {
+			log.Debug().Str("etag", profileETag(profile)).Msg("Profile has been modified since it was last retrieved")
+			return httperrors.ErrPreconditionFailedProfileModified
+		}
+
+		columns := update(profile)
+		if len(columns) == 0 {
+			return nil
+		}
+
+		if _, err := profile.Update(ctx, tx, boil.Whitelist(append(columns, models.AppUserProfileColumns.UpdatedAt)...)); err != nil {
+			log.Debug().Err(err).Msg("Failed to update profile")
+			return err
+		}
+
+		// reload to obtain the timestamp as stored, which the entity tag is derived from
+		return profile.Reload(ctx, tx)
+	}); err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+// profileETag returns the entity tag of the given profile, derived from its last update. Timestamps are stored
+// with microsecond precision, thus the profile must have been loaded from the database after updating.
+func profileETag(profile *models.AppUserProfile) string {
+	return fmt.Sprintf(`"%d"`, profile.UpdatedAt.UnixMicro())
+}
+
+// profileAvatarKey returns the blobstore key of the avatar file with the given name.
+func profileAvatarKey(fileName string) string {
+	return path.Join(profileAvatarsDir, fileName)
+}
+
+// removeProfileAvatar removes the avatar file with the given name, ignoring files already removed.
+func removeProfileAvatar(ctx context.Context, s *api.Server, fileName string) error {
+	if err := s.Blobstore.Delete(ctx, profileAvatarKey(fileName)); err != nil {
+		return fmt.Errorf("failed to remove avatar file: %w", err)
+	}
+
+	return nil
+}
+
+// returnProfile returns the given profile as response, setting its entity tag via the ETag header.
+func returnProfile(c echo.Context, profile *models.AppUserProfile) error {
+	c.Response().Header().Set(util.HTTPHeaderETag, profileETag(profile))
+
+	response := &types.Profile{
+		DisplayName: profile.DisplayName.Ptr(),
+		GivenName:   profile.GivenName.Ptr(),
+		FamilyName:  profile.FamilyName.Ptr(),
+		Locale:      profile.Locale.Ptr(),
+		HasAvatar:   swag.Bool(profile.AvatarFileName.Valid),
+		UpdatedAt:   conv.DateTime(strfmt.DateTime(profile.UpdatedAt)),
+	}
+
+	if profile.LegalAcceptedAt.Valid {
+		response.LegalAcceptedAt = conv.DateTime(strfmt.DateTime(profile.LegalAcceptedAt.Time))
+	}
+
+	return util.ValidateAndReturn(c, http.StatusOK, response)
+}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"fmt"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+)
+
+func PutProfileAvatarRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Auth.PUT("/profile/avatar", putProfileAvatarHandler(s))
+}
+
+func putProfileAvatarHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		user := auth.UserFromEchoContext(c)
+
+		// checked upfront as well, so avatars of requests rejected anyway are not processed and stored
+		if err := requireProfileIfMatch(c); err != nil {
+			return err
+		}
+
+		fh, file, mime, err := util.ParseFileUpload(c, "file", profileAvatarMIMETypes)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		if fh.Size > s.Config.Profile.AvatarMaxFileSize {
+			log.Debug().Int64("file_size", fh.Size).Int64("max_file_size", s.Config.Profile.AvatarMaxFileSize).Msg("Avatar exceeds maximum file size")
+			return httperrors.ErrRequestEntityTooLargeAvatarTooLarge
+		}
+
+		avatar, err := s.SanitizeImage(ctx, file)
+		if err != nil {
+			return err
+		}
+
+		// every upload is stored using a new file name, the previous avatar remains available until the profile has been updated
+		fileName := fmt.Sprintf("%s-%d%s", user.ID, time.Now().UnixNano(), mime.Extension())
+		if err := s.Blobstore.Put(ctx, profileAvatarKey(fileName), avatar, int64(avatar.Len()), mime.String()); err != nil {
+			log.Debug().Err(err).Msg("Failed to write avatar file")
+			return err
+		}
+
+		var previousFileName null.String
+		profile, err := updateProfile(c, s, user.ID, func(profile *models.AppUserProfile) []string {
+			previousFileName = profile.AvatarFileName
+			profile.AvatarFileName = null.StringFrom(fileName)
+			profile.AvatarMimeType = null.StringFrom(mime.String())
+
+			return []string{models.AppUserProfileColumns.AvatarFileName, models.AppUserProfileColumns.AvatarMimeType}
+		})
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to upload avatar")
+
+			if err := removeProfileAvatar(ctx, s, fileName); err != nil {
+				log.Error().Err(err).Str("file_name", fileName).Msg("Failed to remove avatar file after failed upload")
+			}
+
+			return err
+		}
+
+		if previousFileName.Valid {
+			if err := removeProfileAvatar(ctx, s, previousFileName.String); err != nil {
+				log.Error().Err(err).Str("file_name", previousFileName.String).Msg("Failed to remove previous avatar file")
+			}
+		}
+
+		log.Debug().Str("file_name", fileName).Msg("Successfully uploaded avatar")
+
+		return returnProfile(c, profile)
+	}
+}
@@ -0,0 +1,202 @@
+package auth_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/imaging"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/storage"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func prepareAvatarUpload(t *testing.T, filePath string, token string) (*bytes.Buffer, http.Header) {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	src, err := os.Open(filePath)
+	require.NoError(t, err)
+	defer src.Close()
+
+	dst, err := writer.CreateFormFile("file", filepath.Base(filePath))
+	require.NoError(t, err)
+
+	_, err = io.Copy(dst, src)
+	require.NoError(t, err)
+
+	err = writer.Close()
+	require.NoError(t, err)
+
+	headers := test.HeadersWithAuth(t, token)
+	headers.Set(echo.HeaderContentType, writer.FormDataContentType())
+	headers.Set("If-Match", "*")
+
+	return &body, headers
+}
+
+func TestPutProfileAvatarSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		examplePath := filepath.Join(util.GetProjectRootDir(), "test", "testdata", "example.jpg")
+		example, err := os.ReadFile(examplePath)
+		require.NoError(t, err)
+
+		// avatars are stored without metadata, removing the comment contained in the example image
+		var sanitized bytes.Buffer
+		_, err = imaging.Sanitize(&sanitized, example, 0, 0)
+		require.NoError(t, err)
+		assert.Less(t, sanitized.Len(), len(example))
+
+		body, headers := prepareAvatarUpload(t, examplePath, fixtures.User1AccessToken1.Token)
+		res := test.PerformRequestWithRawBody(t, s, "PUT", "/api/v1/auth/profile/avatar", body, headers, nil)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.Profile
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.True(t, *response.HasAvatar)
+
+		profile, err := models.FindAppUserProfile(ctx, s.DB, fixtures.User1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "image/jpeg", profile.AvatarMimeType.String)
+
+		firstFileName := profile.AvatarFileName.String
+		_, err = s.Blobstore.Stat(ctx, "avatars/"+firstFileName)
+		assert.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/profile/avatar", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, "image/jpeg", res.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, sanitized.Bytes(), res.Body.Bytes())
+
+		// uploading another avatar replaces the previous file
+		body, headers = prepareAvatarUpload(t, examplePath, fixtures.User1AccessToken1.Token)
+		res = test.PerformRequestWithRawBody(t, s, "PUT", "/api/v1/auth/profile/avatar", body, headers, nil)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		err = profile.Reload(ctx, s.DB)
+		require.NoError(t, err)
+		assert.NotEqual(t, firstFileName, profile.AvatarFileName.String)
+		_, err = s.Blobstore.Stat(ctx, "avatars/"+profile.AvatarFileName.String)
+		assert.NoError(t, err)
+		_, err = s.Blobstore.Stat(ctx, "avatars/"+firstFileName)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+
+		deleteHeaders := test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token)
+		deleteHeaders.Set("If-Match", res.Header().Get("ETag"))
+		res = test.PerformRequest(t, s, "DELETE", "/api/v1/auth/profile/avatar", nil, deleteHeaders)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.False(t, *response.HasAvatar)
+		_, err = s.Blobstore.Stat(ctx, "avatars/"+profile.AvatarFileName.String)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/profile/avatar", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var errResponse httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &errResponse)
+		assert.Equal(t, *httperrors.ErrNotFoundAvatarNotFound.Type, *errResponse.Type)
+	})
+}
+
+func TestPutProfileAvatarIfMatch(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		examplePath := filepath.Join(util.GetProjectRootDir(), "test", "testdata", "example.jpg")
+
+		body, headers := prepareAvatarUpload(t, examplePath, fixtures.User1AccessToken1.Token)
+		headers.Set("If-Match", `"1"`)
+		res := test.PerformRequestWithRawBody(t, s, "PUT", "/api/v1/auth/profile/avatar", body, headers, nil)
+
+		assert.Equal(t, http.StatusPreconditionFailed, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrPreconditionFailedProfileModified.Type, *response.Type)
+
+		profile, err := models.FindAppUserProfile(ctx, s.DB, fixtures.User1.ID)
+		require.NoError(t, err)
+		assert.False(t, profile.AvatarFileName.Valid)
+
+		// the file written is removed again if the profile could not be updated
+		entries, err := os.ReadDir(filepath.Join(s.Config.Storage.Filesystem.BaseDirAbs, "avatars"))
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+}
+
+func TestPutProfileAvatarIfMatchMissing(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		body, headers := prepareAvatarUpload(t, filepath.Join(util.GetProjectRootDir(), "test", "testdata", "example.jpg"), fixtures.User1AccessToken1.Token)
+		headers.Del("If-Match")
+		res := test.PerformRequestWithRawBody(t, s, "PUT", "/api/v1/auth/profile/avatar", body, headers, nil)
+
+		assert.Equal(t, http.StatusPreconditionRequired, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrPreconditionRequiredIfMatchMissing.Type, *response.Type)
+
+		profile, err := models.FindAppUserProfile(ctx, s.DB, fixtures.User1.ID)
+		require.NoError(t, err)
+		assert.False(t, profile.AvatarFileName.Valid)
+	})
+}
+
+func TestPutProfileAvatarTooLarge(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.Profile.AvatarMaxFileSize = 1024
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		body, headers := prepareAvatarUpload(t, filepath.Join(util.GetProjectRootDir(), "test", "testdata", "example.jpg"), fixtures.User1AccessToken1.Token)
+		res := test.PerformRequestWithRawBody(t, s, "PUT", "/api/v1/auth/profile/avatar", body, headers, nil)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrRequestEntityTooLargeAvatarTooLarge.Type, *response.Type)
+	})
+}
+
+func TestPutProfileAvatarUnsupportedMediaType(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		body, headers := prepareAvatarUpload(t, filepath.Join(util.GetProjectRootDir(), "test", "testdata", "plain.sql"), fixtures.User1AccessToken1.Token)
+		res := test.PerformRequestWithRawBody(t, s, "PUT", "/api/v1/auth/profile/avatar", body, headers, nil)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, res.Result().StatusCode)
+	})
+}
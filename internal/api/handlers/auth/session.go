@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// insertSession creates a new session for the given refresh token family, recording the client's
+// user agent and IP address as well as the (optional) device name provided by the user.
+func insertSession(ctx context.Context, exec boil.ContextExecutor, c echo.Context, userID string, familyID string, deviceName string) error {
+	session := models.Session{
+		ID:         familyID,
+		UserID:     userID,
+		DeviceName: null.NewString(deviceName, len(deviceName) > 0),
+		UserAgent:  null.NewString(c.Request().UserAgent(), len(c.Request().UserAgent()) > 0),
+		IPAddress:  null.NewString(c.RealIP(), len(c.RealIP()) > 0),
+		LastSeenAt: time.Now(),
+	}
+
+	return session.Insert(ctx, exec, boil.Infer())
+}
+
+// touchSession updates the last seen timestamp and client information of the session belonging
+// to the given refresh token family. Token families issued before sessions existed are ignored.
+func touchSession(ctx context.Context, exec boil.ContextExecutor, c echo.Context, familyID string) error {
+	_, err := models.Sessions(models.SessionWhere.ID.EQ(familyID)).UpdateAll(ctx, exec, models.M{
+		models.SessionColumns.UserAgent:  null.NewString(c.Request().UserAgent(), len(c.Request().UserAgent()) > 0),
+		models.SessionColumns.IPAddress:  null.NewString(c.RealIP(), len(c.RealIP()) > 0),
+		models.SessionColumns.LastSeenAt: time.Now(),
+		models.SessionColumns.UpdatedAt:  time.Now(),
+	})
+
+	return err
+}
+
+// deleteSession destroys all access and refresh tokens issued throughout the lifetime of the
+// given session before deleting the session itself.
+func deleteSession(ctx context.Context, exec boil.ContextExecutor, sessionID string) error {
+	if _, err := models.AccessTokens(models.AccessTokenWhere.RefreshTokenFamilyID.EQ(null.StringFrom(sessionID))).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	if _, err := models.RefreshTokens(models.RefreshTokenWhere.FamilyID.EQ(sessionID)).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	if _, err := models.Sessions(models.SessionWhere.ID.EQ(sessionID)).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// deleteOtherSessions destroys all sessions of the given user except the given session (if any), including all access and
+// refresh tokens issued throughout their lifetime. Tokens issued before sessions existed do not belong to any session and
+// are destroyed as well.
+func deleteOtherSessions(ctx context.Context, exec boil.ContextExecutor, userID string, sessionID string) error {
+	accessTokenMods := []qm.QueryMod{models.AccessTokenWhere.UserID.EQ(userID)}
+	refreshTokenMods := []qm.QueryMod{models.RefreshTokenWhere.UserID.EQ(userID)}
+	sessionMods := []qm.QueryMod{models.SessionWhere.UserID.EQ(userID)}
+	if len(sessionID) > 0 {
+		accessTokenMods = append(accessTokenMods, db.CombineWithOr([]qm.QueryMod{
+			models.AccessTokenWhere.RefreshTokenFamilyID.IsNull(),
+			models.AccessTokenWhere.RefreshTokenFamilyID.NEQ(null.StringFrom(sessionID)),
+		})...)
+		refreshTokenMods = append(refreshTokenMods, models.RefreshTokenWhere.FamilyID.NEQ(sessionID))
+		sessionMods = append(sessionMods, models.SessionWhere.ID.NEQ(sessionID))
+	}
+
+	if _, err := models.AccessTokens(accessTokenMods...).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	if _, err := models.RefreshTokens(refreshTokenMods...).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	if _, err := models.Sessions(sessionMods...).DeleteAll(ctx, exec); err != nil {
+		return err
+	}
+
+	return nil
+}
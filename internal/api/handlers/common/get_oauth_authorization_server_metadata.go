@@ -0,0 +1,78 @@
+package common
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+)
+
+func GetOauthAuthorizationServerMetadataRoute(s *api.Server) *echo.Route {
+	return s.Router.Root.GET("/.well-known/oauth-authorization-server", getOauthAuthorizationServerMetadataHandler(s))
+}
+
+// Returns the OAuth 2.0 Authorization Server Metadata (RFC 8414) used by clients to discover our endpoints.
+func getOauthAuthorizationServerMetadataHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		authorizationEndpoint, err := joinURL(s.Config.Frontend.BaseURL, s.Config.Frontend.OAuthAuthorizeEndpoint)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to build OAuth authorization endpoint URL")
+			return err
+		}
+
+		tokenEndpoint, err := joinURL(s.Config.Echo.BaseURL, "/api/v1/auth/oauth/token")
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to build OAuth token endpoint URL")
+			return err
+		}
+
+		userInfoEndpoint, err := joinURL(s.Config.Echo.BaseURL, "/api/v1/auth/userinfo")
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to build user info endpoint URL")
+			return err
+		}
+
+		grantTypes := make([]string, 0, len(auth.OAuthGrantTypes()))
+		for _, g := range auth.OAuthGrantTypes() {
+			grantTypes = append(grantTypes, g.String())
+		}
+
+		response := &types.GetOauthAuthorizationServerMetadataResponse{
+			Issuer:                        swag.String(s.Config.Echo.BaseURL),
+			AuthorizationEndpoint:         swag.String(authorizationEndpoint),
+			TokenEndpoint:                 swag.String(tokenEndpoint),
+			UserinfoEndpoint:              userInfoEndpoint,
+			ResponseTypesSupported:        []string{auth.OAuthResponseTypeCode},
+			GrantTypesSupported:           grantTypes,
+			CodeChallengeMethodsSupported: []string{auth.OAuthCodeChallengeMethodS256},
+			TokenEndpointAuthMethodsSupported: []string{
+				auth.OAuthClientAuthMethodSecretBasic,
+				auth.OAuthClientAuthMethodSecretPost,
+				auth.OAuthClientAuthMethodNone,
+			},
+			ScopesSupported: []string{auth.AuthScopeApp.String()},
+		}
+
+		return util.ValidateAndReturn(c, http.StatusOK, response)
+	}
+}
+
+func joinURL(baseURL string, p string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	u.Path = path.Join(u.Path, p)
+
+	return u.String(), nil
+}
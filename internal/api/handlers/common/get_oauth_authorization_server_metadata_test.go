@@ -0,0 +1,37 @@
+package common_test
+
+import (
+	"net/http"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOauthAuthorizationServerMetadata(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.Echo.BaseURL = "https://api.example.com"
+	config.Frontend.BaseURL = "https://example.com"
+	config.Frontend.OAuthAuthorizeEndpoint = "/oauth/authorize"
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "GET", "/.well-known/oauth-authorization-server", nil, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		var response types.GetOauthAuthorizationServerMetadataResponse
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, "https://api.example.com", *response.Issuer)
+		assert.Equal(t, "https://example.com/oauth/authorize", *response.AuthorizationEndpoint)
+		assert.Equal(t, "https://api.example.com/api/v1/auth/oauth/token", *response.TokenEndpoint)
+		assert.Equal(t, "https://api.example.com/api/v1/auth/userinfo", response.UserinfoEndpoint)
+		assert.ElementsMatch(t, []string{"code"}, response.ResponseTypesSupported)
+		assert.ElementsMatch(t, []string{"authorization_code", "refresh_token", "client_credentials"}, response.GrantTypesSupported)
+		assert.ElementsMatch(t, []string{"S256"}, response.CodeChallengeMethodsSupported)
+		assert.ElementsMatch(t, []string{"client_secret_basic", "client_secret_post", "none"}, response.TokenEndpointAuthMethodsSupported)
+	})
+}
@@ -30,7 +30,7 @@ func getReadyHandler(s *api.Server) echo.HandlerFunc {
 		ctx, cancel := context.WithTimeout(c.Request().Context(), s.Config.Management.ReadinessTimeout)
 		defer cancel()
 
-		_, errs := ProbeReadiness(ctx, s.DB, s.Config.Management.ProbeWriteablePathsAbs)
+		_, errs := ProbeReadiness(ctx, s.DB, s.Blobstore, s.Config.Management.ProbeWriteablePathsAbs)
 
 		// Finally return the health status according to the seen states
 		if ctx.Err() != nil || len(errs) != 0 {
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/storage"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"github.com/stretchr/testify/require"
 )
@@ -41,3 +43,17 @@ func TestGetReadyDBBrokenNotReady(t *testing.T) {
 		require.Equal(t, "Not ready.", res.Body.String())
 	})
 }
+
+func TestGetReadyBlobstoreBrokenNotReady(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+
+		// forcefully point the blobstore to a non-existent directory
+		blobstore, err := storage.NewFilesystem(config.StorageFilesystem{BaseDirAbs: "/this/path/does/not/exist"})
+		require.NoError(t, err)
+		s.Blobstore = blobstore
+
+		res := test.PerformRequest(t, s, "GET", "/-/ready", nil, nil)
+		require.Equal(t, 521, res.Result().StatusCode)
+		require.Equal(t, "Not ready.", res.Body.String())
+	})
+}
@@ -0,0 +1,60 @@
+package common
+
+import (
+	"errors"
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/storage"
+	"allaboutapps.dev/aw/go-starter/internal/types/common"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetStorageBlobRoute(s *api.Server) *echo.Route {
+	return s.Router.Root.GET("/api/v1/storage", getStorageBlobHandler(s))
+}
+
+// Serves blobs of the filesystem blobstore by signed URL, other blobstores serve signed URLs themselves.
+func getStorageBlobHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := common.NewGetStorageBlobRouteParams()
+		if err := util.BindAndValidateQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		fs, ok := s.Blobstore.(*storage.Filesystem)
+		if !ok {
+			log.Debug().Str("backend", s.Config.Storage.Backend).Msg("Blobstore does not serve signed URLs via server")
+			return httperrors.ErrNotFoundBlobNotFound
+		}
+
+		if err := fs.VerifySignedURL(params.Key, params.Expires, params.Signature); err != nil {
+			log.Debug().Err(err).Str("key", params.Key).Msg("Failed to verify signed URL")
+
+			if errors.Is(err, storage.ErrSignatureExpired) {
+				return httperrors.ErrForbiddenSignatureExpired
+			}
+
+			return httperrors.ErrForbiddenSignatureInvalid
+		}
+
+		blob, info, err := fs.Get(ctx, params.Key)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				log.Debug().Str("key", params.Key).Msg("Blob does not exist")
+				return httperrors.ErrNotFoundBlobNotFound
+			}
+
+			log.Debug().Err(err).Str("key", params.Key).Msg("Failed to load blob")
+			return err
+		}
+		defer blob.Close()
+
+		return c.Stream(http.StatusOK, info.ContentType, blob)
+	}
+}
@@ -0,0 +1,87 @@
+package common_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signedURLRequestURI(t *testing.T, s *api.Server, key string, expiry time.Duration) string {
+	t.Helper()
+
+	signedURL, err := s.Blobstore.SignedURL(context.Background(), key, expiry)
+	require.NoError(t, err)
+
+	u, err := url.Parse(signedURL)
+	require.NoError(t, err)
+
+	return u.RequestURI()
+}
+
+func TestGetStorageBlobSuccess(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		err := s.Blobstore.Put(context.Background(), "test/hello.txt", strings.NewReader("hello world"), 11, "")
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", signedURLRequestURI(t, s, "test/hello.txt", time.Minute), nil, nil)
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, "text/plain; charset=utf-8", res.Header().Get("Content-Type"))
+		assert.Equal(t, "hello world", res.Body.String())
+	})
+}
+
+func TestGetStorageBlobSignatureInvalid(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		err := s.Blobstore.Put(context.Background(), "test/hello.txt", strings.NewReader("hello world"), 11, "")
+		require.NoError(t, err)
+
+		// the signature of one key does not grant access to another
+		requestURI := signedURLRequestURI(t, s, "test/other.txt", time.Minute)
+		requestURI = strings.Replace(requestURI, "other.txt", "hello.txt", 1)
+
+		res := test.PerformRequest(t, s, "GET", requestURI, nil, nil)
+
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrForbiddenSignatureInvalid.Type, *response.Type)
+	})
+}
+
+func TestGetStorageBlobSignatureExpired(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		err := s.Blobstore.Put(context.Background(), "test/hello.txt", strings.NewReader("hello world"), 11, "")
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", signedURLRequestURI(t, s, "test/hello.txt", -time.Minute), nil, nil)
+
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrForbiddenSignatureExpired.Type, *response.Type)
+	})
+}
+
+func TestGetStorageBlobNotFound(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		res := test.PerformRequest(t, s, "GET", signedURLRequestURI(t, s, "test/missing.txt", time.Minute), nil, nil)
+
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrNotFoundBlobNotFound.Type, *response.Type)
+	})
+}
@@ -9,15 +9,16 @@ import (
 	"sync"
 	"time"
 
+	"allaboutapps.dev/aw/go-starter/internal/storage"
 	"allaboutapps.dev/aw/go-starter/internal/util"
 	"golang.org/x/sys/unix"
 )
 
-func ProbeReadiness(ctx context.Context, database *sql.DB, writeablePaths []string) (string, []error) {
+func ProbeReadiness(ctx context.Context, database *sql.DB, blobstore storage.Blobstore, writeablePaths []string) (string, []error) {
 	var str strings.Builder
 
 	// slice collects all errors from probes
-	errs := make([]error, 0, 1+len(writeablePaths))
+	errs := make([]error, 0, 2+len(writeablePaths))
 
 	// DB readable?
 	dbPingStr, dbPingErr := probeDatabasePingable(ctx, database)
@@ -27,6 +28,14 @@ func ProbeReadiness(ctx context.Context, database *sql.DB, writeablePaths []stri
 		errs = append(errs, dbPingErr)
 	}
 
+	// Blobstore available?
+	blobstoreStr, blobstoreErr := probeBlobstore(ctx, blobstore)
+	str.WriteString(blobstoreStr)
+
+	if blobstoreErr != nil {
+		errs = append(errs, blobstoreErr)
+	}
+
 	// FS (potentially) writeable?
 	for _, writeablePath := range writeablePaths {
 
@@ -43,10 +52,10 @@ func ProbeReadiness(ctx context.Context, database *sql.DB, writeablePaths []stri
 	return str.String(), errs
 }
 
-func ProbeLiveness(ctx context.Context, database *sql.DB, writeablePaths []string, touch string) (string, []error) {
+func ProbeLiveness(ctx context.Context, database *sql.DB, blobstore storage.Blobstore, writeablePaths []string, touch string) (string, []error) {
 
 	// fail immediately if any readiness probes above have already failed.
-	readinessProbeStr, readinessProbeErrs := ProbeReadiness(ctx, database, writeablePaths)
+	readinessProbeStr, readinessProbeErrs := ProbeReadiness(ctx, database, blobstore, writeablePaths)
 
 	if len(readinessProbeErrs) != 0 {
 		return readinessProbeStr, readinessProbeErrs
@@ -157,6 +166,36 @@ func probeDatabaseNextHealthSequence(ctx context.Context, database *sql.DB) (str
 	return str.String(), nil
 }
 
+func probeBlobstore(ctx context.Context, blobstore storage.Blobstore) (string, error) {
+	var str strings.Builder
+	ctxDeadline := ensureProbeDeadlineFromContext(ctx)
+
+	blobstoreStart := time.Now()
+
+	var blobstoreWg sync.WaitGroup
+	var blobstoreErr error
+
+	blobstoreWg.Add(1)
+	go func() {
+		blobstoreErr = blobstore.Probe(ctx)
+		blobstoreWg.Done()
+	}()
+
+	if err := util.WaitTimeout(&blobstoreWg, time.Until(ctxDeadline)); err != nil {
+		fmt.Fprintf(&str, "Probe blobstore: Probe deadline after %s, error=%v.\n", time.Since(blobstoreStart), err.Error())
+		return str.String(), err
+	}
+
+	if blobstoreErr != nil {
+		fmt.Fprintf(&str, "Probe blobstore: Probe errored after %s, error=%v.\n", time.Since(blobstoreStart), blobstoreErr.Error())
+		return str.String(), blobstoreErr
+	}
+
+	fmt.Fprintf(&str, "Probe blobstore: Probe succeeded in %s.\n", time.Since(blobstoreStart))
+
+	return str.String(), nil
+}
+
 func probePathWriteablePermission(ctx context.Context, writeablePath string) (string, error) {
 	var str strings.Builder
 	ctxDeadline := ensureProbeDeadlineFromContext(ctx)
@@ -8,6 +8,8 @@ import (
 	"testing"
 	"time"
 
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/storage"
 	"allaboutapps.dev/aw/go-starter/internal/util"
 	"github.com/stretchr/testify/assert"
 )
@@ -66,3 +68,17 @@ func TestProbePathWriteableTouchInaccessable(t *testing.T) {
 	_, err := probePathWriteableTouch(context.Background(), "/this/path/does/not/exist", ".touch")
 	assert.True(t, os.IsNotExist(err))
 }
+
+func TestProbeBlobstoreInaccessable(t *testing.T) {
+	blobstore, err := storage.NewFilesystem(config.StorageFilesystem{BaseDirAbs: "/this/path/does/not/exist"})
+	assert.NoError(t, err)
+
+	_, err = probeBlobstore(context.Background(), blobstore)
+	assert.Error(t, err)
+
+	blobstore, err = storage.NewFilesystem(config.StorageFilesystem{BaseDirAbs: t.TempDir()})
+	assert.NoError(t, err)
+
+	_, err = probeBlobstore(context.Background(), blobstore)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,196 @@
+package files
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/imaging"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/storage"
+	"allaboutapps.dev/aw/go-starter/internal/types/files"
+	"allaboutapps.dev/aw/go-starter/internal/uploads"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetFileRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Files.GET("/:id", getFileHandler(s))
+}
+
+func getFileHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := files.NewGetFileRouteParams()
+		if err := util.BindAndValidatePathAndQueryParams(c, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromEchoContext(c)
+
+		upload, err := models.Uploads(
+			models.UploadWhere.ID.EQ(params.ID.String()),
+			models.UploadWhere.UserID.EQ(user.ID),
+			models.UploadWhere.CompletedAt.IsNotNull(),
+		).One(ctx, s.DB)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Debug().Str("upload_id", params.ID.String()).Msg("File does not exist or its upload has not been completed yet")
+				return httperrors.ErrNotFoundFileNotFound
+			}
+
+			log.Debug().Err(err).Str("upload_id", params.ID.String()).Msg("Failed to load file")
+			return err
+		}
+
+		if params.Variant == nil && params.Format == nil {
+			return returnOriginal(c, s, upload)
+		}
+
+		return returnVariant(c, s, upload, params)
+	}
+}
+
+// setCacheHeaders sets the entity tag and cache control headers of the response, reporting whether the client's
+// cached representation is still current and 304 Not Modified should be returned.
+func setCacheHeaders(c echo.Context, s *api.Server, etag string) bool {
+	c.Response().Header().Set(util.HTTPHeaderETag, etag)
+	c.Response().Header().Set(util.HTTPHeaderCacheControl, fmt.Sprintf("private, max-age=%d", int(s.Config.Images.CacheMaxAge.Seconds())))
+
+	return util.CheckIfNoneMatch(c, etag)
+}
+
+func returnOriginal(c echo.Context, s *api.Server, upload *models.Upload) error {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	// completed uploads are immutable, their ID thus is a strong entity tag
+	if setCacheHeaders(c, s, fmt.Sprintf("%q", upload.ID)) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	blob, _, err := s.Blobstore.Get(ctx, upload.BlobKey.String)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.Error().Str("upload_id", upload.ID).Str("key", upload.BlobKey.String).Msg("Blob referenced by completed upload does not exist")
+			return httperrors.ErrNotFoundFileNotFound
+		}
+
+		log.Debug().Err(err).Str("upload_id", upload.ID).Msg("Failed to load file from blobstore")
+		return err
+	}
+	defer blob.Close()
+
+	return c.Stream(http.StatusOK, upload.MimeType, blob)
+}
+
+func returnVariant(c echo.Context, s *api.Server, upload *models.Upload, params files.GetFileRouteParams) error {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	sourceFormat, err := imaging.FormatFromMIMEType(upload.MimeType)
+	if err != nil || !sourceFormat.Decodable() {
+		log.Debug().Str("upload_id", upload.ID).Str("mime_type", upload.MimeType).Msg("Variants of file cannot be generated")
+		return httperrors.ErrUnsupportedMediaTypeImageNotProcessable
+	}
+
+	opts := imaging.Options{
+		Format:      sourceFormat,
+		JPEGQuality: s.Config.Images.JPEGQuality,
+		MaxPixels:   s.Config.Images.MaxPixels,
+	}
+
+	// variants are cached by their size, so changing the size of a configured variant invalidates all cached files
+	variantName := uploads.VariantOriginal
+	if params.Variant != nil {
+		variant, ok := s.Config.Images.Variants[*params.Variant]
+		if !ok {
+			log.Debug().Str("variant", *params.Variant).Msg("Image variant is not configured")
+			return httperrors.ErrBadRequestUnknownImageVariant
+		}
+
+		opts.MaxWidth = variant.MaxWidth
+		opts.MaxHeight = variant.MaxHeight
+		variantName = fmt.Sprintf("%s-%dx%d", *params.Variant, variant.MaxWidth, variant.MaxHeight)
+	}
+
+	if params.Format != nil {
+		opts.Format, err = imaging.ParseFormat(*params.Format)
+		if err != nil {
+			log.Debug().Err(err).Msg("Failed to parse image format")
+			return err
+		}
+	}
+
+	if setCacheHeaders(c, s, fmt.Sprintf(`"%s-%s%s"`, upload.ID, variantName, opts.Format.Extension())) {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	path := uploads.VariantFilePath(s.Config.Paths.MntBaseDirAbs, upload.ID, variantName, opts.Format)
+
+	file, err := os.Open(path)
+	if err == nil {
+		defer file.Close()
+		return c.Stream(http.StatusOK, opts.Format.MIMEType(), file)
+	}
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		log.Debug().Err(err).Str("upload_id", upload.ID).Str("variant", variantName).Msg("Failed to open cached image variant")
+		return err
+	}
+
+	data, err := generateVariant(c, s, upload, opts)
+	if err != nil {
+		return err
+	}
+
+	if err := uploads.WriteVariantFile(path, data); err != nil {
+		// the variant can still be returned, it's simply generated again on the next request
+		log.Error().Err(err).Str("upload_id", upload.ID).Str("variant", variantName).Msg("Failed to cache image variant")
+	}
+
+	log.Debug().Str("upload_id", upload.ID).Str("variant", variantName).Str("format", opts.Format.String()).Msg("Successfully generated image variant")
+
+	return c.Blob(http.StatusOK, opts.Format.MIMEType(), data)
+}
+
+func generateVariant(c echo.Context, s *api.Server, upload *models.Upload, opts imaging.Options) ([]byte, error) {
+	ctx := c.Request().Context()
+	log := util.LogFromContext(ctx)
+
+	blob, _, err := s.Blobstore.Get(ctx, upload.BlobKey.String)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.Error().Str("upload_id", upload.ID).Str("key", upload.BlobKey.String).Msg("Blob referenced by completed upload does not exist")
+			return nil, httperrors.ErrNotFoundFileNotFound
+		}
+
+		log.Debug().Err(err).Str("upload_id", upload.ID).Msg("Failed to load file from blobstore")
+		return nil, err
+	}
+	defer blob.Close()
+
+	source, err := io.ReadAll(blob)
+	if err != nil {
+		log.Debug().Err(err).Str("upload_id", upload.ID).Msg("Failed to read file from blobstore")
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if _, err := imaging.Process(&buf, source, opts); err != nil {
+		log.Debug().Err(err).Str("upload_id", upload.ID).Msg("Failed to process image")
+		return nil, httperrors.ErrUnsupportedMediaTypeImageNotProcessable
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,204 @@
+package files_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/imaging"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/uploads"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func filesTestConfig(t *testing.T) config.Server {
+	t.Helper()
+
+	cfg := config.DefaultServiceConfigFromEnv()
+	cfg.Paths.MntBaseDirAbs = t.TempDir()
+	cfg.Images.Variants = map[string]config.ImageVariant{
+		"thumb": {MaxWidth: 64, MaxHeight: 64},
+	}
+	cfg.Images.CacheMaxAge = time.Hour
+
+	return cfg
+}
+
+// insertCompletedUpload stores the given file in the blobstore and inserts a completed upload referencing it.
+func insertCompletedUpload(t *testing.T, s *api.Server, userID string, mimeType string, data []byte) *models.Upload {
+	t.Helper()
+
+	ctx := context.Background()
+
+	upload := &models.Upload{
+		UserID:        userID,
+		MimeType:      mimeType,
+		Size:          int64(len(data)),
+		BytesReceived: int64(len(data)),
+		CompletedAt:   null.TimeFrom(time.Now()),
+	}
+	require.NoError(t, upload.Insert(ctx, s.DB, boil.Infer()))
+
+	upload.BlobKey = null.StringFrom("uploads/" + userID + "/" + upload.ID)
+	require.NoError(t, s.Blobstore.Put(ctx, upload.BlobKey.String, bytes.NewReader(data), int64(len(data)), mimeType))
+
+	_, err := upload.Update(ctx, s.DB, boil.Whitelist(models.UploadColumns.BlobKey))
+	require.NoError(t, err)
+
+	return upload
+}
+
+func exampleJPEG(t *testing.T) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, 400, 200))
+	draw.Draw(img, img.Bounds(), image.NewUniform(color.RGBA{R: 0xff, A: 0xff}), image.Point{}, draw.Src)
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+
+	return buf.Bytes()
+}
+
+func TestGetFileOriginal(t *testing.T) {
+	test.WithTestServerConfigurable(t, filesTestConfig(t), func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		data := exampleJPEG(t)
+		upload := insertCompletedUpload(t, s, fixtures.User1.ID, "image/jpeg", data)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/files/"+upload.ID, nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, "image/jpeg", res.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "private, max-age=3600", res.Header().Get(util.HTTPHeaderCacheControl))
+		assert.Equal(t, data, res.Body.Bytes())
+
+		etag := res.Header().Get(util.HTTPHeaderETag)
+		require.NotEmpty(t, etag)
+
+		headers := test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token)
+		headers.Set(util.HTTPHeaderIfNoneMatch, etag)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/files/"+upload.ID, nil, headers)
+		assert.Equal(t, http.StatusNotModified, res.Result().StatusCode)
+		assert.Empty(t, res.Body.Bytes())
+	})
+}
+
+func TestGetFileVariant(t *testing.T) {
+	test.WithTestServerConfigurable(t, filesTestConfig(t), func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		upload := insertCompletedUpload(t, s, fixtures.User1.ID, "image/jpeg", exampleJPEG(t))
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/files/"+upload.ID+"?variant=thumb", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, "image/jpeg", res.Header().Get(echo.HeaderContentType))
+
+		cfg, err := jpeg.DecodeConfig(bytes.NewReader(res.Body.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, 64, cfg.Width)
+		assert.Equal(t, 32, cfg.Height)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/files/"+upload.ID+"?variant=thumb&format=webp", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, "image/webp", res.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "WEBP", string(res.Body.Bytes()[8:12]))
+
+		generated := res.Body.Bytes()
+		etag := res.Header().Get(util.HTTPHeaderETag)
+
+		// variants are cached and served from the cache on subsequent requests
+		path := uploads.VariantFilePath(s.Config.Paths.MntBaseDirAbs, upload.ID, "thumb-64x64", imaging.FormatWebP)
+		assert.FileExists(t, path)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/files/"+upload.ID+"?variant=thumb&format=webp", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, generated, res.Body.Bytes())
+		assert.Equal(t, etag, res.Header().Get(util.HTTPHeaderETag))
+
+		headers := test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token)
+		headers.Set(util.HTTPHeaderIfNoneMatch, etag)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/files/"+upload.ID+"?variant=thumb&format=webp", nil, headers)
+		assert.Equal(t, http.StatusNotModified, res.Result().StatusCode)
+
+		// the original size can be converted as well
+		res = test.PerformRequest(t, s, "GET", "/api/v1/files/"+upload.ID+"?format=png", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, "image/png", res.Header().Get(echo.HeaderContentType))
+		assert.FileExists(t, uploads.VariantFilePath(s.Config.Paths.MntBaseDirAbs, upload.ID, uploads.VariantOriginal, imaging.FormatPNG))
+	})
+}
+
+func TestGetFileVariantErrors(t *testing.T) {
+	test.WithTestServerConfigurable(t, filesTestConfig(t), func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		upload := insertCompletedUpload(t, s, fixtures.User1.ID, "image/jpeg", exampleJPEG(t))
+		pdf := insertCompletedUpload(t, s, fixtures.User1.ID, "application/pdf", []byte("%PDF-1.7\n"))
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/files/"+upload.ID+"?variant=huge", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrBadRequestUnknownImageVariant.Type, *response.Type)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/files/"+upload.ID+"?format=gif", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusBadRequest, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/files/"+pdf.ID+"?variant=thumb", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusUnsupportedMediaType, res.Result().StatusCode)
+
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrUnsupportedMediaTypeImageNotProcessable.Type, *response.Type)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/files/"+pdf.ID, nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, "application/pdf", res.Header().Get(echo.HeaderContentType))
+	})
+}
+
+func TestGetFileNotFound(t *testing.T) {
+	test.WithTestServerConfigurable(t, filesTestConfig(t), func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		upload := insertCompletedUpload(t, s, fixtures.User1.ID, "image/jpeg", exampleJPEG(t))
+
+		// files of other users are not accessible
+		res := test.PerformRequest(t, s, "GET", "/api/v1/files/"+upload.ID, nil, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrNotFoundFileNotFound.Type, *response.Type)
+
+		// incomplete uploads are not accessible either
+		upload.CompletedAt = null.Time{}
+		_, err := upload.Update(ctx, s.DB, boil.Whitelist(models.UploadColumns.CompletedAt))
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/files/"+upload.ID, nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/files/"+upload.ID, nil, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
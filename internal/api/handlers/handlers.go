@@ -3,28 +3,73 @@ package handlers
 
 import (
 	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/handlers/admin"
 	"allaboutapps.dev/aw/go-starter/internal/api/handlers/auth"
 	"allaboutapps.dev/aw/go-starter/internal/api/handlers/common"
+	"allaboutapps.dev/aw/go-starter/internal/api/handlers/files"
 	"allaboutapps.dev/aw/go-starter/internal/api/handlers/push"
+	"allaboutapps.dev/aw/go-starter/internal/api/handlers/uploads"
 	"github.com/labstack/echo/v4"
 )
 
 func AttachAllRoutes(s *api.Server) {
 	// attach our routes
 	s.Router.Routes = []*echo.Route{
+		admin.GetAdminPushCampaignRoute(s),
+		admin.GetAdminUserRoute(s),
+		admin.GetAdminUsersRoute(s),
+		admin.PostAdminPushCampaignRoute(s),
+		admin.PostAdminUserActivateRoute(s),
+		admin.PostAdminUserDeactivateRoute(s),
+		admin.PostAdminUserForcePasswordResetRoute(s),
+		admin.PostAdminUserRevokeTokensRoute(s),
+		admin.PutAdminUserScopesRoute(s),
+		auth.DeleteAccountRoute(s),
+		auth.DeleteApiKeyRoute(s),
+		auth.DeleteProfileAvatarRoute(s),
+		auth.DeleteSessionRoute(s),
+		auth.GetAccountExportRoute(s),
+		auth.GetApiKeysRoute(s),
+		auth.GetLegalDocumentsRoute(s),
+		auth.GetProfileAvatarRoute(s),
+		auth.GetProfileRoute(s),
+		auth.GetSessionsRoute(s),
 		auth.GetUserInfoRoute(s),
+		auth.PatchProfileRoute(s),
+		auth.PostAcceptLegalDocumentsRoute(s),
+		auth.PostChangeEmailConfirmRoute(s),
+		auth.PostChangeEmailRevertRoute(s),
+		auth.PostChangeEmailRoute(s),
 		auth.PostChangePasswordRoute(s),
+		auth.PostConfirmTotpRoute(s),
+		auth.PostCreateApiKeyRoute(s),
+		auth.PostEnrollTotpRoute(s),
 		auth.PostForgotPasswordCompleteRoute(s),
 		auth.PostForgotPasswordRoute(s),
+		auth.PostLoginMfaRoute(s),
+		auth.PostLoginOidcRoute(s),
 		auth.PostLoginRoute(s),
 		auth.PostLogoutRoute(s),
+		auth.PostOauthAuthorizeRoute(s),
+		auth.PostOauthTokenRoute(s),
 		auth.PostRefreshRoute(s),
 		auth.PostRegisterRoute(s),
+		auth.PostResendVerificationRoute(s),
+		auth.PostRevokeOtherSessionsRoute(s),
+		auth.PostVerifyEmailRoute(s),
+		auth.PutProfileAvatarRoute(s),
 		common.GetHealthyRoute(s),
+		common.GetOauthAuthorizationServerMetadataRoute(s),
 		common.GetReadyRoute(s),
+		common.GetStorageBlobRoute(s),
 		common.GetSwaggerRoute(s),
 		common.GetVersionRoute(s),
+		files.GetFileRoute(s),
 		push.GetPushTestRoute(s),
 		push.PostUpdatePushTokenRoute(s),
+		uploads.GetUploadRoute(s),
+		uploads.PatchUploadRoute(s),
+		uploads.PostCompleteUploadRoute(s),
+		uploads.PostCreateUploadRoute(s),
 	}
 }
@@ -5,12 +5,14 @@ import (
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/push"
 	"allaboutapps.dev/aw/go-starter/internal/util"
 	"github.com/labstack/echo/v4"
 )
 
 func GetPushTestRoute(s *api.Server) *echo.Route {
-	return s.Router.APIV1Push.GET("/test", getPushTestHandler(s))
+	return s.Router.APIV1Push.GET("/test", getPushTestHandler(s), middleware.RequirePermission(s, auth.PermissionPushSend))
 }
 
 func getPushTestHandler(s *api.Server) echo.HandlerFunc {
@@ -20,13 +22,18 @@ func getPushTestHandler(s *api.Server) echo.HandlerFunc {
 
 		user := auth.UserFromEchoContext(c)
 
-		err := s.Push.SendToUser(ctx, user, "Hello", "World")
+		pushMessage, err := s.Push.SendToUser(ctx, user, push.Message{
+			Notification: &push.Notification{
+				Title: "Hello",
+				Body:  "World",
+			},
+		})
 		if err != nil {
 			log.Debug().Err(err).Str("user_id", user.ID).Msg("Error while sending push to user.")
 			return err
 		}
 
-		log.Debug().Str("user_id", user.ID).Msg("Successfully sent push message.")
+		log.Debug().Str("user_id", user.ID).Str("push_message_id", pushMessage.ID).Msg("Successfully enqueued push message.")
 
 		return c.String(http.StatusOK, "Success")
 	}
@@ -1,12 +1,21 @@
 package push_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
+	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 func TestGetTestPush(t *testing.T) {
@@ -26,3 +35,121 @@ func TestGetTestPushUnauthorized(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
 	})
 }
+
+func TestGetTestPushWithAPIKey(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		key, prefix, hash, err := auth.GenerateAPIKey()
+		require.NoError(t, err)
+
+		apiKey := models.APIKey{
+			UserID:  fixtures.User1.ID,
+			Name:    "Test key",
+			Prefix:  prefix,
+			KeyHash: hash,
+			Scopes:  []string{"app"},
+		}
+		err = apiKey.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/push/test", nil, test.HeadersWithConfigurableAuth(t, auth.APIKeyScheme, key))
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// keys only grant the scopes assigned to them
+		apiKey.Scopes = []string{"cms"}
+		_, err = apiKey.Update(ctx, s.DB, boil.Whitelist(models.APIKeyColumns.Scopes))
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/push/test", nil, test.HeadersWithConfigurableAuth(t, auth.APIKeyScheme, key))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		apiKey.Scopes = []string{"app"}
+		apiKey.ExpiresAt = null.TimeFrom(time.Now().Add(-time.Minute))
+		_, err = apiKey.Update(ctx, s.DB, boil.Whitelist(models.APIKeyColumns.Scopes, models.APIKeyColumns.ExpiresAt))
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/push/test", nil, test.HeadersWithConfigurableAuth(t, auth.APIKeyScheme, key))
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/push/test", nil, test.HeadersWithConfigurableAuth(t, auth.APIKeyScheme, prefix+"_invalid"))
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
+
+func TestGetTestPushMissingPermissions(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		// revoke permissions granted to all users by default
+		_, err := models.Roles(models.RoleWhere.IsDefault.EQ(true)).UpdateAll(ctx, s.DB, models.M{models.RoleColumns.IsDefault: false})
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/push/test", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *middleware.ErrForbiddenMissingPermissions.Type, *response.Type)
+
+		admin, err := models.Roles(models.RoleWhere.Name.EQ("admin")).One(ctx, s.DB)
+		require.NoError(t, err)
+
+		userRole := models.UserRole{
+			UserID: fixtures.User1.ID,
+			RoleID: admin.ID,
+		}
+		err = userRole.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "GET", "/api/v1/push/test", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
+
+func TestGetTestPushMissingPermissionsWithAPIKey(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		// revoke permissions granted to all users by default, User1 is only granted push:send as an admin
+		_, err := models.Roles(models.RoleWhere.IsDefault.EQ(true)).UpdateAll(ctx, s.DB, models.M{models.RoleColumns.IsDefault: false})
+		require.NoError(t, err)
+
+		admin, err := models.Roles(models.RoleWhere.Name.EQ("admin")).One(ctx, s.DB)
+		require.NoError(t, err)
+
+		userRole := models.UserRole{
+			UserID: fixtures.User1.ID,
+			RoleID: admin.ID,
+		}
+		err = userRole.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		key, prefix, hash, err := auth.GenerateAPIKey()
+		require.NoError(t, err)
+
+		apiKey := models.APIKey{
+			UserID:  fixtures.User1.ID,
+			Name:    "Test key",
+			Prefix:  prefix,
+			KeyHash: hash,
+			Scopes:  []string{"app"},
+		}
+		err = apiKey.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/push/test", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// API keys are restricted credentials, not inheriting the permissions of the admin role
+		res = test.PerformRequest(t, s, "GET", "/api/v1/push/test", nil, test.HeadersWithConfigurableAuth(t, auth.APIKeyScheme, key))
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *middleware.ErrForbiddenMissingPermissions.Type, *response.Type)
+	})
+}
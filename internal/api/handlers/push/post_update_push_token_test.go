@@ -5,13 +5,17 @@ import (
 	"database/sql"
 	"net/http"
 	"testing"
+	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/config"
 	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
@@ -191,3 +195,85 @@ func TestPostUpdatePushTokenWithOldTokenNotfound(t *testing.T) {
 		assert.Equal(t, oldCnt+1, cnt)
 	})
 }
+
+func TestPostUpdatePushTokenEmailNotVerified(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.Auth.RequireVerifiedEmail = true
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"newToken": "869f6deb-73e6-4691-9d40-2a2a794006cf",
+			"provider": "fcm",
+		}
+
+		res := test.PerformRequest(t, s, "PUT", "/api/v1/push/token", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		fixtures.User1.EmailVerifiedAt = null.Time{}
+		_, err := fixtures.User1.Update(ctx, s.DB, boil.Whitelist(models.UserColumns.EmailVerifiedAt))
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/push/token", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *middleware.ErrForbiddenEmailNotVerified.Type, *response.Type)
+	})
+}
+
+func TestPostUpdatePushTokenLegalAcceptanceRequired(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.Auth.RequireLegalAcceptance = true
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"newToken": "869f6deb-73e6-4691-9d40-2a2a794006cf",
+			"provider": "fcm",
+		}
+
+		res := test.PerformRequest(t, s, "PUT", "/api/v1/push/token", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		document := models.LegalDocument{
+			Type:        "terms_of_service",
+			Version:     1,
+			Locale:      "en",
+			URL:         "https://example.com/legal/terms_of_service/en",
+			Mandatory:   true,
+			PublishedAt: time.Now().Add(-time.Minute),
+		}
+		err := document.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/push/token", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusForbidden, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *middleware.ErrForbiddenLegalAcceptanceRequired.Type, *response.Type)
+
+		acceptance := models.LegalAcceptance{
+			UserID:          fixtures.User1.ID,
+			LegalDocumentID: document.ID,
+			AcceptedAt:      time.Now(),
+		}
+		err = acceptance.Insert(ctx, s.DB, boil.Infer())
+		require.NoError(t, err)
+
+		payload["newToken"] = "3f4e2a1b-9c8d-4e7f-a6b5-c4d3e2f1a0b9"
+		res = test.PerformRequest(t, s, "PUT", "/api/v1/push/token", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+
+		assert.Equal(t, http.StatusOK, res.Result().StatusCode)
+	})
+}
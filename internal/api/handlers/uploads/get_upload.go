@@ -0,0 +1,37 @@
+package uploads
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	uploadsTypes "allaboutapps.dev/aw/go-starter/internal/types/uploads"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func GetUploadRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Uploads.GET("/:id", getUploadHandler(s))
+}
+
+func getUploadHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := uploadsTypes.NewGetUploadRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromEchoContext(c)
+
+		upload, err := findUpload(ctx, s.DB, user.ID, params.ID.String(), false)
+		if err != nil {
+			log.Debug().Err(err).Str("upload_id", params.ID.String()).Msg("Failed to load upload")
+			return err
+		}
+
+		return returnUpload(c, s, http.StatusOK, upload)
+	}
+}
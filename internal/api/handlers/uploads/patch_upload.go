@@ -0,0 +1,134 @@
+package uploads
+
+import (
+	"errors"
+	"io/fs"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	uploadsTypes "allaboutapps.dev/aw/go-starter/internal/types/uploads"
+	"allaboutapps.dev/aw/go-starter/internal/uploads"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+func PatchUploadRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Uploads.PATCH("/:id", patchUploadHandler(s))
+}
+
+func patchUploadHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := uploadsTypes.NewPatchUploadRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		if mediaType, _, err := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType)); err != nil || mediaType != uploadChunkMIMEType {
+			log.Debug().Str("content_type", c.Request().Header.Get(echo.HeaderContentType)).Msg("Chunk has unsupported content type")
+			return echo.ErrUnsupportedMediaType
+		}
+
+		offset, err := strconv.ParseInt(c.Request().Header.Get(util.HTTPHeaderUploadOffset), 10, 64)
+		if err != nil || offset < 0 {
+			log.Debug().Str("upload_offset", c.Request().Header.Get(util.HTTPHeaderUploadOffset)).Msg("Upload-Offset header is missing or invalid")
+			return httperrors.ErrBadRequestInvalidUploadOffset
+		}
+
+		user := auth.UserFromEchoContext(c)
+
+		upload, err := findUpload(ctx, s.DB, user.ID, params.ID.String(), false)
+		if err != nil {
+			log.Debug().Err(err).Str("upload_id", params.ID.String()).Msg("Failed to load upload")
+			return err
+		}
+
+		// Chunks are streamed to disk without holding any database locks, concurrent chunks of the same upload
+		// are serialized by locking its partial file instead
+		path := uploads.PartialFilePath(s.Config.Paths.MntBaseDirAbs, upload.ID)
+		unlock, err := uploads.LockPartialFile(path)
+		switch {
+		case err == nil:
+			defer func() {
+				if err := unlock(); err != nil {
+					log.Error().Err(err).Str("upload_id", upload.ID).Msg("Failed to unlock partial file of upload")
+				}
+			}()
+		case errors.Is(err, uploads.ErrChunkInProgress):
+			log.Debug().Str("upload_id", upload.ID).Msg("Another chunk of upload is currently being written")
+			return httperrors.ErrConflictUploadChunkInProgress
+		case errors.Is(err, fs.ErrNotExist):
+			// the partial file is removed once the upload has been completed or discarded, reloading it reveals which
+			log.Debug().Str("upload_id", upload.ID).Msg("Partial file of upload not found")
+		default:
+			log.Debug().Err(err).Str("upload_id", upload.ID).Msg("Failed to lock partial file of upload")
+			return err
+		}
+
+		// reload the bytes received, as other chunks might have been written since loading the upload
+		upload, err = findUpload(ctx, s.DB, user.ID, upload.ID, false)
+		if err != nil {
+			log.Debug().Err(err).Str("upload_id", params.ID.String()).Msg("Failed to reload upload")
+			return err
+		}
+
+		if upload.CompletedAt.Valid {
+			log.Debug().Str("upload_id", upload.ID).Msg("Upload has already been completed")
+			return httperrors.ErrConflictUploadCompleted
+		}
+
+		if offset != upload.BytesReceived {
+			log.Debug().Str("upload_id", upload.ID).Int64("upload_offset", offset).Int64("bytes_received", upload.BytesReceived).Msg("Upload-Offset does not match bytes received")
+			return httperrors.ErrConflictUploadOffsetMismatch
+		}
+
+		maxChunkSize := s.Config.Uploads.MaxChunkSize
+		if remaining := upload.Size - upload.BytesReceived; remaining < maxChunkSize {
+			maxChunkSize = remaining
+		}
+
+		written, err := uploads.AppendChunk(path, offset, c.Request().Body, maxChunkSize)
+		if err != nil {
+			if errors.Is(err, uploads.ErrChunkTooLarge) {
+				log.Debug().Str("upload_id", upload.ID).Int64("max_chunk_size", maxChunkSize).Msg("Chunk exceeds maximum chunk size or remaining size of upload")
+				return httperrors.ErrRequestEntityTooLargeUploadChunkTooLarge
+			}
+
+			log.Debug().Err(err).Str("upload_id", upload.ID).Msg("Failed to append chunk to upload")
+			return err
+		}
+
+		// only commit the chunk if the upload has not been modified (e.g. completed or deleted) while writing it
+		upload.BytesReceived += written
+		upload.UpdatedAt = time.Now()
+		rowsAff, err := models.Uploads(
+			models.UploadWhere.ID.EQ(upload.ID),
+			models.UploadWhere.BytesReceived.EQ(offset),
+			models.UploadWhere.CompletedAt.IsNull(),
+		).UpdateAll(ctx, s.DB, models.M{
+			models.UploadColumns.BytesReceived: upload.BytesReceived,
+			models.UploadColumns.UpdatedAt:     upload.UpdatedAt,
+		})
+		if err != nil {
+			log.Debug().Err(err).Str("upload_id", upload.ID).Msg("Failed to update bytes received of upload")
+			return err
+		}
+
+		if rowsAff == 0 {
+			log.Debug().Str("upload_id", upload.ID).Int64("upload_offset", offset).Msg("Upload has been modified while writing chunk")
+			return httperrors.ErrConflictUploadOffsetMismatch
+		}
+
+		log.Debug().Str("upload_id", upload.ID).Int64("bytes_received", upload.BytesReceived).Int64("file_size", upload.Size).Msg("Successfully received chunk")
+
+		return returnUpload(c, s, http.StatusOK, upload)
+	}
+}
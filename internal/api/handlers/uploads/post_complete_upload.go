@@ -0,0 +1,149 @@
+package uploads
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/imaging"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	uploadsTypes "allaboutapps.dev/aw/go-starter/internal/types/uploads"
+	"allaboutapps.dev/aw/go-starter/internal/uploads"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostCompleteUploadRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Uploads.POST("/:id/complete", postCompleteUploadHandler(s))
+}
+
+func postCompleteUploadHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		params := uploadsTypes.NewPostCompleteUploadRouteParams()
+		if err := util.BindAndValidatePathParams(c, &params); err != nil {
+			return err
+		}
+
+		user := auth.UserFromEchoContext(c)
+
+		var upload *models.Upload
+		var completed bool
+		var discard bool
+		if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+			// lock the upload, so it cannot be completed concurrently
+			var err error
+			upload, err = findUpload(ctx, tx, user.ID, params.ID.String(), true)
+			if err != nil {
+				log.Debug().Err(err).Str("upload_id", params.ID.String()).Msg("Failed to load upload")
+				return err
+			}
+
+			if upload.CompletedAt.Valid {
+				log.Debug().Str("upload_id", upload.ID).Msg("Upload has already been completed, returning as is")
+				return nil
+			}
+
+			if upload.BytesReceived != upload.Size {
+				log.Debug().Str("upload_id", upload.ID).Int64("bytes_received", upload.BytesReceived).Int64("file_size", upload.Size).Msg("Upload has not received all bytes yet")
+				return httperrors.ErrConflictUploadIncomplete
+			}
+
+			file, err := os.Open(uploads.PartialFilePath(s.Config.Paths.MntBaseDirAbs, upload.ID))
+			if err != nil {
+				log.Debug().Err(err).Str("upload_id", upload.ID).Msg("Failed to open partial file of upload")
+				return fmt.Errorf("failed to open partial file: %w", err)
+			}
+			defer file.Close()
+
+			// the MIME type is only known for sure once the file has been assembled, uploads not matching the MIME
+			// type declared on creation are discarded as clients cannot fix them by uploading further chunks
+			mime, err := util.DetectAllowedMIMEType(log, file, s.Config.Uploads.AllowedMIMETypes)
+			if err != nil {
+				if errors.Is(err, echo.ErrUnsupportedMediaType) {
+					discard = true
+					return httperrors.ErrUnsupportedMediaTypeUploadMIMETypeNotAllowed
+				}
+
+				return err
+			}
+
+			if !mime.Is(upload.MimeType) {
+				log.Debug().Str("upload_id", upload.ID).Str("mime_type", upload.MimeType).Str("detected_mime_type", mime.String()).Msg("MIME type of upload does not match its content")
+				discard = true
+				return httperrors.ErrUnsupportedMediaTypeUploadMIMETypeNotAllowed
+			}
+
+			var content io.Reader = file
+			size := upload.Size
+
+			// images are stripped of their metadata (e.g. GPS locations) and rotated upright before being stored
+			if _, err := imaging.FormatFromMIMEType(mime.String()); err == nil {
+				image, err := s.SanitizeImage(ctx, file)
+				if err != nil {
+					discard = errors.Is(err, httperrors.ErrUnsupportedMediaTypeImageNotProcessable)
+					return err
+				}
+
+				content = image
+				size = int64(image.Len())
+			}
+
+			key := uploadBlobKey(upload, mime.Extension())
+			if err := s.Blobstore.Put(ctx, key, content, size, mime.String()); err != nil {
+				log.Debug().Err(err).Str("upload_id", upload.ID).Msg("Failed to store upload in blobstore")
+				return err
+			}
+
+			upload.BlobKey = null.StringFrom(key)
+			upload.CompletedAt = null.TimeFrom(time.Now())
+
+			if _, err := upload.Update(ctx, tx, boil.Whitelist(models.UploadColumns.BlobKey, models.UploadColumns.CompletedAt, models.UploadColumns.UpdatedAt)); err != nil {
+				log.Debug().Err(err).Str("upload_id", upload.ID).Msg("Failed to complete upload")
+
+				if err := s.Blobstore.Delete(ctx, key); err != nil {
+					log.Error().Err(err).Str("upload_id", upload.ID).Str("key", key).Msg("Failed to remove blob after failing to complete upload")
+				}
+
+				return err
+			}
+
+			completed = true
+
+			return nil
+		}); err != nil {
+			if discard {
+				if _, err := models.Uploads(models.UploadWhere.ID.EQ(params.ID.String())).DeleteAll(ctx, s.DB); err != nil {
+					log.Error().Err(err).Str("upload_id", params.ID.String()).Msg("Failed to delete discarded upload")
+				}
+
+				if err := uploads.RemovePartialFile(s.Config.Paths.MntBaseDirAbs, params.ID.String()); err != nil {
+					log.Error().Err(err).Str("upload_id", params.ID.String()).Msg("Failed to remove partial file of discarded upload")
+				}
+			}
+
+			return err
+		}
+
+		if completed {
+			if err := uploads.RemovePartialFile(s.Config.Paths.MntBaseDirAbs, upload.ID); err != nil {
+				log.Error().Err(err).Str("upload_id", upload.ID).Msg("Failed to remove partial file of completed upload")
+			}
+
+			log.Debug().Str("upload_id", upload.ID).Str("key", upload.BlobKey.String).Msg("Successfully completed upload")
+		}
+
+		return returnUpload(c, s, http.StatusOK, upload)
+	}
+}
@@ -0,0 +1,70 @@
+package uploads
+
+import (
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/uploads"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func PostCreateUploadRoute(s *api.Server) *echo.Route {
+	return s.Router.APIV1Uploads.POST("", postCreateUploadHandler(s))
+}
+
+func postCreateUploadHandler(s *api.Server) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		log := util.LogFromContext(ctx)
+
+		var body types.PostCreateUploadPayload
+		if err := util.BindAndValidateBody(c, &body); err != nil {
+			return err
+		}
+
+		user := auth.UserFromEchoContext(c)
+
+		if *body.Size > s.Config.Uploads.MaxFileSize {
+			log.Debug().Int64("file_size", *body.Size).Int64("max_file_size", s.Config.Uploads.MaxFileSize).Msg("Upload exceeds maximum file size")
+			return httperrors.ErrRequestEntityTooLargeUploadTooLarge
+		}
+
+		if !uploadMIMETypeAllowed(*body.MimeType, s.Config.Uploads.AllowedMIMETypes) {
+			log.Debug().Str("mime_type", *body.MimeType).Msg("MIME type of upload is not allowed")
+			return httperrors.ErrUnsupportedMediaTypeUploadMIMETypeNotAllowed
+		}
+
+		upload := models.Upload{
+			UserID:   user.ID,
+			FileName: null.StringFromPtr(body.FileName),
+			MimeType: *body.MimeType,
+			Size:     *body.Size,
+		}
+
+		if err := upload.Insert(ctx, s.DB, boil.Infer()); err != nil {
+			log.Debug().Err(err).Msg("Failed to insert upload")
+			return err
+		}
+
+		if err := uploads.CreatePartialFile(s.Config.Paths.MntBaseDirAbs, upload.ID); err != nil {
+			log.Debug().Err(err).Str("upload_id", upload.ID).Msg("Failed to create partial file of upload")
+
+			if _, err := upload.Delete(ctx, s.DB); err != nil {
+				log.Error().Err(err).Str("upload_id", upload.ID).Msg("Failed to delete upload after failing to create its partial file")
+			}
+
+			return err
+		}
+
+		log.Debug().Str("upload_id", upload.ID).Int64("file_size", upload.Size).Msg("Successfully created upload")
+
+		return returnUpload(c, s, http.StatusCreated, &upload)
+	}
+}
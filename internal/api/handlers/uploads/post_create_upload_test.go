@@ -0,0 +1,256 @@
+package uploads_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/imaging"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/uploads"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func uploadsTestConfig(t *testing.T) config.Server {
+	t.Helper()
+
+	config := config.DefaultServiceConfigFromEnv()
+	config.Paths.MntBaseDirAbs = t.TempDir()
+	config.Uploads.MaxFileSize = 1024 * 1024
+	config.Uploads.MaxChunkSize = 4096
+
+	return config
+}
+
+func createUpload(t *testing.T, s *api.Server, token string, size int64, mimeType string) types.Upload {
+	t.Helper()
+
+	payload := test.GenericPayload{
+		"size":      size,
+		"mime_type": mimeType,
+		"file_name": "example.jpg",
+	}
+
+	res := test.PerformRequest(t, s, "POST", "/api/v1/uploads", payload, test.HeadersWithAuth(t, token))
+	require.Equal(t, http.StatusCreated, res.Result().StatusCode)
+
+	var response types.Upload
+	test.ParseResponseAndValidate(t, res, &response)
+
+	return response
+}
+
+func patchUploadChunk(t *testing.T, s *api.Server, token string, uploadID string, offset int64, chunk []byte) *http.Response {
+	t.Helper()
+
+	headers := test.HeadersWithAuth(t, token)
+	headers.Set(echo.HeaderContentType, "application/offset+octet-stream")
+	headers.Set("Upload-Offset", strconv.FormatInt(offset, 10))
+
+	res := test.PerformRequestWithRawBody(t, s, "PATCH", "/api/v1/uploads/"+uploadID, bytes.NewReader(chunk), headers, nil)
+
+	return res.Result()
+}
+
+func TestPostCreateUploadSuccess(t *testing.T) {
+	test.WithTestServerConfigurable(t, uploadsTestConfig(t), func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		example, err := os.ReadFile(filepath.Join(util.GetProjectRootDir(), "test", "testdata", "example.jpg"))
+		require.NoError(t, err)
+
+		upload := createUpload(t, s, fixtures.User1AccessToken1.Token, int64(len(example)), "image/jpeg")
+		assert.Equal(t, int64(len(example)), *upload.Size)
+		assert.Equal(t, int64(0), *upload.Offset)
+		assert.Equal(t, "example.jpg", *upload.FileName)
+		assert.NotNil(t, upload.ExpiresAt)
+		assert.Nil(t, upload.CompletedAt)
+		assert.Nil(t, upload.URL)
+		assert.FileExists(t, uploads.PartialFilePath(s.Config.Paths.MntBaseDirAbs, upload.ID.String()))
+
+		// upload the file in two chunks, querying the progress in between
+		res := patchUploadChunk(t, s, fixtures.User1AccessToken1.Token, upload.ID.String(), 0, example[:4096])
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "4096", res.Header.Get("Upload-Offset"))
+
+		res2 := test.PerformRequest(t, s, "GET", "/api/v1/uploads/"+upload.ID.String(), nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res2.Result().StatusCode)
+
+		var progress types.Upload
+		test.ParseResponseAndValidate(t, res2, &progress)
+		assert.Equal(t, int64(4096), *progress.Offset)
+
+		// completing an incomplete upload is rejected
+		res2 = test.PerformRequest(t, s, "POST", "/api/v1/uploads/"+upload.ID.String()+"/complete", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusConflict, res2.Result().StatusCode)
+
+		var errResponse httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res2, &errResponse)
+		assert.Equal(t, *httperrors.ErrConflictUploadIncomplete.Type, *errResponse.Type)
+
+		res = patchUploadChunk(t, s, fixtures.User1AccessToken1.Token, upload.ID.String(), 4096, example[4096:])
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, strconv.Itoa(len(example)), res.Header.Get("Upload-Offset"))
+
+		res2 = test.PerformRequest(t, s, "POST", "/api/v1/uploads/"+upload.ID.String()+"/complete", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res2.Result().StatusCode)
+
+		var completed types.Upload
+		test.ParseResponseAndValidate(t, res2, &completed)
+		assert.NotNil(t, completed.CompletedAt)
+		assert.NotNil(t, completed.URL)
+		assert.Nil(t, completed.ExpiresAt)
+		assert.NoFileExists(t, uploads.PartialFilePath(s.Config.Paths.MntBaseDirAbs, upload.ID.String()))
+
+		model, err := models.FindUpload(ctx, s.DB, upload.ID.String())
+		require.NoError(t, err)
+		assert.Equal(t, "uploads/"+fixtures.User1.ID+"/"+upload.ID.String()+".jpg", model.BlobKey.String)
+
+		blob, info, err := s.Blobstore.Get(ctx, model.BlobKey.String)
+		require.NoError(t, err)
+		defer blob.Close()
+		assert.Equal(t, "image/jpeg", info.ContentType)
+
+		// images are stored without metadata, removing the comment contained in the example image
+		var sanitized bytes.Buffer
+		_, err = imaging.Sanitize(&sanitized, example, 0, 0)
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(blob)
+		require.NoError(t, err)
+		assert.Equal(t, sanitized.Bytes(), content)
+
+		// completing again returns the completed upload, chunks are no longer accepted
+		res2 = test.PerformRequest(t, s, "POST", "/api/v1/uploads/"+upload.ID.String()+"/complete", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusOK, res2.Result().StatusCode)
+
+		res = patchUploadChunk(t, s, fixtures.User1AccessToken1.Token, upload.ID.String(), int64(len(example)), []byte("more"))
+		assert.Equal(t, http.StatusConflict, res.StatusCode)
+	})
+}
+
+func TestPostCreateUploadTooLarge(t *testing.T) {
+	test.WithTestServerConfigurable(t, uploadsTestConfig(t), func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"size":      1024*1024 + 1,
+			"mime_type": "image/jpeg",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/uploads", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrRequestEntityTooLargeUploadTooLarge.Type, *response.Type)
+	})
+}
+
+func TestPostCreateUploadMIMETypeNotAllowed(t *testing.T) {
+	test.WithTestServerConfigurable(t, uploadsTestConfig(t), func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"size":      1024,
+			"mime_type": "application/x-sh",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/uploads", payload, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusUnsupportedMediaType, res.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+		assert.Equal(t, *httperrors.ErrUnsupportedMediaTypeUploadMIMETypeNotAllowed.Type, *response.Type)
+	})
+}
+
+func TestPatchUploadOffsetMismatchAndChunkTooLarge(t *testing.T) {
+	test.WithTestServerConfigurable(t, uploadsTestConfig(t), func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		upload := createUpload(t, s, fixtures.User1AccessToken1.Token, 8192, "image/jpeg")
+
+		res := patchUploadChunk(t, s, fixtures.User1AccessToken1.Token, upload.ID.String(), 100, make([]byte, 100))
+		assert.Equal(t, http.StatusConflict, res.StatusCode)
+
+		// chunks may not exceed the max chunk size
+		res = patchUploadChunk(t, s, fixtures.User1AccessToken1.Token, upload.ID.String(), 0, make([]byte, 4097))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, res.StatusCode)
+
+		// nor the remaining size of the upload
+		res = patchUploadChunk(t, s, fixtures.User1AccessToken1.Token, upload.ID.String(), 0, make([]byte, 4096))
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		res = patchUploadChunk(t, s, fixtures.User1AccessToken1.Token, upload.ID.String(), 4096, make([]byte, 4096))
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		res = patchUploadChunk(t, s, fixtures.User1AccessToken1.Token, upload.ID.String(), 8192, make([]byte, 1))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, res.StatusCode)
+
+		// chunks must be sent as raw body
+		headers := test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token)
+		headers.Set("Upload-Offset", "0")
+		res2 := test.PerformRequestWithRawBody(t, s, "PATCH", "/api/v1/uploads/"+upload.ID.String(), bytes.NewReader(make([]byte, 10)), headers, nil)
+		assert.Equal(t, http.StatusUnsupportedMediaType, res2.Result().StatusCode)
+
+		// other users cannot access the upload
+		res = patchUploadChunk(t, s, fixtures.User2AccessToken1.Token, upload.ID.String(), 0, make([]byte, 100))
+		assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	})
+}
+
+func TestPostCompleteUploadMIMETypeMismatch(t *testing.T) {
+	test.WithTestServerConfigurable(t, uploadsTestConfig(t), func(s *api.Server) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		example, err := os.ReadFile(filepath.Join(util.GetProjectRootDir(), "test", "testdata", "example.jpg"))
+		require.NoError(t, err)
+
+		// a JPEG declared as PNG is discarded on completion
+		upload := createUpload(t, s, fixtures.User1AccessToken1.Token, int64(len(example)), "image/png")
+
+		res := patchUploadChunk(t, s, fixtures.User1AccessToken1.Token, upload.ID.String(), 0, example[:4096])
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		res = patchUploadChunk(t, s, fixtures.User1AccessToken1.Token, upload.ID.String(), 4096, example[4096:])
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		res2 := test.PerformRequest(t, s, "POST", "/api/v1/uploads/"+upload.ID.String()+"/complete", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		assert.Equal(t, http.StatusUnsupportedMediaType, res2.Result().StatusCode)
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res2, &response)
+		assert.Equal(t, *httperrors.ErrUnsupportedMediaTypeUploadMIMETypeNotAllowed.Type, *response.Type)
+
+		exists, err := models.UploadExists(ctx, s.DB, upload.ID.String())
+		require.NoError(t, err)
+		assert.False(t, exists)
+		assert.NoFileExists(t, uploads.PartialFilePath(s.Config.Paths.MntBaseDirAbs, upload.ID.String()))
+	})
+}
+
+func TestPostCreateUploadUnauthorized(t *testing.T) {
+	test.WithTestServer(t, func(s *api.Server) {
+		payload := test.GenericPayload{
+			"size":      swag.Int64(1024),
+			"mime_type": "image/jpeg",
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/uploads", payload, nil)
+		assert.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+	})
+}
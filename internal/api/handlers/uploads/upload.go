@@ -0,0 +1,109 @@
+package uploads
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"mime"
+	"path"
+	"strconv"
+	"strings"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/strfmt/conv"
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// uploadsBlobDir is the blobstore directory completed uploads are stored in
+	uploadsBlobDir = "uploads"
+
+	// uploadChunkMIMEType is the content type chunks must be sent with, as defined by the tus protocol
+	uploadChunkMIMEType = "application/offset+octet-stream"
+)
+
+// findUpload loads the given upload of the given user, returning ErrNotFoundUploadNotFound if it does not exist or
+// belongs to another user. If lock is set, the upload is locked for the remainder of the transaction.
+func findUpload(ctx context.Context, exec boil.ContextExecutor, userID string, uploadID string, lock bool) (*models.Upload, error) {
+	mods := []qm.QueryMod{
+		models.UploadWhere.ID.EQ(uploadID),
+		models.UploadWhere.UserID.EQ(userID),
+	}
+
+	if lock {
+		mods = append(mods, qm.For("UPDATE"))
+	}
+
+	upload, err := models.Uploads(mods...).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, httperrors.ErrNotFoundUploadNotFound
+		}
+
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+// uploadMIMETypeAllowed reports whether the given declared MIME type is one of the allowed MIME types, ignoring parameters.
+func uploadMIMETypeAllowed(mimeType string, allowedMIMETypes []string) bool {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return false
+	}
+
+	for _, allowedType := range allowedMIMETypes {
+		if strings.EqualFold(mediaType, allowedType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// uploadBlobKey returns the blobstore key of the given completed upload.
+func uploadBlobKey(upload *models.Upload, ext string) string {
+	return path.Join(uploadsBlobDir, upload.UserID, upload.ID+ext)
+}
+
+// returnUpload returns the given upload as response, setting the number of bytes received via the Upload-Offset header.
+// Completed uploads include a signed URL to download the file from.
+func returnUpload(c echo.Context, s *api.Server, code int, upload *models.Upload) error {
+	ctx := c.Request().Context()
+
+	c.Response().Header().Set(util.HTTPHeaderUploadOffset, strconv.FormatInt(upload.BytesReceived, 10))
+
+	response := &types.Upload{
+		ID:        conv.UUID4(strfmt.UUID4(upload.ID)),
+		FileName:  upload.FileName.Ptr(),
+		MimeType:  swag.String(upload.MimeType),
+		Size:      swag.Int64(upload.Size),
+		Offset:    swag.Int64(upload.BytesReceived),
+		CreatedAt: conv.DateTime(strfmt.DateTime(upload.CreatedAt)),
+	}
+
+	if upload.CompletedAt.Valid {
+		response.CompletedAt = conv.DateTime(strfmt.DateTime(upload.CompletedAt.Time))
+
+		url, err := s.Blobstore.SignedURL(ctx, upload.BlobKey.String, s.Config.Storage.SignedURLValidity)
+		if err != nil {
+			util.LogFromContext(ctx).Debug().Err(err).Str("upload_id", upload.ID).Msg("Failed to sign URL of upload")
+			return err
+		}
+
+		response.URL = swag.String(url)
+	} else {
+		response.ExpiresAt = conv.DateTime(strfmt.DateTime(upload.UpdatedAt.Add(s.Config.Uploads.ExpiresAfter)))
+	}
+
+	return util.ValidateAndReturn(c, code, response)
+}
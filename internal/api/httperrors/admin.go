@@ -0,0 +1,12 @@
+package httperrors
+
+import (
+	"net/http"
+)
+
+var (
+	ErrNotFoundUserNotFound         = NewHTTPError(http.StatusNotFound, "USER_NOT_FOUND", "User was not found")
+	ErrNotFoundPushCampaignNotFound = NewHTTPError(http.StatusNotFound, "PUSH_CAMPAIGN_NOT_FOUND", "Push campaign was not found")
+	ErrBadRequestInvalidPushSegment = NewHTTPError(http.StatusBadRequest, "INVALID_PUSH_SEGMENT", "Push segment is invalid")
+	ErrBadRequestInvalidPushMessage = NewHTTPError(http.StatusBadRequest, "INVALID_PUSH_MESSAGE", "Push message requires a notification or data")
+)
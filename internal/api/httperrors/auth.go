@@ -1,13 +1,38 @@
 package httperrors
 
 import (
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 var (
-	ErrBadRequestInvalidPassword = NewHTTPErrorWithDetail(http.StatusBadRequest, "INVALID_PASSWORD", "The password provided was invalid", "Password was either too weak or did not match other criteria")
-	ErrForbiddenNotLocalUser     = NewHTTPError(http.StatusForbidden, "NOT_LOCAL_USER", "User account is not valid for local authentication")
-	ErrNotFoundTokenNotFound     = NewHTTPError(http.StatusNotFound, "TOKEN_NOT_FOUND", "Provided token was not found")
-	ErrConflictTokenExpired      = NewHTTPError(http.StatusConflict, "TOKEN_EXPIRED", "Provided token has expired and is no longer valid")
-	ErrConflictUserAlreadyExists = NewHTTPError(http.StatusConflict, "USER_ALREADY_EXISTS", "User with given username already exists")
+	ErrBadRequestInvalidPassword      = NewHTTPErrorWithDetail(http.StatusBadRequest, "INVALID_PASSWORD", "The password provided was invalid", "Password was either too weak or did not match other criteria")
+	ErrForbiddenNotLocalUser          = NewHTTPError(http.StatusForbidden, "NOT_LOCAL_USER", "User account is not valid for local authentication")
+	ErrNotFoundTokenNotFound          = NewHTTPError(http.StatusNotFound, "TOKEN_NOT_FOUND", "Provided token was not found")
+	ErrConflictTokenExpired           = NewHTTPError(http.StatusConflict, "TOKEN_EXPIRED", "Provided token has expired and is no longer valid")
+	ErrConflictUserAlreadyExists      = NewHTTPError(http.StatusConflict, "USER_ALREADY_EXISTS", "User with given username already exists")
+	ErrNotFoundSessionNotFound        = NewHTTPError(http.StatusNotFound, "SESSION_NOT_FOUND", "Session was not found")
+	ErrBadRequestInvalidTotpCode      = NewHTTPError(http.StatusBadRequest, "INVALID_TOTP_CODE", "The TOTP code provided was invalid")
+	ErrNotFoundTotpNotEnrolled        = NewHTTPError(http.StatusNotFound, "TOTP_NOT_ENROLLED", "User has not enrolled in TOTP two-factor authentication")
+	ErrConflictTotpAlreadyEnabled     = NewHTTPError(http.StatusConflict, "TOTP_ALREADY_ENABLED", "User has already enabled TOTP two-factor authentication")
+	ErrTooManyRequestsTooManyAttempts = NewHTTPError(http.StatusTooManyRequests, "TOO_MANY_ATTEMPTS", "Too many failed attempts, please try again later")
+	ErrUnauthorizedInvalidIDToken     = NewHTTPError(http.StatusUnauthorized, "INVALID_ID_TOKEN", "Provided ID token is invalid or was not issued by a known provider")
+	ErrNotFoundOAuthClientNotFound    = NewHTTPError(http.StatusNotFound, "OAUTH_CLIENT_NOT_FOUND", "OAuth client was not found")
+	ErrBadRequestInvalidRedirectURI   = NewHTTPError(http.StatusBadRequest, "INVALID_REDIRECT_URI", "Redirect URI is not registered for the OAuth client")
+	ErrNotFoundAPIKeyNotFound         = NewHTTPError(http.StatusNotFound, "API_KEY_NOT_FOUND", "API key was not found")
+	ErrBadRequestInvalidScopes        = NewHTTPError(http.StatusBadRequest, "INVALID_SCOPES", "Requested scopes are not granted to the user")
+	ErrForbiddenPasswordResetRequired = NewHTTPError(http.StatusForbidden, "PASSWORD_RESET_REQUIRED", "User is required to reset their password before logging in")
+	ErrNotFoundLegalDocumentNotFound  = NewHTTPError(http.StatusNotFound, "LEGAL_DOCUMENT_NOT_FOUND", "Legal document was not found")
 )
+
+// NewHTTPErrorTooManyAttempts returns ErrTooManyRequestsTooManyAttempts, instructing the client to wait
+// for the given duration (rounded up to full seconds) using the Retry-After header.
+func NewHTTPErrorTooManyAttempts(retryAfter time.Duration) *HTTPError {
+	err := *ErrTooManyRequestsTooManyAttempts
+	err.Header = http.Header{}
+	err.Header.Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+
+	return &err
+}
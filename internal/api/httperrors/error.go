@@ -2,6 +2,7 @@ package httperrors
 
 import (
 	"fmt"
+	"net/http"
 	"sort"
 	"strings"
 
@@ -21,6 +22,7 @@ type HTTPError struct {
 	types.PublicHTTPError
 	Internal       error                  `json:"-"`
 	AdditionalData map[string]interface{} `json:"-"`
+	Header         http.Header            `json:"-"` // additional headers set on the error response
 }
 
 type HTTPValidationError struct {
@@ -0,0 +1,11 @@
+package httperrors
+
+import (
+	"net/http"
+)
+
+var (
+	ErrNotFoundFileNotFound                    = NewHTTPError(http.StatusNotFound, "FILE_NOT_FOUND", "File not found")
+	ErrBadRequestUnknownImageVariant           = NewHTTPError(http.StatusBadRequest, "UNKNOWN_IMAGE_VARIANT", "Image variant is not configured")
+	ErrUnsupportedMediaTypeImageNotProcessable = NewHTTPError(http.StatusUnsupportedMediaType, "IMAGE_NOT_PROCESSABLE", "File is not an image that can be processed")
+)
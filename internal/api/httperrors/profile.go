@@ -0,0 +1,13 @@
+package httperrors
+
+import (
+	"net/http"
+)
+
+var (
+	ErrNotFoundProfileNotFound             = NewHTTPError(http.StatusNotFound, "PROFILE_NOT_FOUND", "User does not have a profile")
+	ErrNotFoundAvatarNotFound              = NewHTTPError(http.StatusNotFound, "AVATAR_NOT_FOUND", "User has not uploaded an avatar")
+	ErrPreconditionFailedProfileModified   = NewHTTPError(http.StatusPreconditionFailed, "PROFILE_MODIFIED", "Profile has been modified since it was last retrieved")
+	ErrPreconditionRequiredIfMatchMissing  = NewHTTPError(http.StatusPreconditionRequired, "IF_MATCH_MISSING", "If-Match header with the profile's entity tag is required")
+	ErrRequestEntityTooLargeAvatarTooLarge = NewHTTPError(http.StatusRequestEntityTooLarge, "AVATAR_TOO_LARGE", "Avatar exceeds the maximum file size")
+)
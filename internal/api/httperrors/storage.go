@@ -0,0 +1,11 @@
+package httperrors
+
+import (
+	"net/http"
+)
+
+var (
+	ErrForbiddenSignatureInvalid = NewHTTPError(http.StatusForbidden, "SIGNATURE_INVALID", "Signed URL is invalid")
+	ErrForbiddenSignatureExpired = NewHTTPError(http.StatusForbidden, "SIGNATURE_EXPIRED", "Signed URL has expired")
+	ErrNotFoundBlobNotFound      = NewHTTPError(http.StatusNotFound, "BLOB_NOT_FOUND", "Blob does not exist")
+)
@@ -0,0 +1,17 @@
+package httperrors
+
+import (
+	"net/http"
+)
+
+var (
+	ErrNotFoundUploadNotFound                       = NewHTTPError(http.StatusNotFound, "UPLOAD_NOT_FOUND", "Upload not found")
+	ErrBadRequestInvalidUploadOffset                = NewHTTPError(http.StatusBadRequest, "INVALID_UPLOAD_OFFSET", "Upload-Offset header is missing or invalid")
+	ErrConflictUploadOffsetMismatch                 = NewHTTPError(http.StatusConflict, "UPLOAD_OFFSET_MISMATCH", "Upload-Offset does not match the bytes received so far")
+	ErrConflictUploadIncomplete                     = NewHTTPError(http.StatusConflict, "UPLOAD_INCOMPLETE", "Upload has not received all bytes yet")
+	ErrConflictUploadCompleted                      = NewHTTPError(http.StatusConflict, "UPLOAD_COMPLETED", "Upload has already been completed")
+	ErrConflictUploadChunkInProgress                = NewHTTPError(http.StatusConflict, "UPLOAD_CHUNK_IN_PROGRESS", "Another chunk of the upload is currently being written")
+	ErrRequestEntityTooLargeUploadTooLarge          = NewHTTPError(http.StatusRequestEntityTooLarge, "UPLOAD_TOO_LARGE", "Upload exceeds the maximum file size")
+	ErrRequestEntityTooLargeUploadChunkTooLarge     = NewHTTPError(http.StatusRequestEntityTooLarge, "UPLOAD_CHUNK_TOO_LARGE", "Chunk exceeds the maximum chunk size or the remaining size of the upload")
+	ErrUnsupportedMediaTypeUploadMIMETypeNotAllowed = NewHTTPError(http.StatusUnsupportedMediaType, "UPLOAD_MIME_TYPE_NOT_ALLOWED", "MIME type of upload is not allowed or does not match its content")
+)
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/imaging"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+)
+
+// SanitizeImage strips all metadata (e.g. GPS locations) from the given uploaded image and rotates it upright
+// according to its EXIF orientation, see imaging.Sanitize. Images which cannot be processed are rejected with
+// ErrUnsupportedMediaTypeImageNotProcessable.
+func (s *Server) SanitizeImage(ctx context.Context, r io.Reader) (*bytes.Buffer, error) {
+	log := util.LogFromContext(ctx)
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := imaging.Sanitize(&buf, data, s.Config.Images.MaxPixels, s.Config.Images.JPEGQuality); err != nil {
+		log.Debug().Err(err).Msg("Failed to sanitize image")
+		return nil, httperrors.ErrUnsupportedMediaTypeImageNotProcessable
+	}
+
+	return &buf, nil
+}
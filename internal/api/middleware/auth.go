@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -16,6 +17,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
@@ -24,9 +26,18 @@ var (
 	ErrUnauthorizedLastAuthenticatedAtExceeded = httperrors.NewHTTPError(http.StatusUnauthorized, "LAST_AUTHENTICATED_AT_EXCEEDED", "LastAuthenticatedAt timestamp exceeds threshold, re-authentication required")
 	ErrForbiddenUserDeactivated                = httperrors.NewHTTPError(http.StatusForbidden, "USER_DEACTIVATED", "User account is deactivated")
 	ErrForbiddenMissingScopes                  = httperrors.NewHTTPError(http.StatusForbidden, "MISSING_SCOPES", "User is missing required scopes")
+	ErrForbiddenEmailNotVerified               = httperrors.NewHTTPError(http.StatusForbidden, "EMAIL_NOT_VERIFIED", "User has not verified their email address")
+	ErrForbiddenMissingPermissions             = httperrors.NewHTTPError(http.StatusForbidden, "MISSING_PERMISSIONS", "User is missing required permissions")
+	ErrForbiddenLegalAcceptanceRequired        = httperrors.NewHTTPError(http.StatusForbidden, "LEGAL_ACCEPTANCE_REQUIRED", "User is required to accept the latest version of mandatory legal documents")
+	ErrForbiddenRestrictedCredentials          = httperrors.NewHTTPError(http.StatusForbidden, "RESTRICTED_CREDENTIALS", "Restricted credentials are not permitted")
 	ErrAuthTokenValidationFailed               = errors.New("auth token validation failed")
 )
 
+const (
+	// apiKeyLastUsedAtInterval limits how often the last used at timestamp of API keys is updated
+	apiKeyLastUsedAtInterval = time.Minute
+)
+
 // AuthMode controls the type of authentication check performed for a specific route or group
 type AuthMode int
 
@@ -172,13 +183,141 @@ func DefaultAuthTokenValidator(c echo.Context, config AuthConfig, token string)
 		return auth.AuthenticationResult{}, echo.ErrInternalServerError
 	}
 
+	user := accessToken.R.User
+
+	// Access tokens issued to OAuth clients are restricted to the scopes granted
+	if accessToken.Scopes != nil {
+		user.Scopes = auth.IntersectScopes(user.Scopes, accessToken.Scopes)
+	}
+
 	return auth.AuthenticationResult{
 		Token:      accessToken.Token,
-		User:       accessToken.R.User,
+		User:       user,
 		ValidUntil: accessToken.ValidUntil,
+		SessionID:  accessToken.RefreshTokenFamilyID.String,
+		Restricted: accessToken.Scopes != nil,
+	}, nil
+}
+
+// JWTAuthTokenFormatValidator accepts JWT access tokens as well as opaque access tokens, allowing tokens issued
+// before switching the server's token format to be used until they expire.
+func JWTAuthTokenFormatValidator(token string) bool {
+	return auth.IsJWT(token) || DefaultAuthTokenFormatValidator(token)
+}
+
+// JWTAuthTokenValidator verifies JWT access tokens using the server's JWT service. Unless revocation checks are
+// disabled (SERVER_AUTH_JWT_CHECK_REVOCATION), the token's user and session are loaded from the database, rejecting
+// tokens of deleted users and revoked sessions. Opaque access tokens are passed on to DefaultAuthTokenValidator.
+func JWTAuthTokenValidator(c echo.Context, config AuthConfig, token string) (auth.AuthenticationResult, error) {
+	if !auth.IsJWT(token) {
+		return DefaultAuthTokenValidator(c, config, token)
+	}
+
+	if config.S.JWT == nil {
+		log.Trace().Msg("Received JWT access token, but JWT service is not initialized")
+		return auth.AuthenticationResult{}, ErrAuthTokenValidationFailed
+	}
+
+	res, err := config.S.JWT.ParseAccessToken(token)
+	if err != nil {
+		log.Trace().Err(err).Msg("Failed to verify JWT access token")
+		return auth.AuthenticationResult{}, ErrAuthTokenValidationFailed
+	}
+
+	if !config.S.Config.Auth.JWT.CheckRevocation {
+		return res, nil
+	}
+
+	mods := []qm.QueryMod{models.UserWhere.ID.EQ(res.User.ID)}
+	if len(res.SessionID) > 0 {
+		// Revoking a session deletes it, invalidating all JWTs issued for it
+		mods = append(mods, qm.Where("EXISTS (SELECT 1 FROM sessions WHERE sessions.id = ? AND sessions.user_id = users.id)", res.SessionID))
+	}
+
+	user, err := models.Users(mods...).One(c.Request().Context(), config.S.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Trace().Err(err).Str("user_id", res.User.ID).Str("session_id", res.SessionID).Msg("User or session of JWT access token not found in database")
+			return auth.AuthenticationResult{}, ErrAuthTokenValidationFailed
+		}
+
+		log.Error().Err(err).Msg("Failed to query for user of JWT access token in database, aborting request")
+		return auth.AuthenticationResult{}, echo.ErrInternalServerError
+	}
+
+	// The scopes embedded might have been restricted to an OAuth grant, but must not outlast scopes removed from the user
+	res.Scopes = auth.IntersectScopes(user.Scopes, res.Scopes)
+	res.User = user
+
+	return res, nil
+}
+
+// APIKeyAuthTokenFormatValidator accepts well formed API keys, see auth.GenerateAPIKey.
+func APIKeyAuthTokenFormatValidator(token string) bool {
+	_, ok := auth.ParseAPIKeyPrefix(token)
+	return ok
+}
+
+// APIKeyAuthTokenValidator looks up the API key by its public prefix and authenticates the request on behalf of the key's
+// owner, restricted to the scopes granted to the key. The time the key was last used is updated at most once per apiKeyLastUsedAtInterval.
+// API keys are restricted credentials, which are only granted the permissions of default roles, see auth.PermissionsFromContext.
+func APIKeyAuthTokenValidator(c echo.Context, config AuthConfig, token string) (auth.AuthenticationResult, error) {
+	ctx := c.Request().Context()
+
+	prefix, ok := auth.ParseAPIKeyPrefix(token)
+	if !ok {
+		return auth.AuthenticationResult{}, ErrAuthTokenValidationFailed
+	}
+
+	apiKey, err := models.APIKeys(
+		models.APIKeyWhere.Prefix.EQ(prefix),
+		qm.Load(models.APIKeyRels.User),
+	).One(ctx, config.S.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Trace().Err(err).Msg("API key not found in database")
+			return auth.AuthenticationResult{}, ErrAuthTokenValidationFailed
+		}
+
+		log.Error().Err(err).Msg("Failed to query for API key in database, aborting request")
+		return auth.AuthenticationResult{}, echo.ErrInternalServerError
+	}
+
+	if !auth.CompareAPIKeyAndHash(token, apiKey.KeyHash) {
+		log.Trace().Str("api_key_id", apiKey.ID).Msg("API key does not match stored hash")
+		return auth.AuthenticationResult{}, ErrAuthTokenValidationFailed
+	}
+
+	if !apiKey.LastUsedAt.Valid || time.Since(apiKey.LastUsedAt.Time) > apiKeyLastUsedAtInterval {
+		// Failing to track usage must not prevent the key from being used
+		if _, err := models.APIKeys(models.APIKeyWhere.ID.EQ(apiKey.ID)).UpdateAll(ctx, config.S.DB, models.M{
+			models.APIKeyColumns.LastUsedAt: null.TimeFrom(time.Now()),
+			models.APIKeyColumns.UpdatedAt:  time.Now(),
+		}); err != nil {
+			log.Error().Err(err).Str("api_key_id", apiKey.ID).Msg("Failed to update last used at timestamp of API key")
+		}
+	}
+
+	user := apiKey.R.User
+
+	return auth.AuthenticationResult{
+		Token:      token,
+		User:       user,
+		ValidUntil: apiKey.ExpiresAt.Time, // zero if the key does not expire
+		Scopes:     auth.IntersectScopes(user.Scopes, apiKey.Scopes),
+		Restricted: true,
 	}, nil
 }
 
+// APIKeyAuth authenticates requests using API keys provided via `Authorization: ApiKey <key>`. Requests without
+// a valid API key are passed on, so it is chained in front of the regular auth middleware, which accepts
+// the authentication already performed.
+func APIKeyAuth(s *api.Server) echo.MiddlewareFunc {
+	c := DefaultAPIKeyAuthConfig
+	c.S = s
+	return AuthWithConfig(c)
+}
+
 var (
 	DefaultAuthConfig = AuthConfig{
 		Mode:            AuthModeRequired,
@@ -191,19 +330,33 @@ var (
 		TokenValidator:  DefaultAuthTokenValidator,
 		Scopes:          []string{auth.AuthScopeApp.String()},
 	}
+	DefaultAPIKeyAuthConfig = AuthConfig{
+		Mode:            AuthModeTry,
+		FailureMode:     AuthFailureModeUnauthorized,
+		TokenSource:     AuthTokenSourceHeader,
+		TokenSourceKey:  echo.HeaderAuthorization,
+		Scheme:          auth.APIKeyScheme,
+		Skipper:         middleware.DefaultSkipper,
+		FormatValidator: APIKeyAuthTokenFormatValidator,
+		TokenValidator:  APIKeyAuthTokenValidator,
+	}
 )
 
 type AuthConfig struct {
-	S               *api.Server              // API server used for database and service access
-	Mode            AuthMode                 // Controls type of authentication required (default: AuthModeRequired)
-	FailureMode     AuthFailureMode          // Controls response on auth failure (default: AuthFailureModeUnauthorized)
-	TokenSource     AuthTokenSource          // Sets source of auth token (default: AuthTokenSourceHeader)
-	TokenSourceKey  string                   // Sets key for auth token source lookup (default: "Authorization")
-	Scheme          string                   // Sets required token scheme (default: "Bearer")
-	Skipper         middleware.Skipper       // Controls skipping of certain routes (default: no skipped routes)
-	FormatValidator AuthTokenFormatValidator // Validates the format of the token retrieved
-	TokenValidator  AuthTokenValidator       // Validates token retrieved and returns associated user (default: performs lookup in access_tokens table)
-	Scopes          []string                 // List of scopes required to access endpoint (default: none required)
+	S                      *api.Server              // API server used for database and service access
+	Mode                   AuthMode                 // Controls type of authentication required (default: AuthModeRequired)
+	FailureMode            AuthFailureMode          // Controls response on auth failure (default: AuthFailureModeUnauthorized)
+	TokenSource            AuthTokenSource          // Sets source of auth token (default: AuthTokenSourceHeader)
+	TokenSourceKey         string                   // Sets key for auth token source lookup (default: "Authorization")
+	Scheme                 string                   // Sets required token scheme (default: "Bearer")
+	Skipper                middleware.Skipper       // Controls skipping of certain routes (default: no skipped routes)
+	FormatValidator        AuthTokenFormatValidator // Validates the format of the token retrieved
+	TokenValidator         AuthTokenValidator       // Validates token retrieved and returns associated user (default: performs lookup in access_tokens table)
+	Scopes                 []string                 // List of scopes required to access endpoint (default: none required)
+	RequireVerifiedEmail   bool                     // Rejects users who have not verified their email address yet (default: false)
+	RequireLegalAcceptance bool                     // Rejects users who have not accepted the latest version of all mandatory legal documents (default: false)
+	FirstPartyOnly         bool                     // Rejects restricted credentials, e.g. API keys or access tokens issued to OAuth clients (default: false)
+	FirstPartyOnlySkipper  middleware.Skipper       // Controls routes restricted credentials are permitted for despite FirstPartyOnly (default: no skipped routes)
 }
 
 func (c AuthConfig) CheckLastAuthenticatedAt(user *models.User) bool {
@@ -218,18 +371,57 @@ func (c AuthConfig) CheckLastAuthenticatedAt(user *models.User) bool {
 	return time.Since(user.LastAuthenticatedAt.Time).Seconds() <= c.S.Config.Auth.LastAuthenticatedAtThreshold.Seconds()
 }
 
+// CheckRestricted reports whether restricted credentials (see auth.AuthenticationResult) are permitted for the current
+// request, always succeeding for credentials not restricted or if FirstPartyOnly is not set.
+func (c AuthConfig) CheckRestricted(echoCtx echo.Context, restricted bool) bool {
+	if !restricted || !c.FirstPartyOnly {
+		return true
+	}
+
+	return c.FirstPartyOnlySkipper(echoCtx)
+}
+
+func (c AuthConfig) CheckEmailVerified(user *models.User) bool {
+	if !c.RequireVerifiedEmail {
+		return true
+	}
+
+	return user.EmailVerifiedAt.Valid
+}
+
+// CheckLegalAccepted reports whether the user has accepted the latest version of all mandatory legal documents,
+// always succeeding if legal acceptance is not required.
+func (c AuthConfig) CheckLegalAccepted(ctx context.Context, user *models.User) (bool, error) {
+	if !c.RequireLegalAcceptance {
+		return true, nil
+	}
+
+	pending, err := auth.HasPendingLegalDocuments(ctx, c.S.DB, user.ID)
+	if err != nil {
+		return false, err
+	}
+
+	return !pending, nil
+}
+
 func (c AuthConfig) CheckUserScopes(user *models.User) bool {
+	return c.CheckScopes(user.Scopes)
+}
+
+// CheckScopes reports whether the given scopes granted to the credentials used for authentication include
+// any of the scopes required.
+func (c AuthConfig) CheckScopes(scopes []string) bool {
 	if len(c.Scopes) == 0 {
 		return true
 	}
 
-	if len(user.Scopes) == 0 {
+	if len(scopes) == 0 {
 		return false
 	}
 
 	for _, scope := range c.Scopes {
-		for _, userScope := range user.Scopes {
-			if scope == userScope {
+		for _, s := range scopes {
+			if scope == s {
 				return true
 			}
 		}
@@ -241,6 +433,8 @@ func (c AuthConfig) CheckUserScopes(user *models.User) bool {
 func Auth(s *api.Server) echo.MiddlewareFunc {
 	c := DefaultAuthConfig
 	c.S = s
+	c.FormatValidator = nil
+	c.TokenValidator = nil
 	return AuthWithConfig(c)
 }
 
@@ -261,12 +455,25 @@ func AuthWithConfig(config AuthConfig) echo.MiddlewareFunc {
 		config.Skipper = DefaultAuthConfig.Skipper
 	}
 
+	if config.FirstPartyOnlySkipper == nil {
+		config.FirstPartyOnlySkipper = middleware.DefaultSkipper
+	}
+
+	// Servers issuing JWT access tokens verify them using their JWT service by default
 	if config.FormatValidator == nil {
-		config.FormatValidator = DefaultAuthConfig.FormatValidator
+		if config.S.JWT != nil {
+			config.FormatValidator = JWTAuthTokenFormatValidator
+		} else {
+			config.FormatValidator = DefaultAuthConfig.FormatValidator
+		}
 	}
 
 	if config.TokenValidator == nil {
-		config.TokenValidator = DefaultAuthConfig.TokenValidator
+		if config.S.JWT != nil {
+			config.TokenValidator = JWTAuthTokenValidator
+		} else {
+			config.TokenValidator = DefaultAuthConfig.TokenValidator
+		}
 	}
 
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
@@ -293,14 +500,34 @@ func AuthWithConfig(config AuthConfig) echo.MiddlewareFunc {
 					return ErrUnauthorizedLastAuthenticatedAtExceeded
 				}
 
-				if !config.CheckUserScopes(user) {
+				if scopes := auth.ScopesFromEchoContext(c); !config.CheckScopes(scopes) {
 					log.Trace().
 						Strs("scopes", config.Scopes).
-						Strs("user_scopes", user.Scopes).
+						Strs("user_scopes", scopes).
 						Msg("Authentication already performed, but user does not have required scopes, rejecting request")
 					return ErrForbiddenMissingScopes
 				}
 
+				if !config.CheckRestricted(c, auth.RestrictedFromEchoContext(c)) {
+					log.Trace().Msg("Authentication already performed, but restricted credentials are not permitted, rejecting request")
+					return ErrForbiddenRestrictedCredentials
+				}
+
+				if !config.CheckEmailVerified(user) {
+					log.Trace().Msg("Authentication already performed, but user has not verified their email address, rejecting request")
+					return ErrForbiddenEmailNotVerified
+				}
+
+				accepted, err := config.CheckLegalAccepted(c.Request().Context(), user)
+				if err != nil {
+					log.Error().Err(err).Msg("Failed to check legal acceptance of user")
+					return err
+				}
+				if !accepted {
+					log.Trace().Msg("Authentication already performed, but user has not accepted mandatory legal documents, rejecting request")
+					return ErrForbiddenLegalAcceptanceRequired
+				}
+
 				log.Trace().Msg("Authentication already performed, allowing request")
 				return next(c)
 			}
@@ -372,14 +599,40 @@ func AuthWithConfig(config AuthConfig) echo.MiddlewareFunc {
 				return ErrUnauthorizedLastAuthenticatedAtExceeded
 			}
 
-			if !config.CheckUserScopes(user) {
+			// Credentials restricted to a subset of the user's scopes (e.g. API keys) carry their scopes in the result
+			scopes := res.Scopes
+			if scopes == nil {
+				scopes = user.Scopes
+			}
+
+			if !config.CheckScopes(scopes) {
 				log.Trace().
 					Strs("scopes", config.Scopes).
-					Strs("user_scopes", user.Scopes).
+					Strs("user_scopes", scopes).
 					Msg("Authentication already performed, but user does not have required scopes, rejecting request")
 				return ErrForbiddenMissingScopes
 			}
 
+			if !config.CheckRestricted(c, res.Restricted) {
+				log.Trace().Str("user_id", user.ID).Msg("Restricted credentials are not permitted, rejecting request")
+				return ErrForbiddenRestrictedCredentials
+			}
+
+			if !config.CheckEmailVerified(user) {
+				log.Trace().Str("user_id", user.ID).Msg("User has not verified their email address, rejecting request")
+				return ErrForbiddenEmailNotVerified
+			}
+
+			accepted, err := config.CheckLegalAccepted(c.Request().Context(), user)
+			if err != nil {
+				log.Error().Err(err).Str("user_id", user.ID).Msg("Failed to check legal acceptance of user")
+				return err
+			}
+			if !accepted {
+				log.Trace().Str("user_id", user.ID).Msg("User has not accepted mandatory legal documents, rejecting request")
+				return ErrForbiddenLegalAcceptanceRequired
+			}
+
 			auth.EnrichEchoContextWithCredentials(c, res)
 
 			log.Trace().Str("user_id", user.ID).Msg("Auth token is valid, allowing request")
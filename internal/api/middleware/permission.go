@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"errors"
+
+	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+)
+
+// RequirePermission rejects requests of users lacking any of the given permissions, granted by the roles assigned to them.
+// The user's permissions are resolved once per request, see auth.PermissionsFromContext. As the authenticated user is
+// required, the middleware must be chained after the auth middleware.
+func RequirePermission(s *api.Server, permissions ...auth.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			log := util.LogFromEchoContext(c).With().Str("middleware", "permission").Logger()
+
+			granted, err := auth.PermissionsFromContext(c.Request().Context(), s.DB)
+			if err != nil {
+				if errors.Is(err, auth.ErrNotAuthenticated) {
+					log.Trace().Msg("Request is not authenticated, rejecting")
+					return echo.ErrUnauthorized
+				}
+
+				log.Error().Err(err).Msg("Failed to resolve permissions of user, aborting request")
+				return echo.ErrInternalServerError
+			}
+
+			for _, permission := range permissions {
+				if !auth.HasPermission(granted, permission) {
+					log.Trace().
+						Str("permission", permission.String()).
+						Strs("user_permissions", granted).
+						Msg("User does not have required permission, rejecting request")
+					return ErrForbiddenMissingPermissions
+				}
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/ratelimit"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+	HeaderRateLimitPolicy    = "RateLimit-Policy"
+)
+
+var (
+	ErrTooManyRequestsRateLimitExceeded = httperrors.NewHTTPError(http.StatusTooManyRequests, "RATE_LIMIT_EXCEEDED", "Rate limit exceeded, please try again later")
+)
+
+// RateLimitKeyExtractor returns the key requests are counted by, e.g. the client's IP.
+type RateLimitKeyExtractor func(c echo.Context) (string, error)
+
+type RateLimitConfig struct {
+	Skipper middleware.Skipper
+	Limiter *ratelimit.Limiter
+	Policy  config.RateLimitPolicy
+	// Name of the policy, separating the counters of groups sharing the same keys
+	Name         string
+	KeyExtractor RateLimitKeyExtractor
+}
+
+var (
+	DefaultRateLimitConfig = RateLimitConfig{
+		Skipper:      middleware.DefaultSkipper,
+		KeyExtractor: RateLimitKeyByIP,
+	}
+)
+
+// RateLimitKeyByIP counts requests by the client's IP.
+func RateLimitKeyByIP(c echo.Context) (string, error) {
+	return fmt.Sprintf("ip:%s", c.RealIP()), nil
+}
+
+// RateLimitKeyByUser counts requests by the authenticated user, falling back to the client's IP
+// for unauthenticated requests. The auth middleware must run before the rate limit middleware.
+func RateLimitKeyByUser(c echo.Context) (string, error) {
+	user := auth.UserFromEchoContext(c)
+	if user == nil {
+		return RateLimitKeyByIP(c)
+	}
+
+	return fmt.Sprintf("user:%s", user.ID), nil
+}
+
+// RateLimitWithConfig returns a middleware limiting requests per key to the given policy, reporting the
+// state of the limit via RateLimit-* headers. Requests exceeding the limit are rejected with a Retry-After header.
+// Errors of the limiter's store are logged and the request is allowed, so an unavailable store doesn't take down the API.
+func RateLimitWithConfig(config RateLimitConfig) echo.MiddlewareFunc {
+	if config.Limiter == nil {
+		panic("rate limit middleware: limiter is required")
+	}
+
+	if config.Skipper == nil {
+		config.Skipper = DefaultRateLimitConfig.Skipper
+	}
+
+	if config.KeyExtractor == nil {
+		config.KeyExtractor = DefaultRateLimitConfig.KeyExtractor
+	}
+
+	policyHeader := fmt.Sprintf("%d;w=%d", config.Policy.Limit, int(config.Policy.Period.Seconds()))
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) {
+				return next(c)
+			}
+
+			ctx := c.Request().Context()
+			log := util.LogFromEchoContext(c).With().Str("middleware", "rate_limit").Str("rate_limit_policy", config.Name).Logger()
+
+			key, err := config.KeyExtractor(c)
+			if err != nil {
+				log.Debug().Err(err).Msg("Failed to extract rate limit key")
+				return err
+			}
+
+			res, err := config.Limiter.Allow(ctx, fmt.Sprintf("%s:%s", config.Name, key), config.Policy, time.Now())
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to apply rate limit, allowing request")
+				return next(c)
+			}
+
+			reset := strconv.Itoa(int(math.Ceil(res.Reset.Seconds())))
+
+			header := c.Response().Header()
+			header.Set(HeaderRateLimitLimit, strconv.Itoa(res.Limit))
+			header.Set(HeaderRateLimitRemaining, strconv.Itoa(res.Remaining))
+			header.Set(HeaderRateLimitReset, reset)
+			header.Set(HeaderRateLimitPolicy, policyHeader)
+
+			if !res.Allowed {
+				log.Debug().Str("key", key).Msg("Rate limit exceeded, rejecting request")
+				header.Set(echo.HeaderRetryAfter, reset)
+				return ErrTooManyRequestsRateLimitExceeded
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/ratelimit"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rateLimitTestHandler(c echo.Context) error {
+	return c.NoContent(http.StatusNoContent)
+}
+
+func TestRateLimit(t *testing.T) {
+	limiter := ratelimit.New(ratelimit.NewMemoryStore())
+	rateLimitMW := middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+		Limiter: limiter,
+		Policy:  config.RateLimitPolicy{Limit: 2, Period: time.Minute},
+		Name:    "test",
+	})
+
+	e := echo.New()
+
+	for i := 0; i < 2; i++ {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = "203.0.113.42:1234"
+		c := e.NewContext(req, rec)
+
+		require.NoError(t, rateLimitMW(rateLimitTestHandler)(c))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "2", rec.Header().Get(middleware.HeaderRateLimitLimit))
+		assert.Equal(t, []string{"1", "0"}[i], rec.Header().Get(middleware.HeaderRateLimitRemaining))
+		assert.NotEmpty(t, rec.Header().Get(middleware.HeaderRateLimitReset))
+		assert.Equal(t, "2;w=60", rec.Header().Get(middleware.HeaderRateLimitPolicy))
+		assert.Empty(t, rec.Header().Get(echo.HeaderRetryAfter))
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "203.0.113.42:1234"
+	c := e.NewContext(req, rec)
+
+	err := rateLimitMW(rateLimitTestHandler)(c)
+	assert.Equal(t, middleware.ErrTooManyRequestsRateLimitExceeded, err)
+	assert.Equal(t, "0", rec.Header().Get(middleware.HeaderRateLimitRemaining))
+	assert.Equal(t, rec.Header().Get(middleware.HeaderRateLimitReset), rec.Header().Get(echo.HeaderRetryAfter))
+
+	// other clients are not affected
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "198.51.100.7:1234"
+	c = e.NewContext(req, rec)
+
+	require.NoError(t, rateLimitMW(rateLimitTestHandler)(c))
+	assert.Equal(t, "1", rec.Header().Get(middleware.HeaderRateLimitRemaining))
+}
+
+func TestRateLimitCustomKeyExtractor(t *testing.T) {
+	limiter := ratelimit.New(ratelimit.NewMemoryStore())
+	rateLimitMW := middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+		Limiter: limiter,
+		Policy:  config.RateLimitPolicy{Limit: 1, Period: time.Minute},
+		Name:    "test",
+		KeyExtractor: func(c echo.Context) (string, error) {
+			return c.Request().Header.Get("X-Client-ID"), nil
+		},
+	})
+
+	e := echo.New()
+
+	for _, clientID := range []string{"a", "b"} {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Client-ID", clientID)
+		c := e.NewContext(req, rec)
+
+		require.NoError(t, rateLimitMW(rateLimitTestHandler)(c))
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Client-ID", "a")
+	c := e.NewContext(req, rec)
+
+	assert.Equal(t, middleware.ErrTooManyRequestsRateLimitExceeded, rateLimitMW(rateLimitTestHandler)(c))
+}
+
+func TestRateLimitSkipper(t *testing.T) {
+	limiter := ratelimit.New(ratelimit.NewMemoryStore())
+	rateLimitMW := middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+		Limiter: limiter,
+		Policy:  config.RateLimitPolicy{Limit: 1, Period: time.Minute},
+		Name:    "test",
+		Skipper: func(c echo.Context) bool {
+			return true
+		},
+	})
+
+	e := echo.New()
+
+	for i := 0; i < 3; i++ {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		c := e.NewContext(req, rec)
+
+		require.NoError(t, rateLimitMW(rateLimitTestHandler)(c))
+		assert.Empty(t, rec.Header().Get(middleware.HeaderRateLimitLimit))
+	}
+}
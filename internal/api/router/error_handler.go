@@ -41,6 +41,12 @@ func HTTPErrorHandlerWithConfig(config HTTPErrorHandlerConfig) echo.HTTPErrorHan
 			code = *httpError.Code
 			he = httpError
 
+			for key, values := range httpError.Header {
+				for _, value := range values {
+					c.Response().Header().Add(key, value)
+				}
+			}
+
 			if code == http.StatusInternalServerError && config.HideInternalServerErrorDetails {
 				if httpError.Internal == nil {
 					//nolint:errorlint
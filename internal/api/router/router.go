@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
 	"allaboutapps.dev/aw/go-starter/internal/api/handlers"
 	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
 	"github.com/labstack/echo/v4"
@@ -146,6 +147,66 @@ func Init(s *api.Server) {
 	// Add your custom / additional middlewares here.
 	// see https://echo.labstack.com/middleware
 
+	// Rate limiting of our general groups below, policies are configured per group
+	managementRateLimit := middleware.Noop()
+	authRateLimit := middleware.Noop()
+	accountRateLimit := middleware.Noop()
+	pushRateLimit := middleware.Noop()
+	uploadsRateLimit := middleware.Noop()
+
+	if s.RateLimiter != nil {
+		managementRateLimit = middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+			Limiter:      s.RateLimiter,
+			Policy:       s.Config.RateLimit.Management,
+			Name:         "management",
+			KeyExtractor: middleware.RateLimitKeyByIP,
+			Skipper: func(c echo.Context) bool {
+				// We never limit readiness and liveness probes
+				switch c.Path() {
+				case "/-/ready", "/-/healthy":
+					return true
+				}
+				return false
+			},
+		})
+
+		// Credential endpoints are limited per IP, as they are called without (valid) credentials
+		authRateLimit = middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+			Limiter:      s.RateLimiter,
+			Policy:       s.Config.RateLimit.Auth,
+			Name:         "auth",
+			KeyExtractor: middleware.RateLimitKeyByIP,
+			Skipper: func(c echo.Context) bool {
+				return !isCredentialPath(c.Path())
+			},
+		})
+
+		// All other endpoints of the auth group are limited per user, thus applied after the auth middleware
+		accountRateLimit = middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+			Limiter:      s.RateLimiter,
+			Policy:       s.Config.RateLimit.Account,
+			Name:         "account",
+			KeyExtractor: middleware.RateLimitKeyByUser,
+			Skipper: func(c echo.Context) bool {
+				return isCredentialPath(c.Path())
+			},
+		})
+
+		pushRateLimit = middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+			Limiter:      s.RateLimiter,
+			Policy:       s.Config.RateLimit.Push,
+			Name:         "push",
+			KeyExtractor: middleware.RateLimitKeyByUser,
+		})
+
+		uploadsRateLimit = middleware.RateLimitWithConfig(middleware.RateLimitConfig{
+			Limiter:      s.RateLimiter,
+			Policy:       s.Config.RateLimit.Uploads,
+			Name:         "uploads",
+			KeyExtractor: middleware.RateLimitKeyByUser,
+		})
+	}
+
 	// ---
 	// Initialize our general groups and set middleware to use above them
 	s.Router = &api.Router{
@@ -155,7 +216,7 @@ func Init(s *api.Server) {
 		Root: s.Echo.Group(""),
 
 		// Management endpoints, uncacheable, secured by key auth (query param), available at /-/**
-		Management: s.Echo.Group("/-", echoMiddleware.KeyAuthWithConfig(echoMiddleware.KeyAuthConfig{
+		Management: s.Echo.Group("/-", managementRateLimit, echoMiddleware.KeyAuthWithConfig(echoMiddleware.KeyAuthConfig{
 			KeyLookup: "query:mgmt-secret",
 			Validator: func(key string, c echo.Context) (bool, error) {
 				return key == s.Config.Management.Secret, nil
@@ -170,27 +231,85 @@ func Init(s *api.Server) {
 		}), middleware.NoCache()),
 
 		// OAuth2, unsecured or secured by bearer auth, available at /api/v1/auth/**
-		APIV1Auth: s.Echo.Group("/api/v1/auth", middleware.AuthWithConfig(middleware.AuthConfig{
-			S:    s,
-			Mode: middleware.AuthModeRequired,
+		// Credential endpoints are rate limited per IP, all others per user
+		// Restricted credentials (API keys, access tokens issued to OAuth clients) may only access the user's info
+		APIV1Auth: s.Echo.Group("/api/v1/auth", authRateLimit, middleware.AuthWithConfig(middleware.AuthConfig{
+			S:              s,
+			Mode:           middleware.AuthModeRequired,
+			FirstPartyOnly: true,
+			FirstPartyOnlySkipper: func(c echo.Context) bool {
+				return c.Path() == "/api/v1/auth/userinfo"
+			},
 			Skipper: func(c echo.Context) bool {
 				switch c.Path() {
-				case "/api/v1/auth/forgot-password",
+				case "/api/v1/auth/change-email/confirm",
+					"/api/v1/auth/change-email/revert",
+					"/api/v1/auth/forgot-password",
 					"/api/v1/auth/forgot-password/complete",
+					"/api/v1/auth/legal-documents",
 					"/api/v1/auth/login",
+					"/api/v1/auth/login/mfa",
+					"/api/v1/auth/login/oidc",
+					"/api/v1/auth/oauth/token",
 					"/api/v1/auth/refresh",
-					"/api/v1/auth/register":
+					"/api/v1/auth/register",
+					"/api/v1/auth/resend-verification",
+					"/api/v1/auth/verify-email":
 					return true
 				}
 				return false
 			},
-		})),
+		}), accountRateLimit),
 
 		// Your other endpoints, typically secured by bearer auth, available at /api/v1/**
-		APIV1Push: s.Echo.Group("/api/v1/push", middleware.Auth(s)),
+		// Rate limited per user, thus the rate limit middleware is applied after the auth middleware
+		// Users who have not verified their email address yet or have not accepted the latest version of all mandatory
+		// legal documents are rejected if required by the server's config
+		// Machine clients may authenticate using API keys (`Authorization: ApiKey <key>`) instead
+		APIV1Push: s.Echo.Group("/api/v1/push", middleware.APIKeyAuth(s), middleware.AuthWithConfig(middleware.AuthConfig{
+			S:                      s,
+			Scopes:                 middleware.DefaultAuthConfig.Scopes,
+			RequireVerifiedEmail:   s.Config.Auth.RequireVerifiedEmail,
+			RequireLegalAcceptance: s.Config.Auth.RequireLegalAcceptance,
+		}), pushRateLimit),
+
+		// Administrative endpoints, uncacheable, secured by bearer auth requiring the cms scope, available at /api/v1/admin/**
+		APIV1Admin: s.Echo.Group("/api/v1/admin", middleware.AuthWithConfig(middleware.AuthConfig{
+			S:      s,
+			Scopes: []string{auth.AuthScopeCMS.String()},
+		}), middleware.NoCache()),
+
+		// Files uploaded by users, secured by bearer auth, cacheable by clients, available at /api/v1/files/**
+		APIV1Files: s.Echo.Group("/api/v1/files", middleware.AuthWithConfig(middleware.AuthConfig{
+			S:      s,
+			Scopes: middleware.DefaultAuthConfig.Scopes,
+		})),
+
+		// Resumable uploads of files by users, secured by bearer auth, available at /api/v1/uploads/**
+		// Rate limited per user, thus the rate limit middleware is applied after the auth middleware
+		APIV1Uploads: s.Echo.Group("/api/v1/uploads", middleware.AuthWithConfig(middleware.AuthConfig{
+			S:      s,
+			Scopes: middleware.DefaultAuthConfig.Scopes,
+		}), uploadsRateLimit),
 	}
 
 	// ---
 	// Finally attach our handlers
 	handlers.AttachAllRoutes(s)
 }
+
+// isCredentialPath reports whether the given route path is one of the auth group's credential endpoints (login,
+// registration, password reset, token refresh and exchange), which are rate limited per IP.
+func isCredentialPath(path string) bool {
+	switch path {
+	case "/api/v1/auth/forgot-password",
+		"/api/v1/auth/login",
+		"/api/v1/auth/login/mfa",
+		"/api/v1/auth/oauth/token",
+		"/api/v1/auth/refresh",
+		"/api/v1/auth/register":
+		return true
+	}
+
+	return false
+}
@@ -1,11 +1,17 @@
 package router_test
 
 import (
+	"net/http"
+	"strconv"
 	"testing"
 
 	"allaboutapps.dev/aw/go-starter/internal/api"
+	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
+	"allaboutapps.dev/aw/go-starter/internal/api/middleware"
 	"allaboutapps.dev/aw/go-starter/internal/config"
 	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -68,3 +74,128 @@ func TestMiddlewaresDisabled(t *testing.T) {
 		require.Equal(t, 200, res.Result().StatusCode)
 	})
 }
+
+func TestRateLimitAuthGroup(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.RateLimit.Enabled = true
+	config.RateLimit.Auth.Limit = 2
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		payload := test.GenericPayload{
+			"username": fixtures.User1.Username,
+			"password": "not my password",
+		}
+
+		for i := 0; i < 2; i++ {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+			require.Equal(t, http.StatusUnauthorized, res.Result().StatusCode)
+			assert.Equal(t, "2", res.Header().Get(middleware.HeaderRateLimitLimit))
+		}
+
+		res := test.PerformRequest(t, s, "POST", "/api/v1/auth/login", payload, nil)
+		require.Equal(t, http.StatusTooManyRequests, res.Result().StatusCode)
+		assert.NotEmpty(t, res.Header().Get(echo.HeaderRetryAfter))
+
+		var response httperrors.HTTPError
+		test.ParseResponseAndValidate(t, res, &response)
+
+		assert.Equal(t, *middleware.ErrTooManyRequestsRateLimitExceeded.Type, *response.Type)
+
+		// the credential endpoints share the per IP limit
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/refresh", test.GenericPayload{"refresh_token": fixtures.User1RefreshToken1.Token}, nil)
+		require.Equal(t, http.StatusTooManyRequests, res.Result().StatusCode)
+
+		// authenticated endpoints of the auth group are limited per user instead
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, strconv.Itoa(config.RateLimit.Account.Limit), res.Header().Get(middleware.HeaderRateLimitLimit))
+
+		// readiness probes are never limited
+		res = test.PerformRequest(t, s, "GET", "/-/ready", nil, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Empty(t, res.Header().Get(middleware.HeaderRateLimitLimit))
+	})
+}
+
+func TestRateLimitAuthGroupPerUser(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.RateLimit.Enabled = true
+	config.RateLimit.Account.Limit = 2
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		for i := 0; i < 2; i++ {
+			res := test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+			require.Equal(t, http.StatusOK, res.Result().StatusCode)
+			assert.Equal(t, "2", res.Header().Get(middleware.HeaderRateLimitLimit))
+		}
+
+		res := test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		require.Equal(t, http.StatusTooManyRequests, res.Result().StatusCode)
+
+		// other users sharing the same IP are not affected
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+
+		// neither are the credential endpoints
+		res = test.PerformRequest(t, s, "POST", "/api/v1/auth/login", test.GenericPayload{
+			"username": fixtures.User1.Username,
+			"password": test.PlainTestUserPassword,
+		}, nil)
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, strconv.Itoa(config.RateLimit.Auth.Limit), res.Header().Get(middleware.HeaderRateLimitLimit))
+	})
+}
+
+func TestRateLimitUploadsGroup(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.RateLimit.Enabled = true
+	config.RateLimit.Account.Limit = 1
+	config.RateLimit.Uploads.Limit = 2
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		fixtures := test.Fixtures()
+
+		path := "/api/v1/uploads/f2b1c4a6-7d3e-4b8a-9c5f-1e2d3a4b5c6d"
+		for i := 0; i < 2; i++ {
+			res := test.PerformRequest(t, s, "GET", path, nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+			require.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+			assert.Equal(t, "2", res.Header().Get(middleware.HeaderRateLimitLimit))
+		}
+
+		res := test.PerformRequest(t, s, "GET", path, nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		require.Equal(t, http.StatusTooManyRequests, res.Result().StatusCode)
+
+		// other users sharing the same IP are not affected
+		res = test.PerformRequest(t, s, "GET", path, nil, test.HeadersWithAuth(t, fixtures.User2AccessToken1.Token))
+		require.Equal(t, http.StatusNotFound, res.Result().StatusCode)
+
+		// neither is the limit of the auth group
+		res = test.PerformRequest(t, s, "GET", "/api/v1/auth/userinfo", nil, test.HeadersWithAuth(t, fixtures.User1AccessToken1.Token))
+		require.Equal(t, http.StatusOK, res.Result().StatusCode)
+		assert.Equal(t, "1", res.Header().Get(middleware.HeaderRateLimitLimit))
+	})
+}
+
+func TestRateLimitDisabled(t *testing.T) {
+	config := config.DefaultServiceConfigFromEnv()
+	config.RateLimit.Enabled = false
+	config.RateLimit.Auth.Limit = 1
+
+	test.WithTestServerConfigurable(t, config, func(s *api.Server) {
+		assert.Nil(t, s.RateLimiter)
+
+		payload := test.GenericPayload{
+			"username": "unknown@example.com",
+		}
+
+		for i := 0; i < 2; i++ {
+			res := test.PerformRequest(t, s, "POST", "/api/v1/auth/resend-verification", payload, nil)
+			require.Equal(t, http.StatusNoContent, res.Result().StatusCode)
+			assert.Empty(t, res.Header().Get(middleware.HeaderRateLimitLimit))
+		}
+	})
+}
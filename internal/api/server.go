@@ -5,13 +5,21 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
+	"allaboutapps.dev/aw/go-starter/internal/api/auth"
 	"allaboutapps.dev/aw/go-starter/internal/config"
 	"allaboutapps.dev/aw/go-starter/internal/i18n"
+	"allaboutapps.dev/aw/go-starter/internal/lockout"
 	"allaboutapps.dev/aw/go-starter/internal/mailer"
 	"allaboutapps.dev/aw/go-starter/internal/mailer/transport"
+	"allaboutapps.dev/aw/go-starter/internal/oidc"
 	"allaboutapps.dev/aw/go-starter/internal/push"
 	"allaboutapps.dev/aw/go-starter/internal/push/provider"
+	"allaboutapps.dev/aw/go-starter/internal/ratelimit"
+	"allaboutapps.dev/aw/go-starter/internal/storage"
+	"allaboutapps.dev/aw/go-starter/internal/uploads"
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
 
@@ -20,32 +28,45 @@ import (
 )
 
 type Router struct {
-	Routes     []*echo.Route
-	Root       *echo.Group
-	Management *echo.Group
-	APIV1Auth  *echo.Group
-	APIV1Push  *echo.Group
+	Routes       []*echo.Route
+	Root         *echo.Group
+	Management   *echo.Group
+	APIV1Auth    *echo.Group
+	APIV1Push    *echo.Group
+	APIV1Admin   *echo.Group
+	APIV1Files   *echo.Group
+	APIV1Uploads *echo.Group
 }
 
 type Server struct {
-	Config config.Server
-	DB     *sql.DB
-	Echo   *echo.Echo
-	Router *Router
-	Mailer *mailer.Mailer
-	Push   *push.Service
-	I18n   *i18n.Service
+	Config      config.Server
+	DB          *sql.DB
+	Echo        *echo.Echo
+	Router      *Router
+	Mailer      *mailer.Mailer
+	Push        *push.Service
+	I18n        *i18n.Service
+	JWT         *auth.JWTService // only set if SERVER_AUTH_TOKEN_FORMAT is jwt
+	Lockout     *lockout.Service
+	OIDC        *oidc.Service
+	RateLimiter *ratelimit.Limiter // only set if SERVER_RATE_LIMIT_ENABLED is true
+	Blobstore   storage.Blobstore
 }
 
 func NewServer(config config.Server) *Server {
 	s := &Server{
-		Config: config,
-		DB:     nil,
-		Echo:   nil,
-		Router: nil,
-		Mailer: nil,
-		Push:   nil,
-		I18n:   nil,
+		Config:      config,
+		DB:          nil,
+		Echo:        nil,
+		Router:      nil,
+		Mailer:      nil,
+		Push:        nil,
+		I18n:        nil,
+		JWT:         nil,
+		Lockout:     nil,
+		OIDC:        nil,
+		RateLimiter: nil,
+		Blobstore:   nil,
 	}
 
 	return s
@@ -57,7 +78,12 @@ func (s *Server) Ready() bool {
 		s.Router != nil &&
 		s.Mailer != nil &&
 		s.Push != nil &&
-		s.I18n != nil
+		s.I18n != nil &&
+		s.Lockout != nil &&
+		s.OIDC != nil &&
+		s.Blobstore != nil &&
+		(s.JWT != nil || config.AuthTokenFormat(s.Config.Auth.TokenFormat) != config.AuthTokenFormatJWT) &&
+		(s.RateLimiter != nil || !s.Config.RateLimit.Enabled)
 }
 
 func (s *Server) InitDB(ctx context.Context) error {
@@ -99,8 +125,13 @@ func (s *Server) InitMailer() error {
 	return s.Mailer.ParseTemplates()
 }
 
+// InitPush initializes the push service, requires the i18n service to be initialized first.
 func (s *Server) InitPush() error {
-	s.Push = push.New(s.DB)
+	if s.I18n == nil {
+		return errors.New("i18n service must be initialized before the push service")
+	}
+
+	s.Push = push.New(s.DB, s.I18n, s.Config.Push.Outbox)
 
 	if s.Config.Push.UseFCMProvider {
 		fcmProvider, err := provider.NewFCM(s.Config.FCMConfig)
@@ -110,6 +141,14 @@ func (s *Server) InitPush() error {
 		s.Push.RegisterProvider(fcmProvider)
 	}
 
+	if s.Config.Push.UseAPNsProvider {
+		apnsProvider, err := provider.NewAPNs(s.Config.APNsConfig)
+		if err != nil {
+			return err
+		}
+		s.Push.RegisterProvider(apnsProvider)
+	}
+
 	if s.Config.Push.UseMockProvider {
 		log.Warn().Msg("Initializing mock push provider")
 		mockProvider := provider.NewMock(push.ProviderTypeFCM)
@@ -135,6 +174,225 @@ func (s *Server) InitI18n() error {
 	return nil
 }
 
+func (s *Server) InitJWT() error {
+	switch config.AuthTokenFormat(s.Config.Auth.TokenFormat) {
+	case config.AuthTokenFormatOpaque:
+		return nil
+	case config.AuthTokenFormatJWT:
+		jwtService, err := auth.NewJWTService(s.Config.Auth.JWT)
+		if err != nil {
+			return err
+		}
+
+		s.JWT = jwtService
+
+		return nil
+	default:
+		return fmt.Errorf("Unsupported auth token format: %s", s.Config.Auth.TokenFormat)
+	}
+}
+
+func (s *Server) InitLockout() error {
+	switch config.AuthLockoutStore(s.Config.Auth.Lockout.Store) {
+	case config.AuthLockoutStorePostgres:
+		s.Lockout = lockout.New(s.Config.Auth.Lockout, lockout.NewPostgresStore(s.DB))
+	case config.AuthLockoutStoreMemory:
+		log.Warn().Msg("Initializing in-memory auth lockout store")
+		s.Lockout = lockout.New(s.Config.Auth.Lockout, lockout.NewMemoryStore())
+	default:
+		return fmt.Errorf("Unsupported auth lockout store: %s", s.Config.Auth.Lockout.Store)
+	}
+
+	return nil
+}
+
+func (s *Server) InitOIDC() error {
+	s.OIDC = oidc.New(s.Config.Auth.OIDC, &http.Client{Timeout: s.Config.Auth.OIDC.HTTPTimeout})
+
+	if s.OIDC.GetProviderCount() < 1 {
+		log.Warn().Msg("No providers configured for OIDC login")
+	}
+
+	return nil
+}
+
+func (s *Server) InitRateLimiter() error {
+	if !s.Config.RateLimit.Enabled {
+		log.Warn().Msg("Disabling rate limiting due to environment config")
+		return nil
+	}
+
+	switch config.RateLimitStore(s.Config.RateLimit.Store) {
+	case config.RateLimitStorePostgres:
+		s.RateLimiter = ratelimit.New(ratelimit.NewPostgresStore(s.DB))
+	case config.RateLimitStoreMemory:
+		log.Warn().Msg("Initializing in-memory rate limit store")
+		s.RateLimiter = ratelimit.New(ratelimit.NewMemoryStore())
+	default:
+		return fmt.Errorf("Unsupported rate limit store: %s", s.Config.RateLimit.Store)
+	}
+
+	return nil
+}
+
+func (s *Server) InitBlobstore() error {
+	if config.StorageBackend(s.Config.Storage.Backend) == config.StorageBackendFilesystem {
+		log.Warn().Msg("Initializing filesystem blobstore, blobs are not shared between replicas unless the base directory is mounted on all of them")
+	}
+
+	blobstore, err := storage.New(s.Config.Storage)
+	if err != nil {
+		return err
+	}
+
+	s.Blobstore = blobstore
+
+	return nil
+}
+
+// PurgeAuthRecords periodically purges users whose account deletion grace period has passed, refresh tokens
+// rotated or revoked longer than their retention period ago and expired failed auth attempts until ctx is done.
+// Purging is idempotent, so this is safe to run on multiple replicas concurrently.
+func (s *Server) PurgeAuthRecords(ctx context.Context) {
+	if s.Config.Auth.PurgeInterval <= 0 {
+		log.Debug().Msg("Auth purge interval not set, not purging auth records")
+		return
+	}
+
+	ticker := time.NewTicker(s.Config.Auth.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.purgeAuthRecords(ctx)
+		}
+	}
+}
+
+func (s *Server) purgeAuthRecords(ctx context.Context) {
+	if s.Config.Auth.AccountDeletion.GracePeriod > 0 {
+		purged, err := auth.PurgeDeletedUsers(ctx, s.DB, s.Config.Auth.AccountDeletion.GracePeriod)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to purge deleted users")
+		} else if purged > 0 {
+			log.Info().Int64("purged", purged).Msg("Purged deleted users")
+		}
+	}
+
+	if s.Config.Auth.RefreshTokenRetention > 0 {
+		purged, err := auth.PurgeRefreshTokens(ctx, s.DB, s.Config.Auth.RefreshTokenRetention)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to purge refresh tokens")
+		} else if purged > 0 {
+			log.Info().Int64("purged", purged).Msg("Purged refresh tokens")
+		}
+	}
+
+	purged, err := s.Lockout.Purge(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to purge auth attempts")
+	} else if purged > 0 {
+		log.Info().Int64("purged", purged).Msg("Purged auth attempts")
+	}
+}
+
+// PurgeStaleUploads periodically purges incomplete uploads which have not received any chunks within their
+// expiry, including their partial files, until ctx is done. Purging is idempotent, so this is safe to run on
+// multiple replicas concurrently.
+func (s *Server) PurgeStaleUploads(ctx context.Context) {
+	if s.Config.Uploads.ExpiresAfter <= 0 || s.Config.Uploads.PurgeInterval <= 0 {
+		log.Debug().Msg("Upload expiry or purge interval not set, not purging stale uploads")
+		return
+	}
+
+	ticker := time.NewTicker(s.Config.Uploads.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := uploads.PurgeStale(ctx, s.DB, s.Config.Paths.MntBaseDirAbs, s.Config.Uploads.ExpiresAfter)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to purge stale uploads")
+				continue
+			}
+
+			if purged > 0 {
+				log.Info().Int64("purged", purged).Msg("Purged stale uploads")
+			}
+		}
+	}
+}
+
+// PurgeRateLimitCounters periodically purges rate limit counters which no longer affect any limit until ctx is done.
+// Purging is idempotent, so this is safe to run on multiple replicas concurrently.
+func (s *Server) PurgeRateLimitCounters(ctx context.Context) {
+	if s.RateLimiter == nil || s.Config.RateLimit.PurgeInterval <= 0 {
+		log.Debug().Msg("Rate limiting disabled or purge interval not set, not purging rate limit counters")
+		return
+	}
+
+	ticker := time.NewTicker(s.Config.RateLimit.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.RateLimiter.Purge(ctx, time.Now(), s.Config.RateLimit.MaxPeriod())
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to purge rate limit counters")
+				continue
+			}
+
+			if purged > 0 {
+				log.Debug().Int64("purged", purged).Msg("Purged rate limit counters")
+			}
+		}
+	}
+}
+
+// RunPushWorkers delivers enqueued push messages until ctx is done. Deliveries are claimed using row locks,
+// so this is safe to run on multiple replicas concurrently.
+func (s *Server) RunPushWorkers(ctx context.Context) {
+	s.Push.RunWorkers(ctx)
+}
+
+// PurgePushMessages periodically purges push messages whose retention period has passed and which have no pending
+// deliveries left until ctx is done. Purging is idempotent, so this is safe to run on multiple replicas concurrently.
+func (s *Server) PurgePushMessages(ctx context.Context) {
+	if s.Config.Push.Outbox.Retention <= 0 || s.Config.Push.Outbox.PurgeInterval <= 0 {
+		log.Debug().Msg("Push message retention or purge interval not set, not purging push messages")
+		return
+	}
+
+	ticker := time.NewTicker(s.Config.Push.Outbox.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.Push.PurgeMessages(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("Failed to purge push messages")
+				continue
+			}
+
+			if purged > 0 {
+				log.Info().Int64("purged", purged).Msg("Purged push messages")
+			}
+		}
+	}
+}
+
 func (s *Server) Start() error {
 	if !s.Ready() {
 		return errors.New("server is not ready")
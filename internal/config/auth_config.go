@@ -0,0 +1,123 @@
+package config
+
+import "time"
+
+type AuthTokenFormat string
+
+var (
+	// AuthTokenFormatOpaque issues random UUID4 access tokens, which are looked up in the database on every request
+	AuthTokenFormatOpaque AuthTokenFormat = "opaque"
+	// AuthTokenFormatJWT issues short-lived signed JWT access tokens. Unless CheckRevocation is disabled, their user and
+	// session are still looked up on every request, so revoking sessions and deactivating users takes effect immediately.
+	AuthTokenFormatJWT AuthTokenFormat = "jwt"
+)
+
+func (f AuthTokenFormat) String() string {
+	return string(f)
+}
+
+type AuthJWTSigningMethod string
+
+var (
+	AuthJWTSigningMethodHS256 AuthJWTSigningMethod = "HS256"
+	AuthJWTSigningMethodEdDSA AuthJWTSigningMethod = "EdDSA"
+)
+
+func (m AuthJWTSigningMethod) String() string {
+	return string(m)
+}
+
+type AuthServerJWT struct {
+	SigningMethod string
+	// ID of the key (`kid` header) used to sign newly issued tokens, must be present in Keys
+	SigningKeyID string
+	// Base64 encoded keys by their ID, holding the shared secret (HS256) or the private key seed (EdDSA).
+	// Keep retired keys around until all tokens signed by them have expired to allow for seamless key rotation.
+	Keys                map[string]string `json:"-"` // sensitive
+	Issuer              string
+	AccessTokenValidity time.Duration
+	// Rejects JWTs of deactivated or deleted users and revoked sessions by looking up the user (and session) by its
+	// primary key on every request. If disabled, JWTs are verified without accessing the database and revoking
+	// them only takes effect once they expire, so keep AccessTokenValidity short.
+	CheckRevocation bool
+}
+
+type AuthLockoutStore string
+
+var (
+	// AuthLockoutStorePostgres stores failed attempts in the database, sharing them between all replicas
+	AuthLockoutStorePostgres AuthLockoutStore = "postgres"
+	// AuthLockoutStoreMemory stores failed attempts in memory, only suitable for single instances and tests
+	AuthLockoutStoreMemory AuthLockoutStore = "memory"
+)
+
+func (s AuthLockoutStore) String() string {
+	return string(s)
+}
+
+type AuthServerLockoutPolicy struct {
+	// Number of failures tolerated before delays are imposed
+	FreeAttempts int
+	// Number of failures after which further attempts are locked for the lock duration
+	MaxAttempts int
+}
+
+type AuthServerLockout struct {
+	Enabled bool
+	Store   string
+	// Failures are forgotten once the last failure is older than the window
+	Window time.Duration
+	// Delay imposed after exceeding the free attempts, doubling with every further failure up to MaxDelay
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	LockDuration time.Duration
+	Username     AuthServerLockoutPolicy
+	IP           AuthServerLockoutPolicy
+}
+
+type AuthServerOIDCProvider struct {
+	// Name of the provider, used for logging only
+	Name string
+	// Issuer URL, its OpenID Connect discovery document is expected at <Issuer>/.well-known/openid-configuration
+	Issuer string
+	// Additional values accepted as the ID token's `iss` claim, e.g. Google's issuer without scheme
+	IssuerAliases []string
+	// Client IDs of our apps registered with the provider, accepted as the ID token's `aud` claim.
+	// The provider is disabled if no client IDs are configured.
+	ClientIDs []string
+}
+
+func (p AuthServerOIDCProvider) Enabled() bool {
+	return len(p.ClientIDs) > 0
+}
+
+type AuthServerOIDC struct {
+	Google  AuthServerOIDCProvider
+	Apple   AuthServerOIDCProvider
+	Generic AuthServerOIDCProvider
+	// Time provider keys are cached for before being fetched again
+	JWKSCacheTTL time.Duration
+	HTTPTimeout  time.Duration
+}
+
+// Providers returns all enabled providers.
+func (c AuthServerOIDC) Providers() []AuthServerOIDCProvider {
+	providers := make([]AuthServerOIDCProvider, 0, 3)
+	for _, p := range []AuthServerOIDCProvider{c.Google, c.Apple, c.Generic} {
+		if p.Enabled() {
+			providers = append(providers, p)
+		}
+	}
+
+	return providers
+}
+
+type AuthServerOAuth struct {
+	// Time authorization codes may be exchanged for tokens within
+	AuthorizationCodeValidity time.Duration
+}
+
+type AuthServerAccountDeletion struct {
+	// Time deleted accounts are kept (deactivated) before being purged, 0 deletes accounts immediately
+	GracePeriod time.Duration
+}
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/rs/zerolog/log"
+)
+
+type ImageVariant struct {
+	// Variants are scaled down to fit within these bounds preserving the aspect ratio, 0 leaves a dimension unconstrained
+	MaxWidth  int
+	MaxHeight int
+}
+
+type ImagesServer struct {
+	// Named variants (e.g. thumbnails) of uploaded images, generated on first request and cached below MntBaseDirAbs
+	Variants    map[string]ImageVariant
+	JPEGQuality int
+	// Images exceeding this number of pixels are not processed, protecting against decompression bombs
+	MaxPixels int
+	// Max age completed uploads and their variants may be cached by clients for
+	CacheMaxAge time.Duration
+}
+
+// getEnvAsImageVariants reads ENV formatted as "<name>:<max width>x<max height>,..." (e.g. "thumb:256x256,wide:1024x0"),
+// skipping invalid variants.
+func getEnvAsImageVariants(key string, defaultVal map[string]ImageVariant) map[string]ImageVariant {
+	pairs := util.GetEnvAsStringMap(key, nil)
+	if pairs == nil {
+		return defaultVal
+	}
+
+	res := make(map[string]ImageVariant, len(pairs))
+	for name, size := range pairs {
+		variant, err := parseImageVariant(size)
+		if err != nil {
+			log.Error().Err(err).Str("key", key).Str("variant", name).Msg("Invalid image variant, skipping.")
+			continue
+		}
+
+		res[name] = variant
+	}
+
+	return res
+}
+
+func parseImageVariant(size string) (ImageVariant, error) {
+	w, h, ok := strings.Cut(strings.ToLower(size), "x")
+	if !ok {
+		return ImageVariant{}, fmt.Errorf("invalid size %q, expected <max width>x<max height>", size)
+	}
+
+	maxWidth, err := strconv.Atoi(w)
+	if err != nil || maxWidth < 0 {
+		return ImageVariant{}, fmt.Errorf("invalid max width %q", w)
+	}
+
+	maxHeight, err := strconv.Atoi(h)
+	if err != nil || maxHeight < 0 {
+		return ImageVariant{}, fmt.Errorf("invalid max height %q", h)
+	}
+
+	if maxWidth == 0 && maxHeight == 0 {
+		return ImageVariant{}, fmt.Errorf("invalid size %q, at least one dimension must be constrained", size)
+	}
+
+	return ImageVariant{MaxWidth: maxWidth, MaxHeight: maxHeight}, nil
+}
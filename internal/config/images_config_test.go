@@ -0,0 +1,20 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEnvAsImageVariants(t *testing.T) {
+	defaultVal := map[string]ImageVariant{"thumb": {MaxWidth: 256, MaxHeight: 256}}
+
+	assert.Equal(t, defaultVal, getEnvAsImageVariants("IMAGES_CONFIG_TEST_VARIANTS", defaultVal))
+
+	t.Setenv("IMAGES_CONFIG_TEST_VARIANTS", "small:128x128, wide:1024x0,invalid:12,none:0x0,negative:-1x10,TALL:0X800")
+	assert.Equal(t, map[string]ImageVariant{
+		"small": {MaxWidth: 128, MaxHeight: 128},
+		"wide":  {MaxWidth: 1024, MaxHeight: 0},
+		"TALL":  {MaxWidth: 0, MaxHeight: 800},
+	}, getEnvAsImageVariants("IMAGES_CONFIG_TEST_VARIANTS", defaultVal))
+}
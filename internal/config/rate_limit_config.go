@@ -0,0 +1,52 @@
+package config
+
+import "time"
+
+type RateLimitStore string
+
+var (
+	// RateLimitStorePostgres stores request counters in the database, sharing them between all replicas
+	RateLimitStorePostgres RateLimitStore = "postgres"
+	// RateLimitStoreMemory stores request counters in memory, only suitable for single instances and tests
+	RateLimitStoreMemory RateLimitStore = "memory"
+)
+
+func (s RateLimitStore) String() string {
+	return string(s)
+}
+
+type RateLimitPolicy struct {
+	// Number of requests allowed within the period
+	Limit int
+	// Length of the sliding window the limit applies to
+	Period time.Duration
+}
+
+type RateLimitServer struct {
+	Enabled bool
+	Store   string
+	// Applied per IP to the credential endpoints of the auth group (login, register, refresh, ...)
+	Auth RateLimitPolicy
+	// Applied per user to the remaining endpoints of the auth group (per IP for unauthenticated requests)
+	Account RateLimitPolicy
+	// Applied per user to the push group
+	Push RateLimitPolicy
+	// Applied per user to the uploads group
+	Uploads RateLimitPolicy
+	// Applied per IP to the management group
+	Management RateLimitPolicy
+	// Interval in which counters no longer affecting any limit are purged in the background, 0 disables purging
+	PurgeInterval time.Duration
+}
+
+// MaxPeriod returns the longest period of all policies.
+func (c RateLimitServer) MaxPeriod() time.Duration {
+	var maxPeriod time.Duration
+	for _, p := range []RateLimitPolicy{c.Auth, c.Account, c.Push, c.Uploads, c.Management} {
+		if p.Period > maxPeriod {
+			maxPeriod = p.Period
+		}
+	}
+
+	return maxPeriod
+}
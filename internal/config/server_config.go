@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"allaboutapps.dev/aw/go-starter/internal/mailer/transport"
+	"allaboutapps.dev/aw/go-starter/internal/push"
 	"allaboutapps.dev/aw/go-starter/internal/push/provider"
 	"allaboutapps.dev/aw/go-starter/internal/util"
 	"github.com/rs/zerolog"
@@ -50,10 +51,40 @@ type EchoServerSecureMiddleware struct {
 }
 
 type AuthServer struct {
-	AccessTokenValidity          time.Duration
-	PasswordResetTokenValidity   time.Duration
-	DefaultUserScopes            []string
-	LastAuthenticatedAtThreshold time.Duration
+	AccessTokenValidity            time.Duration
+	PasswordResetTokenValidity     time.Duration
+	DefaultUserScopes              []string
+	LastAuthenticatedAtThreshold   time.Duration
+	TokenFormat                    string
+	JWT                            AuthServerJWT
+	MFAChallengeValidity           time.Duration
+	TOTPIssuer                     string
+	EmailVerificationTokenValidity time.Duration
+	EmailChangeTokenValidity       time.Duration
+	EmailChangeRevertValidity      time.Duration
+	RequireVerifiedEmail           bool
+	RequireLegalAcceptance         bool
+	Lockout                        AuthServerLockout
+	OIDC                           AuthServerOIDC
+	OAuth                          AuthServerOAuth
+	AccountDeletion                AuthServerAccountDeletion
+	// Rotated and revoked refresh tokens are kept for reuse detection for this long before being purged, 0 keeps them
+	RefreshTokenRetention time.Duration
+	// Interval in which expired auth records (deleted accounts, refresh tokens, failed attempts) are purged in the background, 0 disables purging
+	PurgeInterval time.Duration
+}
+
+type ProfileServer struct {
+	AvatarMaxFileSize int64
+}
+
+type UploadsServer struct {
+	MaxFileSize      int64
+	MaxChunkSize     int64
+	AllowedMIMETypes []string
+	// Incomplete uploads not receiving any chunks within this duration are purged, including their partial files
+	ExpiresAfter  time.Duration
+	PurgeInterval time.Duration
 }
 
 type PathsServer struct {
@@ -70,8 +101,12 @@ type ManagementServer struct {
 }
 
 type FrontendServer struct {
-	BaseURL               string
-	PasswordResetEndpoint string
+	BaseURL                   string
+	PasswordResetEndpoint     string
+	EmailVerificationEndpoint string
+	EmailChangeEndpoint       string
+	EmailChangeRevertEndpoint string
+	OAuthAuthorizeEndpoint    string
 }
 
 type LoggerServer struct {
@@ -97,6 +132,10 @@ type Server struct {
 	Pprof      PprofServer
 	Paths      PathsServer
 	Auth       AuthServer
+	Profile    ProfileServer
+	Uploads    UploadsServer
+	Images     ImagesServer
+	Storage    Storage
 	Management ManagementServer
 	Mailer     Mailer
 	SMTP       transport.SMTPMailTransportConfig
@@ -104,7 +143,9 @@ type Server struct {
 	Logger     LoggerServer
 	Push       PushService
 	FCMConfig  provider.FCMConfig
+	APNsConfig provider.APNsConfig
 	I18n       I18n
+	RateLimit  RateLimitServer
 }
 
 // DefaultServiceConfigFromEnv returns the server config as parsed from environment variables
@@ -184,6 +225,103 @@ func DefaultServiceConfigFromEnv() Server {
 			PasswordResetTokenValidity:   time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_PASSWORD_RESET_TOKEN_VALIDITY", 900)),
 			DefaultUserScopes:            util.GetEnvAsStringArr("SERVER_AUTH_DEFAULT_USER_SCOPES", []string{"app"}),
 			LastAuthenticatedAtThreshold: time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_LAST_AUTHENTICATED_AT_THRESHOLD", 900)),
+			TokenFormat:                  util.GetEnvEnum("SERVER_AUTH_TOKEN_FORMAT", AuthTokenFormatOpaque.String(), []string{AuthTokenFormatOpaque.String(), AuthTokenFormatJWT.String()}),
+			JWT: AuthServerJWT{
+				SigningMethod:       util.GetEnvEnum("SERVER_AUTH_JWT_SIGNING_METHOD", AuthJWTSigningMethodHS256.String(), []string{AuthJWTSigningMethodHS256.String(), AuthJWTSigningMethodEdDSA.String()}),
+				SigningKeyID:        util.GetEnv("SERVER_AUTH_JWT_SIGNING_KEY_ID", ""),
+				Keys:                util.GetEnvAsStringMap("SERVER_AUTH_JWT_KEYS", map[string]string{}),
+				Issuer:              util.GetEnv("SERVER_AUTH_JWT_ISSUER", "go-starter"),
+				AccessTokenValidity: time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_JWT_ACCESS_TOKEN_VALIDITY", 900)),
+				CheckRevocation:     util.GetEnvAsBool("SERVER_AUTH_JWT_CHECK_REVOCATION", true),
+			},
+			MFAChallengeValidity:           time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_MFA_CHALLENGE_VALIDITY", 300)),
+			TOTPIssuer:                     util.GetEnv("SERVER_AUTH_TOTP_ISSUER", "go-starter"),
+			EmailVerificationTokenValidity: time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_EMAIL_VERIFICATION_TOKEN_VALIDITY", 86400)),
+			EmailChangeTokenValidity:       time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_EMAIL_CHANGE_TOKEN_VALIDITY", 86400)),
+			EmailChangeRevertValidity:      time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_EMAIL_CHANGE_REVERT_VALIDITY", 604800)),
+			RequireVerifiedEmail:           util.GetEnvAsBool("SERVER_AUTH_REQUIRE_VERIFIED_EMAIL", false),
+			RequireLegalAcceptance:         util.GetEnvAsBool("SERVER_AUTH_REQUIRE_LEGAL_ACCEPTANCE", false),
+			Lockout: AuthServerLockout{
+				Enabled:      util.GetEnvAsBool("SERVER_AUTH_LOCKOUT_ENABLED", true),
+				Store:        util.GetEnvEnum("SERVER_AUTH_LOCKOUT_STORE", AuthLockoutStorePostgres.String(), []string{AuthLockoutStorePostgres.String(), AuthLockoutStoreMemory.String()}),
+				Window:       time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_LOCKOUT_WINDOW", 3600)),
+				BaseDelay:    time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_LOCKOUT_BASE_DELAY", 1)),
+				MaxDelay:     time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_LOCKOUT_MAX_DELAY", 60)),
+				LockDuration: time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_LOCKOUT_LOCK_DURATION", 900)),
+				Username: AuthServerLockoutPolicy{
+					FreeAttempts: util.GetEnvAsInt("SERVER_AUTH_LOCKOUT_USERNAME_FREE_ATTEMPTS", 3),
+					MaxAttempts:  util.GetEnvAsInt("SERVER_AUTH_LOCKOUT_USERNAME_MAX_ATTEMPTS", 10),
+				},
+				IP: AuthServerLockoutPolicy{
+					FreeAttempts: util.GetEnvAsInt("SERVER_AUTH_LOCKOUT_IP_FREE_ATTEMPTS", 20),
+					MaxAttempts:  util.GetEnvAsInt("SERVER_AUTH_LOCKOUT_IP_MAX_ATTEMPTS", 100),
+				},
+			},
+			OIDC: AuthServerOIDC{
+				Google: AuthServerOIDCProvider{
+					Name:          "google",
+					Issuer:        "https://accounts.google.com",
+					IssuerAliases: []string{"accounts.google.com"},
+					ClientIDs:     util.GetEnvAsStringArrTrimmed("SERVER_AUTH_OIDC_GOOGLE_CLIENT_IDS", []string{}),
+				},
+				Apple: AuthServerOIDCProvider{
+					Name:      "apple",
+					Issuer:    "https://appleid.apple.com",
+					ClientIDs: util.GetEnvAsStringArrTrimmed("SERVER_AUTH_OIDC_APPLE_CLIENT_IDS", []string{}),
+				},
+				Generic: AuthServerOIDCProvider{
+					Name:      util.GetEnv("SERVER_AUTH_OIDC_GENERIC_NAME", "oidc"),
+					Issuer:    util.GetEnv("SERVER_AUTH_OIDC_GENERIC_ISSUER", ""),
+					ClientIDs: util.GetEnvAsStringArrTrimmed("SERVER_AUTH_OIDC_GENERIC_CLIENT_IDS", []string{}),
+				},
+				JWKSCacheTTL: time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_OIDC_JWKS_CACHE_TTL", 3600)),
+				HTTPTimeout:  time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_OIDC_HTTP_TIMEOUT", 10)),
+			},
+			OAuth: AuthServerOAuth{
+				AuthorizationCodeValidity: time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_OAUTH_AUTHORIZATION_CODE_VALIDITY", 60)),
+			},
+			AccountDeletion: AuthServerAccountDeletion{
+				GracePeriod: time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_ACCOUNT_DELETION_GRACE_PERIOD", 0)),
+			},
+			RefreshTokenRetention: time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_REFRESH_TOKEN_RETENTION", 2592000)), // 30 days
+			PurgeInterval:         time.Second * time.Duration(util.GetEnvAsInt("SERVER_AUTH_PURGE_INTERVAL", 3600)),
+		},
+		Profile: ProfileServer{
+			AvatarMaxFileSize: int64(util.GetEnvAsInt("SERVER_PROFILE_AVATAR_MAX_FILE_SIZE", 5242880)), // 5 MiB
+		},
+		Uploads: UploadsServer{
+			MaxFileSize:      int64(util.GetEnvAsInt("SERVER_UPLOADS_MAX_FILE_SIZE", 104857600)), // 100 MiB
+			MaxChunkSize:     int64(util.GetEnvAsInt("SERVER_UPLOADS_MAX_CHUNK_SIZE", 8388608)),  // 8 MiB
+			AllowedMIMETypes: util.GetEnvAsStringArr("SERVER_UPLOADS_ALLOWED_MIME_TYPES", []string{"image/jpeg", "image/png", "image/webp", "application/pdf", "video/mp4"}),
+			ExpiresAfter:     time.Second * time.Duration(util.GetEnvAsInt("SERVER_UPLOADS_EXPIRES_AFTER", 86400)),
+			PurgeInterval:    time.Second * time.Duration(util.GetEnvAsInt("SERVER_UPLOADS_PURGE_INTERVAL", 3600)),
+		},
+		Images: ImagesServer{
+			Variants: getEnvAsImageVariants("SERVER_IMAGES_VARIANTS", map[string]ImageVariant{
+				"thumb":  {MaxWidth: 256, MaxHeight: 256},
+				"medium": {MaxWidth: 1024, MaxHeight: 1024},
+			}),
+			JPEGQuality: util.GetEnvAsInt("SERVER_IMAGES_JPEG_QUALITY", 85),
+			MaxPixels:   util.GetEnvAsInt("SERVER_IMAGES_MAX_PIXELS", 50000000), // 50 MP
+			CacheMaxAge: time.Second * time.Duration(util.GetEnvAsInt("SERVER_IMAGES_CACHE_MAX_AGE", 86400)),
+		},
+		Storage: Storage{
+			Backend:           util.GetEnvEnum("SERVER_STORAGE_BACKEND", StorageBackendFilesystem.String(), []string{StorageBackendFilesystem.String(), StorageBackendS3.String()}),
+			SignedURLValidity: time.Second * time.Duration(util.GetEnvAsInt("SERVER_STORAGE_SIGNED_URL_VALIDITY", 900)),
+			Filesystem: StorageFilesystem{
+				BaseDirAbs:    util.GetEnv("SERVER_STORAGE_FILESYSTEM_BASE_DIR_ABS", filepath.Join(util.GetProjectRootDir(), "/assets/mnt")), // /app/assets/mnt (user-generated content)
+				SignedURLBase: util.GetEnv("SERVER_STORAGE_FILESYSTEM_SIGNED_URL_BASE", "http://localhost:8080/api/v1/storage"),
+				SigningKey:    util.GetEnv("SERVER_STORAGE_FILESYSTEM_SIGNING_KEY", ""),
+			},
+			S3: StorageS3{
+				Endpoint:        util.GetEnv("SERVER_STORAGE_S3_ENDPOINT", "http://minio:9000"),
+				Region:          util.GetEnv("SERVER_STORAGE_S3_REGION", "us-east-1"),
+				Bucket:          util.GetEnv("SERVER_STORAGE_S3_BUCKET", "go-starter"),
+				AccessKeyID:     util.GetEnv("SERVER_STORAGE_S3_ACCESS_KEY_ID", ""),
+				SecretAccessKey: util.GetEnv("SERVER_STORAGE_S3_SECRET_ACCESS_KEY", ""),
+				UsePathStyle:    util.GetEnvAsBool("SERVER_STORAGE_S3_USE_PATH_STYLE", true),
+				HTTPTimeout:     time.Second * time.Duration(util.GetEnvAsInt("SERVER_STORAGE_S3_HTTP_TIMEOUT", 30)),
+			},
 		},
 		Management: ManagementServer{
 			Secret:           util.GetMgmtSecret("SERVER_MANAGEMENT_SECRET"),
@@ -209,8 +347,12 @@ func DefaultServiceConfigFromEnv() Server {
 			TLSConfig: nil,
 		},
 		Frontend: FrontendServer{
-			BaseURL:               util.GetEnv("SERVER_FRONTEND_BASE_URL", "http://localhost:3000"),
-			PasswordResetEndpoint: util.GetEnv("SERVER_FRONTEND_PASSWORD_RESET_ENDPOINT", "/set-new-password"),
+			BaseURL:                   util.GetEnv("SERVER_FRONTEND_BASE_URL", "http://localhost:3000"),
+			PasswordResetEndpoint:     util.GetEnv("SERVER_FRONTEND_PASSWORD_RESET_ENDPOINT", "/set-new-password"),
+			EmailVerificationEndpoint: util.GetEnv("SERVER_FRONTEND_EMAIL_VERIFICATION_ENDPOINT", "/verify-email"),
+			EmailChangeEndpoint:       util.GetEnv("SERVER_FRONTEND_EMAIL_CHANGE_ENDPOINT", "/change-email"),
+			EmailChangeRevertEndpoint: util.GetEnv("SERVER_FRONTEND_EMAIL_CHANGE_REVERT_ENDPOINT", "/change-email/revert"),
+			OAuthAuthorizeEndpoint:    util.GetEnv("SERVER_FRONTEND_OAUTH_AUTHORIZE_ENDPOINT", "/oauth/authorize"),
 		},
 		Logger: LoggerServer{
 			Level:              util.LogLevelFromString(util.GetEnv("SERVER_LOGGER_LEVEL", zerolog.DebugLevel.String())),
@@ -225,17 +367,69 @@ func DefaultServiceConfigFromEnv() Server {
 		},
 		Push: PushService{
 			UseFCMProvider:  util.GetEnvAsBool("SERVER_PUSH_USE_FCM", false),
+			UseAPNsProvider: util.GetEnvAsBool("SERVER_PUSH_USE_APNS", false),
 			UseMockProvider: util.GetEnvAsBool("SERVER_PUSH_USE_MOCK", true),
+			Outbox: push.OutboxConfig{
+				Workers:             util.GetEnvAsInt("SERVER_PUSH_WORKERS", 4),
+				BatchSize:           util.GetEnvAsInt("SERVER_PUSH_BATCH_SIZE", 100),
+				PollInterval:        time.Second * time.Duration(util.GetEnvAsInt("SERVER_PUSH_POLL_INTERVAL", 1)),
+				MaxAttempts:         util.GetEnvAsInt("SERVER_PUSH_MAX_ATTEMPTS", 5),
+				RetryBaseDelay:      time.Second * time.Duration(util.GetEnvAsInt("SERVER_PUSH_RETRY_BASE_DELAY", 10)),
+				RetryMaxDelay:       time.Second * time.Duration(util.GetEnvAsInt("SERVER_PUSH_RETRY_MAX_DELAY", 3600)),
+				LeaseDuration:       time.Second * time.Duration(util.GetEnvAsInt("SERVER_PUSH_LEASE_DURATION", 300)),
+				Retention:           time.Second * time.Duration(util.GetEnvAsInt("SERVER_PUSH_RETENTION", 604800)),
+				PurgeInterval:       time.Second * time.Duration(util.GetEnvAsInt("SERVER_PUSH_PURGE_INTERVAL", 3600)),
+				BroadcastPageSize:   util.GetEnvAsInt("SERVER_PUSH_BROADCAST_PAGE_SIZE", 1000),
+				SegmentQueryTimeout: time.Second * time.Duration(util.GetEnvAsInt("SERVER_PUSH_SEGMENT_QUERY_TIMEOUT", 30)),
+			},
 		},
 		FCMConfig: provider.FCMConfig{
 			GoogleApplicationCredentials: util.GetEnv("GOOGLE_APPLICATION_CREDENTIALS", ""),
 			ProjectID:                    util.GetEnv("SERVER_FCM_PROJECT_ID", "no-fcm-project-id-set"),
 			ValidateOnly:                 util.GetEnvAsBool("SERVER_FCM_VALIDATE_ONLY", true),
+			Workers:                      util.GetEnvAsInt("SERVER_FCM_WORKERS", 10),
+			QPS:                          util.GetEnvAsInt("SERVER_FCM_QPS", 500),
+		},
+		APNsConfig: provider.APNsConfig{
+			AuthKey:     util.GetEnv("SERVER_APNS_AUTH_KEY", ""),
+			AuthKeyFile: util.GetEnv("SERVER_APNS_AUTH_KEY_FILE", ""),
+			KeyID:       util.GetEnv("SERVER_APNS_KEY_ID", ""),
+			TeamID:      util.GetEnv("SERVER_APNS_TEAM_ID", ""),
+			Topic:       util.GetEnv("SERVER_APNS_TOPIC", ""),
+			Production:  util.GetEnvAsBool("SERVER_APNS_PRODUCTION", false),
+			Priority:    util.GetEnvAsInt("SERVER_APNS_PRIORITY", provider.APNsPriorityImmediate),
+			Endpoint:    util.GetEnv("SERVER_APNS_ENDPOINT", ""),
+			Timeout:     time.Second * time.Duration(util.GetEnvAsInt("SERVER_APNS_TIMEOUT_SEC", 30)),
 		},
 		I18n: I18n{
 			DefaultLanguage: util.GetEnvAsLanguageTag("SERVER_I18N_DEFAULT_LANGUAGE", language.English),
 			BundleDirAbs:    util.GetEnv("SERVER_I18N_BUNDLE_DIR_ABS", filepath.Join(util.GetProjectRootDir(), "/web/i18n")), // /app/web/i18n
 		},
+		RateLimit: RateLimitServer{
+			Enabled: util.GetEnvAsBool("SERVER_RATE_LIMIT_ENABLED", true),
+			Store:   util.GetEnvEnum("SERVER_RATE_LIMIT_STORE", RateLimitStorePostgres.String(), []string{RateLimitStorePostgres.String(), RateLimitStoreMemory.String()}),
+			Auth: RateLimitPolicy{
+				Limit:  util.GetEnvAsInt("SERVER_RATE_LIMIT_AUTH_LIMIT", 60),
+				Period: time.Second * time.Duration(util.GetEnvAsInt("SERVER_RATE_LIMIT_AUTH_PERIOD", 60)),
+			},
+			Account: RateLimitPolicy{
+				Limit:  util.GetEnvAsInt("SERVER_RATE_LIMIT_ACCOUNT_LIMIT", 120),
+				Period: time.Second * time.Duration(util.GetEnvAsInt("SERVER_RATE_LIMIT_ACCOUNT_PERIOD", 60)),
+			},
+			Push: RateLimitPolicy{
+				Limit:  util.GetEnvAsInt("SERVER_RATE_LIMIT_PUSH_LIMIT", 120),
+				Period: time.Second * time.Duration(util.GetEnvAsInt("SERVER_RATE_LIMIT_PUSH_PERIOD", 60)),
+			},
+			Uploads: RateLimitPolicy{
+				Limit:  util.GetEnvAsInt("SERVER_RATE_LIMIT_UPLOADS_LIMIT", 300),
+				Period: time.Second * time.Duration(util.GetEnvAsInt("SERVER_RATE_LIMIT_UPLOADS_PERIOD", 60)),
+			},
+			Management: RateLimitPolicy{
+				Limit:  util.GetEnvAsInt("SERVER_RATE_LIMIT_MANAGEMENT_LIMIT", 30),
+				Period: time.Second * time.Duration(util.GetEnvAsInt("SERVER_RATE_LIMIT_MANAGEMENT_PERIOD", 60)),
+			},
+			PurgeInterval: time.Second * time.Duration(util.GetEnvAsInt("SERVER_RATE_LIMIT_PURGE_INTERVAL", 300)),
+		},
 	}
 
 }
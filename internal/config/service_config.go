@@ -1,6 +1,10 @@
 package config
 
+import "allaboutapps.dev/aw/go-starter/internal/push"
+
 type PushService struct {
 	UseFCMProvider  bool
+	UseAPNsProvider bool
 	UseMockProvider bool
+	Outbox          push.OutboxConfig
 }
@@ -0,0 +1,45 @@
+package config
+
+import "time"
+
+type StorageBackend string
+
+var (
+	// StorageBackendFilesystem stores blobs on the local (or mounted) filesystem
+	StorageBackendFilesystem StorageBackend = "filesystem"
+	// StorageBackendS3 stores blobs in a bucket of an S3-compatible object storage (e.g. AWS S3, MinIO)
+	StorageBackendS3 StorageBackend = "s3"
+)
+
+func (b StorageBackend) String() string {
+	return string(b)
+}
+
+type StorageFilesystem struct {
+	// Directory blobs are stored in
+	BaseDirAbs string
+	// URL signed URLs point to, blobs are served by the server at /api/v1/storage
+	SignedURLBase string
+	// Key signed URLs are signed with, a random key is generated if empty (signed URLs are then only valid for the current process)
+	SigningKey string `json:"-"` // sensitive
+}
+
+type StorageS3 struct {
+	// Endpoint of the object storage, e.g. https://s3.eu-central-1.amazonaws.com or http://minio:9000
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string `json:"-"` // sensitive
+	// Addresses buckets via path (<endpoint>/<bucket>/<key>) instead of virtual hosts (<bucket>.<endpoint>/<key>), required by most S3-compatible object storages
+	UsePathStyle bool
+	HTTPTimeout  time.Duration
+}
+
+type Storage struct {
+	Backend string
+	// Default validity of signed URLs
+	SignedURLValidity time.Duration
+	Filesystem        StorageFilesystem
+	S3                StorageS3
+}
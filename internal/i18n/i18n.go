@@ -30,8 +30,9 @@ type Service struct {
 	matcher language.Matcher
 }
 
-// Data should be used to pass your template data
-type Data map[string]string
+// Data should be used to pass your template data.
+// It's an alias so the Service satisfies interfaces of packages which cannot import i18n (e.g. push.Translator).
+type Data = map[string]string
 
 // New returns a new Service struct holding bundle and matcher with the settings of the given config
 //
@@ -0,0 +1,194 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"mime"
+	"strings"
+)
+
+type Format string
+
+const (
+	FormatJPEG Format = "jpeg"
+	FormatPNG  Format = "png"
+	FormatWebP Format = "webp"
+)
+
+// DefaultJPEGQuality is used to encode JPEG images if no quality has been configured
+const DefaultJPEGQuality = 85
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format")
+	ErrImageTooLarge     = errors.New("image exceeds maximum number of pixels")
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+func (f Format) MIMEType() string {
+	return "image/" + string(f)
+}
+
+func (f Format) Extension() string {
+	if f == FormatJPEG {
+		return ".jpg"
+	}
+
+	return "." + string(f)
+}
+
+// Decodable reports whether images of this format can be decoded and thus processed. WebP images can only be encoded.
+func (f Format) Decodable() bool {
+	return f == FormatJPEG || f == FormatPNG
+}
+
+// FormatFromMIMEType returns the format of the given image MIME type, ignoring parameters.
+func FormatFromMIMEType(mimeType string) (Format, error) {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil || !strings.HasPrefix(mediaType, "image/") {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedFormat, mimeType)
+	}
+
+	return ParseFormat(strings.TrimPrefix(mediaType, "image/"))
+}
+
+// ParseFormat returns the format with the given name, also accepting "jpg" for JPEG.
+func ParseFormat(name string) (Format, error) {
+	switch strings.ToLower(name) {
+	case "jpeg", "jpg":
+		return FormatJPEG, nil
+	case "png":
+		return FormatPNG, nil
+	case "webp":
+		return FormatWebP, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedFormat, name)
+	}
+}
+
+// DetectFormat detects the format of the given encoded image by its signature.
+func DetectFormat(data []byte) (Format, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte("\xff\xd8\xff")):
+		return FormatJPEG, nil
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return FormatPNG, nil
+	case len(data) >= 12 && bytes.Equal(data[0:4], []byte("RIFF")) && bytes.Equal(data[8:12], []byte("WEBP")):
+		return FormatWebP, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+type Options struct {
+	// Images are scaled down to fit within the given bounds preserving their aspect ratio, but never scaled up.
+	// A bound of 0 leaves the respective dimension unconstrained.
+	MaxWidth  int
+	MaxHeight int
+	// Output format, defaults to the format of the source image
+	Format Format
+	// Quality of JPEG output (1-100), defaults to DefaultJPEGQuality
+	JPEGQuality int
+	// Images having more pixels are rejected before decoding them, 0 disables the limit
+	MaxPixels int
+}
+
+// Decode decodes the given JPEG or PNG image and rotates it according to its EXIF orientation.
+// All metadata is discarded. WebP images cannot be decoded and are rejected with ErrUnsupportedFormat.
+func Decode(data []byte, maxPixels int) (*image.RGBA, Format, error) {
+	format, err := DetectFormat(data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !format.Decodable() {
+		return nil, "", fmt.Errorf("%w: decoding %s images is not supported", ErrUnsupportedFormat, format)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to decode image config: %w", err)
+	}
+
+	if maxPixels > 0 && cfg.Width*cfg.Height > maxPixels {
+		return nil, "", fmt.Errorf("%w: %dx%d", ErrImageTooLarge, cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	orientation := OrientationNormal
+	if format == FormatJPEG {
+		orientation = JPEGOrientation(data)
+	}
+
+	return orient(img, orientation), format, nil
+}
+
+// Encode encodes the given image in the given format. jpegQuality defaults to DefaultJPEGQuality if 0.
+func Encode(w io.Writer, img image.Image, format Format, jpegQuality int) error {
+	switch format {
+	case FormatJPEG:
+		if jpegQuality <= 0 {
+			jpegQuality = DefaultJPEGQuality
+		}
+
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality})
+	case FormatPNG:
+		return png.Encode(w, img)
+	case FormatWebP:
+		return EncodeWebP(w, img)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedFormat, format)
+	}
+}
+
+// Process decodes the given JPEG or PNG image, auto-rotates it, scales it down to fit the given bounds and encodes
+// it in the requested format, stripping all metadata. Returns the format the image has been encoded in.
+func Process(w io.Writer, data []byte, opts Options) (Format, error) {
+	img, format, err := Decode(data, opts.MaxPixels)
+	if err != nil {
+		return "", err
+	}
+
+	if len(opts.Format) > 0 {
+		format = opts.Format
+	}
+
+	if err := Encode(w, Fit(img, opts.MaxWidth, opts.MaxHeight), format, opts.JPEGQuality); err != nil {
+		return "", fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	return format, nil
+}
+
+// Sanitize removes all metadata (e.g. EXIF GPS locations) from the given image, keeping its format.
+// JPEG images are rotated according to their EXIF orientation, which requires re-encoding them, otherwise only their
+// metadata segments are removed. PNG images are re-encoded losslessly. WebP images only have their EXIF and XMP
+// chunks removed, their orientation is left untouched.
+func Sanitize(w io.Writer, data []byte, maxPixels int, jpegQuality int) (Format, error) {
+	format, err := DetectFormat(data)
+	if err != nil {
+		return "", err
+	}
+
+	switch format {
+	case FormatJPEG:
+		if JPEGOrientation(data) == OrientationNormal {
+			return format, stripJPEGMetadata(w, data)
+		}
+	case FormatWebP:
+		return format, stripWebPMetadata(w, data)
+	}
+
+	return Process(w, data, Options{JPEGQuality: jpegQuality, MaxPixels: maxPixels})
+}
@@ -0,0 +1,239 @@
+package imaging_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/imaging"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// halvesImage returns an image with its left half red and its right half blue.
+func halvesImage(width int, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, image.Rect(0, 0, width/2, height), image.NewUniform(color.RGBA{R: 0xff, A: 0xff}), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(width/2, 0, width, height), image.NewUniform(color.RGBA{B: 0xff, A: 0xff}), image.Point{}, draw.Src)
+
+	return img
+}
+
+// withEXIF inserts an EXIF segment with the given orientation and a fake GPS marker after the SOI marker of the given JPEG.
+func withEXIF(t *testing.T, data []byte, orientation imaging.Orientation) []byte {
+	t.Helper()
+
+	tiff := []byte("II*\x00\x08\x00\x00\x00")
+	entries := make([]byte, 2+12+4)
+	binary.LittleEndian.PutUint16(entries[0:], 1)
+	binary.LittleEndian.PutUint16(entries[2:], 0x0112)
+	binary.LittleEndian.PutUint16(entries[4:], 3)
+	binary.LittleEndian.PutUint32(entries[6:], 1)
+	binary.LittleEndian.PutUint16(entries[10:], uint16(orientation))
+	tiff = append(tiff, entries...)
+	tiff = append(tiff, []byte("GPS 48.2082N 16.3738E")...)
+
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xff, 0xe1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	res := append([]byte{}, data[:2]...)
+	res = append(res, segment...)
+	return append(res, data[2:]...)
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}))
+	return buf.Bytes()
+}
+
+func assertColorNear(t *testing.T, expected color.RGBA, actual color.Color) {
+	t.Helper()
+
+	r, g, b, a := actual.RGBA()
+	assert.InDelta(t, expected.R, r>>8, 16)
+	assert.InDelta(t, expected.G, g>>8, 16)
+	assert.InDelta(t, expected.B, b>>8, 16)
+	assert.InDelta(t, expected.A, a>>8, 16)
+}
+
+func TestDetectFormat(t *testing.T) {
+	example, err := os.ReadFile(filepath.Join(util.GetProjectRootDir(), "test", "testdata", "example.jpg"))
+	require.NoError(t, err)
+
+	format, err := imaging.DetectFormat(example)
+	require.NoError(t, err)
+	assert.Equal(t, imaging.FormatJPEG, format)
+	assert.Equal(t, "image/jpeg", format.MIMEType())
+	assert.Equal(t, ".jpg", format.Extension())
+
+	_, err = imaging.DetectFormat([]byte("%PDF-1.7"))
+	assert.ErrorIs(t, err, imaging.ErrUnsupportedFormat)
+
+	format, err = imaging.ParseFormat("WEBP")
+	require.NoError(t, err)
+	assert.Equal(t, imaging.FormatWebP, format)
+
+	_, err = imaging.ParseFormat("gif")
+	assert.ErrorIs(t, err, imaging.ErrUnsupportedFormat)
+
+	format, err = imaging.FormatFromMIMEType("image/png")
+	require.NoError(t, err)
+	assert.Equal(t, imaging.FormatPNG, format)
+	assert.True(t, format.Decodable())
+
+	format, err = imaging.FormatFromMIMEType("image/webp")
+	require.NoError(t, err)
+	assert.False(t, format.Decodable())
+
+	_, err = imaging.FormatFromMIMEType("application/pdf")
+	assert.ErrorIs(t, err, imaging.ErrUnsupportedFormat)
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	data := encodeJPEG(t, halvesImage(8, 4))
+	assert.Equal(t, imaging.OrientationNormal, imaging.JPEGOrientation(data))
+
+	for o := imaging.OrientationNormal; o <= imaging.OrientationRotate90CCW; o++ {
+		assert.Equal(t, o, imaging.JPEGOrientation(withEXIF(t, data, o)))
+	}
+
+	assert.Equal(t, imaging.OrientationNormal, imaging.JPEGOrientation(withEXIF(t, data, 9)))
+	assert.Equal(t, imaging.OrientationNormal, imaging.JPEGOrientation([]byte("invalid")))
+}
+
+func TestSanitizeJPEG(t *testing.T) {
+	original := encodeJPEG(t, halvesImage(40, 20))
+
+	// without rotation metadata is removed losslessly
+	var buf bytes.Buffer
+	format, err := imaging.Sanitize(&buf, withEXIF(t, original, imaging.OrientationNormal), 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, imaging.FormatJPEG, format)
+	assert.Equal(t, original, buf.Bytes())
+
+	// rotated images are re-encoded upright
+	buf.Reset()
+	format, err = imaging.Sanitize(&buf, withEXIF(t, original, imaging.OrientationRotate90CW), 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, imaging.FormatJPEG, format)
+	assert.NotContains(t, buf.String(), "GPS")
+	assert.NotContains(t, buf.String(), "Exif")
+
+	img, err := jpeg.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 20, 40), img.Bounds())
+	assertColorNear(t, color.RGBA{R: 0xff, A: 0xff}, img.At(10, 5))
+	assertColorNear(t, color.RGBA{B: 0xff, A: 0xff}, img.At(10, 35))
+}
+
+func TestSanitizePNG(t *testing.T) {
+	var original bytes.Buffer
+	require.NoError(t, png.Encode(&original, halvesImage(8, 4)))
+
+	// insert a tEXt chunk after the IHDR chunk, which is discarded when re-encoding
+	text := []byte("\x00\x00\x00\x0etEXtComment\x00secret")
+	text = binary.BigEndian.AppendUint32(text, crc32.ChecksumIEEE(text[4:]))
+	data := append([]byte{}, original.Bytes()[:33]...)
+	data = append(data, text...)
+	data = append(data, original.Bytes()[33:]...)
+
+	var buf bytes.Buffer
+	format, err := imaging.Sanitize(&buf, data, 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, imaging.FormatPNG, format)
+	assert.NotContains(t, buf.String(), "secret")
+
+	img, err := png.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 8, 4), img.Bounds())
+	assertColorNear(t, color.RGBA{B: 0xff, A: 0xff}, img.At(7, 3))
+}
+
+func TestProcess(t *testing.T) {
+	data := withEXIF(t, encodeJPEG(t, halvesImage(400, 200)), imaging.OrientationRotate90CCW)
+
+	var buf bytes.Buffer
+	format, err := imaging.Process(&buf, data, imaging.Options{MaxWidth: 64, MaxHeight: 64, Format: imaging.FormatPNG})
+	require.NoError(t, err)
+	assert.Equal(t, imaging.FormatPNG, format)
+
+	img, err := png.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 32, 64), img.Bounds())
+
+	// rotated counter-clockwise, the left (red) half is now at the bottom
+	assertColorNear(t, color.RGBA{B: 0xff, A: 0xff}, img.At(16, 8))
+	assertColorNear(t, color.RGBA{R: 0xff, A: 0xff}, img.At(16, 56))
+
+	buf.Reset()
+	format, err = imaging.Process(&buf, data, imaging.Options{MaxWidth: 16, Format: imaging.FormatWebP})
+	require.NoError(t, err)
+	assert.Equal(t, imaging.FormatWebP, format)
+	assert.Equal(t, "WEBPVP8L", buf.String()[8:16])
+
+	// format defaults to the source's format
+	buf.Reset()
+	format, err = imaging.Process(&buf, data, imaging.Options{MaxHeight: 100})
+	require.NoError(t, err)
+	assert.Equal(t, imaging.FormatJPEG, format)
+
+	cfg, err := jpeg.DecodeConfig(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, 50, cfg.Width)
+	assert.Equal(t, 100, cfg.Height)
+}
+
+func TestProcessRejected(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := imaging.Process(&buf, encodeJPEG(t, halvesImage(100, 100)), imaging.Options{MaxPixels: 9999})
+	assert.ErrorIs(t, err, imaging.ErrImageTooLarge)
+
+	var webp bytes.Buffer
+	require.NoError(t, imaging.EncodeWebP(&webp, halvesImage(4, 4)))
+	_, err = imaging.Process(&buf, webp.Bytes(), imaging.Options{})
+	assert.ErrorIs(t, err, imaging.ErrUnsupportedFormat)
+
+	_, err = imaging.Process(&buf, []byte("\xff\xd8\xffgarbage"), imaging.Options{})
+	assert.Error(t, err)
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		width, height, maxWidth, maxHeight int
+		expectedWidth, expectedHeight      int
+	}{
+		{400, 200, 100, 100, 100, 50},
+		{200, 400, 100, 100, 50, 100},
+		{400, 200, 0, 100, 200, 100},
+		{400, 200, 0, 50, 100, 50},
+		{400, 200, 1000, 1000, 400, 200},
+		{1000, 1, 10, 10, 10, 1},
+	}
+
+	for _, tt := range tests {
+		width, height := imaging.FitSize(tt.width, tt.height, tt.maxWidth, tt.maxHeight)
+		assert.Equal(t, tt.expectedWidth, width)
+		assert.Equal(t, tt.expectedHeight, height)
+	}
+
+	img := imaging.Fit(halvesImage(30, 10), 3, 3)
+	assert.Equal(t, image.Rect(0, 0, 3, 1), img.Bounds())
+	assertColorNear(t, color.RGBA{R: 0xff, A: 0xff}, img.At(0, 0))
+	// the center pixel averages both halves
+	assertColorNear(t, color.RGBA{R: 0x80, B: 0x80, A: 0xff}, img.At(1, 0))
+	assertColorNear(t, color.RGBA{B: 0xff, A: 0xff}, img.At(2, 0))
+}
@@ -0,0 +1,190 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+const (
+	jpegMarkerSOI   = 0xd8
+	jpegMarkerEOI   = 0xd9
+	jpegMarkerSOS   = 0xda
+	jpegMarkerAPP0  = 0xe0
+	jpegMarkerAPP1  = 0xe1
+	jpegMarkerAPP2  = 0xe2
+	jpegMarkerAPP14 = 0xee
+	jpegMarkerAPP15 = 0xef
+	jpegMarkerCOM   = 0xfe
+
+	exifTagOrientation = 0x0112
+)
+
+var errInvalidJPEG = errors.New("invalid jpeg")
+
+type jpegSegment struct {
+	marker byte
+	// Raw bytes of the segment including its marker
+	raw []byte
+	// Payload of the segment excluding marker and length
+	payload []byte
+}
+
+// walkJPEG calls fn for every segment preceding the first scan of the given JPEG image and returns the remaining
+// data (starting at the SOS marker). Iteration stops early if fn returns false.
+func walkJPEG(data []byte, fn func(segment jpegSegment) bool) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0xff || data[1] != jpegMarkerSOI {
+		return nil, errInvalidJPEG
+	}
+
+	pos := 2
+	for pos < len(data) {
+		start := pos
+		if data[pos] != 0xff {
+			return nil, errInvalidJPEG
+		}
+
+		// markers may be preceded by any number of fill bytes
+		for pos < len(data) && data[pos] == 0xff {
+			pos++
+		}
+		if pos >= len(data) {
+			return nil, errInvalidJPEG
+		}
+
+		marker := data[pos]
+		pos++
+
+		if marker == jpegMarkerSOS || marker == jpegMarkerEOI {
+			return data[start:], nil
+		}
+
+		// standalone markers (TEM, RSTn) do not have a length
+		if marker == 0x01 || (marker >= 0xd0 && marker <= 0xd7) {
+			if !fn(jpegSegment{marker: marker, raw: data[start:pos]}) {
+				return data[pos:], nil
+			}
+			continue
+		}
+
+		if pos+2 > len(data) {
+			return nil, errInvalidJPEG
+		}
+
+		length := int(binary.BigEndian.Uint16(data[pos:]))
+		if length < 2 || pos+length > len(data) {
+			return nil, errInvalidJPEG
+		}
+
+		segment := jpegSegment{
+			marker:  marker,
+			raw:     data[start : pos+length],
+			payload: data[pos+2 : pos+length],
+		}
+		pos += length
+
+		if !fn(segment) {
+			return data[pos:], nil
+		}
+	}
+
+	return nil, errInvalidJPEG
+}
+
+// JPEGOrientation returns the EXIF orientation of the given JPEG image, OrientationNormal if it has none or is invalid.
+func JPEGOrientation(data []byte) Orientation {
+	orientation := OrientationNormal
+
+	_, _ = walkJPEG(data, func(segment jpegSegment) bool {
+		if segment.marker != jpegMarkerAPP1 || !bytes.HasPrefix(segment.payload, []byte("Exif\x00\x00")) {
+			return true
+		}
+
+		if o, ok := exifOrientation(segment.payload[6:]); ok {
+			orientation = o
+		}
+
+		return false
+	})
+
+	return orientation
+}
+
+// exifOrientation reads the orientation tag from the first IFD of the given TIFF structured EXIF data.
+func exifOrientation(tiff []byte) (Orientation, bool) {
+	if len(tiff) < 8 {
+		return 0, false
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[0:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0, false
+	}
+
+	if order.Uint16(tiff[2:]) != 42 {
+		return 0, false
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 0, false
+	}
+
+	entries := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0, false
+		}
+
+		if order.Uint16(tiff[entry:]) != exifTagOrientation {
+			continue
+		}
+
+		// orientation is stored as SHORT, left-aligned within the value field
+		orientation := Orientation(order.Uint16(tiff[entry+8:]))
+		if orientation < OrientationNormal || orientation > OrientationRotate90CCW {
+			return 0, false
+		}
+
+		return orientation, true
+	}
+
+	return 0, false
+}
+
+// stripJPEGMetadata writes the given JPEG image without its metadata segments (EXIF, XMP, IPTC and comments) without
+// re-encoding it. The JFIF header, ICC color profiles and Adobe color transform segments are required to render the
+// image correctly and thus retained.
+func stripJPEGMetadata(w io.Writer, data []byte) error {
+	var buf bytes.Buffer
+	buf.Grow(len(data))
+	buf.Write(data[:2])
+
+	rest, err := walkJPEG(data, func(segment jpegSegment) bool {
+		switch {
+		case segment.marker == jpegMarkerCOM,
+			segment.marker >= jpegMarkerAPP0 && segment.marker <= jpegMarkerAPP15 &&
+				segment.marker != jpegMarkerAPP0 && segment.marker != jpegMarkerAPP2 && segment.marker != jpegMarkerAPP14:
+			// drop metadata segment
+		default:
+			buf.Write(segment.raw)
+		}
+
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	buf.Write(rest)
+
+	_, err = buf.WriteTo(w)
+	return err
+}
@@ -0,0 +1,181 @@
+package imaging
+
+import (
+	"image"
+	"image/draw"
+	"math"
+)
+
+// Orientation as defined by the EXIF orientation tag, describing the transformation required to display an image upright.
+type Orientation int
+
+const (
+	OrientationNormal Orientation = iota + 1
+	OrientationFlipHorizontal
+	OrientationRotate180
+	OrientationFlipVertical
+	OrientationTranspose
+	OrientationRotate90CW
+	OrientationTransverse
+	OrientationRotate90CCW
+)
+
+// toRGBA converts the given image to a premultiplied RGBA image with its origin at (0, 0).
+func toRGBA(img image.Image) *image.RGBA {
+	bounds := img.Bounds()
+	if rgba, ok := img.(*image.RGBA); ok && bounds.Min == (image.Point{}) {
+		return rgba
+	}
+
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, bounds.Min, draw.Src)
+
+	return rgba
+}
+
+// orient converts the given image to RGBA, applying the transformation described by the given orientation.
+func orient(img image.Image, orientation Orientation) *image.RGBA {
+	src := toRGBA(img)
+	if orientation <= OrientationNormal || orientation > OrientationRotate90CCW {
+		return src
+	}
+
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+
+	dw, dh := w, h
+	if orientation >= OrientationTranspose {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case OrientationFlipHorizontal:
+				dx, dy = w-1-x, y
+			case OrientationRotate180:
+				dx, dy = w-1-x, h-1-y
+			case OrientationFlipVertical:
+				dx, dy = x, h-1-y
+			case OrientationTranspose:
+				dx, dy = y, x
+			case OrientationRotate90CW:
+				dx, dy = h-1-y, x
+			case OrientationTransverse:
+				dx, dy = h-1-y, w-1-x
+			case OrientationRotate90CCW:
+				dx, dy = y, w-1-x
+			}
+
+			copy(dst.Pix[dst.PixOffset(dx, dy):dst.PixOffset(dx, dy)+4], src.Pix[src.PixOffset(x, y):src.PixOffset(x, y)+4])
+		}
+	}
+
+	return dst
+}
+
+// FitSize returns the size of an image of the given size scaled down to fit within the given bounds, preserving its
+// aspect ratio. Images are never scaled up, a bound of 0 leaves the respective dimension unconstrained.
+func FitSize(width int, height int, maxWidth int, maxHeight int) (int, int) {
+	scale := 1.0
+	if maxWidth > 0 && width > maxWidth {
+		scale = math.Min(scale, float64(maxWidth)/float64(width))
+	}
+	if maxHeight > 0 && height > maxHeight {
+		scale = math.Min(scale, float64(maxHeight)/float64(height))
+	}
+
+	if scale == 1.0 {
+		return width, height
+	}
+
+	return int(math.Max(1, math.Round(float64(width)*scale))), int(math.Max(1, math.Round(float64(height)*scale)))
+}
+
+// Fit scales the given image down to fit within the given bounds, see FitSize. Pixels are resampled using area
+// averaging, which yields sharp thumbnails without aliasing.
+func Fit(img image.Image, maxWidth int, maxHeight int) *image.RGBA {
+	src := toRGBA(img)
+
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	dw, dh := FitSize(w, h, maxWidth, maxHeight)
+	if dw == w && dh == h {
+		return src
+	}
+
+	// resample rows first, then columns, as the box filter is separable
+	tmp := image.NewRGBA(image.Rect(0, 0, dw, h))
+	for i, c := range areaContributions(w, dw) {
+		for y := 0; y < h; y++ {
+			resamplePixel(tmp.Pix[tmp.PixOffset(i, y):], src.Pix, src.PixOffset(c.start, y), 4, c.weights)
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for i, c := range areaContributions(h, dh) {
+		for x := 0; x < dw; x++ {
+			resamplePixel(dst.Pix[dst.PixOffset(x, i):], tmp.Pix, tmp.PixOffset(x, c.start), tmp.Stride, c.weights)
+		}
+	}
+
+	return dst
+}
+
+type contribution struct {
+	start   int
+	weights []float64
+}
+
+// areaContributions returns the source pixels and their weights contributing to each destination pixel when scaling
+// down from srcSize to dstSize, weighted by the area they overlap the destination pixel.
+func areaContributions(srcSize int, dstSize int) []contribution {
+	scale := float64(srcSize) / float64(dstSize)
+	res := make([]contribution, dstSize)
+
+	for i := range res {
+		left := float64(i) * scale
+		right := math.Min(float64(i+1)*scale, float64(srcSize))
+
+		start := int(left)
+		end := int(math.Ceil(right))
+
+		weights := make([]float64, 0, end-start)
+		for j := start; j < end; j++ {
+			weights = append(weights, (math.Min(right, float64(j+1))-math.Max(left, float64(j)))/scale)
+		}
+
+		res[i] = contribution{start: start, weights: weights}
+	}
+
+	return res
+}
+
+// resamplePixel writes the weighted sum of the consecutive source pixels starting at offset, stride bytes apart, to dst.
+func resamplePixel(dst []uint8, src []uint8, offset int, stride int, weights []float64) {
+	var r, g, b, a float64
+	for i, weight := range weights {
+		p := src[offset+i*stride : offset+i*stride+4]
+		r += float64(p[0]) * weight
+		g += float64(p[1]) * weight
+		b += float64(p[2]) * weight
+		a += float64(p[3]) * weight
+	}
+
+	dst[0] = clampUint8(r)
+	dst[1] = clampUint8(g)
+	dst[2] = clampUint8(b)
+	dst[3] = clampUint8(a)
+}
+
+func clampUint8(v float64) uint8 {
+	v = math.Round(v)
+	if v < 0 {
+		return 0
+	}
+	if v > 255 {
+		return 255
+	}
+
+	return uint8(v)
+}
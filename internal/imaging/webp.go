@@ -0,0 +1,417 @@
+package imaging
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+)
+
+// WebP lossless (VP8L) bitstream constants, see https://developers.google.com/speed/webp/docs/webp_lossless_bitstream_specification
+const (
+	vp8lSignature          = 0x2f
+	vp8lMaxDimension       = 1 << 14
+	vp8lTransformSubtractG = 2
+	vp8lNumLiteralCodes    = 256
+	vp8lNumLengthCodes     = 24
+	vp8lNumDistanceCodes   = 40
+	vp8lMaxCodeLength      = 15
+	vp8lMaxCodeLengthCode  = 7
+	vp8lNumCodeLengthCodes = 19
+
+	webpFlagEXIF = 0x08
+	webpFlagXMP  = 0x04
+)
+
+// vp8lCodeLengthCodeOrder is the order code length code lengths are stored in
+var vp8lCodeLengthCodeOrder = [vp8lNumCodeLengthCodes]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+var errInvalidWebP = errors.New("invalid webp")
+
+// EncodeWebP encodes the given image as lossless WebP. Only the subtract green transform is applied and no backward
+// references are used, trading file size for a simple, dependency free encoder suitable for thumbnails.
+func EncodeWebP(w io.Writer, img image.Image) error {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < 1 || height < 1 || width > vp8lMaxDimension || height > vp8lMaxDimension {
+		return fmt.Errorf("invalid webp dimensions %dx%d", width, height)
+	}
+
+	// collect non-premultiplied ARGB pixels with green subtracted from red and blue
+	pixels := make([][4]uint8, 0, width*height)
+	opaque := true
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			if c.A != 0xff {
+				opaque = false
+			}
+
+			pixels = append(pixels, [4]uint8{c.G, c.R - c.G, c.B - c.G, c.A})
+		}
+	}
+
+	// green, red, blue and alpha codes, the green alphabet also contains the (unused) backward reference lengths
+	freqs := [4][]int{
+		make([]int, vp8lNumLiteralCodes+vp8lNumLengthCodes),
+		make([]int, vp8lNumLiteralCodes),
+		make([]int, vp8lNumLiteralCodes),
+		make([]int, vp8lNumLiteralCodes),
+	}
+	for _, p := range pixels {
+		for i := range p {
+			freqs[i][p[i]]++
+		}
+	}
+
+	codes := [4]huffmanCode{}
+	for i := range freqs {
+		codes[i] = newHuffmanCode(freqs[i], vp8lMaxCodeLength)
+	}
+
+	bw := &bitWriter{}
+	bw.writeBits(vp8lSignature, 8)
+	bw.writeBits(uint32(width-1), 14)
+	bw.writeBits(uint32(height-1), 14)
+	if opaque {
+		bw.writeBits(0, 1)
+	} else {
+		bw.writeBits(1, 1)
+	}
+	bw.writeBits(0, 3) // version
+
+	bw.writeBits(1, 1) // transform present
+	bw.writeBits(vp8lTransformSubtractG, 2)
+	bw.writeBits(0, 1) // no further transforms
+
+	bw.writeBits(0, 1) // no color cache
+	bw.writeBits(0, 1) // no meta prefix codes
+
+	for _, code := range codes {
+		writeHuffmanCode(bw, code)
+	}
+	writeHuffmanCode(bw, newHuffmanCode(make([]int, vp8lNumDistanceCodes), vp8lMaxCodeLength))
+
+	for _, p := range pixels {
+		for i := range p {
+			codes[i].write(bw, int(p[i]))
+		}
+	}
+
+	data := bw.bytes()
+
+	var buf bytes.Buffer
+	buf.WriteString("RIFF")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(4+8+len(data)+len(data)%2))
+	buf.WriteString("WEBPVP8L")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(len(data)))
+	buf.Write(data)
+	if len(data)%2 == 1 {
+		buf.WriteByte(0)
+	}
+
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// stripWebPMetadata writes the given WebP image without its EXIF and XMP chunks.
+func stripWebPMetadata(w io.Writer, data []byte) error {
+	if len(data) < 12 {
+		return errInvalidWebP
+	}
+
+	var chunks bytes.Buffer
+	pos := 12
+	for pos < len(data) {
+		if pos+8 > len(data) {
+			return errInvalidWebP
+		}
+
+		fourCC := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		end := pos + 8 + size + size%2
+		if end > len(data) {
+			// the padding byte of the last chunk is sometimes omitted
+			if pos+8+size != len(data) {
+				return errInvalidWebP
+			}
+			end = len(data)
+		}
+
+		switch fourCC {
+		case "EXIF", "XMP ":
+			// drop metadata chunk
+		case "VP8X":
+			chunk := bytes.Clone(data[pos:end])
+			if len(chunk) > 8 {
+				chunk[8] &^= webpFlagEXIF | webpFlagXMP
+			}
+			chunks.Write(chunk)
+		default:
+			chunks.Write(data[pos:end])
+		}
+
+		pos = end
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("RIFF")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(4+chunks.Len()))
+	buf.WriteString("WEBP")
+	buf.Write(chunks.Bytes())
+
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// bitWriter writes values LSB first, as required by the VP8L bitstream.
+type bitWriter struct {
+	buf  []byte
+	acc  uint64
+	nAcc uint
+}
+
+func (w *bitWriter) writeBits(v uint32, n uint) {
+	w.acc |= uint64(v) << w.nAcc
+	w.nAcc += n
+
+	for w.nAcc >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.nAcc -= 8
+	}
+}
+
+func (w *bitWriter) bytes() []byte {
+	if w.nAcc > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc = 0
+		w.nAcc = 0
+	}
+
+	return w.buf
+}
+
+// huffmanCode is a canonical prefix code, codes are stored bit reversed as they are written LSB first.
+type huffmanCode struct {
+	lengths []uint8
+	codes   []uint32
+	// Codes consisting of a single symbol are decoded without reading any bits
+	single bool
+}
+
+func (c huffmanCode) write(w *bitWriter, symbol int) {
+	if c.single {
+		return
+	}
+
+	w.writeBits(c.codes[symbol], uint(c.lengths[symbol]))
+}
+
+func (c huffmanCode) usedSymbols() []int {
+	res := make([]int, 0)
+	for symbol, length := range c.lengths {
+		if length > 0 {
+			res = append(res, symbol)
+		}
+	}
+
+	return res
+}
+
+// newHuffmanCode builds a canonical prefix code for the given symbol frequencies, limiting code lengths to maxLength.
+func newHuffmanCode(freqs []int, maxLength int) huffmanCode {
+	lengths := huffmanCodeLengths(freqs, maxLength)
+
+	var blCount [vp8lMaxCodeLength + 1]uint32
+	used := 0
+	for _, length := range lengths {
+		if length > 0 {
+			blCount[length]++
+			used++
+		}
+	}
+
+	var nextCode [vp8lMaxCodeLength + 1]uint32
+	code := uint32(0)
+	for bits := 1; bits <= vp8lMaxCodeLength; bits++ {
+		code = (code + blCount[bits-1]) << 1
+		nextCode[bits] = code
+	}
+
+	codes := make([]uint32, len(lengths))
+	for symbol, length := range lengths {
+		if length == 0 {
+			continue
+		}
+
+		codes[symbol] = reverseBits(nextCode[length], length)
+		nextCode[length]++
+	}
+
+	return huffmanCode{lengths: lengths, codes: codes, single: used <= 1}
+}
+
+func reverseBits(v uint32, n uint8) uint32 {
+	res := uint32(0)
+	for i := uint8(0); i < n; i++ {
+		res = (res << 1) | (v & 1)
+		v >>= 1
+	}
+
+	return res
+}
+
+type huffmanNode struct {
+	weight int
+	// Leaf nodes reference their symbol, inner nodes their children
+	symbol      int
+	left, right *huffmanNode
+	// Insertion order, used to break ties deterministically
+	order int
+}
+
+type huffmanHeap []*huffmanNode
+
+func (h huffmanHeap) Len() int { return len(h) }
+func (h huffmanHeap) Less(i, j int) bool {
+	if h[i].weight != h[j].weight {
+		return h[i].weight < h[j].weight
+	}
+	return h[i].order < h[j].order
+}
+func (h huffmanHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *huffmanHeap) Push(x any)   { *h = append(*h, x.(*huffmanNode)) }
+func (h *huffmanHeap) Pop() any {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
+
+// huffmanCodeLengths computes the code lengths of an optimal prefix code for the given frequencies. If the resulting
+// code exceeds maxLength, rare symbols are assigned increasingly higher minimum frequencies until it fits, which keeps
+// the code complete as required by decoders. A single used symbol is assigned a length of 1.
+func huffmanCodeLengths(freqs []int, maxLength int) []uint8 {
+	lengths := make([]uint8, len(freqs))
+
+	used := 0
+	last := 0
+	for symbol, freq := range freqs {
+		if freq > 0 {
+			used++
+			last = symbol
+		}
+	}
+
+	if used == 0 {
+		return lengths
+	}
+
+	if used == 1 {
+		lengths[last] = 1
+		return lengths
+	}
+
+	for countMin := 1; ; countMin *= 2 {
+		h := make(huffmanHeap, 0, used)
+		for symbol, freq := range freqs {
+			if freq == 0 {
+				continue
+			}
+
+			if freq < countMin {
+				freq = countMin
+			}
+
+			h = append(h, &huffmanNode{weight: freq, symbol: symbol, order: len(h)})
+		}
+		heap.Init(&h)
+
+		order := len(h)
+		for h.Len() > 1 {
+			left := heap.Pop(&h).(*huffmanNode)
+			right := heap.Pop(&h).(*huffmanNode)
+			heap.Push(&h, &huffmanNode{weight: left.weight + right.weight, symbol: -1, left: left, right: right, order: order})
+			order++
+		}
+
+		maxDepth := assignDepths(h[0], 0, lengths)
+		if maxDepth <= maxLength {
+			return lengths
+		}
+	}
+}
+
+func assignDepths(node *huffmanNode, depth int, lengths []uint8) int {
+	if node.left == nil {
+		lengths[node.symbol] = uint8(depth)
+		return depth
+	}
+
+	left := assignDepths(node.left, depth+1, lengths)
+	right := assignDepths(node.right, depth+1, lengths)
+	if left > right {
+		return left
+	}
+
+	return right
+}
+
+// writeHuffmanCode writes the given prefix code. Codes of up to two symbols fitting into 8 bits are written as simple
+// codes, all other codes have their code lengths written using a code length code.
+func writeHuffmanCode(w *bitWriter, code huffmanCode) {
+	used := code.usedSymbols()
+	if len(used) == 0 {
+		used = []int{0}
+	}
+
+	if len(used) <= 2 && used[len(used)-1] < 256 {
+		w.writeBits(1, 1) // simple code
+		w.writeBits(uint32(len(used)-1), 1)
+
+		if used[0] < 2 {
+			w.writeBits(0, 1)
+			w.writeBits(uint32(used[0]), 1)
+		} else {
+			w.writeBits(1, 1)
+			w.writeBits(uint32(used[0]), 8)
+		}
+
+		if len(used) == 2 {
+			w.writeBits(uint32(used[1]), 8)
+		}
+
+		return
+	}
+
+	// code lengths are written as literals (0-15), repeat codes (16-18) are not used
+	freqs := make([]int, vp8lNumCodeLengthCodes)
+	for _, length := range code.lengths {
+		freqs[length]++
+	}
+
+	lengthCode := newHuffmanCode(freqs, vp8lMaxCodeLengthCode)
+
+	numCodes := 4
+	for i, symbol := range vp8lCodeLengthCodeOrder {
+		if lengthCode.lengths[symbol] > 0 && i+1 > numCodes {
+			numCodes = i + 1
+		}
+	}
+
+	w.writeBits(0, 1) // normal code
+	w.writeBits(uint32(numCodes-4), 4)
+	for i := 0; i < numCodes; i++ {
+		w.writeBits(uint32(lengthCode.lengths[vp8lCodeLengthCodeOrder[i]]), 3)
+	}
+
+	w.writeBits(0, 1) // code lengths of all symbols of the alphabet follow
+	for _, length := range code.lengths {
+		lengthCode.write(w, int(length))
+	}
+}
@@ -0,0 +1,134 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/webp"
+)
+
+func TestEncodeWebP(t *testing.T) {
+	gradient := image.NewNRGBA(image.Rect(0, 0, 67, 31))
+	for y := 0; y < 31; y++ {
+		for x := 0; x < 67; x++ {
+			gradient.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 3), G: uint8(y * 8), B: uint8(x * y), A: uint8(255 - x)})
+		}
+	}
+
+	noise := image.NewNRGBA(image.Rect(0, 0, 64, 64))
+	seed := uint32(1)
+	for i := range noise.Pix {
+		seed = seed*1664525 + 1013904223
+		noise.Pix[i] = uint8(seed >> 24)
+	}
+
+	opaque := image.NewNRGBA(image.Rect(0, 0, 5, 3))
+	for i := range opaque.Pix {
+		opaque.Pix[i] = 0xff
+	}
+	opaque.SetNRGBA(2, 1, color.NRGBA{R: 0x10, G: 0x20, B: 0x30, A: 0xff})
+
+	tests := []struct {
+		name string
+		img  *image.NRGBA
+	}{
+		{"gradient", gradient},
+		{"noise", noise},
+		{"opaque", opaque},
+		{"single pixel", image.NewNRGBA(image.Rect(0, 0, 1, 1))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, EncodeWebP(&buf, tt.img))
+
+			format, err := DetectFormat(buf.Bytes())
+			require.NoError(t, err)
+			assert.Equal(t, FormatWebP, format)
+
+			// decode using an independent implementation to verify the bitstream is valid
+			decoded, err := webp.Decode(bytes.NewReader(buf.Bytes()))
+			require.NoError(t, err)
+			require.IsType(t, &image.NRGBA{}, decoded)
+			assert.Equal(t, tt.img.Rect, decoded.Bounds())
+			assert.Equal(t, tt.img.Pix, decoded.(*image.NRGBA).Pix)
+		})
+	}
+}
+
+func TestEncodeWebPInvalidDimensions(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Error(t, EncodeWebP(&buf, image.NewNRGBA(image.Rect(0, 0, 0, 0))))
+	assert.Error(t, EncodeWebP(&buf, image.NewNRGBA(image.Rect(0, 0, vp8lMaxDimension+1, 1))))
+}
+
+func TestHuffmanCodeLengthsLimited(t *testing.T) {
+	// fibonacci frequencies result in maximally unbalanced trees
+	freqs := make([]int, 30)
+	a, b := 1, 1
+	for i := range freqs {
+		freqs[i] = a
+		a, b = b, a+b
+	}
+
+	lengths := huffmanCodeLengths(freqs, 7)
+
+	kraft := 0.0
+	for _, length := range lengths {
+		require.NotZero(t, length)
+		assert.LessOrEqual(t, length, uint8(7))
+		kraft += 1.0 / float64(uint(1)<<length)
+	}
+
+	// the code must be complete
+	assert.Equal(t, 1.0, kraft)
+}
+
+func TestStripWebPMetadata(t *testing.T) {
+	var encoded bytes.Buffer
+	require.NoError(t, EncodeWebP(&encoded, image.NewNRGBA(image.Rect(0, 0, 2, 2))))
+	vp8l := encoded.Bytes()[12:]
+
+	chunk := func(fourCC string, payload []byte) []byte {
+		res := append([]byte(fourCC), 0, 0, 0, 0)
+		binary.LittleEndian.PutUint32(res[4:], uint32(len(payload)))
+		res = append(res, payload...)
+		if len(payload)%2 == 1 {
+			res = append(res, 0)
+		}
+		return res
+	}
+
+	vp8x := make([]byte, 10)
+	vp8x[0] = webpFlagEXIF | webpFlagXMP
+	vp8x[4], vp8x[7] = 1, 1 // canvas size 2x2
+
+	var body []byte
+	body = append(body, chunk("VP8X", vp8x)...)
+	body = append(body, vp8l...)
+	body = append(body, chunk("EXIF", []byte("Exif\x00\x00GPS data"))...)
+	body = append(body, chunk("XMP ", []byte("<x:xmpmeta/>"))...)
+
+	data := append([]byte("RIFF\x00\x00\x00\x00WEBP"), body...)
+	binary.LittleEndian.PutUint32(data[4:], uint32(len(data)-8))
+
+	var buf bytes.Buffer
+	require.NoError(t, stripWebPMetadata(&buf, data))
+
+	stripped := buf.Bytes()
+	assert.NotContains(t, string(stripped), "EXIF")
+	assert.NotContains(t, string(stripped), "GPS data")
+	assert.NotContains(t, string(stripped), "xmpmeta")
+	assert.Equal(t, uint32(len(stripped)-8), binary.LittleEndian.Uint32(stripped[4:]))
+	assert.Equal(t, "VP8X", string(stripped[12:16]))
+	assert.Equal(t, byte(0), stripped[20])
+	assert.Equal(t, vp8l, stripped[30:])
+
+	assert.Error(t, stripWebPMetadata(&buf, data[:len(data)-5]))
+}
@@ -0,0 +1,148 @@
+package lockout
+
+import (
+	"context"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+)
+
+// Scope separates the attempts of different operations, so e.g. excessive password reset requests
+// do not lock a user out of logging in.
+type Scope string
+
+const (
+	ScopeLogin          Scope = "login"
+	ScopeForgotPassword Scope = "forgot_password"
+)
+
+func (s Scope) String() string {
+	return string(s)
+}
+
+// Service protects authentication endpoints against brute-force attacks by tracking failed attempts
+// per username and per client IP. After exceeding the free attempts of a policy, further attempts are
+// delayed with exponential backoff, while exceeding the maximum attempts locks them for the lock duration.
+type Service struct {
+	config config.AuthServerLockout
+	store  Store
+}
+
+func New(cfg config.AuthServerLockout, store Store) *Service {
+	return &Service{
+		config: cfg,
+		store:  store,
+	}
+}
+
+// Check returns the duration the client has to wait before attempting the given scope for the given username
+// and IP again. A zero duration is returned if the attempt is allowed.
+func (s *Service) Check(ctx context.Context, scope Scope, username string, ip string) (time.Duration, error) {
+	if !s.config.Enabled {
+		return 0, nil
+	}
+
+	now := time.Now()
+
+	var retryAfter time.Duration
+	for _, key := range s.keys(scope, username, ip) {
+		a, err := s.store.Get(ctx, key.key)
+		if err != nil {
+			return 0, err
+		}
+
+		if remaining := a.BlockedUntil.Sub(now); remaining > retryAfter {
+			retryAfter = remaining
+		}
+	}
+
+	return retryAfter, nil
+}
+
+// RegisterFailure records a failed attempt of the given scope for the given username and IP,
+// blocking further attempts according to the policy of each key.
+func (s *Service) RegisterFailure(ctx context.Context, scope Scope, username string, ip string) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	now := time.Now()
+
+	for _, key := range s.keys(scope, username, ip) {
+		a, err := s.store.RecordFailure(ctx, key.key, now, s.config.Window)
+		if err != nil {
+			return err
+		}
+
+		if d := s.blockDuration(key.policy, a.Failures); d > 0 {
+			if err := s.store.Block(ctx, key.key, now.Add(d)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Reset forgets the failed attempts of the given scope for the given username, e.g. after a successful login.
+// Attempts recorded for the client's IP are kept, as a single valid account must not unlock a whole IP.
+func (s *Service) Reset(ctx context.Context, scope Scope, username string) error {
+	if !s.config.Enabled {
+		return nil
+	}
+
+	return s.store.Reset(ctx, usernameKey(scope, username))
+}
+
+// Purge forgets the attempts of all keys which neither count towards the window nor are blocked anymore,
+// returning the number of keys purged.
+func (s *Service) Purge(ctx context.Context) (int64, error) {
+	if !s.config.Enabled {
+		return 0, nil
+	}
+
+	return s.store.Purge(ctx, time.Now(), s.config.Window)
+}
+
+func (s *Service) blockDuration(policy config.AuthServerLockoutPolicy, failures int) time.Duration {
+	if failures >= policy.MaxAttempts {
+		return s.config.LockDuration
+	}
+
+	if failures <= policy.FreeAttempts {
+		return 0
+	}
+
+	d := s.config.BaseDelay
+	for i := policy.FreeAttempts + 1; i < failures; i++ {
+		d *= 2
+		if d >= s.config.MaxDelay {
+			return s.config.MaxDelay
+		}
+	}
+
+	return d
+}
+
+type policyKey struct {
+	key    string
+	policy config.AuthServerLockoutPolicy
+}
+
+func (s *Service) keys(scope Scope, username string, ip string) []policyKey {
+	keys := make([]policyKey, 0, 2)
+
+	if len(username) > 0 {
+		keys = append(keys, policyKey{key: usernameKey(scope, username), policy: s.config.Username})
+	}
+
+	if len(ip) > 0 {
+		keys = append(keys, policyKey{key: scope.String() + ":ip:" + ip, policy: s.config.IP})
+	}
+
+	return keys
+}
+
+func usernameKey(scope Scope, username string) string {
+	return scope.String() + ":username:" + username
+}
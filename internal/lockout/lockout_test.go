@@ -0,0 +1,191 @@
+package lockout_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/lockout"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func lockoutTestConfig() config.AuthServerLockout {
+	return config.AuthServerLockout{
+		Enabled:      true,
+		Store:        config.AuthLockoutStoreMemory.String(),
+		Window:       time.Hour,
+		BaseDelay:    time.Second,
+		MaxDelay:     time.Second * 4,
+		LockDuration: time.Minute * 15,
+		Username: config.AuthServerLockoutPolicy{
+			FreeAttempts: 2,
+			MaxAttempts:  6,
+		},
+		IP: config.AuthServerLockoutPolicy{
+			FreeAttempts: 4,
+			MaxAttempts:  10,
+		},
+	}
+}
+
+func TestServiceExponentialBackoffAndLock(t *testing.T) {
+	ctx := context.Background()
+	s := lockout.New(lockoutTestConfig(), lockout.NewMemoryStore())
+
+	// failures within the free attempts are not delayed, afterwards delays double up to the max delay
+	expectedDelays := []time.Duration{0, 0, time.Second, time.Second * 2, time.Second * 4}
+	for i, expected := range expectedDelays {
+		err := s.RegisterFailure(ctx, lockout.ScopeLogin, "user1@example.com", "")
+		require.NoError(t, err)
+
+		retryAfter, err := s.Check(ctx, lockout.ScopeLogin, "user1@example.com", "")
+		require.NoError(t, err)
+		assert.InDelta(t, expected, retryAfter, float64(time.Second), "failure %d", i+1)
+	}
+
+	// reaching the max attempts locks the username
+	err := s.RegisterFailure(ctx, lockout.ScopeLogin, "user1@example.com", "")
+	require.NoError(t, err)
+
+	retryAfter, err := s.Check(ctx, lockout.ScopeLogin, "user1@example.com", "")
+	require.NoError(t, err)
+	assert.InDelta(t, time.Minute*15, retryAfter, float64(time.Second))
+
+	// other usernames and scopes are not affected
+	retryAfter, err = s.Check(ctx, lockout.ScopeLogin, "user2@example.com", "")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+
+	retryAfter, err = s.Check(ctx, lockout.ScopeForgotPassword, "user1@example.com", "")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+
+	err = s.Reset(ctx, lockout.ScopeLogin, "user1@example.com")
+	require.NoError(t, err)
+
+	retryAfter, err = s.Check(ctx, lockout.ScopeLogin, "user1@example.com", "")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+}
+
+func TestServiceIPPolicy(t *testing.T) {
+	ctx := context.Background()
+	s := lockout.New(lockoutTestConfig(), lockout.NewMemoryStore())
+
+	// failures are tracked per IP across usernames, using the IP's policy
+	for i := 0; i < 5; i++ {
+		err := s.RegisterFailure(ctx, lockout.ScopeLogin, "", "203.0.113.42")
+		require.NoError(t, err)
+	}
+
+	retryAfter, err := s.Check(ctx, lockout.ScopeLogin, "user1@example.com", "203.0.113.42")
+	require.NoError(t, err)
+	assert.InDelta(t, time.Second, retryAfter, float64(time.Second))
+
+	retryAfter, err = s.Check(ctx, lockout.ScopeLogin, "user1@example.com", "198.51.100.7")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+
+	// resetting the username does not unlock the IP
+	err = s.Reset(ctx, lockout.ScopeLogin, "user1@example.com")
+	require.NoError(t, err)
+
+	retryAfter, err = s.Check(ctx, lockout.ScopeLogin, "user1@example.com", "203.0.113.42")
+	require.NoError(t, err)
+	assert.Greater(t, retryAfter, time.Duration(0))
+}
+
+func TestServiceDisabled(t *testing.T) {
+	ctx := context.Background()
+	cfg := lockoutTestConfig()
+	cfg.Enabled = false
+	s := lockout.New(cfg, lockout.NewMemoryStore())
+
+	for i := 0; i < 10; i++ {
+		err := s.RegisterFailure(ctx, lockout.ScopeLogin, "user1@example.com", "203.0.113.42")
+		require.NoError(t, err)
+	}
+
+	retryAfter, err := s.Check(ctx, lockout.ScopeLogin, "user1@example.com", "203.0.113.42")
+	require.NoError(t, err)
+	assert.Zero(t, retryAfter)
+}
+
+func TestMemoryStoreWindow(t *testing.T) {
+	ctx := context.Background()
+	store := lockout.NewMemoryStore()
+	now := time.Now()
+
+	a, err := store.RecordFailure(ctx, "login:username:user1@example.com", now, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 1, a.Failures)
+
+	err = store.Block(ctx, "login:username:user1@example.com", now.Add(time.Minute))
+	require.NoError(t, err)
+
+	a, err = store.RecordFailure(ctx, "login:username:user1@example.com", now.Add(time.Minute*30), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 2, a.Failures)
+	assert.Equal(t, now.Add(time.Minute), a.BlockedUntil)
+
+	// failures older than the window are forgotten, including any block
+	a, err = store.RecordFailure(ctx, "login:username:user1@example.com", now.Add(time.Minute*91), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, 1, a.Failures)
+	assert.True(t, a.BlockedUntil.IsZero())
+
+	a, err = store.Get(ctx, "login:username:user1@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, 1, a.Failures)
+
+	err = store.Reset(ctx, "login:username:user1@example.com")
+	require.NoError(t, err)
+
+	a, err = store.Get(ctx, "login:username:user1@example.com")
+	require.NoError(t, err)
+	assert.Zero(t, a.Failures)
+}
+
+// testStorePurge ensures the given store only purges keys which neither count towards the window nor are blocked.
+func testStorePurge(t *testing.T, store lockout.Store) {
+	t.Helper()
+
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Microsecond)
+
+	_, err := store.RecordFailure(ctx, "login:username:expired@example.com", now.Add(-time.Hour*2), time.Hour)
+	require.NoError(t, err)
+
+	_, err = store.RecordFailure(ctx, "login:username:blocked@example.com", now.Add(-time.Hour*2), time.Hour)
+	require.NoError(t, err)
+	err = store.Block(ctx, "login:username:blocked@example.com", now.Add(time.Minute))
+	require.NoError(t, err)
+
+	_, err = store.RecordFailure(ctx, "login:username:recent@example.com", now.Add(-time.Minute), time.Hour)
+	require.NoError(t, err)
+
+	purged, err := store.Purge(ctx, now, time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	a, err := store.Get(ctx, "login:username:expired@example.com")
+	require.NoError(t, err)
+	assert.Zero(t, a.Failures)
+
+	for _, key := range []string{"login:username:blocked@example.com", "login:username:recent@example.com"} {
+		a, err = store.Get(ctx, key)
+		require.NoError(t, err)
+		assert.Equal(t, 1, a.Failures, key)
+	}
+
+	// blocks are purged once they have passed
+	purged, err = store.Purge(ctx, now.Add(time.Minute*2), time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+}
+
+func TestMemoryStorePurge(t *testing.T) {
+	testStorePurge(t, lockout.NewMemoryStore())
+}
@@ -0,0 +1,79 @@
+package lockout
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps failed attempts in memory. As attempts are not shared between replicas,
+// it is only suitable for tests and single instance deployments.
+type MemoryStore struct {
+	sync.RWMutex
+	attempts map[string]Attempts
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		RWMutex:  sync.RWMutex{},
+		attempts: make(map[string]Attempts),
+	}
+}
+
+func (m *MemoryStore) Get(_ context.Context, key string) (Attempts, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.attempts[key], nil
+}
+
+func (m *MemoryStore) RecordFailure(_ context.Context, key string, now time.Time, window time.Duration) (Attempts, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	a := m.attempts[key]
+	if a.LastFailureAt.Before(now.Add(-window)) {
+		a = Attempts{}
+	}
+
+	a.Failures++
+	a.LastFailureAt = now
+	m.attempts[key] = a
+
+	return a, nil
+}
+
+func (m *MemoryStore) Block(_ context.Context, key string, until time.Time) error {
+	m.Lock()
+	defer m.Unlock()
+
+	a := m.attempts[key]
+	a.BlockedUntil = until
+	m.attempts[key] = a
+
+	return nil
+}
+
+func (m *MemoryStore) Reset(_ context.Context, key string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.attempts, key)
+
+	return nil
+}
+
+func (m *MemoryStore) Purge(_ context.Context, now time.Time, window time.Duration) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	var purged int64
+	for key, a := range m.attempts {
+		if a.LastFailureAt.Before(now.Add(-window)) && !a.BlockedUntil.After(now) {
+			delete(m.attempts, key)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,92 @@
+package lockout
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// PostgresStore keeps failed attempts in the auth_attempts table, sharing them between all replicas.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{
+		db: db,
+	}
+}
+
+func (p *PostgresStore) Get(ctx context.Context, key string) (Attempts, error) {
+	attempt, err := models.FindAuthAttempt(ctx, p.db, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Attempts{}, nil
+		}
+
+		return Attempts{}, err
+	}
+
+	return Attempts{
+		Failures:      attempt.Failures,
+		LastFailureAt: attempt.LastFailureAt,
+		BlockedUntil:  attempt.BlockedUntil.Time,
+	}, nil
+}
+
+// RecordFailure atomically upserts the attempts of the given key, so concurrent failures are never lost.
+func (p *PostgresStore) RecordFailure(ctx context.Context, key string, now time.Time, window time.Duration) (Attempts, error) {
+	var failures int
+	var blockedUntil null.Time
+
+	if err := p.db.QueryRowContext(ctx, `
+		INSERT INTO auth_attempts (key, failures, last_failure_at, created_at, updated_at)
+			VALUES ($1, 1, $2, $2, $2)
+		ON CONFLICT (key)
+			DO UPDATE SET
+				failures = CASE WHEN auth_attempts.last_failure_at < $3 THEN 1 ELSE auth_attempts.failures + 1 END,
+				blocked_until = CASE WHEN auth_attempts.last_failure_at < $3 THEN NULL ELSE auth_attempts.blocked_until END,
+				last_failure_at = $2,
+				updated_at = $2
+		RETURNING failures, blocked_until;`,
+		key, now, now.Add(-window),
+	).Scan(&failures, &blockedUntil); err != nil {
+		return Attempts{}, err
+	}
+
+	return Attempts{
+		Failures:      failures,
+		LastFailureAt: now,
+		BlockedUntil:  blockedUntil.Time,
+	}, nil
+}
+
+func (p *PostgresStore) Block(ctx context.Context, key string, until time.Time) error {
+	_, err := models.AuthAttempts(models.AuthAttemptWhere.Key.EQ(key)).UpdateAll(ctx, p.db, models.M{
+		models.AuthAttemptColumns.BlockedUntil: null.TimeFrom(until),
+		models.AuthAttemptColumns.UpdatedAt:    time.Now(),
+	})
+
+	return err
+}
+
+func (p *PostgresStore) Reset(ctx context.Context, key string) error {
+	_, err := models.AuthAttempts(models.AuthAttemptWhere.Key.EQ(key)).DeleteAll(ctx, p.db)
+
+	return err
+}
+
+func (p *PostgresStore) Purge(ctx context.Context, now time.Time, window time.Duration) (int64, error) {
+	return models.AuthAttempts(
+		models.AuthAttemptWhere.LastFailureAt.LT(now.Add(-window)),
+		qm.Expr(
+			models.AuthAttemptWhere.BlockedUntil.IsNull(),
+			qm.Or2(models.AuthAttemptWhere.BlockedUntil.LT(null.TimeFrom(now))),
+		),
+	).DeleteAll(ctx, p.db)
+}
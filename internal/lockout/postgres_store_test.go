@@ -0,0 +1,84 @@
+package lockout_test
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/lockout"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresStore(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		store := lockout.NewPostgresStore(db)
+		now := time.Now().Truncate(time.Microsecond)
+
+		a, err := store.Get(ctx, "login:username:user1@example.com")
+		require.NoError(t, err)
+		assert.Zero(t, a.Failures)
+
+		a, err = store.RecordFailure(ctx, "login:username:user1@example.com", now, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 1, a.Failures)
+
+		err = store.Block(ctx, "login:username:user1@example.com", now.Add(time.Minute))
+		require.NoError(t, err)
+
+		a, err = store.RecordFailure(ctx, "login:username:user1@example.com", now.Add(time.Minute*30), time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 2, a.Failures)
+		assert.True(t, now.Add(time.Minute).Equal(a.BlockedUntil))
+
+		a, err = store.Get(ctx, "login:username:user1@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, 2, a.Failures)
+		assert.True(t, now.Add(time.Minute).Equal(a.BlockedUntil))
+
+		// failures older than the window are forgotten, including any block
+		a, err = store.RecordFailure(ctx, "login:username:user1@example.com", now.Add(time.Minute*91), time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 1, a.Failures)
+		assert.True(t, a.BlockedUntil.IsZero())
+
+		err = store.Reset(ctx, "login:username:user1@example.com")
+		require.NoError(t, err)
+
+		a, err = store.Get(ctx, "login:username:user1@example.com")
+		require.NoError(t, err)
+		assert.Zero(t, a.Failures)
+	})
+}
+
+func TestPostgresStoreConcurrentFailures(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		store := lockout.NewPostgresStore(db)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := store.RecordFailure(ctx, "login:ip:203.0.113.42", time.Now(), time.Hour)
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		a, err := store.Get(ctx, "login:ip:203.0.113.42")
+		require.NoError(t, err)
+		assert.Equal(t, 10, a.Failures)
+	})
+}
+
+func TestPostgresStorePurge(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		testStorePurge(t, lockout.NewPostgresStore(db))
+	})
+}
@@ -0,0 +1,29 @@
+package lockout
+
+import (
+	"context"
+	"time"
+)
+
+// Attempts represents the failed attempts recorded for a single key.
+type Attempts struct {
+	Failures      int
+	LastFailureAt time.Time
+	BlockedUntil  time.Time
+}
+
+// Store persists failed attempts by key. Implementations must be safe for concurrent use.
+type Store interface {
+	// Get returns the attempts recorded for the given key, the zero value is returned if none were recorded.
+	Get(ctx context.Context, key string) (Attempts, error)
+	// RecordFailure increments the failures of the given key and returns the updated attempts. If the last
+	// failure is older than the given window, the count restarts at one and any block previously set is cleared.
+	RecordFailure(ctx context.Context, key string, now time.Time, window time.Duration) (Attempts, error)
+	// Block rejects further attempts of the given key until the given time.
+	Block(ctx context.Context, key string, until time.Time) error
+	// Reset forgets all attempts recorded for the given key.
+	Reset(ctx context.Context, key string) error
+	// Purge forgets the attempts of all keys whose last failure is older than the given window and which are not
+	// blocked anymore, returning the number of keys purged.
+	Purge(ctx context.Context, now time.Time, window time.Duration) (int64, error)
+}
@@ -16,8 +16,11 @@ import (
 )
 
 var (
-	ErrEmailTemplateNotFound   = errors.New("email template not found")
-	emailTemplatePasswordReset = "password_reset" // /app/templates/email/password_reset/**.
+	ErrEmailTemplateNotFound       = errors.New("email template not found")
+	emailTemplatePasswordReset     = "password_reset"     // /app/templates/email/password_reset/**.
+	emailTemplateEmailVerification = "email_verification" // /app/templates/email/email_verification/**.
+	emailTemplateEmailChange       = "email_change"       // /app/templates/email/email_change/**.
+	emailTemplateEmailChanged      = "email_changed"      // /app/templates/email/email_changed/**.
 )
 
 type Mailer struct {
@@ -98,3 +101,127 @@ func (m *Mailer) SendPasswordReset(ctx context.Context, to string, passwordReset
 
 	return nil
 }
+
+func (m *Mailer) SendEmailVerification(ctx context.Context, to string, emailVerificationLink string) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", emailTemplateEmailVerification).Logger()
+
+	t, ok := m.Templates[emailTemplateEmailVerification]
+	if !ok {
+		log.Error().Msg("Email verification email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	data := map[string]interface{}{
+		"emailVerificationLink": emailVerificationLink,
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute email verification email template")
+		return err
+	}
+
+	e := email.NewEmail()
+
+	e.From = m.Config.DefaultSender
+	e.To = []string{to}
+	e.Subject = "Verify your email address"
+	e.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Str("emailVerificationLink", emailVerificationLink).Msg("Sending has been disabled in mailer config, skipping email verification email")
+		return nil
+	}
+
+	if err := m.Transport.Send(e); err != nil {
+		log.Debug().Err(err).Msg("Failed to send email verification email")
+		return err
+	}
+
+	log.Debug().Msg("Successfully sent email verification email")
+
+	return nil
+}
+
+func (m *Mailer) SendEmailChange(ctx context.Context, to string, emailChangeLink string) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", emailTemplateEmailChange).Logger()
+
+	t, ok := m.Templates[emailTemplateEmailChange]
+	if !ok {
+		log.Error().Msg("Email change email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	data := map[string]interface{}{
+		"emailChangeLink": emailChangeLink,
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute email change email template")
+		return err
+	}
+
+	e := email.NewEmail()
+
+	e.From = m.Config.DefaultSender
+	e.To = []string{to}
+	e.Subject = "Confirm your new email address"
+	e.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Str("emailChangeLink", emailChangeLink).Msg("Sending has been disabled in mailer config, skipping email change email")
+		return nil
+	}
+
+	if err := m.Transport.Send(e); err != nil {
+		log.Debug().Err(err).Msg("Failed to send email change email")
+		return err
+	}
+
+	log.Debug().Msg("Successfully sent email change email")
+
+	return nil
+}
+
+func (m *Mailer) SendEmailChanged(ctx context.Context, to string, newEmail string, emailChangeRevertLink string) error {
+	log := util.LogFromContext(ctx).With().Str("component", "mailer").Str("email_template", emailTemplateEmailChanged).Logger()
+
+	t, ok := m.Templates[emailTemplateEmailChanged]
+	if !ok {
+		log.Error().Msg("Email changed email template not found")
+		return ErrEmailTemplateNotFound
+	}
+
+	data := map[string]interface{}{
+		"newEmail":              newEmail,
+		"emailChangeRevertLink": emailChangeRevertLink,
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		log.Error().Err(err).Msg("Failed to execute email changed email template")
+		return err
+	}
+
+	e := email.NewEmail()
+
+	e.From = m.Config.DefaultSender
+	e.To = []string{to}
+	e.Subject = "Your email address has been changed"
+	e.HTML = buf.Bytes()
+
+	if !m.Config.Send {
+		log.Warn().Str("to", to).Str("emailChangeRevertLink", emailChangeRevertLink).Msg("Sending has been disabled in mailer config, skipping email changed email")
+		return nil
+	}
+
+	if err := m.Transport.Send(e); err != nil {
+		log.Debug().Err(err).Msg("Failed to send email changed email")
+		return err
+	}
+
+	log.Debug().Msg("Successfully sent email changed email")
+
+	return nil
+}
@@ -34,6 +34,63 @@ func TestMailerSendPasswordReset(t *testing.T) {
 	assert.Contains(t, string(mail.HTML), passwordResetLink)
 }
 
+func TestMailerSendEmailVerification(t *testing.T) {
+	ctx := context.Background()
+	fixtures := test.Fixtures()
+
+	m := test.NewTestMailer(t)
+	emailVerificationLink := "http://localhost/verify-email?token=12345"
+	err := m.SendEmailVerification(ctx, fixtures.User1.Username.String, emailVerificationLink)
+	require.NoError(t, err)
+
+	mt := test.GetTestMailerMockTransport(t, m)
+	mail := mt.GetLastSentMail()
+	require.NotNil(t, mail)
+	assert.Equal(t, test.TestMailerDefaultSender, mail.From)
+	assert.Len(t, mail.To, 1)
+	assert.Equal(t, fixtures.User1.Username.String, mail.To[0])
+	assert.Equal(t, "Verify your email address", mail.Subject)
+	assert.Contains(t, string(mail.HTML), "http://localhost/verify-email?token=12345")
+}
+
+func TestMailerSendEmailChange(t *testing.T) {
+	ctx := context.Background()
+
+	m := test.NewTestMailer(t)
+	emailChangeLink := "http://localhost/change-email?token=12345"
+	err := m.SendEmailChange(ctx, "new@example.com", emailChangeLink)
+	require.NoError(t, err)
+
+	mt := test.GetTestMailerMockTransport(t, m)
+	mail := mt.GetLastSentMail()
+	require.NotNil(t, mail)
+	assert.Equal(t, test.TestMailerDefaultSender, mail.From)
+	assert.Len(t, mail.To, 1)
+	assert.Equal(t, "new@example.com", mail.To[0])
+	assert.Equal(t, "Confirm your new email address", mail.Subject)
+	assert.Contains(t, string(mail.HTML), "http://localhost/change-email?token=12345")
+}
+
+func TestMailerSendEmailChanged(t *testing.T) {
+	ctx := context.Background()
+	fixtures := test.Fixtures()
+
+	m := test.NewTestMailer(t)
+	emailChangeRevertLink := "http://localhost/change-email/revert?token=12345"
+	err := m.SendEmailChanged(ctx, fixtures.User1.Username.String, "new@example.com", emailChangeRevertLink)
+	require.NoError(t, err)
+
+	mt := test.GetTestMailerMockTransport(t, m)
+	mail := mt.GetLastSentMail()
+	require.NotNil(t, mail)
+	assert.Equal(t, test.TestMailerDefaultSender, mail.From)
+	assert.Len(t, mail.To, 1)
+	assert.Equal(t, fixtures.User1.Username.String, mail.To[0])
+	assert.Equal(t, "Your email address has been changed", mail.Subject)
+	assert.Contains(t, string(mail.HTML), "new@example.com")
+	assert.Contains(t, string(mail.HTML), "http://localhost/change-email/revert?token=12345")
+}
+
 func SkipTestMailerSendPasswordResetWithMailhog(t *testing.T) {
 	t.Skip()
 	ctx := context.Background()
@@ -14,51 +14,68 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/strmangle"
 )
 
 // AccessToken is an object representing the database table.
 type AccessToken struct {
-	Token      string    `boil:"token" json:"token" toml:"token" yaml:"token"`
-	ValidUntil time.Time `boil:"valid_until" json:"valid_until" toml:"valid_until" yaml:"valid_until"`
-	UserID     string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Token                string            `boil:"token" json:"token" toml:"token" yaml:"token"`
+	ValidUntil           time.Time         `boil:"valid_until" json:"valid_until" toml:"valid_until" yaml:"valid_until"`
+	UserID               string            `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt            time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt            time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	RefreshTokenFamilyID null.String       `boil:"refresh_token_family_id" json:"refresh_token_family_id,omitempty" toml:"refresh_token_family_id" yaml:"refresh_token_family_id,omitempty"`
+	OauthClientID        null.String       `boil:"oauth_client_id" json:"oauth_client_id,omitempty" toml:"oauth_client_id" yaml:"oauth_client_id,omitempty"`
+	Scopes               types.StringArray `boil:"scopes" json:"scopes,omitempty" toml:"scopes" yaml:"scopes,omitempty"`
 
 	R *accessTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L accessTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AccessTokenColumns = struct {
-	Token      string
-	ValidUntil string
-	UserID     string
-	CreatedAt  string
-	UpdatedAt  string
+	Token                string
+	ValidUntil           string
+	UserID               string
+	CreatedAt            string
+	UpdatedAt            string
+	RefreshTokenFamilyID string
+	OauthClientID        string
+	Scopes               string
 }{
-	Token:      "token",
-	ValidUntil: "valid_until",
-	UserID:     "user_id",
-	CreatedAt:  "created_at",
-	UpdatedAt:  "updated_at",
+	Token:                "token",
+	ValidUntil:           "valid_until",
+	UserID:               "user_id",
+	CreatedAt:            "created_at",
+	UpdatedAt:            "updated_at",
+	RefreshTokenFamilyID: "refresh_token_family_id",
+	OauthClientID:        "oauth_client_id",
+	Scopes:               "scopes",
 }
 
 var AccessTokenTableColumns = struct {
-	Token      string
-	ValidUntil string
-	UserID     string
-	CreatedAt  string
-	UpdatedAt  string
+	Token                string
+	ValidUntil           string
+	UserID               string
+	CreatedAt            string
+	UpdatedAt            string
+	RefreshTokenFamilyID string
+	OauthClientID        string
+	Scopes               string
 }{
-	Token:      "access_tokens.token",
-	ValidUntil: "access_tokens.valid_until",
-	UserID:     "access_tokens.user_id",
-	CreatedAt:  "access_tokens.created_at",
-	UpdatedAt:  "access_tokens.updated_at",
+	Token:                "access_tokens.token",
+	ValidUntil:           "access_tokens.valid_until",
+	UserID:               "access_tokens.user_id",
+	CreatedAt:            "access_tokens.created_at",
+	UpdatedAt:            "access_tokens.updated_at",
+	RefreshTokenFamilyID: "access_tokens.refresh_token_family_id",
+	OauthClientID:        "access_tokens.oauth_client_id",
+	Scopes:               "access_tokens.scopes",
 }
 
 // Generated where
@@ -107,30 +124,103 @@ func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpertypes_StringArray) IsNull() qm.QueryMod { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpertypes_StringArray) IsNotNull() qm.QueryMod {
+	return qmhelper.WhereIsNotNull(w.field)
+}
+
 var AccessTokenWhere = struct {
-	Token      whereHelperstring
-	ValidUntil whereHelpertime_Time
-	UserID     whereHelperstring
-	CreatedAt  whereHelpertime_Time
-	UpdatedAt  whereHelpertime_Time
+	Token                whereHelperstring
+	ValidUntil           whereHelpertime_Time
+	UserID               whereHelperstring
+	CreatedAt            whereHelpertime_Time
+	UpdatedAt            whereHelpertime_Time
+	RefreshTokenFamilyID whereHelpernull_String
+	OauthClientID        whereHelpernull_String
+	Scopes               whereHelpertypes_StringArray
 }{
-	Token:      whereHelperstring{field: "\"access_tokens\".\"token\""},
-	ValidUntil: whereHelpertime_Time{field: "\"access_tokens\".\"valid_until\""},
-	UserID:     whereHelperstring{field: "\"access_tokens\".\"user_id\""},
-	CreatedAt:  whereHelpertime_Time{field: "\"access_tokens\".\"created_at\""},
-	UpdatedAt:  whereHelpertime_Time{field: "\"access_tokens\".\"updated_at\""},
+	Token:                whereHelperstring{field: "\"access_tokens\".\"token\""},
+	ValidUntil:           whereHelpertime_Time{field: "\"access_tokens\".\"valid_until\""},
+	UserID:               whereHelperstring{field: "\"access_tokens\".\"user_id\""},
+	CreatedAt:            whereHelpertime_Time{field: "\"access_tokens\".\"created_at\""},
+	UpdatedAt:            whereHelpertime_Time{field: "\"access_tokens\".\"updated_at\""},
+	RefreshTokenFamilyID: whereHelpernull_String{field: "\"access_tokens\".\"refresh_token_family_id\""},
+	OauthClientID:        whereHelpernull_String{field: "\"access_tokens\".\"oauth_client_id\""},
+	Scopes:               whereHelpertypes_StringArray{field: "\"access_tokens\".\"scopes\""},
 }
 
 // AccessTokenRels is where relationship names are stored.
 var AccessTokenRels = struct {
-	User string
+	OauthClient string
+	User        string
 }{
-	User: "User",
+	OauthClient: "OauthClient",
+	User:        "User",
 }
 
 // accessTokenR is where relationships are stored.
 type accessTokenR struct {
-	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	OauthClient *OauthClient `boil:"OauthClient" json:"OauthClient" toml:"OauthClient" yaml:"OauthClient"`
+	User        *User        `boil:"User" json:"User" toml:"User" yaml:"User"`
 }
 
 // NewStruct creates a new relationship struct
@@ -138,6 +228,13 @@ func (*accessTokenR) NewStruct() *accessTokenR {
 	return &accessTokenR{}
 }
 
+func (r *accessTokenR) GetOauthClient() *OauthClient {
+	if r == nil {
+		return nil
+	}
+	return r.OauthClient
+}
+
 func (r *accessTokenR) GetUser() *User {
 	if r == nil {
 		return nil
@@ -149,9 +246,9 @@ func (r *accessTokenR) GetUser() *User {
 type accessTokenL struct{}
 
 var (
-	accessTokenAllColumns            = []string{"token", "valid_until", "user_id", "created_at", "updated_at"}
+	accessTokenAllColumns            = []string{"token", "valid_until", "user_id", "created_at", "updated_at", "refresh_token_family_id", "oauth_client_id", "scopes"}
 	accessTokenColumnsWithoutDefault = []string{"valid_until", "user_id", "created_at", "updated_at"}
-	accessTokenColumnsWithDefault    = []string{"token"}
+	accessTokenColumnsWithDefault    = []string{"token", "refresh_token_family_id", "oauth_client_id", "scopes"}
 	accessTokenPrimaryKeyColumns     = []string{"token"}
 	accessTokenGeneratedColumns      = []string{}
 )
@@ -247,6 +344,17 @@ func (q accessTokenQuery) Exists(ctx context.Context, exec boil.ContextExecutor)
 	return count > 0, nil
 }
 
+// OauthClient pointed to by the foreign key.
+func (o *AccessToken) OauthClient(mods ...qm.QueryMod) oauthClientQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OauthClientID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return OauthClients(queryMods...)
+}
+
 // User pointed to by the foreign key.
 func (o *AccessToken) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -258,6 +366,122 @@ func (o *AccessToken) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// LoadOauthClient allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (accessTokenL) LoadOauthClient(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccessToken interface{}, mods queries.Applicator) error {
+	var slice []*AccessToken
+	var object *AccessToken
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccessToken.(*AccessToken)
+		if !ok {
+			object = new(AccessToken)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccessToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccessToken))
+			}
+		}
+	} else {
+		s, ok := maybeAccessToken.(*[]*AccessToken)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccessToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccessToken))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accessTokenR{}
+		}
+		if !queries.IsNil(object.OauthClientID) {
+			args = append(args, object.OauthClientID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accessTokenR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.OauthClientID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.OauthClientID) {
+				args = append(args, obj.OauthClientID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oauth_clients`),
+		qm.WhereIn(`oauth_clients.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load OauthClient")
+	}
+
+	var resultSlice []*OauthClient
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice OauthClient")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for oauth_clients")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oauth_clients")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OauthClient = foreign
+		if foreign.R == nil {
+			foreign.R = &oauthClientR{}
+		}
+		foreign.R.AccessTokens = append(foreign.R.AccessTokens, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.OauthClientID, foreign.ID) {
+				local.R.OauthClient = foreign
+				if foreign.R == nil {
+					foreign.R = &oauthClientR{}
+				}
+				foreign.R.AccessTokens = append(foreign.R.AccessTokens, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (accessTokenL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeAccessToken interface{}, mods queries.Applicator) error {
@@ -370,6 +594,86 @@ func (accessTokenL) LoadUser(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
+// SetOauthClient of the accessToken to the related item.
+// Sets o.R.OauthClient to related.
+// Adds o to related.R.AccessTokens.
+func (o *AccessToken) SetOauthClient(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OauthClient) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"access_tokens\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"oauth_client_id"}),
+		strmangle.WhereClause("\"", "\"", 2, accessTokenPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Token}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OauthClientID, related.ID)
+	if o.R == nil {
+		o.R = &accessTokenR{
+			OauthClient: related,
+		}
+	} else {
+		o.R.OauthClient = related
+	}
+
+	if related.R == nil {
+		related.R = &oauthClientR{
+			AccessTokens: AccessTokenSlice{o},
+		}
+	} else {
+		related.R.AccessTokens = append(related.R.AccessTokens, o)
+	}
+
+	return nil
+}
+
+// RemoveOauthClient relationship.
+// Sets o.R.OauthClient to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *AccessToken) RemoveOauthClient(ctx context.Context, exec boil.ContextExecutor, related *OauthClient) error {
+	var err error
+
+	queries.SetScanner(&o.OauthClientID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("oauth_client_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.OauthClient = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AccessTokens {
+		if queries.Equal(o.OauthClientID, ri.OauthClientID) {
+			continue
+		}
+
+		ln := len(related.R.AccessTokens)
+		if ln > 1 && i < ln-1 {
+			related.R.AccessTokens[i] = related.R.AccessTokens[ln-1]
+		}
+		related.R.AccessTokens = related.R.AccessTokens[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetUser of the accessToken to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.AccessTokens.
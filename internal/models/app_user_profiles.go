@@ -24,10 +24,16 @@ import (
 
 // AppUserProfile is an object representing the database table.
 type AppUserProfile struct {
-	UserID          string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	LegalAcceptedAt null.Time `boil:"legal_accepted_at" json:"legal_accepted_at,omitempty" toml:"legal_accepted_at" yaml:"legal_accepted_at,omitempty"`
-	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt       time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	UserID          string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	LegalAcceptedAt null.Time   `boil:"legal_accepted_at" json:"legal_accepted_at,omitempty" toml:"legal_accepted_at" yaml:"legal_accepted_at,omitempty"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DisplayName     null.String `boil:"display_name" json:"display_name,omitempty" toml:"display_name" yaml:"display_name,omitempty"`
+	GivenName       null.String `boil:"given_name" json:"given_name,omitempty" toml:"given_name" yaml:"given_name,omitempty"`
+	FamilyName      null.String `boil:"family_name" json:"family_name,omitempty" toml:"family_name" yaml:"family_name,omitempty"`
+	Locale          null.String `boil:"locale" json:"locale,omitempty" toml:"locale" yaml:"locale,omitempty"`
+	AvatarFileName  null.String `boil:"avatar_file_name" json:"avatar_file_name,omitempty" toml:"avatar_file_name" yaml:"avatar_file_name,omitempty"`
+	AvatarMimeType  null.String `boil:"avatar_mime_type" json:"avatar_mime_type,omitempty" toml:"avatar_mime_type" yaml:"avatar_mime_type,omitempty"`
 
 	R *appUserProfileR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L appUserProfileL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -38,11 +44,23 @@ var AppUserProfileColumns = struct {
 	LegalAcceptedAt string
 	CreatedAt       string
 	UpdatedAt       string
+	DisplayName     string
+	GivenName       string
+	FamilyName      string
+	Locale          string
+	AvatarFileName  string
+	AvatarMimeType  string
 }{
 	UserID:          "user_id",
 	LegalAcceptedAt: "legal_accepted_at",
 	CreatedAt:       "created_at",
 	UpdatedAt:       "updated_at",
+	DisplayName:     "display_name",
+	GivenName:       "given_name",
+	FamilyName:      "family_name",
+	Locale:          "locale",
+	AvatarFileName:  "avatar_file_name",
+	AvatarMimeType:  "avatar_mime_type",
 }
 
 var AppUserProfileTableColumns = struct {
@@ -50,49 +68,49 @@ var AppUserProfileTableColumns = struct {
 	LegalAcceptedAt string
 	CreatedAt       string
 	UpdatedAt       string
+	DisplayName     string
+	GivenName       string
+	FamilyName      string
+	Locale          string
+	AvatarFileName  string
+	AvatarMimeType  string
 }{
 	UserID:          "app_user_profiles.user_id",
 	LegalAcceptedAt: "app_user_profiles.legal_accepted_at",
 	CreatedAt:       "app_user_profiles.created_at",
 	UpdatedAt:       "app_user_profiles.updated_at",
+	DisplayName:     "app_user_profiles.display_name",
+	GivenName:       "app_user_profiles.given_name",
+	FamilyName:      "app_user_profiles.family_name",
+	Locale:          "app_user_profiles.locale",
+	AvatarFileName:  "app_user_profiles.avatar_file_name",
+	AvatarMimeType:  "app_user_profiles.avatar_mime_type",
 }
 
 // Generated where
 
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var AppUserProfileWhere = struct {
 	UserID          whereHelperstring
 	LegalAcceptedAt whereHelpernull_Time
 	CreatedAt       whereHelpertime_Time
 	UpdatedAt       whereHelpertime_Time
+	DisplayName     whereHelpernull_String
+	GivenName       whereHelpernull_String
+	FamilyName      whereHelpernull_String
+	Locale          whereHelpernull_String
+	AvatarFileName  whereHelpernull_String
+	AvatarMimeType  whereHelpernull_String
 }{
 	UserID:          whereHelperstring{field: "\"app_user_profiles\".\"user_id\""},
 	LegalAcceptedAt: whereHelpernull_Time{field: "\"app_user_profiles\".\"legal_accepted_at\""},
 	CreatedAt:       whereHelpertime_Time{field: "\"app_user_profiles\".\"created_at\""},
 	UpdatedAt:       whereHelpertime_Time{field: "\"app_user_profiles\".\"updated_at\""},
+	DisplayName:     whereHelpernull_String{field: "\"app_user_profiles\".\"display_name\""},
+	GivenName:       whereHelpernull_String{field: "\"app_user_profiles\".\"given_name\""},
+	FamilyName:      whereHelpernull_String{field: "\"app_user_profiles\".\"family_name\""},
+	Locale:          whereHelpernull_String{field: "\"app_user_profiles\".\"locale\""},
+	AvatarFileName:  whereHelpernull_String{field: "\"app_user_profiles\".\"avatar_file_name\""},
+	AvatarMimeType:  whereHelpernull_String{field: "\"app_user_profiles\".\"avatar_mime_type\""},
 }
 
 // AppUserProfileRels is where relationship names are stored.
@@ -123,9 +141,9 @@ func (r *appUserProfileR) GetUser() *User {
 type appUserProfileL struct{}
 
 var (
-	appUserProfileAllColumns            = []string{"user_id", "legal_accepted_at", "created_at", "updated_at"}
+	appUserProfileAllColumns            = []string{"user_id", "legal_accepted_at", "created_at", "updated_at", "display_name", "given_name", "family_name", "locale", "avatar_file_name", "avatar_mime_type"}
 	appUserProfileColumnsWithoutDefault = []string{"user_id", "created_at", "updated_at"}
-	appUserProfileColumnsWithDefault    = []string{"legal_accepted_at"}
+	appUserProfileColumnsWithDefault    = []string{"legal_accepted_at", "display_name", "given_name", "family_name", "locale", "avatar_file_name", "avatar_mime_type"}
 	appUserProfilePrimaryKeyColumns     = []string{"user_id"}
 	appUserProfileGeneratedColumns      = []string{}
 )
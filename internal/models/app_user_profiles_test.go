@@ -532,7 +532,7 @@ func testAppUserProfilesSelect(t *testing.T) {
 }
 
 var (
-	appUserProfileDBTypes = map[string]string{`UserID`: `uuid`, `LegalAcceptedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	appUserProfileDBTypes = map[string]string{`UserID`: `uuid`, `LegalAcceptedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `DisplayName`: `text`, `GivenName`: `text`, `FamilyName`: `text`, `Locale`: `text`, `AvatarFileName`: `text`, `AvatarMimeType`: `text`}
 	_                     = bytes.MinRead
 )
 
@@ -13,105 +13,357 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokens)
+	t.Run("APIKeys", testAPIKeys)
 	t.Run("AppUserProfiles", testAppUserProfiles)
+	t.Run("AuthAttempts", testAuthAttempts)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokens)
+	t.Run("EmailChangeTokens", testEmailChangeTokens)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokens)
+	t.Run("Identities", testIdentities)
+	t.Run("LegalAcceptances", testLegalAcceptances)
+	t.Run("LegalDocuments", testLegalDocuments)
+	t.Run("MfaChallenges", testMfaChallenges)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodes)
+	t.Run("OauthClients", testOauthClients)
 	t.Run("PasswordResetTokens", testPasswordResetTokens)
+	t.Run("PushCampaigns", testPushCampaigns)
+	t.Run("PushDeliveries", testPushDeliveries)
+	t.Run("PushMessages", testPushMessages)
 	t.Run("PushTokens", testPushTokens)
+	t.Run("RateLimitCounters", testRateLimitCounters)
+	t.Run("RecoveryCodes", testRecoveryCodes)
 	t.Run("RefreshTokens", testRefreshTokens)
+	t.Run("Roles", testRoles)
+	t.Run("Sessions", testSessions)
+	t.Run("TotpCredentials", testTotpCredentials)
+	t.Run("Uploads", testUploads)
+	t.Run("UserRoles", testUserRoles)
 	t.Run("Users", testUsers)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensDelete)
+	t.Run("APIKeys", testAPIKeysDelete)
 	t.Run("AppUserProfiles", testAppUserProfilesDelete)
+	t.Run("AuthAttempts", testAuthAttemptsDelete)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensDelete)
+	t.Run("EmailChangeTokens", testEmailChangeTokensDelete)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensDelete)
+	t.Run("Identities", testIdentitiesDelete)
+	t.Run("LegalAcceptances", testLegalAcceptancesDelete)
+	t.Run("LegalDocuments", testLegalDocumentsDelete)
+	t.Run("MfaChallenges", testMfaChallengesDelete)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesDelete)
+	t.Run("OauthClients", testOauthClientsDelete)
 	t.Run("PasswordResetTokens", testPasswordResetTokensDelete)
+	t.Run("PushCampaigns", testPushCampaignsDelete)
+	t.Run("PushDeliveries", testPushDeliveriesDelete)
+	t.Run("PushMessages", testPushMessagesDelete)
 	t.Run("PushTokens", testPushTokensDelete)
+	t.Run("RateLimitCounters", testRateLimitCountersDelete)
+	t.Run("RecoveryCodes", testRecoveryCodesDelete)
 	t.Run("RefreshTokens", testRefreshTokensDelete)
+	t.Run("Roles", testRolesDelete)
+	t.Run("Sessions", testSessionsDelete)
+	t.Run("TotpCredentials", testTotpCredentialsDelete)
+	t.Run("Uploads", testUploadsDelete)
+	t.Run("UserRoles", testUserRolesDelete)
 	t.Run("Users", testUsersDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensQueryDeleteAll)
+	t.Run("APIKeys", testAPIKeysQueryDeleteAll)
 	t.Run("AppUserProfiles", testAppUserProfilesQueryDeleteAll)
+	t.Run("AuthAttempts", testAuthAttemptsQueryDeleteAll)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensQueryDeleteAll)
+	t.Run("EmailChangeTokens", testEmailChangeTokensQueryDeleteAll)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensQueryDeleteAll)
+	t.Run("Identities", testIdentitiesQueryDeleteAll)
+	t.Run("LegalAcceptances", testLegalAcceptancesQueryDeleteAll)
+	t.Run("LegalDocuments", testLegalDocumentsQueryDeleteAll)
+	t.Run("MfaChallenges", testMfaChallengesQueryDeleteAll)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesQueryDeleteAll)
+	t.Run("OauthClients", testOauthClientsQueryDeleteAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensQueryDeleteAll)
+	t.Run("PushCampaigns", testPushCampaignsQueryDeleteAll)
+	t.Run("PushDeliveries", testPushDeliveriesQueryDeleteAll)
+	t.Run("PushMessages", testPushMessagesQueryDeleteAll)
 	t.Run("PushTokens", testPushTokensQueryDeleteAll)
+	t.Run("RateLimitCounters", testRateLimitCountersQueryDeleteAll)
+	t.Run("RecoveryCodes", testRecoveryCodesQueryDeleteAll)
 	t.Run("RefreshTokens", testRefreshTokensQueryDeleteAll)
+	t.Run("Roles", testRolesQueryDeleteAll)
+	t.Run("Sessions", testSessionsQueryDeleteAll)
+	t.Run("TotpCredentials", testTotpCredentialsQueryDeleteAll)
+	t.Run("Uploads", testUploadsQueryDeleteAll)
+	t.Run("UserRoles", testUserRolesQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensSliceDeleteAll)
+	t.Run("APIKeys", testAPIKeysSliceDeleteAll)
 	t.Run("AppUserProfiles", testAppUserProfilesSliceDeleteAll)
+	t.Run("AuthAttempts", testAuthAttemptsSliceDeleteAll)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensSliceDeleteAll)
+	t.Run("EmailChangeTokens", testEmailChangeTokensSliceDeleteAll)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensSliceDeleteAll)
+	t.Run("Identities", testIdentitiesSliceDeleteAll)
+	t.Run("LegalAcceptances", testLegalAcceptancesSliceDeleteAll)
+	t.Run("LegalDocuments", testLegalDocumentsSliceDeleteAll)
+	t.Run("MfaChallenges", testMfaChallengesSliceDeleteAll)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesSliceDeleteAll)
+	t.Run("OauthClients", testOauthClientsSliceDeleteAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensSliceDeleteAll)
+	t.Run("PushCampaigns", testPushCampaignsSliceDeleteAll)
+	t.Run("PushDeliveries", testPushDeliveriesSliceDeleteAll)
+	t.Run("PushMessages", testPushMessagesSliceDeleteAll)
 	t.Run("PushTokens", testPushTokensSliceDeleteAll)
+	t.Run("RateLimitCounters", testRateLimitCountersSliceDeleteAll)
+	t.Run("RecoveryCodes", testRecoveryCodesSliceDeleteAll)
 	t.Run("RefreshTokens", testRefreshTokensSliceDeleteAll)
+	t.Run("Roles", testRolesSliceDeleteAll)
+	t.Run("Sessions", testSessionsSliceDeleteAll)
+	t.Run("TotpCredentials", testTotpCredentialsSliceDeleteAll)
+	t.Run("Uploads", testUploadsSliceDeleteAll)
+	t.Run("UserRoles", testUserRolesSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensExists)
+	t.Run("APIKeys", testAPIKeysExists)
 	t.Run("AppUserProfiles", testAppUserProfilesExists)
+	t.Run("AuthAttempts", testAuthAttemptsExists)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensExists)
+	t.Run("EmailChangeTokens", testEmailChangeTokensExists)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensExists)
+	t.Run("Identities", testIdentitiesExists)
+	t.Run("LegalAcceptances", testLegalAcceptancesExists)
+	t.Run("LegalDocuments", testLegalDocumentsExists)
+	t.Run("MfaChallenges", testMfaChallengesExists)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesExists)
+	t.Run("OauthClients", testOauthClientsExists)
 	t.Run("PasswordResetTokens", testPasswordResetTokensExists)
+	t.Run("PushCampaigns", testPushCampaignsExists)
+	t.Run("PushDeliveries", testPushDeliveriesExists)
+	t.Run("PushMessages", testPushMessagesExists)
 	t.Run("PushTokens", testPushTokensExists)
+	t.Run("RateLimitCounters", testRateLimitCountersExists)
+	t.Run("RecoveryCodes", testRecoveryCodesExists)
 	t.Run("RefreshTokens", testRefreshTokensExists)
+	t.Run("Roles", testRolesExists)
+	t.Run("Sessions", testSessionsExists)
+	t.Run("TotpCredentials", testTotpCredentialsExists)
+	t.Run("Uploads", testUploadsExists)
+	t.Run("UserRoles", testUserRolesExists)
 	t.Run("Users", testUsersExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensFind)
+	t.Run("APIKeys", testAPIKeysFind)
 	t.Run("AppUserProfiles", testAppUserProfilesFind)
+	t.Run("AuthAttempts", testAuthAttemptsFind)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensFind)
+	t.Run("EmailChangeTokens", testEmailChangeTokensFind)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensFind)
+	t.Run("Identities", testIdentitiesFind)
+	t.Run("LegalAcceptances", testLegalAcceptancesFind)
+	t.Run("LegalDocuments", testLegalDocumentsFind)
+	t.Run("MfaChallenges", testMfaChallengesFind)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesFind)
+	t.Run("OauthClients", testOauthClientsFind)
 	t.Run("PasswordResetTokens", testPasswordResetTokensFind)
+	t.Run("PushCampaigns", testPushCampaignsFind)
+	t.Run("PushDeliveries", testPushDeliveriesFind)
+	t.Run("PushMessages", testPushMessagesFind)
 	t.Run("PushTokens", testPushTokensFind)
+	t.Run("RateLimitCounters", testRateLimitCountersFind)
+	t.Run("RecoveryCodes", testRecoveryCodesFind)
 	t.Run("RefreshTokens", testRefreshTokensFind)
+	t.Run("Roles", testRolesFind)
+	t.Run("Sessions", testSessionsFind)
+	t.Run("TotpCredentials", testTotpCredentialsFind)
+	t.Run("Uploads", testUploadsFind)
+	t.Run("UserRoles", testUserRolesFind)
 	t.Run("Users", testUsersFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensBind)
+	t.Run("APIKeys", testAPIKeysBind)
 	t.Run("AppUserProfiles", testAppUserProfilesBind)
+	t.Run("AuthAttempts", testAuthAttemptsBind)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensBind)
+	t.Run("EmailChangeTokens", testEmailChangeTokensBind)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensBind)
+	t.Run("Identities", testIdentitiesBind)
+	t.Run("LegalAcceptances", testLegalAcceptancesBind)
+	t.Run("LegalDocuments", testLegalDocumentsBind)
+	t.Run("MfaChallenges", testMfaChallengesBind)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesBind)
+	t.Run("OauthClients", testOauthClientsBind)
 	t.Run("PasswordResetTokens", testPasswordResetTokensBind)
+	t.Run("PushCampaigns", testPushCampaignsBind)
+	t.Run("PushDeliveries", testPushDeliveriesBind)
+	t.Run("PushMessages", testPushMessagesBind)
 	t.Run("PushTokens", testPushTokensBind)
+	t.Run("RateLimitCounters", testRateLimitCountersBind)
+	t.Run("RecoveryCodes", testRecoveryCodesBind)
 	t.Run("RefreshTokens", testRefreshTokensBind)
+	t.Run("Roles", testRolesBind)
+	t.Run("Sessions", testSessionsBind)
+	t.Run("TotpCredentials", testTotpCredentialsBind)
+	t.Run("Uploads", testUploadsBind)
+	t.Run("UserRoles", testUserRolesBind)
 	t.Run("Users", testUsersBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensOne)
+	t.Run("APIKeys", testAPIKeysOne)
 	t.Run("AppUserProfiles", testAppUserProfilesOne)
+	t.Run("AuthAttempts", testAuthAttemptsOne)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensOne)
+	t.Run("EmailChangeTokens", testEmailChangeTokensOne)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensOne)
+	t.Run("Identities", testIdentitiesOne)
+	t.Run("LegalAcceptances", testLegalAcceptancesOne)
+	t.Run("LegalDocuments", testLegalDocumentsOne)
+	t.Run("MfaChallenges", testMfaChallengesOne)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesOne)
+	t.Run("OauthClients", testOauthClientsOne)
 	t.Run("PasswordResetTokens", testPasswordResetTokensOne)
+	t.Run("PushCampaigns", testPushCampaignsOne)
+	t.Run("PushDeliveries", testPushDeliveriesOne)
+	t.Run("PushMessages", testPushMessagesOne)
 	t.Run("PushTokens", testPushTokensOne)
+	t.Run("RateLimitCounters", testRateLimitCountersOne)
+	t.Run("RecoveryCodes", testRecoveryCodesOne)
 	t.Run("RefreshTokens", testRefreshTokensOne)
+	t.Run("Roles", testRolesOne)
+	t.Run("Sessions", testSessionsOne)
+	t.Run("TotpCredentials", testTotpCredentialsOne)
+	t.Run("Uploads", testUploadsOne)
+	t.Run("UserRoles", testUserRolesOne)
 	t.Run("Users", testUsersOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensAll)
+	t.Run("APIKeys", testAPIKeysAll)
 	t.Run("AppUserProfiles", testAppUserProfilesAll)
+	t.Run("AuthAttempts", testAuthAttemptsAll)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensAll)
+	t.Run("EmailChangeTokens", testEmailChangeTokensAll)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensAll)
+	t.Run("Identities", testIdentitiesAll)
+	t.Run("LegalAcceptances", testLegalAcceptancesAll)
+	t.Run("LegalDocuments", testLegalDocumentsAll)
+	t.Run("MfaChallenges", testMfaChallengesAll)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesAll)
+	t.Run("OauthClients", testOauthClientsAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensAll)
+	t.Run("PushCampaigns", testPushCampaignsAll)
+	t.Run("PushDeliveries", testPushDeliveriesAll)
+	t.Run("PushMessages", testPushMessagesAll)
 	t.Run("PushTokens", testPushTokensAll)
+	t.Run("RateLimitCounters", testRateLimitCountersAll)
+	t.Run("RecoveryCodes", testRecoveryCodesAll)
 	t.Run("RefreshTokens", testRefreshTokensAll)
+	t.Run("Roles", testRolesAll)
+	t.Run("Sessions", testSessionsAll)
+	t.Run("TotpCredentials", testTotpCredentialsAll)
+	t.Run("Uploads", testUploadsAll)
+	t.Run("UserRoles", testUserRolesAll)
 	t.Run("Users", testUsersAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensCount)
+	t.Run("APIKeys", testAPIKeysCount)
 	t.Run("AppUserProfiles", testAppUserProfilesCount)
+	t.Run("AuthAttempts", testAuthAttemptsCount)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensCount)
+	t.Run("EmailChangeTokens", testEmailChangeTokensCount)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensCount)
+	t.Run("Identities", testIdentitiesCount)
+	t.Run("LegalAcceptances", testLegalAcceptancesCount)
+	t.Run("LegalDocuments", testLegalDocumentsCount)
+	t.Run("MfaChallenges", testMfaChallengesCount)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesCount)
+	t.Run("OauthClients", testOauthClientsCount)
 	t.Run("PasswordResetTokens", testPasswordResetTokensCount)
+	t.Run("PushCampaigns", testPushCampaignsCount)
+	t.Run("PushDeliveries", testPushDeliveriesCount)
+	t.Run("PushMessages", testPushMessagesCount)
 	t.Run("PushTokens", testPushTokensCount)
+	t.Run("RateLimitCounters", testRateLimitCountersCount)
+	t.Run("RecoveryCodes", testRecoveryCodesCount)
 	t.Run("RefreshTokens", testRefreshTokensCount)
+	t.Run("Roles", testRolesCount)
+	t.Run("Sessions", testSessionsCount)
+	t.Run("TotpCredentials", testTotpCredentialsCount)
+	t.Run("Uploads", testUploadsCount)
+	t.Run("UserRoles", testUserRolesCount)
 	t.Run("Users", testUsersCount)
 }
 
 func TestInsert(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensInsert)
 	t.Run("AccessTokens", testAccessTokensInsertWhitelist)
+	t.Run("APIKeys", testAPIKeysInsert)
+	t.Run("APIKeys", testAPIKeysInsertWhitelist)
 	t.Run("AppUserProfiles", testAppUserProfilesInsert)
 	t.Run("AppUserProfiles", testAppUserProfilesInsertWhitelist)
+	t.Run("AuthAttempts", testAuthAttemptsInsert)
+	t.Run("AuthAttempts", testAuthAttemptsInsertWhitelist)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensInsert)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensInsertWhitelist)
+	t.Run("EmailChangeTokens", testEmailChangeTokensInsert)
+	t.Run("EmailChangeTokens", testEmailChangeTokensInsertWhitelist)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensInsert)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensInsertWhitelist)
+	t.Run("Identities", testIdentitiesInsert)
+	t.Run("Identities", testIdentitiesInsertWhitelist)
+	t.Run("LegalAcceptances", testLegalAcceptancesInsert)
+	t.Run("LegalAcceptances", testLegalAcceptancesInsertWhitelist)
+	t.Run("LegalDocuments", testLegalDocumentsInsert)
+	t.Run("LegalDocuments", testLegalDocumentsInsertWhitelist)
+	t.Run("MfaChallenges", testMfaChallengesInsert)
+	t.Run("MfaChallenges", testMfaChallengesInsertWhitelist)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesInsert)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesInsertWhitelist)
+	t.Run("OauthClients", testOauthClientsInsert)
+	t.Run("OauthClients", testOauthClientsInsertWhitelist)
 	t.Run("PasswordResetTokens", testPasswordResetTokensInsert)
 	t.Run("PasswordResetTokens", testPasswordResetTokensInsertWhitelist)
+	t.Run("PushCampaigns", testPushCampaignsInsert)
+	t.Run("PushCampaigns", testPushCampaignsInsertWhitelist)
+	t.Run("PushDeliveries", testPushDeliveriesInsert)
+	t.Run("PushDeliveries", testPushDeliveriesInsertWhitelist)
+	t.Run("PushMessages", testPushMessagesInsert)
+	t.Run("PushMessages", testPushMessagesInsertWhitelist)
 	t.Run("PushTokens", testPushTokensInsert)
 	t.Run("PushTokens", testPushTokensInsertWhitelist)
+	t.Run("RateLimitCounters", testRateLimitCountersInsert)
+	t.Run("RateLimitCounters", testRateLimitCountersInsertWhitelist)
+	t.Run("RecoveryCodes", testRecoveryCodesInsert)
+	t.Run("RecoveryCodes", testRecoveryCodesInsertWhitelist)
 	t.Run("RefreshTokens", testRefreshTokensInsert)
 	t.Run("RefreshTokens", testRefreshTokensInsertWhitelist)
+	t.Run("Roles", testRolesInsert)
+	t.Run("Roles", testRolesInsertWhitelist)
+	t.Run("Sessions", testSessionsInsert)
+	t.Run("Sessions", testSessionsInsertWhitelist)
+	t.Run("TotpCredentials", testTotpCredentialsInsert)
+	t.Run("TotpCredentials", testTotpCredentialsInsertWhitelist)
+	t.Run("Uploads", testUploadsInsert)
+	t.Run("Uploads", testUploadsInsertWhitelist)
+	t.Run("UserRoles", testUserRolesInsert)
+	t.Run("UserRoles", testUserRolesInsertWhitelist)
 	t.Run("Users", testUsersInsert)
 	t.Run("Users", testUsersInsertWhitelist)
 }
@@ -119,46 +371,119 @@ func TestInsert(t *testing.T) {
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("AccessTokenToOauthClientUsingOauthClient", testAccessTokenToOneOauthClientUsingOauthClient)
 	t.Run("AccessTokenToUserUsingUser", testAccessTokenToOneUserUsingUser)
+	t.Run("APIKeyToUserUsingUser", testAPIKeyToOneUserUsingUser)
 	t.Run("AppUserProfileToUserUsingUser", testAppUserProfileToOneUserUsingUser)
+	t.Run("EmailChangeRevertTokenToUserUsingUser", testEmailChangeRevertTokenToOneUserUsingUser)
+	t.Run("EmailChangeTokenToUserUsingUser", testEmailChangeTokenToOneUserUsingUser)
+	t.Run("EmailVerificationTokenToUserUsingUser", testEmailVerificationTokenToOneUserUsingUser)
+	t.Run("IdentityToUserUsingUser", testIdentityToOneUserUsingUser)
+	t.Run("LegalAcceptanceToLegalDocumentUsingLegalDocument", testLegalAcceptanceToOneLegalDocumentUsingLegalDocument)
+	t.Run("LegalAcceptanceToUserUsingUser", testLegalAcceptanceToOneUserUsingUser)
+	t.Run("MfaChallengeToUserUsingUser", testMfaChallengeToOneUserUsingUser)
+	t.Run("OauthAuthorizationCodeToOauthClientUsingOauthClient", testOauthAuthorizationCodeToOneOauthClientUsingOauthClient)
+	t.Run("OauthAuthorizationCodeToUserUsingUser", testOauthAuthorizationCodeToOneUserUsingUser)
+	t.Run("OauthClientToUserUsingUser", testOauthClientToOneUserUsingUser)
 	t.Run("PasswordResetTokenToUserUsingUser", testPasswordResetTokenToOneUserUsingUser)
+	t.Run("PushDeliveryToPushMessageUsingPushMessage", testPushDeliveryToOnePushMessageUsingPushMessage)
+	t.Run("PushMessageToPushCampaignUsingPushCampaign", testPushMessageToOnePushCampaignUsingPushCampaign)
+	t.Run("PushMessageToUserUsingUser", testPushMessageToOneUserUsingUser)
 	t.Run("PushTokenToUserUsingUser", testPushTokenToOneUserUsingUser)
+	t.Run("RecoveryCodeToUserUsingUser", testRecoveryCodeToOneUserUsingUser)
+	t.Run("RefreshTokenToOauthClientUsingOauthClient", testRefreshTokenToOneOauthClientUsingOauthClient)
 	t.Run("RefreshTokenToUserUsingUser", testRefreshTokenToOneUserUsingUser)
+	t.Run("SessionToUserUsingUser", testSessionToOneUserUsingUser)
+	t.Run("TotpCredentialToUserUsingUser", testTotpCredentialToOneUserUsingUser)
+	t.Run("UploadToUserUsingUser", testUploadToOneUserUsingUser)
+	t.Run("UserRoleToRoleUsingRole", testUserRoleToOneRoleUsingRole)
+	t.Run("UserRoleToUserUsingUser", testUserRoleToOneUserUsingUser)
 }
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
 	t.Run("UserToAppUserProfileUsingAppUserProfile", testUserOneToOneAppUserProfileUsingAppUserProfile)
+	t.Run("UserToTotpCredentialUsingTotpCredential", testUserOneToOneTotpCredentialUsingTotpCredential)
 }
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("LegalDocumentToLegalAcceptances", testLegalDocumentToManyLegalAcceptances)
+	t.Run("OauthClientToAccessTokens", testOauthClientToManyAccessTokens)
+	t.Run("OauthClientToOauthAuthorizationCodes", testOauthClientToManyOauthAuthorizationCodes)
+	t.Run("OauthClientToRefreshTokens", testOauthClientToManyRefreshTokens)
+	t.Run("PushCampaignToPushMessages", testPushCampaignToManyPushMessages)
+	t.Run("PushMessageToPushDeliveries", testPushMessageToManyPushDeliveries)
+	t.Run("RoleToUserRoles", testRoleToManyUserRoles)
 	t.Run("UserToAccessTokens", testUserToManyAccessTokens)
+	t.Run("UserToAPIKeys", testUserToManyAPIKeys)
+	t.Run("UserToEmailChangeRevertTokens", testUserToManyEmailChangeRevertTokens)
+	t.Run("UserToEmailChangeTokens", testUserToManyEmailChangeTokens)
+	t.Run("UserToEmailVerificationTokens", testUserToManyEmailVerificationTokens)
+	t.Run("UserToIdentities", testUserToManyIdentities)
+	t.Run("UserToLegalAcceptances", testUserToManyLegalAcceptances)
+	t.Run("UserToMfaChallenges", testUserToManyMfaChallenges)
+	t.Run("UserToOauthAuthorizationCodes", testUserToManyOauthAuthorizationCodes)
+	t.Run("UserToOauthClients", testUserToManyOauthClients)
 	t.Run("UserToPasswordResetTokens", testUserToManyPasswordResetTokens)
+	t.Run("UserToPushMessages", testUserToManyPushMessages)
 	t.Run("UserToPushTokens", testUserToManyPushTokens)
+	t.Run("UserToRecoveryCodes", testUserToManyRecoveryCodes)
 	t.Run("UserToRefreshTokens", testUserToManyRefreshTokens)
+	t.Run("UserToSessions", testUserToManySessions)
+	t.Run("UserToUploads", testUserToManyUploads)
+	t.Run("UserToUserRoles", testUserToManyUserRoles)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("AccessTokenToOauthClientUsingAccessTokens", testAccessTokenToOneSetOpOauthClientUsingOauthClient)
 	t.Run("AccessTokenToUserUsingAccessTokens", testAccessTokenToOneSetOpUserUsingUser)
+	t.Run("APIKeyToUserUsingAPIKeys", testAPIKeyToOneSetOpUserUsingUser)
 	t.Run("AppUserProfileToUserUsingAppUserProfile", testAppUserProfileToOneSetOpUserUsingUser)
+	t.Run("EmailChangeRevertTokenToUserUsingEmailChangeRevertTokens", testEmailChangeRevertTokenToOneSetOpUserUsingUser)
+	t.Run("EmailChangeTokenToUserUsingEmailChangeTokens", testEmailChangeTokenToOneSetOpUserUsingUser)
+	t.Run("EmailVerificationTokenToUserUsingEmailVerificationTokens", testEmailVerificationTokenToOneSetOpUserUsingUser)
+	t.Run("IdentityToUserUsingIdentities", testIdentityToOneSetOpUserUsingUser)
+	t.Run("LegalAcceptanceToLegalDocumentUsingLegalAcceptances", testLegalAcceptanceToOneSetOpLegalDocumentUsingLegalDocument)
+	t.Run("LegalAcceptanceToUserUsingLegalAcceptances", testLegalAcceptanceToOneSetOpUserUsingUser)
+	t.Run("MfaChallengeToUserUsingMfaChallenges", testMfaChallengeToOneSetOpUserUsingUser)
+	t.Run("OauthAuthorizationCodeToOauthClientUsingOauthAuthorizationCodes", testOauthAuthorizationCodeToOneSetOpOauthClientUsingOauthClient)
+	t.Run("OauthAuthorizationCodeToUserUsingOauthAuthorizationCodes", testOauthAuthorizationCodeToOneSetOpUserUsingUser)
+	t.Run("OauthClientToUserUsingOauthClients", testOauthClientToOneSetOpUserUsingUser)
 	t.Run("PasswordResetTokenToUserUsingPasswordResetTokens", testPasswordResetTokenToOneSetOpUserUsingUser)
+	t.Run("PushDeliveryToPushMessageUsingPushDeliveries", testPushDeliveryToOneSetOpPushMessageUsingPushMessage)
+	t.Run("PushMessageToPushCampaignUsingPushMessages", testPushMessageToOneSetOpPushCampaignUsingPushCampaign)
+	t.Run("PushMessageToUserUsingPushMessages", testPushMessageToOneSetOpUserUsingUser)
 	t.Run("PushTokenToUserUsingPushTokens", testPushTokenToOneSetOpUserUsingUser)
+	t.Run("RecoveryCodeToUserUsingRecoveryCodes", testRecoveryCodeToOneSetOpUserUsingUser)
+	t.Run("RefreshTokenToOauthClientUsingRefreshTokens", testRefreshTokenToOneSetOpOauthClientUsingOauthClient)
 	t.Run("RefreshTokenToUserUsingRefreshTokens", testRefreshTokenToOneSetOpUserUsingUser)
+	t.Run("SessionToUserUsingSessions", testSessionToOneSetOpUserUsingUser)
+	t.Run("TotpCredentialToUserUsingTotpCredential", testTotpCredentialToOneSetOpUserUsingUser)
+	t.Run("UploadToUserUsingUploads", testUploadToOneSetOpUserUsingUser)
+	t.Run("UserRoleToRoleUsingUserRoles", testUserRoleToOneSetOpRoleUsingRole)
+	t.Run("UserRoleToUserUsingUserRoles", testUserRoleToOneSetOpUserUsingUser)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("AccessTokenToOauthClientUsingAccessTokens", testAccessTokenToOneRemoveOpOauthClientUsingOauthClient)
+	t.Run("OauthClientToUserUsingOauthClients", testOauthClientToOneRemoveOpUserUsingUser)
+	t.Run("PushMessageToPushCampaignUsingPushMessages", testPushMessageToOneRemoveOpPushCampaignUsingPushCampaign)
+	t.Run("PushMessageToUserUsingPushMessages", testPushMessageToOneRemoveOpUserUsingUser)
+	t.Run("RefreshTokenToOauthClientUsingRefreshTokens", testRefreshTokenToOneRemoveOpOauthClientUsingOauthClient)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
 	t.Run("UserToAppUserProfileUsingAppUserProfile", testUserOneToOneSetOpAppUserProfileUsingAppUserProfile)
+	t.Run("UserToTotpCredentialUsingTotpCredential", testUserOneToOneSetOpTotpCredentialUsingTotpCredential)
 }
 
 // TestOneToOneRemove tests cannot be run in parallel
@@ -168,61 +493,199 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("LegalDocumentToLegalAcceptances", testLegalDocumentToManyAddOpLegalAcceptances)
+	t.Run("OauthClientToAccessTokens", testOauthClientToManyAddOpAccessTokens)
+	t.Run("OauthClientToOauthAuthorizationCodes", testOauthClientToManyAddOpOauthAuthorizationCodes)
+	t.Run("OauthClientToRefreshTokens", testOauthClientToManyAddOpRefreshTokens)
+	t.Run("PushCampaignToPushMessages", testPushCampaignToManyAddOpPushMessages)
+	t.Run("PushMessageToPushDeliveries", testPushMessageToManyAddOpPushDeliveries)
+	t.Run("RoleToUserRoles", testRoleToManyAddOpUserRoles)
 	t.Run("UserToAccessTokens", testUserToManyAddOpAccessTokens)
+	t.Run("UserToAPIKeys", testUserToManyAddOpAPIKeys)
+	t.Run("UserToEmailChangeRevertTokens", testUserToManyAddOpEmailChangeRevertTokens)
+	t.Run("UserToEmailChangeTokens", testUserToManyAddOpEmailChangeTokens)
+	t.Run("UserToEmailVerificationTokens", testUserToManyAddOpEmailVerificationTokens)
+	t.Run("UserToIdentities", testUserToManyAddOpIdentities)
+	t.Run("UserToLegalAcceptances", testUserToManyAddOpLegalAcceptances)
+	t.Run("UserToMfaChallenges", testUserToManyAddOpMfaChallenges)
+	t.Run("UserToOauthAuthorizationCodes", testUserToManyAddOpOauthAuthorizationCodes)
+	t.Run("UserToOauthClients", testUserToManyAddOpOauthClients)
 	t.Run("UserToPasswordResetTokens", testUserToManyAddOpPasswordResetTokens)
+	t.Run("UserToPushMessages", testUserToManyAddOpPushMessages)
 	t.Run("UserToPushTokens", testUserToManyAddOpPushTokens)
+	t.Run("UserToRecoveryCodes", testUserToManyAddOpRecoveryCodes)
 	t.Run("UserToRefreshTokens", testUserToManyAddOpRefreshTokens)
+	t.Run("UserToSessions", testUserToManyAddOpSessions)
+	t.Run("UserToUploads", testUserToManyAddOpUploads)
+	t.Run("UserToUserRoles", testUserToManyAddOpUserRoles)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("OauthClientToAccessTokens", testOauthClientToManySetOpAccessTokens)
+	t.Run("OauthClientToRefreshTokens", testOauthClientToManySetOpRefreshTokens)
+	t.Run("PushCampaignToPushMessages", testPushCampaignToManySetOpPushMessages)
+	t.Run("UserToOauthClients", testUserToManySetOpOauthClients)
+	t.Run("UserToPushMessages", testUserToManySetOpPushMessages)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("OauthClientToAccessTokens", testOauthClientToManyRemoveOpAccessTokens)
+	t.Run("OauthClientToRefreshTokens", testOauthClientToManyRemoveOpRefreshTokens)
+	t.Run("PushCampaignToPushMessages", testPushCampaignToManyRemoveOpPushMessages)
+	t.Run("UserToOauthClients", testUserToManyRemoveOpOauthClients)
+	t.Run("UserToPushMessages", testUserToManyRemoveOpPushMessages)
+}
 
 func TestReload(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensReload)
+	t.Run("APIKeys", testAPIKeysReload)
 	t.Run("AppUserProfiles", testAppUserProfilesReload)
+	t.Run("AuthAttempts", testAuthAttemptsReload)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensReload)
+	t.Run("EmailChangeTokens", testEmailChangeTokensReload)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensReload)
+	t.Run("Identities", testIdentitiesReload)
+	t.Run("LegalAcceptances", testLegalAcceptancesReload)
+	t.Run("LegalDocuments", testLegalDocumentsReload)
+	t.Run("MfaChallenges", testMfaChallengesReload)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesReload)
+	t.Run("OauthClients", testOauthClientsReload)
 	t.Run("PasswordResetTokens", testPasswordResetTokensReload)
+	t.Run("PushCampaigns", testPushCampaignsReload)
+	t.Run("PushDeliveries", testPushDeliveriesReload)
+	t.Run("PushMessages", testPushMessagesReload)
 	t.Run("PushTokens", testPushTokensReload)
+	t.Run("RateLimitCounters", testRateLimitCountersReload)
+	t.Run("RecoveryCodes", testRecoveryCodesReload)
 	t.Run("RefreshTokens", testRefreshTokensReload)
+	t.Run("Roles", testRolesReload)
+	t.Run("Sessions", testSessionsReload)
+	t.Run("TotpCredentials", testTotpCredentialsReload)
+	t.Run("Uploads", testUploadsReload)
+	t.Run("UserRoles", testUserRolesReload)
 	t.Run("Users", testUsersReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensReloadAll)
+	t.Run("APIKeys", testAPIKeysReloadAll)
 	t.Run("AppUserProfiles", testAppUserProfilesReloadAll)
+	t.Run("AuthAttempts", testAuthAttemptsReloadAll)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensReloadAll)
+	t.Run("EmailChangeTokens", testEmailChangeTokensReloadAll)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensReloadAll)
+	t.Run("Identities", testIdentitiesReloadAll)
+	t.Run("LegalAcceptances", testLegalAcceptancesReloadAll)
+	t.Run("LegalDocuments", testLegalDocumentsReloadAll)
+	t.Run("MfaChallenges", testMfaChallengesReloadAll)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesReloadAll)
+	t.Run("OauthClients", testOauthClientsReloadAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensReloadAll)
+	t.Run("PushCampaigns", testPushCampaignsReloadAll)
+	t.Run("PushDeliveries", testPushDeliveriesReloadAll)
+	t.Run("PushMessages", testPushMessagesReloadAll)
 	t.Run("PushTokens", testPushTokensReloadAll)
+	t.Run("RateLimitCounters", testRateLimitCountersReloadAll)
+	t.Run("RecoveryCodes", testRecoveryCodesReloadAll)
 	t.Run("RefreshTokens", testRefreshTokensReloadAll)
+	t.Run("Roles", testRolesReloadAll)
+	t.Run("Sessions", testSessionsReloadAll)
+	t.Run("TotpCredentials", testTotpCredentialsReloadAll)
+	t.Run("Uploads", testUploadsReloadAll)
+	t.Run("UserRoles", testUserRolesReloadAll)
 	t.Run("Users", testUsersReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensSelect)
+	t.Run("APIKeys", testAPIKeysSelect)
 	t.Run("AppUserProfiles", testAppUserProfilesSelect)
+	t.Run("AuthAttempts", testAuthAttemptsSelect)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensSelect)
+	t.Run("EmailChangeTokens", testEmailChangeTokensSelect)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensSelect)
+	t.Run("Identities", testIdentitiesSelect)
+	t.Run("LegalAcceptances", testLegalAcceptancesSelect)
+	t.Run("LegalDocuments", testLegalDocumentsSelect)
+	t.Run("MfaChallenges", testMfaChallengesSelect)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesSelect)
+	t.Run("OauthClients", testOauthClientsSelect)
 	t.Run("PasswordResetTokens", testPasswordResetTokensSelect)
+	t.Run("PushCampaigns", testPushCampaignsSelect)
+	t.Run("PushDeliveries", testPushDeliveriesSelect)
+	t.Run("PushMessages", testPushMessagesSelect)
 	t.Run("PushTokens", testPushTokensSelect)
+	t.Run("RateLimitCounters", testRateLimitCountersSelect)
+	t.Run("RecoveryCodes", testRecoveryCodesSelect)
 	t.Run("RefreshTokens", testRefreshTokensSelect)
+	t.Run("Roles", testRolesSelect)
+	t.Run("Sessions", testSessionsSelect)
+	t.Run("TotpCredentials", testTotpCredentialsSelect)
+	t.Run("Uploads", testUploadsSelect)
+	t.Run("UserRoles", testUserRolesSelect)
 	t.Run("Users", testUsersSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensUpdate)
+	t.Run("APIKeys", testAPIKeysUpdate)
 	t.Run("AppUserProfiles", testAppUserProfilesUpdate)
+	t.Run("AuthAttempts", testAuthAttemptsUpdate)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensUpdate)
+	t.Run("EmailChangeTokens", testEmailChangeTokensUpdate)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensUpdate)
+	t.Run("Identities", testIdentitiesUpdate)
+	t.Run("LegalAcceptances", testLegalAcceptancesUpdate)
+	t.Run("LegalDocuments", testLegalDocumentsUpdate)
+	t.Run("MfaChallenges", testMfaChallengesUpdate)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesUpdate)
+	t.Run("OauthClients", testOauthClientsUpdate)
 	t.Run("PasswordResetTokens", testPasswordResetTokensUpdate)
+	t.Run("PushCampaigns", testPushCampaignsUpdate)
+	t.Run("PushDeliveries", testPushDeliveriesUpdate)
+	t.Run("PushMessages", testPushMessagesUpdate)
 	t.Run("PushTokens", testPushTokensUpdate)
+	t.Run("RateLimitCounters", testRateLimitCountersUpdate)
+	t.Run("RecoveryCodes", testRecoveryCodesUpdate)
 	t.Run("RefreshTokens", testRefreshTokensUpdate)
+	t.Run("Roles", testRolesUpdate)
+	t.Run("Sessions", testSessionsUpdate)
+	t.Run("TotpCredentials", testTotpCredentialsUpdate)
+	t.Run("Uploads", testUploadsUpdate)
+	t.Run("UserRoles", testUserRolesUpdate)
 	t.Run("Users", testUsersUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensSliceUpdateAll)
+	t.Run("APIKeys", testAPIKeysSliceUpdateAll)
 	t.Run("AppUserProfiles", testAppUserProfilesSliceUpdateAll)
+	t.Run("AuthAttempts", testAuthAttemptsSliceUpdateAll)
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensSliceUpdateAll)
+	t.Run("EmailChangeTokens", testEmailChangeTokensSliceUpdateAll)
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensSliceUpdateAll)
+	t.Run("Identities", testIdentitiesSliceUpdateAll)
+	t.Run("LegalAcceptances", testLegalAcceptancesSliceUpdateAll)
+	t.Run("LegalDocuments", testLegalDocumentsSliceUpdateAll)
+	t.Run("MfaChallenges", testMfaChallengesSliceUpdateAll)
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesSliceUpdateAll)
+	t.Run("OauthClients", testOauthClientsSliceUpdateAll)
 	t.Run("PasswordResetTokens", testPasswordResetTokensSliceUpdateAll)
+	t.Run("PushCampaigns", testPushCampaignsSliceUpdateAll)
+	t.Run("PushDeliveries", testPushDeliveriesSliceUpdateAll)
+	t.Run("PushMessages", testPushMessagesSliceUpdateAll)
 	t.Run("PushTokens", testPushTokensSliceUpdateAll)
+	t.Run("RateLimitCounters", testRateLimitCountersSliceUpdateAll)
+	t.Run("RecoveryCodes", testRecoveryCodesSliceUpdateAll)
 	t.Run("RefreshTokens", testRefreshTokensSliceUpdateAll)
+	t.Run("Roles", testRolesSliceUpdateAll)
+	t.Run("Sessions", testSessionsSliceUpdateAll)
+	t.Run("TotpCredentials", testTotpCredentialsSliceUpdateAll)
+	t.Run("Uploads", testUploadsSliceUpdateAll)
+	t.Run("UserRoles", testUserRolesSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
 }
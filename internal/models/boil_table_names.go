@@ -4,17 +4,59 @@
 package models
 
 var TableNames = struct {
-	AccessTokens        string
-	AppUserProfiles     string
-	PasswordResetTokens string
-	PushTokens          string
-	RefreshTokens       string
-	Users               string
+	AccessTokens            string
+	APIKeys                 string
+	AppUserProfiles         string
+	AuthAttempts            string
+	EmailChangeRevertTokens string
+	EmailChangeTokens       string
+	EmailVerificationTokens string
+	Identities              string
+	LegalAcceptances        string
+	LegalDocuments          string
+	MfaChallenges           string
+	OauthAuthorizationCodes string
+	OauthClients            string
+	PasswordResetTokens     string
+	PushCampaigns           string
+	PushDeliveries          string
+	PushMessages            string
+	PushTokens              string
+	RateLimitCounters       string
+	RecoveryCodes           string
+	RefreshTokens           string
+	Roles                   string
+	Sessions                string
+	TotpCredentials         string
+	Uploads                 string
+	UserRoles               string
+	Users                   string
 }{
-	AccessTokens:        "access_tokens",
-	AppUserProfiles:     "app_user_profiles",
-	PasswordResetTokens: "password_reset_tokens",
-	PushTokens:          "push_tokens",
-	RefreshTokens:       "refresh_tokens",
-	Users:               "users",
+	AccessTokens:            "access_tokens",
+	APIKeys:                 "api_keys",
+	AppUserProfiles:         "app_user_profiles",
+	AuthAttempts:            "auth_attempts",
+	EmailChangeRevertTokens: "email_change_revert_tokens",
+	EmailChangeTokens:       "email_change_tokens",
+	EmailVerificationTokens: "email_verification_tokens",
+	Identities:              "identities",
+	LegalAcceptances:        "legal_acceptances",
+	LegalDocuments:          "legal_documents",
+	MfaChallenges:           "mfa_challenges",
+	OauthAuthorizationCodes: "oauth_authorization_codes",
+	OauthClients:            "oauth_clients",
+	PasswordResetTokens:     "password_reset_tokens",
+	PushCampaigns:           "push_campaigns",
+	PushDeliveries:          "push_deliveries",
+	PushMessages:            "push_messages",
+	PushTokens:              "push_tokens",
+	RateLimitCounters:       "rate_limit_counters",
+	RecoveryCodes:           "recovery_codes",
+	RefreshTokens:           "refresh_tokens",
+	Roles:                   "roles",
+	Sessions:                "sessions",
+	TotpCredentials:         "totp_credentials",
+	Uploads:                 "uploads",
+	UserRoles:               "user_roles",
+	Users:                   "users",
 }
@@ -51,6 +51,21 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	return str
 }
 
+// Enum values for PushCampaignSegment
+const (
+	PushCampaignSegmentAll      string = "all"
+	PushCampaignSegmentLanguage string = "language"
+	PushCampaignSegmentSQL      string = "sql"
+)
+
+func AllPushCampaignSegment() []string {
+	return []string{
+		PushCampaignSegmentAll,
+		PushCampaignSegmentLanguage,
+		PushCampaignSegmentSQL,
+	}
+}
+
 // Enum values for ProviderType
 const (
 	ProviderTypeFCM string = "fcm"
@@ -63,3 +78,20 @@ func AllProviderType() []string {
 		ProviderTypeApn,
 	}
 }
+
+// Enum values for PushDeliveryStatus
+const (
+	PushDeliveryStatusPending      string = "pending"
+	PushDeliveryStatusDelivered    string = "delivered"
+	PushDeliveryStatusInvalidToken string = "invalid_token"
+	PushDeliveryStatusDeadLetter   string = "dead_letter"
+)
+
+func AllPushDeliveryStatus() []string {
+	return []string{
+		PushDeliveryStatusPending,
+		PushDeliveryStatusDelivered,
+		PushDeliveryStatusInvalidToken,
+		PushDeliveryStatusDeadLetter,
+	}
+}
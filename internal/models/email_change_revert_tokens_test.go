@@ -0,0 +1,700 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testEmailChangeRevertTokens(t *testing.T) {
+	t.Parallel()
+
+	query := EmailChangeRevertTokens()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testEmailChangeRevertTokensDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EmailChangeRevertTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEmailChangeRevertTokensQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := EmailChangeRevertTokens().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EmailChangeRevertTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEmailChangeRevertTokensSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := EmailChangeRevertTokenSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EmailChangeRevertTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEmailChangeRevertTokensExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := EmailChangeRevertTokenExists(ctx, tx, o.Token)
+	if err != nil {
+		t.Errorf("Unable to check if EmailChangeRevertToken exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected EmailChangeRevertTokenExists to return true, but got false.")
+	}
+}
+
+func testEmailChangeRevertTokensFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	emailChangeRevertTokenFound, err := FindEmailChangeRevertToken(ctx, tx, o.Token)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if emailChangeRevertTokenFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testEmailChangeRevertTokensBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = EmailChangeRevertTokens().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEmailChangeRevertTokensOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := EmailChangeRevertTokens().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testEmailChangeRevertTokensAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	emailChangeRevertTokenOne := &EmailChangeRevertToken{}
+	emailChangeRevertTokenTwo := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, emailChangeRevertTokenOne, emailChangeRevertTokenDBTypes, false, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+	if err = randomize.Struct(seed, emailChangeRevertTokenTwo, emailChangeRevertTokenDBTypes, false, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = emailChangeRevertTokenOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = emailChangeRevertTokenTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := EmailChangeRevertTokens().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testEmailChangeRevertTokensCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	emailChangeRevertTokenOne := &EmailChangeRevertToken{}
+	emailChangeRevertTokenTwo := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, emailChangeRevertTokenOne, emailChangeRevertTokenDBTypes, false, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+	if err = randomize.Struct(seed, emailChangeRevertTokenTwo, emailChangeRevertTokenDBTypes, false, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = emailChangeRevertTokenOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = emailChangeRevertTokenTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EmailChangeRevertTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testEmailChangeRevertTokensInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EmailChangeRevertTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testEmailChangeRevertTokensInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(emailChangeRevertTokenColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EmailChangeRevertTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testEmailChangeRevertTokenToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local EmailChangeRevertToken
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, emailChangeRevertTokenDBTypes, false, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := EmailChangeRevertTokenSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*EmailChangeRevertToken)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testEmailChangeRevertTokenToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a EmailChangeRevertToken
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, emailChangeRevertTokenDBTypes, false, strmangle.SetComplement(emailChangeRevertTokenPrimaryKeyColumns, emailChangeRevertTokenColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.EmailChangeRevertTokens[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testEmailChangeRevertTokensReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEmailChangeRevertTokensReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := EmailChangeRevertTokenSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEmailChangeRevertTokensSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := EmailChangeRevertTokens().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	emailChangeRevertTokenDBTypes = map[string]string{`Token`: `uuid`, `ValidUntil`: `timestamp with time zone`, `UserID`: `uuid`, `OldUsername`: `text`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                             = bytes.MinRead
+)
+
+func testEmailChangeRevertTokensUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(emailChangeRevertTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(emailChangeRevertTokenAllColumns) == len(emailChangeRevertTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EmailChangeRevertTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testEmailChangeRevertTokensSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(emailChangeRevertTokenAllColumns) == len(emailChangeRevertTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EmailChangeRevertTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, emailChangeRevertTokenDBTypes, true, emailChangeRevertTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(emailChangeRevertTokenAllColumns, emailChangeRevertTokenPrimaryKeyColumns) {
+		fields = emailChangeRevertTokenAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			emailChangeRevertTokenAllColumns,
+			emailChangeRevertTokenPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := EmailChangeRevertTokenSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testEmailChangeRevertTokensUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(emailChangeRevertTokenAllColumns) == len(emailChangeRevertTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := EmailChangeRevertToken{}
+	if err = randomize.Struct(seed, &o, emailChangeRevertTokenDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert EmailChangeRevertToken: %s", err)
+	}
+
+	count, err := EmailChangeRevertTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, emailChangeRevertTokenDBTypes, false, emailChangeRevertTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EmailChangeRevertToken struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert EmailChangeRevertToken: %s", err)
+	}
+
+	count, err = EmailChangeRevertTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
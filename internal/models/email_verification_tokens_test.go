@@ -0,0 +1,700 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testEmailVerificationTokens(t *testing.T) {
+	t.Parallel()
+
+	query := EmailVerificationTokens()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testEmailVerificationTokensDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EmailVerificationTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEmailVerificationTokensQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := EmailVerificationTokens().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EmailVerificationTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEmailVerificationTokensSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := EmailVerificationTokenSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := EmailVerificationTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testEmailVerificationTokensExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := EmailVerificationTokenExists(ctx, tx, o.Token)
+	if err != nil {
+		t.Errorf("Unable to check if EmailVerificationToken exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected EmailVerificationTokenExists to return true, but got false.")
+	}
+}
+
+func testEmailVerificationTokensFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	emailVerificationTokenFound, err := FindEmailVerificationToken(ctx, tx, o.Token)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if emailVerificationTokenFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testEmailVerificationTokensBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = EmailVerificationTokens().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEmailVerificationTokensOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := EmailVerificationTokens().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testEmailVerificationTokensAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	emailVerificationTokenOne := &EmailVerificationToken{}
+	emailVerificationTokenTwo := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, emailVerificationTokenOne, emailVerificationTokenDBTypes, false, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+	if err = randomize.Struct(seed, emailVerificationTokenTwo, emailVerificationTokenDBTypes, false, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = emailVerificationTokenOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = emailVerificationTokenTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := EmailVerificationTokens().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testEmailVerificationTokensCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	emailVerificationTokenOne := &EmailVerificationToken{}
+	emailVerificationTokenTwo := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, emailVerificationTokenOne, emailVerificationTokenDBTypes, false, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+	if err = randomize.Struct(seed, emailVerificationTokenTwo, emailVerificationTokenDBTypes, false, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = emailVerificationTokenOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = emailVerificationTokenTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EmailVerificationTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testEmailVerificationTokensInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EmailVerificationTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testEmailVerificationTokensInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(emailVerificationTokenColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EmailVerificationTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testEmailVerificationTokenToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local EmailVerificationToken
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, emailVerificationTokenDBTypes, false, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := EmailVerificationTokenSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*EmailVerificationToken)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testEmailVerificationTokenToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a EmailVerificationToken
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, emailVerificationTokenDBTypes, false, strmangle.SetComplement(emailVerificationTokenPrimaryKeyColumns, emailVerificationTokenColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.EmailVerificationTokens[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testEmailVerificationTokensReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEmailVerificationTokensReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := EmailVerificationTokenSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testEmailVerificationTokensSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := EmailVerificationTokens().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	emailVerificationTokenDBTypes = map[string]string{`Token`: `uuid`, `ValidUntil`: `timestamp with time zone`, `UserID`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                             = bytes.MinRead
+)
+
+func testEmailVerificationTokensUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(emailVerificationTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(emailVerificationTokenAllColumns) == len(emailVerificationTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EmailVerificationTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testEmailVerificationTokensSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(emailVerificationTokenAllColumns) == len(emailVerificationTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &EmailVerificationToken{}
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := EmailVerificationTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, emailVerificationTokenDBTypes, true, emailVerificationTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(emailVerificationTokenAllColumns, emailVerificationTokenPrimaryKeyColumns) {
+		fields = emailVerificationTokenAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			emailVerificationTokenAllColumns,
+			emailVerificationTokenPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := EmailVerificationTokenSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testEmailVerificationTokensUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(emailVerificationTokenAllColumns) == len(emailVerificationTokenPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := EmailVerificationToken{}
+	if err = randomize.Struct(seed, &o, emailVerificationTokenDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert EmailVerificationToken: %s", err)
+	}
+
+	count, err := EmailVerificationTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, emailVerificationTokenDBTypes, false, emailVerificationTokenPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize EmailVerificationToken struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert EmailVerificationToken: %s", err)
+	}
+
+	count, err = EmailVerificationTokens().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1077 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LegalAcceptance is an object representing the database table.
+type LegalAcceptance struct {
+	ID              string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID          string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	LegalDocumentID string    `boil:"legal_document_id" json:"legal_document_id" toml:"legal_document_id" yaml:"legal_document_id"`
+	AcceptedAt      time.Time `boil:"accepted_at" json:"accepted_at" toml:"accepted_at" yaml:"accepted_at"`
+	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *legalAcceptanceR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L legalAcceptanceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LegalAcceptanceColumns = struct {
+	ID              string
+	UserID          string
+	LegalDocumentID string
+	AcceptedAt      string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "id",
+	UserID:          "user_id",
+	LegalDocumentID: "legal_document_id",
+	AcceptedAt:      "accepted_at",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+}
+
+var LegalAcceptanceTableColumns = struct {
+	ID              string
+	UserID          string
+	LegalDocumentID string
+	AcceptedAt      string
+	CreatedAt       string
+	UpdatedAt       string
+}{
+	ID:              "legal_acceptances.id",
+	UserID:          "legal_acceptances.user_id",
+	LegalDocumentID: "legal_acceptances.legal_document_id",
+	AcceptedAt:      "legal_acceptances.accepted_at",
+	CreatedAt:       "legal_acceptances.created_at",
+	UpdatedAt:       "legal_acceptances.updated_at",
+}
+
+// Generated where
+
+var LegalAcceptanceWhere = struct {
+	ID              whereHelperstring
+	UserID          whereHelperstring
+	LegalDocumentID whereHelperstring
+	AcceptedAt      whereHelpertime_Time
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+}{
+	ID:              whereHelperstring{field: "\"legal_acceptances\".\"id\""},
+	UserID:          whereHelperstring{field: "\"legal_acceptances\".\"user_id\""},
+	LegalDocumentID: whereHelperstring{field: "\"legal_acceptances\".\"legal_document_id\""},
+	AcceptedAt:      whereHelpertime_Time{field: "\"legal_acceptances\".\"accepted_at\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"legal_acceptances\".\"created_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"legal_acceptances\".\"updated_at\""},
+}
+
+// LegalAcceptanceRels is where relationship names are stored.
+var LegalAcceptanceRels = struct {
+	LegalDocument string
+	User          string
+}{
+	LegalDocument: "LegalDocument",
+	User:          "User",
+}
+
+// legalAcceptanceR is where relationships are stored.
+type legalAcceptanceR struct {
+	LegalDocument *LegalDocument `boil:"LegalDocument" json:"LegalDocument" toml:"LegalDocument" yaml:"LegalDocument"`
+	User          *User          `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*legalAcceptanceR) NewStruct() *legalAcceptanceR {
+	return &legalAcceptanceR{}
+}
+
+func (r *legalAcceptanceR) GetLegalDocument() *LegalDocument {
+	if r == nil {
+		return nil
+	}
+	return r.LegalDocument
+}
+
+func (r *legalAcceptanceR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// legalAcceptanceL is where Load methods for each relationship are stored.
+type legalAcceptanceL struct{}
+
+var (
+	legalAcceptanceAllColumns            = []string{"id", "user_id", "legal_document_id", "accepted_at", "created_at", "updated_at"}
+	legalAcceptanceColumnsWithoutDefault = []string{"user_id", "legal_document_id", "accepted_at", "created_at", "updated_at"}
+	legalAcceptanceColumnsWithDefault    = []string{"id"}
+	legalAcceptancePrimaryKeyColumns     = []string{"id"}
+	legalAcceptanceGeneratedColumns      = []string{}
+)
+
+type (
+	// LegalAcceptanceSlice is an alias for a slice of pointers to LegalAcceptance.
+	// This should almost always be used instead of []LegalAcceptance.
+	LegalAcceptanceSlice []*LegalAcceptance
+
+	legalAcceptanceQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	legalAcceptanceType                 = reflect.TypeOf(&LegalAcceptance{})
+	legalAcceptanceMapping              = queries.MakeStructMapping(legalAcceptanceType)
+	legalAcceptancePrimaryKeyMapping, _ = queries.BindMapping(legalAcceptanceType, legalAcceptanceMapping, legalAcceptancePrimaryKeyColumns)
+	legalAcceptanceInsertCacheMut       sync.RWMutex
+	legalAcceptanceInsertCache          = make(map[string]insertCache)
+	legalAcceptanceUpdateCacheMut       sync.RWMutex
+	legalAcceptanceUpdateCache          = make(map[string]updateCache)
+	legalAcceptanceUpsertCacheMut       sync.RWMutex
+	legalAcceptanceUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single legalAcceptance record from the query.
+func (q legalAcceptanceQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LegalAcceptance, error) {
+	o := &LegalAcceptance{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for legal_acceptances")
+	}
+
+	return o, nil
+}
+
+// All returns all LegalAcceptance records from the query.
+func (q legalAcceptanceQuery) All(ctx context.Context, exec boil.ContextExecutor) (LegalAcceptanceSlice, error) {
+	var o []*LegalAcceptance
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to LegalAcceptance slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all LegalAcceptance records in the query.
+func (q legalAcceptanceQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count legal_acceptances rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q legalAcceptanceQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if legal_acceptances exists")
+	}
+
+	return count > 0, nil
+}
+
+// LegalDocument pointed to by the foreign key.
+func (o *LegalAcceptance) LegalDocument(mods ...qm.QueryMod) legalDocumentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LegalDocumentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return LegalDocuments(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *LegalAcceptance) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadLegalDocument allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (legalAcceptanceL) LoadLegalDocument(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLegalAcceptance interface{}, mods queries.Applicator) error {
+	var slice []*LegalAcceptance
+	var object *LegalAcceptance
+
+	if singular {
+		var ok bool
+		object, ok = maybeLegalAcceptance.(*LegalAcceptance)
+		if !ok {
+			object = new(LegalAcceptance)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLegalAcceptance)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLegalAcceptance))
+			}
+		}
+	} else {
+		s, ok := maybeLegalAcceptance.(*[]*LegalAcceptance)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLegalAcceptance)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLegalAcceptance))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &legalAcceptanceR{}
+		}
+		args = append(args, object.LegalDocumentID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &legalAcceptanceR{}
+			}
+
+			for _, a := range args {
+				if a == obj.LegalDocumentID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.LegalDocumentID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`legal_documents`),
+		qm.WhereIn(`legal_documents.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load LegalDocument")
+	}
+
+	var resultSlice []*LegalDocument
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice LegalDocument")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for legal_documents")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for legal_documents")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.LegalDocument = foreign
+		if foreign.R == nil {
+			foreign.R = &legalDocumentR{}
+		}
+		foreign.R.LegalAcceptances = append(foreign.R.LegalAcceptances, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.LegalDocumentID == foreign.ID {
+				local.R.LegalDocument = foreign
+				if foreign.R == nil {
+					foreign.R = &legalDocumentR{}
+				}
+				foreign.R.LegalAcceptances = append(foreign.R.LegalAcceptances, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (legalAcceptanceL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLegalAcceptance interface{}, mods queries.Applicator) error {
+	var slice []*LegalAcceptance
+	var object *LegalAcceptance
+
+	if singular {
+		var ok bool
+		object, ok = maybeLegalAcceptance.(*LegalAcceptance)
+		if !ok {
+			object = new(LegalAcceptance)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLegalAcceptance)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLegalAcceptance))
+			}
+		}
+	} else {
+		s, ok := maybeLegalAcceptance.(*[]*LegalAcceptance)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLegalAcceptance)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLegalAcceptance))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &legalAcceptanceR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &legalAcceptanceR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.LegalAcceptances = append(foreign.R.LegalAcceptances, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.LegalAcceptances = append(foreign.R.LegalAcceptances, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetLegalDocument of the legalAcceptance to the related item.
+// Sets o.R.LegalDocument to related.
+// Adds o to related.R.LegalAcceptances.
+func (o *LegalAcceptance) SetLegalDocument(ctx context.Context, exec boil.ContextExecutor, insert bool, related *LegalDocument) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"legal_acceptances\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"legal_document_id"}),
+		strmangle.WhereClause("\"", "\"", 2, legalAcceptancePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.LegalDocumentID = related.ID
+	if o.R == nil {
+		o.R = &legalAcceptanceR{
+			LegalDocument: related,
+		}
+	} else {
+		o.R.LegalDocument = related
+	}
+
+	if related.R == nil {
+		related.R = &legalDocumentR{
+			LegalAcceptances: LegalAcceptanceSlice{o},
+		}
+	} else {
+		related.R.LegalAcceptances = append(related.R.LegalAcceptances, o)
+	}
+
+	return nil
+}
+
+// SetUser of the legalAcceptance to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.LegalAcceptances.
+func (o *LegalAcceptance) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"legal_acceptances\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, legalAcceptancePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &legalAcceptanceR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			LegalAcceptances: LegalAcceptanceSlice{o},
+		}
+	} else {
+		related.R.LegalAcceptances = append(related.R.LegalAcceptances, o)
+	}
+
+	return nil
+}
+
+// LegalAcceptances retrieves all the records using an executor.
+func LegalAcceptances(mods ...qm.QueryMod) legalAcceptanceQuery {
+	mods = append(mods, qm.From("\"legal_acceptances\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"legal_acceptances\".*"})
+	}
+
+	return legalAcceptanceQuery{q}
+}
+
+// FindLegalAcceptance retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLegalAcceptance(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*LegalAcceptance, error) {
+	legalAcceptanceObj := &LegalAcceptance{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"legal_acceptances\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, legalAcceptanceObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from legal_acceptances")
+	}
+
+	return legalAcceptanceObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LegalAcceptance) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no legal_acceptances provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(legalAcceptanceColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	legalAcceptanceInsertCacheMut.RLock()
+	cache, cached := legalAcceptanceInsertCache[key]
+	legalAcceptanceInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			legalAcceptanceAllColumns,
+			legalAcceptanceColumnsWithDefault,
+			legalAcceptanceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(legalAcceptanceType, legalAcceptanceMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(legalAcceptanceType, legalAcceptanceMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"legal_acceptances\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"legal_acceptances\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into legal_acceptances")
+	}
+
+	if !cached {
+		legalAcceptanceInsertCacheMut.Lock()
+		legalAcceptanceInsertCache[key] = cache
+		legalAcceptanceInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the LegalAcceptance.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LegalAcceptance) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	legalAcceptanceUpdateCacheMut.RLock()
+	cache, cached := legalAcceptanceUpdateCache[key]
+	legalAcceptanceUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			legalAcceptanceAllColumns,
+			legalAcceptancePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update legal_acceptances, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"legal_acceptances\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, legalAcceptancePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(legalAcceptanceType, legalAcceptanceMapping, append(wl, legalAcceptancePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update legal_acceptances row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for legal_acceptances")
+	}
+
+	if !cached {
+		legalAcceptanceUpdateCacheMut.Lock()
+		legalAcceptanceUpdateCache[key] = cache
+		legalAcceptanceUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q legalAcceptanceQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for legal_acceptances")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for legal_acceptances")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LegalAcceptanceSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), legalAcceptancePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"legal_acceptances\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, legalAcceptancePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in legalAcceptance slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all legalAcceptance")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LegalAcceptance) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no legal_acceptances provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(legalAcceptanceColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	legalAcceptanceUpsertCacheMut.RLock()
+	cache, cached := legalAcceptanceUpsertCache[key]
+	legalAcceptanceUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			legalAcceptanceAllColumns,
+			legalAcceptanceColumnsWithDefault,
+			legalAcceptanceColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			legalAcceptanceAllColumns,
+			legalAcceptancePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert legal_acceptances, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(legalAcceptancePrimaryKeyColumns))
+			copy(conflict, legalAcceptancePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"legal_acceptances\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(legalAcceptanceType, legalAcceptanceMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(legalAcceptanceType, legalAcceptanceMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert legal_acceptances")
+	}
+
+	if !cached {
+		legalAcceptanceUpsertCacheMut.Lock()
+		legalAcceptanceUpsertCache[key] = cache
+		legalAcceptanceUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single LegalAcceptance record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LegalAcceptance) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no LegalAcceptance provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), legalAcceptancePrimaryKeyMapping)
+	sql := "DELETE FROM \"legal_acceptances\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from legal_acceptances")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for legal_acceptances")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q legalAcceptanceQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no legalAcceptanceQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from legal_acceptances")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for legal_acceptances")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LegalAcceptanceSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), legalAcceptancePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"legal_acceptances\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, legalAcceptancePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from legalAcceptance slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for legal_acceptances")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LegalAcceptance) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLegalAcceptance(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LegalAcceptanceSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LegalAcceptanceSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), legalAcceptancePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"legal_acceptances\".* FROM \"legal_acceptances\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, legalAcceptancePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LegalAcceptanceSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LegalAcceptanceExists checks if the LegalAcceptance row exists.
+func LegalAcceptanceExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"legal_acceptances\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if legal_acceptances exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,808 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testLegalAcceptances(t *testing.T) {
+	t.Parallel()
+
+	query := LegalAcceptances()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testLegalAcceptancesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LegalAcceptances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLegalAcceptancesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := LegalAcceptances().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LegalAcceptances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLegalAcceptancesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LegalAcceptanceSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LegalAcceptances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLegalAcceptancesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := LegalAcceptanceExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if LegalAcceptance exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected LegalAcceptanceExists to return true, but got false.")
+	}
+}
+
+func testLegalAcceptancesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	legalAcceptanceFound, err := FindLegalAcceptance(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if legalAcceptanceFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testLegalAcceptancesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = LegalAcceptances().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLegalAcceptancesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := LegalAcceptances().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testLegalAcceptancesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	legalAcceptanceOne := &LegalAcceptance{}
+	legalAcceptanceTwo := &LegalAcceptance{}
+	if err = randomize.Struct(seed, legalAcceptanceOne, legalAcceptanceDBTypes, false, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+	if err = randomize.Struct(seed, legalAcceptanceTwo, legalAcceptanceDBTypes, false, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = legalAcceptanceOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = legalAcceptanceTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LegalAcceptances().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testLegalAcceptancesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	legalAcceptanceOne := &LegalAcceptance{}
+	legalAcceptanceTwo := &LegalAcceptance{}
+	if err = randomize.Struct(seed, legalAcceptanceOne, legalAcceptanceDBTypes, false, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+	if err = randomize.Struct(seed, legalAcceptanceTwo, legalAcceptanceDBTypes, false, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = legalAcceptanceOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = legalAcceptanceTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LegalAcceptances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testLegalAcceptancesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LegalAcceptances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLegalAcceptancesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(legalAcceptanceColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LegalAcceptances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLegalAcceptanceToOneLegalDocumentUsingLegalDocument(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local LegalAcceptance
+	var foreign LegalDocument
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, legalAcceptanceDBTypes, false, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, legalDocumentDBTypes, false, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.LegalDocumentID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.LegalDocument().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := LegalAcceptanceSlice{&local}
+	if err = local.L.LoadLegalDocument(ctx, tx, false, (*[]*LegalAcceptance)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.LegalDocument == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.LegalDocument = nil
+	if err = local.L.LoadLegalDocument(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.LegalDocument == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testLegalAcceptanceToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local LegalAcceptance
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, legalAcceptanceDBTypes, false, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := LegalAcceptanceSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*LegalAcceptance)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testLegalAcceptanceToOneSetOpLegalDocumentUsingLegalDocument(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LegalAcceptance
+	var b, c LegalDocument
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, legalAcceptanceDBTypes, false, strmangle.SetComplement(legalAcceptancePrimaryKeyColumns, legalAcceptanceColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, legalDocumentDBTypes, false, strmangle.SetComplement(legalDocumentPrimaryKeyColumns, legalDocumentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, legalDocumentDBTypes, false, strmangle.SetComplement(legalDocumentPrimaryKeyColumns, legalDocumentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*LegalDocument{&b, &c} {
+		err = a.SetLegalDocument(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.LegalDocument != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.LegalAcceptances[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.LegalDocumentID != x.ID {
+			t.Error("foreign key was wrong value", a.LegalDocumentID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.LegalDocumentID))
+		reflect.Indirect(reflect.ValueOf(&a.LegalDocumentID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.LegalDocumentID != x.ID {
+			t.Error("foreign key was wrong value", a.LegalDocumentID, x.ID)
+		}
+	}
+}
+func testLegalAcceptanceToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LegalAcceptance
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, legalAcceptanceDBTypes, false, strmangle.SetComplement(legalAcceptancePrimaryKeyColumns, legalAcceptanceColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.LegalAcceptances[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testLegalAcceptancesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLegalAcceptancesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LegalAcceptanceSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLegalAcceptancesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LegalAcceptances().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	legalAcceptanceDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `LegalDocumentID`: `uuid`, `AcceptedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                      = bytes.MinRead
+)
+
+func testLegalAcceptancesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(legalAcceptancePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(legalAcceptanceAllColumns) == len(legalAcceptancePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LegalAcceptances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptancePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testLegalAcceptancesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(legalAcceptanceAllColumns) == len(legalAcceptancePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalAcceptance{}
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LegalAcceptances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, legalAcceptanceDBTypes, true, legalAcceptancePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(legalAcceptanceAllColumns, legalAcceptancePrimaryKeyColumns) {
+		fields = legalAcceptanceAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			legalAcceptanceAllColumns,
+			legalAcceptancePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := LegalAcceptanceSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testLegalAcceptancesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(legalAcceptanceAllColumns) == len(legalAcceptancePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := LegalAcceptance{}
+	if err = randomize.Struct(seed, &o, legalAcceptanceDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LegalAcceptance: %s", err)
+	}
+
+	count, err := LegalAcceptances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, legalAcceptanceDBTypes, false, legalAcceptancePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LegalAcceptance struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LegalAcceptance: %s", err)
+	}
+
+	count, err = LegalAcceptances().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,931 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// LegalDocument is an object representing the database table.
+type LegalDocument struct {
+	ID          string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Type        string    `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Version     int       `boil:"version" json:"version" toml:"version" yaml:"version"`
+	Locale      string    `boil:"locale" json:"locale" toml:"locale" yaml:"locale"`
+	URL         string    `boil:"url" json:"url" toml:"url" yaml:"url"`
+	Mandatory   bool      `boil:"mandatory" json:"mandatory" toml:"mandatory" yaml:"mandatory"`
+	PublishedAt time.Time `boil:"published_at" json:"published_at" toml:"published_at" yaml:"published_at"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *legalDocumentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L legalDocumentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LegalDocumentColumns = struct {
+	ID          string
+	Type        string
+	Version     string
+	Locale      string
+	URL         string
+	Mandatory   string
+	PublishedAt string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	Type:        "type",
+	Version:     "version",
+	Locale:      "locale",
+	URL:         "url",
+	Mandatory:   "mandatory",
+	PublishedAt: "published_at",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var LegalDocumentTableColumns = struct {
+	ID          string
+	Type        string
+	Version     string
+	Locale      string
+	URL         string
+	Mandatory   string
+	PublishedAt string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "legal_documents.id",
+	Type:        "legal_documents.type",
+	Version:     "legal_documents.version",
+	Locale:      "legal_documents.locale",
+	URL:         "legal_documents.url",
+	Mandatory:   "legal_documents.mandatory",
+	PublishedAt: "legal_documents.published_at",
+	CreatedAt:   "legal_documents.created_at",
+	UpdatedAt:   "legal_documents.updated_at",
+}
+
+// Generated where
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var LegalDocumentWhere = struct {
+	ID          whereHelperstring
+	Type        whereHelperstring
+	Version     whereHelperint
+	Locale      whereHelperstring
+	URL         whereHelperstring
+	Mandatory   whereHelperbool
+	PublishedAt whereHelpertime_Time
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "\"legal_documents\".\"id\""},
+	Type:        whereHelperstring{field: "\"legal_documents\".\"type\""},
+	Version:     whereHelperint{field: "\"legal_documents\".\"version\""},
+	Locale:      whereHelperstring{field: "\"legal_documents\".\"locale\""},
+	URL:         whereHelperstring{field: "\"legal_documents\".\"url\""},
+	Mandatory:   whereHelperbool{field: "\"legal_documents\".\"mandatory\""},
+	PublishedAt: whereHelpertime_Time{field: "\"legal_documents\".\"published_at\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"legal_documents\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"legal_documents\".\"updated_at\""},
+}
+
+// LegalDocumentRels is where relationship names are stored.
+var LegalDocumentRels = struct {
+	LegalAcceptances string
+}{
+	LegalAcceptances: "LegalAcceptances",
+}
+
+// legalDocumentR is where relationships are stored.
+type legalDocumentR struct {
+	LegalAcceptances LegalAcceptanceSlice `boil:"LegalAcceptances" json:"LegalAcceptances" toml:"LegalAcceptances" yaml:"LegalAcceptances"`
+}
+
+// NewStruct creates a new relationship struct
+func (*legalDocumentR) NewStruct() *legalDocumentR {
+	return &legalDocumentR{}
+}
+
+func (r *legalDocumentR) GetLegalAcceptances() LegalAcceptanceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.LegalAcceptances
+}
+
+// legalDocumentL is where Load methods for each relationship are stored.
+type legalDocumentL struct{}
+
+var (
+	legalDocumentAllColumns            = []string{"id", "type", "version", "locale", "url", "mandatory", "published_at", "created_at", "updated_at"}
+	legalDocumentColumnsWithoutDefault = []string{"type", "version", "locale", "url", "published_at", "created_at", "updated_at"}
+	legalDocumentColumnsWithDefault    = []string{"id", "mandatory"}
+	legalDocumentPrimaryKeyColumns     = []string{"id"}
+	legalDocumentGeneratedColumns      = []string{}
+)
+
+type (
+	// LegalDocumentSlice is an alias for a slice of pointers to LegalDocument.
+	// This should almost always be used instead of []LegalDocument.
+	LegalDocumentSlice []*LegalDocument
+
+	legalDocumentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	legalDocumentType                 = reflect.TypeOf(&LegalDocument{})
+	legalDocumentMapping              = queries.MakeStructMapping(legalDocumentType)
+	legalDocumentPrimaryKeyMapping, _ = queries.BindMapping(legalDocumentType, legalDocumentMapping, legalDocumentPrimaryKeyColumns)
+	legalDocumentInsertCacheMut       sync.RWMutex
+	legalDocumentInsertCache          = make(map[string]insertCache)
+	legalDocumentUpdateCacheMut       sync.RWMutex
+	legalDocumentUpdateCache          = make(map[string]updateCache)
+	legalDocumentUpsertCacheMut       sync.RWMutex
+	legalDocumentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single legalDocument record from the query.
+func (q legalDocumentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LegalDocument, error) {
+	o := &LegalDocument{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for legal_documents")
+	}
+
+	return o, nil
+}
+
+// All returns all LegalDocument records from the query.
+func (q legalDocumentQuery) All(ctx context.Context, exec boil.ContextExecutor) (LegalDocumentSlice, error) {
+	var o []*LegalDocument
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to LegalDocument slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all LegalDocument records in the query.
+func (q legalDocumentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count legal_documents rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q legalDocumentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if legal_documents exists")
+	}
+
+	return count > 0, nil
+}
+
+// LegalAcceptances retrieves all the legal_acceptance's LegalAcceptances with an executor.
+func (o *LegalDocument) LegalAcceptances(mods ...qm.QueryMod) legalAcceptanceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"legal_acceptances\".\"legal_document_id\"=?", o.ID),
+	)
+
+	return LegalAcceptances(queryMods...)
+}
+
+// LoadLegalAcceptances allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (legalDocumentL) LoadLegalAcceptances(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLegalDocument interface{}, mods queries.Applicator) error {
+	var slice []*LegalDocument
+	var object *LegalDocument
+
+	if singular {
+		var ok bool
+		object, ok = maybeLegalDocument.(*LegalDocument)
+		if !ok {
+			object = new(LegalDocument)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLegalDocument)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLegalDocument))
+			}
+		}
+	} else {
+		s, ok := maybeLegalDocument.(*[]*LegalDocument)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLegalDocument)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLegalDocument))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &legalDocumentR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &legalDocumentR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`legal_acceptances`),
+		qm.WhereIn(`legal_acceptances.legal_document_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load legal_acceptances")
+	}
+
+	var resultSlice []*LegalAcceptance
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice legal_acceptances")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on legal_acceptances")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for legal_acceptances")
+	}
+
+	if singular {
+		object.R.LegalAcceptances = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &legalAcceptanceR{}
+			}
+			foreign.R.LegalDocument = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.LegalDocumentID {
+				local.R.LegalAcceptances = append(local.R.LegalAcceptances, foreign)
+				if foreign.R == nil {
+					foreign.R = &legalAcceptanceR{}
+				}
+				foreign.R.LegalDocument = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddLegalAcceptances adds the given related objects to the existing relationships
+// of the legal_document, optionally inserting them as new records.
+// Appends related to o.R.LegalAcceptances.
+// Sets related.R.LegalDocument appropriately.
+func (o *LegalDocument) AddLegalAcceptances(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LegalAcceptance) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.LegalDocumentID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"legal_acceptances\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"legal_document_id"}),
+				strmangle.WhereClause("\"", "\"", 2, legalAcceptancePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.LegalDocumentID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &legalDocumentR{
+			LegalAcceptances: related,
+		}
+	} else {
+		o.R.LegalAcceptances = append(o.R.LegalAcceptances, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &legalAcceptanceR{
+				LegalDocument: o,
+			}
+		} else {
+			rel.R.LegalDocument = o
+		}
+	}
+	return nil
+}
+
+// LegalDocuments retrieves all the records using an executor.
+func LegalDocuments(mods ...qm.QueryMod) legalDocumentQuery {
+	mods = append(mods, qm.From("\"legal_documents\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"legal_documents\".*"})
+	}
+
+	return legalDocumentQuery{q}
+}
+
+// FindLegalDocument retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLegalDocument(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*LegalDocument, error) {
+	legalDocumentObj := &LegalDocument{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"legal_documents\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, legalDocumentObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from legal_documents")
+	}
+
+	return legalDocumentObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LegalDocument) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no legal_documents provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(legalDocumentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	legalDocumentInsertCacheMut.RLock()
+	cache, cached := legalDocumentInsertCache[key]
+	legalDocumentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			legalDocumentAllColumns,
+			legalDocumentColumnsWithDefault,
+			legalDocumentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(legalDocumentType, legalDocumentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(legalDocumentType, legalDocumentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"legal_documents\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"legal_documents\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into legal_documents")
+	}
+
+	if !cached {
+		legalDocumentInsertCacheMut.Lock()
+		legalDocumentInsertCache[key] = cache
+		legalDocumentInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the LegalDocument.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LegalDocument) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	legalDocumentUpdateCacheMut.RLock()
+	cache, cached := legalDocumentUpdateCache[key]
+	legalDocumentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			legalDocumentAllColumns,
+			legalDocumentPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update legal_documents, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"legal_documents\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, legalDocumentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(legalDocumentType, legalDocumentMapping, append(wl, legalDocumentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update legal_documents row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for legal_documents")
+	}
+
+	if !cached {
+		legalDocumentUpdateCacheMut.Lock()
+		legalDocumentUpdateCache[key] = cache
+		legalDocumentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q legalDocumentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for legal_documents")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for legal_documents")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LegalDocumentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), legalDocumentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"legal_documents\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, legalDocumentPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in legalDocument slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all legalDocument")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LegalDocument) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no legal_documents provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(legalDocumentColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	legalDocumentUpsertCacheMut.RLock()
+	cache, cached := legalDocumentUpsertCache[key]
+	legalDocumentUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			legalDocumentAllColumns,
+			legalDocumentColumnsWithDefault,
+			legalDocumentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			legalDocumentAllColumns,
+			legalDocumentPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert legal_documents, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(legalDocumentPrimaryKeyColumns))
+			copy(conflict, legalDocumentPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"legal_documents\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(legalDocumentType, legalDocumentMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(legalDocumentType, legalDocumentMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert legal_documents")
+	}
+
+	if !cached {
+		legalDocumentUpsertCacheMut.Lock()
+		legalDocumentUpsertCache[key] = cache
+		legalDocumentUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single LegalDocument record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LegalDocument) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no LegalDocument provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), legalDocumentPrimaryKeyMapping)
+	sql := "DELETE FROM \"legal_documents\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from legal_documents")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for legal_documents")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q legalDocumentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no legalDocumentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from legal_documents")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for legal_documents")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LegalDocumentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), legalDocumentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"legal_documents\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, legalDocumentPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from legalDocument slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for legal_documents")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LegalDocument) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLegalDocument(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LegalDocumentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LegalDocumentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), legalDocumentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"legal_documents\".* FROM \"legal_documents\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, legalDocumentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LegalDocumentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LegalDocumentExists checks if the LegalDocument row exists.
+func LegalDocumentExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"legal_documents\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if legal_documents exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,745 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testLegalDocuments(t *testing.T) {
+	t.Parallel()
+
+	query := LegalDocuments()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testLegalDocumentsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LegalDocuments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLegalDocumentsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := LegalDocuments().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LegalDocuments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLegalDocumentsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LegalDocumentSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := LegalDocuments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testLegalDocumentsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := LegalDocumentExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if LegalDocument exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected LegalDocumentExists to return true, but got false.")
+	}
+}
+
+func testLegalDocumentsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	legalDocumentFound, err := FindLegalDocument(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if legalDocumentFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testLegalDocumentsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = LegalDocuments().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLegalDocumentsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := LegalDocuments().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testLegalDocumentsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	legalDocumentOne := &LegalDocument{}
+	legalDocumentTwo := &LegalDocument{}
+	if err = randomize.Struct(seed, legalDocumentOne, legalDocumentDBTypes, false, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+	if err = randomize.Struct(seed, legalDocumentTwo, legalDocumentDBTypes, false, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = legalDocumentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = legalDocumentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LegalDocuments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testLegalDocumentsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	legalDocumentOne := &LegalDocument{}
+	legalDocumentTwo := &LegalDocument{}
+	if err = randomize.Struct(seed, legalDocumentOne, legalDocumentDBTypes, false, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+	if err = randomize.Struct(seed, legalDocumentTwo, legalDocumentDBTypes, false, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = legalDocumentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = legalDocumentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LegalDocuments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testLegalDocumentsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LegalDocuments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLegalDocumentsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(legalDocumentColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LegalDocuments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testLegalDocumentToManyLegalAcceptances(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LegalDocument
+	var b, c LegalAcceptance
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, legalAcceptanceDBTypes, false, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, legalAcceptanceDBTypes, false, legalAcceptanceColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.LegalDocumentID = a.ID
+	c.LegalDocumentID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.LegalAcceptances().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.LegalDocumentID == b.LegalDocumentID {
+			bFound = true
+		}
+		if v.LegalDocumentID == c.LegalDocumentID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := LegalDocumentSlice{&a}
+	if err = a.L.LoadLegalAcceptances(ctx, tx, false, (*[]*LegalDocument)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.LegalAcceptances); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.LegalAcceptances = nil
+	if err = a.L.LoadLegalAcceptances(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.LegalAcceptances); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testLegalDocumentToManyAddOpLegalAcceptances(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a LegalDocument
+	var b, c, d, e LegalAcceptance
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, legalDocumentDBTypes, false, strmangle.SetComplement(legalDocumentPrimaryKeyColumns, legalDocumentColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*LegalAcceptance{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, legalAcceptanceDBTypes, false, strmangle.SetComplement(legalAcceptancePrimaryKeyColumns, legalAcceptanceColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*LegalAcceptance{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddLegalAcceptances(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.LegalDocumentID {
+			t.Error("foreign key was wrong value", a.ID, first.LegalDocumentID)
+		}
+		if a.ID != second.LegalDocumentID {
+			t.Error("foreign key was wrong value", a.ID, second.LegalDocumentID)
+		}
+
+		if first.R.LegalDocument != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.LegalDocument != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.LegalAcceptances[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.LegalAcceptances[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.LegalAcceptances().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testLegalDocumentsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLegalDocumentsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := LegalDocumentSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testLegalDocumentsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := LegalDocuments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	legalDocumentDBTypes = map[string]string{`ID`: `uuid`, `Type`: `text`, `Version`: `integer`, `Locale`: `text`, `URL`: `text`, `Mandatory`: `boolean`, `PublishedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                    = bytes.MinRead
+)
+
+func testLegalDocumentsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(legalDocumentPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(legalDocumentAllColumns) == len(legalDocumentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LegalDocuments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testLegalDocumentsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(legalDocumentAllColumns) == len(legalDocumentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &LegalDocument{}
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := LegalDocuments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, legalDocumentDBTypes, true, legalDocumentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(legalDocumentAllColumns, legalDocumentPrimaryKeyColumns) {
+		fields = legalDocumentAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			legalDocumentAllColumns,
+			legalDocumentPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := LegalDocumentSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testLegalDocumentsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(legalDocumentAllColumns) == len(legalDocumentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := LegalDocument{}
+	if err = randomize.Struct(seed, &o, legalDocumentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LegalDocument: %s", err)
+	}
+
+	count, err := LegalDocuments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, legalDocumentDBTypes, false, legalDocumentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize LegalDocument struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert LegalDocument: %s", err)
+	}
+
+	count, err = LegalDocuments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,905 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// MfaChallenge is an object representing the database table.
+type MfaChallenge struct {
+	Token          string      `boil:"token" json:"token" toml:"token" yaml:"token"`
+	ValidUntil     time.Time   `boil:"valid_until" json:"valid_until" toml:"valid_until" yaml:"valid_until"`
+	UserID         string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	DeviceName     null.String `boil:"device_name" json:"device_name,omitempty" toml:"device_name" yaml:"device_name,omitempty"`
+	FailedAttempts int         `boil:"failed_attempts" json:"failed_attempts" toml:"failed_attempts" yaml:"failed_attempts"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *mfaChallengeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L mfaChallengeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MfaChallengeColumns = struct {
+	Token          string
+	ValidUntil     string
+	UserID         string
+	DeviceName     string
+	FailedAttempts string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	Token:          "token",
+	ValidUntil:     "valid_until",
+	UserID:         "user_id",
+	DeviceName:     "device_name",
+	FailedAttempts: "failed_attempts",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var MfaChallengeTableColumns = struct {
+	Token          string
+	ValidUntil     string
+	UserID         string
+	DeviceName     string
+	FailedAttempts string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	Token:          "mfa_challenges.token",
+	ValidUntil:     "mfa_challenges.valid_until",
+	UserID:         "mfa_challenges.user_id",
+	DeviceName:     "mfa_challenges.device_name",
+	FailedAttempts: "mfa_challenges.failed_attempts",
+	CreatedAt:      "mfa_challenges.created_at",
+	UpdatedAt:      "mfa_challenges.updated_at",
+}
+
+// Generated where
+
+var MfaChallengeWhere = struct {
+	Token          whereHelperstring
+	ValidUntil     whereHelpertime_Time
+	UserID         whereHelperstring
+	DeviceName     whereHelpernull_String
+	FailedAttempts whereHelperint
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	Token:          whereHelperstring{field: "\"mfa_challenges\".\"token\""},
+	ValidUntil:     whereHelpertime_Time{field: "\"mfa_challenges\".\"valid_until\""},
+	UserID:         whereHelperstring{field: "\"mfa_challenges\".\"user_id\""},
+	DeviceName:     whereHelpernull_String{field: "\"mfa_challenges\".\"device_name\""},
+	FailedAttempts: whereHelperint{field: "\"mfa_challenges\".\"failed_attempts\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"mfa_challenges\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"mfa_challenges\".\"updated_at\""},
+}
+
+// MfaChallengeRels is where relationship names are stored.
+var MfaChallengeRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// mfaChallengeR is where relationships are stored.
+type mfaChallengeR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*mfaChallengeR) NewStruct() *mfaChallengeR {
+	return &mfaChallengeR{}
+}
+
+func (r *mfaChallengeR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// mfaChallengeL is where Load methods for each relationship are stored.
+type mfaChallengeL struct{}
+
+var (
+	mfaChallengeAllColumns            = []string{"token", "valid_until", "user_id", "device_name", "failed_attempts", "created_at", "updated_at"}
+	mfaChallengeColumnsWithoutDefault = []string{"valid_until", "user_id", "created_at", "updated_at"}
+	mfaChallengeColumnsWithDefault    = []string{"token", "device_name", "failed_attempts"}
+	mfaChallengePrimaryKeyColumns     = []string{"token"}
+	mfaChallengeGeneratedColumns      = []string{}
+)
+
+type (
+	// MfaChallengeSlice is an alias for a slice of pointers to MfaChallenge.
+	// This should almost always be used instead of []MfaChallenge.
+	MfaChallengeSlice []*MfaChallenge
+
+	mfaChallengeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	mfaChallengeType                 = reflect.TypeOf(&MfaChallenge{})
+	mfaChallengeMapping              = queries.MakeStructMapping(mfaChallengeType)
+	mfaChallengePrimaryKeyMapping, _ = queries.BindMapping(mfaChallengeType, mfaChallengeMapping, mfaChallengePrimaryKeyColumns)
+	mfaChallengeInsertCacheMut       sync.RWMutex
+	mfaChallengeInsertCache          = make(map[string]insertCache)
+	mfaChallengeUpdateCacheMut       sync.RWMutex
+	mfaChallengeUpdateCache          = make(map[string]updateCache)
+	mfaChallengeUpsertCacheMut       sync.RWMutex
+	mfaChallengeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single mfaChallenge record from the query.
+func (q mfaChallengeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MfaChallenge, error) {
+	o := &MfaChallenge{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for mfa_challenges")
+	}
+
+	return o, nil
+}
+
+// All returns all MfaChallenge records from the query.
+func (q mfaChallengeQuery) All(ctx context.Context, exec boil.ContextExecutor) (MfaChallengeSlice, error) {
+	var o []*MfaChallenge
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to MfaChallenge slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MfaChallenge records in the query.
+func (q mfaChallengeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count mfa_challenges rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q mfaChallengeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if mfa_challenges exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *MfaChallenge) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (mfaChallengeL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMfaChallenge interface{}, mods queries.Applicator) error {
+	var slice []*MfaChallenge
+	var object *MfaChallenge
+
+	if singular {
+		var ok bool
+		object, ok = maybeMfaChallenge.(*MfaChallenge)
+		if !ok {
+			object = new(MfaChallenge)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMfaChallenge)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMfaChallenge))
+			}
+		}
+	} else {
+		s, ok := maybeMfaChallenge.(*[]*MfaChallenge)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMfaChallenge)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMfaChallenge))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &mfaChallengeR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &mfaChallengeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.MfaChallenges = append(foreign.R.MfaChallenges, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.MfaChallenges = append(foreign.R.MfaChallenges, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the mfaChallenge to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.MfaChallenges.
+func (o *MfaChallenge) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"mfa_challenges\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, mfaChallengePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Token}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &mfaChallengeR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			MfaChallenges: MfaChallengeSlice{o},
+		}
+	} else {
+		related.R.MfaChallenges = append(related.R.MfaChallenges, o)
+	}
+
+	return nil
+}
+
+// MfaChallenges retrieves all the records using an executor.
+func MfaChallenges(mods ...qm.QueryMod) mfaChallengeQuery {
+	mods = append(mods, qm.From("\"mfa_challenges\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"mfa_challenges\".*"})
+	}
+
+	return mfaChallengeQuery{q}
+}
+
+// FindMfaChallenge retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMfaChallenge(ctx context.Context, exec boil.ContextExecutor, token string, selectCols ...string) (*MfaChallenge, error) {
+	mfaChallengeObj := &MfaChallenge{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"mfa_challenges\" where \"token\"=$1", sel,
+	)
+
+	q := queries.Raw(query, token)
+
+	err := q.Bind(ctx, exec, mfaChallengeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from mfa_challenges")
+	}
+
+	return mfaChallengeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MfaChallenge) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no mfa_challenges provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mfaChallengeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	mfaChallengeInsertCacheMut.RLock()
+	cache, cached := mfaChallengeInsertCache[key]
+	mfaChallengeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			mfaChallengeAllColumns,
+			mfaChallengeColumnsWithDefault,
+			mfaChallengeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mfaChallengeType, mfaChallengeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(mfaChallengeType, mfaChallengeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"mfa_challenges\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"mfa_challenges\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into mfa_challenges")
+	}
+
+	if !cached {
+		mfaChallengeInsertCacheMut.Lock()
+		mfaChallengeInsertCache[key] = cache
+		mfaChallengeInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the MfaChallenge.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MfaChallenge) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	mfaChallengeUpdateCacheMut.RLock()
+	cache, cached := mfaChallengeUpdateCache[key]
+	mfaChallengeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			mfaChallengeAllColumns,
+			mfaChallengePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update mfa_challenges, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"mfa_challenges\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, mfaChallengePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(mfaChallengeType, mfaChallengeMapping, append(wl, mfaChallengePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update mfa_challenges row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for mfa_challenges")
+	}
+
+	if !cached {
+		mfaChallengeUpdateCacheMut.Lock()
+		mfaChallengeUpdateCache[key] = cache
+		mfaChallengeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q mfaChallengeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for mfa_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for mfa_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MfaChallengeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mfaChallengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"mfa_challenges\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, mfaChallengePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in mfaChallenge slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all mfaChallenge")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MfaChallenge) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no mfa_challenges provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mfaChallengeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	mfaChallengeUpsertCacheMut.RLock()
+	cache, cached := mfaChallengeUpsertCache[key]
+	mfaChallengeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			mfaChallengeAllColumns,
+			mfaChallengeColumnsWithDefault,
+			mfaChallengeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			mfaChallengeAllColumns,
+			mfaChallengePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert mfa_challenges, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(mfaChallengePrimaryKeyColumns))
+			copy(conflict, mfaChallengePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"mfa_challenges\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(mfaChallengeType, mfaChallengeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(mfaChallengeType, mfaChallengeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert mfa_challenges")
+	}
+
+	if !cached {
+		mfaChallengeUpsertCacheMut.Lock()
+		mfaChallengeUpsertCache[key] = cache
+		mfaChallengeUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single MfaChallenge record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MfaChallenge) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no MfaChallenge provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), mfaChallengePrimaryKeyMapping)
+	sql := "DELETE FROM \"mfa_challenges\" WHERE \"token\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from mfa_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for mfa_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q mfaChallengeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no mfaChallengeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from mfa_challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for mfa_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MfaChallengeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mfaChallengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"mfa_challenges\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mfaChallengePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from mfaChallenge slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for mfa_challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MfaChallenge) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMfaChallenge(ctx, exec, o.Token)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MfaChallengeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MfaChallengeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mfaChallengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"mfa_challenges\".* FROM \"mfa_challenges\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mfaChallengePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in MfaChallengeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MfaChallengeExists checks if the MfaChallenge row exists.
+func MfaChallengeExists(ctx context.Context, exec boil.ContextExecutor, token string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"mfa_challenges\" where \"token\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, token)
+	}
+	row := exec.QueryRowContext(ctx, sql, token)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if mfa_challenges exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1106 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// OauthAuthorizationCode is an object representing the database table.
+type OauthAuthorizationCode struct {
+	Code                string            `boil:"code" json:"code" toml:"code" yaml:"code"`
+	OauthClientID       string            `boil:"oauth_client_id" json:"oauth_client_id" toml:"oauth_client_id" yaml:"oauth_client_id"`
+	UserID              string            `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	RedirectURI         string            `boil:"redirect_uri" json:"redirect_uri" toml:"redirect_uri" yaml:"redirect_uri"`
+	Scopes              types.StringArray `boil:"scopes" json:"scopes" toml:"scopes" yaml:"scopes"`
+	CodeChallenge       string            `boil:"code_challenge" json:"code_challenge" toml:"code_challenge" yaml:"code_challenge"`
+	CodeChallengeMethod string            `boil:"code_challenge_method" json:"code_challenge_method" toml:"code_challenge_method" yaml:"code_challenge_method"`
+	ValidUntil          time.Time         `boil:"valid_until" json:"valid_until" toml:"valid_until" yaml:"valid_until"`
+	CreatedAt           time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt           time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *oauthAuthorizationCodeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oauthAuthorizationCodeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OauthAuthorizationCodeColumns = struct {
+	Code                string
+	OauthClientID       string
+	UserID              string
+	RedirectURI         string
+	Scopes              string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	ValidUntil          string
+	CreatedAt           string
+	UpdatedAt           string
+}{
+	Code:                "code",
+	OauthClientID:       "oauth_client_id",
+	UserID:              "user_id",
+	RedirectURI:         "redirect_uri",
+	Scopes:              "scopes",
+	CodeChallenge:       "code_challenge",
+	CodeChallengeMethod: "code_challenge_method",
+	ValidUntil:          "valid_until",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+}
+
+var OauthAuthorizationCodeTableColumns = struct {
+	Code                string
+	OauthClientID       string
+	UserID              string
+	RedirectURI         string
+	Scopes              string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	ValidUntil          string
+	CreatedAt           string
+	UpdatedAt           string
+}{
+	Code:                "oauth_authorization_codes.code",
+	OauthClientID:       "oauth_authorization_codes.oauth_client_id",
+	UserID:              "oauth_authorization_codes.user_id",
+	RedirectURI:         "oauth_authorization_codes.redirect_uri",
+	Scopes:              "oauth_authorization_codes.scopes",
+	CodeChallenge:       "oauth_authorization_codes.code_challenge",
+	CodeChallengeMethod: "oauth_authorization_codes.code_challenge_method",
+	ValidUntil:          "oauth_authorization_codes.valid_until",
+	CreatedAt:           "oauth_authorization_codes.created_at",
+	UpdatedAt:           "oauth_authorization_codes.updated_at",
+}
+
+// Generated where
+
+var OauthAuthorizationCodeWhere = struct {
+	Code                whereHelperstring
+	OauthClientID       whereHelperstring
+	UserID              whereHelperstring
+	RedirectURI         whereHelperstring
+	Scopes              whereHelpertypes_StringArray
+	CodeChallenge       whereHelperstring
+	CodeChallengeMethod whereHelperstring
+	ValidUntil          whereHelpertime_Time
+	CreatedAt           whereHelpertime_Time
+	UpdatedAt           whereHelpertime_Time
+}{
+	Code:                whereHelperstring{field: "\"oauth_authorization_codes\".\"code\""},
+	OauthClientID:       whereHelperstring{field: "\"oauth_authorization_codes\".\"oauth_client_id\""},
+	UserID:              whereHelperstring{field: "\"oauth_authorization_codes\".\"user_id\""},
+	RedirectURI:         whereHelperstring{field: "\"oauth_authorization_codes\".\"redirect_uri\""},
+	Scopes:              whereHelpertypes_StringArray{field: "\"oauth_authorization_codes\".\"scopes\""},
+	CodeChallenge:       whereHelperstring{field: "\"oauth_authorization_codes\".\"code_challenge\""},
+	CodeChallengeMethod: whereHelperstring{field: "\"oauth_authorization_codes\".\"code_challenge_method\""},
+	ValidUntil:          whereHelpertime_Time{field: "\"oauth_authorization_codes\".\"valid_until\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"oauth_authorization_codes\".\"created_at\""},
+	UpdatedAt:           whereHelpertime_Time{field: "\"oauth_authorization_codes\".\"updated_at\""},
+}
+
+// OauthAuthorizationCodeRels is where relationship names are stored.
+var OauthAuthorizationCodeRels = struct {
+	OauthClient string
+	User        string
+}{
+	OauthClient: "OauthClient",
+	User:        "User",
+}
+
+// oauthAuthorizationCodeR is where relationships are stored.
+type oauthAuthorizationCodeR struct {
+	OauthClient *OauthClient `boil:"OauthClient" json:"OauthClient" toml:"OauthClient" yaml:"OauthClient"`
+	User        *User        `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*oauthAuthorizationCodeR) NewStruct() *oauthAuthorizationCodeR {
+	return &oauthAuthorizationCodeR{}
+}
+
+func (r *oauthAuthorizationCodeR) GetOauthClient() *OauthClient {
+	if r == nil {
+		return nil
+	}
+	return r.OauthClient
+}
+
+func (r *oauthAuthorizationCodeR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// oauthAuthorizationCodeL is where Load methods for each relationship are stored.
+type oauthAuthorizationCodeL struct{}
+
+var (
+	oauthAuthorizationCodeAllColumns            = []string{"code", "oauth_client_id", "user_id", "redirect_uri", "scopes", "code_challenge", "code_challenge_method", "valid_until", "created_at", "updated_at"}
+	oauthAuthorizationCodeColumnsWithoutDefault = []string{"oauth_client_id", "user_id", "redirect_uri", "scopes", "code_challenge", "code_challenge_method", "valid_until", "created_at", "updated_at"}
+	oauthAuthorizationCodeColumnsWithDefault    = []string{"code"}
+	oauthAuthorizationCodePrimaryKeyColumns     = []string{"code"}
+	oauthAuthorizationCodeGeneratedColumns      = []string{}
+)
+
+type (
+	// OauthAuthorizationCodeSlice is an alias for a slice of pointers to OauthAuthorizationCode.
+	// This should almost always be used instead of []OauthAuthorizationCode.
+	OauthAuthorizationCodeSlice []*OauthAuthorizationCode
+
+	oauthAuthorizationCodeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oauthAuthorizationCodeType                 = reflect.TypeOf(&OauthAuthorizationCode{})
+	oauthAuthorizationCodeMapping              = queries.MakeStructMapping(oauthAuthorizationCodeType)
+	oauthAuthorizationCodePrimaryKeyMapping, _ = queries.BindMapping(oauthAuthorizationCodeType, oauthAuthorizationCodeMapping, oauthAuthorizationCodePrimaryKeyColumns)
+	oauthAuthorizationCodeInsertCacheMut       sync.RWMutex
+	oauthAuthorizationCodeInsertCache          = make(map[string]insertCache)
+	oauthAuthorizationCodeUpdateCacheMut       sync.RWMutex
+	oauthAuthorizationCodeUpdateCache          = make(map[string]updateCache)
+	oauthAuthorizationCodeUpsertCacheMut       sync.RWMutex
+	oauthAuthorizationCodeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single oauthAuthorizationCode record from the query.
+func (q oauthAuthorizationCodeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OauthAuthorizationCode, error) {
+	o := &OauthAuthorizationCode{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for oauth_authorization_codes")
+	}
+
+	return o, nil
+}
+
+// All returns all OauthAuthorizationCode records from the query.
+func (q oauthAuthorizationCodeQuery) All(ctx context.Context, exec boil.ContextExecutor) (OauthAuthorizationCodeSlice, error) {
+	var o []*OauthAuthorizationCode
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OauthAuthorizationCode slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OauthAuthorizationCode records in the query.
+func (q oauthAuthorizationCodeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count oauth_authorization_codes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q oauthAuthorizationCodeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if oauth_authorization_codes exists")
+	}
+
+	return count > 0, nil
+}
+
+// OauthClient pointed to by the foreign key.
+func (o *OauthAuthorizationCode) OauthClient(mods ...qm.QueryMod) oauthClientQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OauthClientID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return OauthClients(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *OauthAuthorizationCode) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadOauthClient allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oauthAuthorizationCodeL) LoadOauthClient(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOauthAuthorizationCode interface{}, mods queries.Applicator) error {
+	var slice []*OauthAuthorizationCode
+	var object *OauthAuthorizationCode
+
+	if singular {
+		var ok bool
+		object, ok = maybeOauthAuthorizationCode.(*OauthAuthorizationCode)
+		if !ok {
+			object = new(OauthAuthorizationCode)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOauthAuthorizationCode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOauthAuthorizationCode))
+			}
+		}
+	} else {
+		s, ok := maybeOauthAuthorizationCode.(*[]*OauthAuthorizationCode)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOauthAuthorizationCode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOauthAuthorizationCode))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oauthAuthorizationCodeR{}
+		}
+		args = append(args, object.OauthClientID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oauthAuthorizationCodeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.OauthClientID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.OauthClientID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oauth_clients`),
+		qm.WhereIn(`oauth_clients.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load OauthClient")
+	}
+
+	var resultSlice []*OauthClient
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice OauthClient")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for oauth_clients")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oauth_clients")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OauthClient = foreign
+		if foreign.R == nil {
+			foreign.R = &oauthClientR{}
+		}
+		foreign.R.OauthAuthorizationCodes = append(foreign.R.OauthAuthorizationCodes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OauthClientID == foreign.ID {
+				local.R.OauthClient = foreign
+				if foreign.R == nil {
+					foreign.R = &oauthClientR{}
+				}
+				foreign.R.OauthAuthorizationCodes = append(foreign.R.OauthAuthorizationCodes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oauthAuthorizationCodeL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOauthAuthorizationCode interface{}, mods queries.Applicator) error {
+	var slice []*OauthAuthorizationCode
+	var object *OauthAuthorizationCode
+
+	if singular {
+		var ok bool
+		object, ok = maybeOauthAuthorizationCode.(*OauthAuthorizationCode)
+		if !ok {
+			object = new(OauthAuthorizationCode)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOauthAuthorizationCode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOauthAuthorizationCode))
+			}
+		}
+	} else {
+		s, ok := maybeOauthAuthorizationCode.(*[]*OauthAuthorizationCode)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOauthAuthorizationCode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOauthAuthorizationCode))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oauthAuthorizationCodeR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oauthAuthorizationCodeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OauthAuthorizationCodes = append(foreign.R.OauthAuthorizationCodes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OauthAuthorizationCodes = append(foreign.R.OauthAuthorizationCodes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOauthClient of the oauthAuthorizationCode to the related item.
+// Sets o.R.OauthClient to related.
+// Adds o to related.R.OauthAuthorizationCodes.
+func (o *OauthAuthorizationCode) SetOauthClient(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OauthClient) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oauth_authorization_codes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"oauth_client_id"}),
+		strmangle.WhereClause("\"", "\"", 2, oauthAuthorizationCodePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Code}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OauthClientID = related.ID
+	if o.R == nil {
+		o.R = &oauthAuthorizationCodeR{
+			OauthClient: related,
+		}
+	} else {
+		o.R.OauthClient = related
+	}
+
+	if related.R == nil {
+		related.R = &oauthClientR{
+			OauthAuthorizationCodes: OauthAuthorizationCodeSlice{o},
+		}
+	} else {
+		related.R.OauthAuthorizationCodes = append(related.R.OauthAuthorizationCodes, o)
+	}
+
+	return nil
+}
+
+// SetUser of the oauthAuthorizationCode to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.OauthAuthorizationCodes.
+func (o *OauthAuthorizationCode) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oauth_authorization_codes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, oauthAuthorizationCodePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Code}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &oauthAuthorizationCodeR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OauthAuthorizationCodes: OauthAuthorizationCodeSlice{o},
+		}
+	} else {
+		related.R.OauthAuthorizationCodes = append(related.R.OauthAuthorizationCodes, o)
+	}
+
+	return nil
+}
+
+// OauthAuthorizationCodes retrieves all the records using an executor.
+func OauthAuthorizationCodes(mods ...qm.QueryMod) oauthAuthorizationCodeQuery {
+	mods = append(mods, qm.From("\"oauth_authorization_codes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"oauth_authorization_codes\".*"})
+	}
+
+	return oauthAuthorizationCodeQuery{q}
+}
+
+// FindOauthAuthorizationCode retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOauthAuthorizationCode(ctx context.Context, exec boil.ContextExecutor, code string, selectCols ...string) (*OauthAuthorizationCode, error) {
+	oauthAuthorizationCodeObj := &OauthAuthorizationCode{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"oauth_authorization_codes\" where \"code\"=$1", sel,
+	)
+
+	q := queries.Raw(query, code)
+
+	err := q.Bind(ctx, exec, oauthAuthorizationCodeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from oauth_authorization_codes")
+	}
+
+	return oauthAuthorizationCodeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OauthAuthorizationCode) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oauth_authorization_codes provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oauthAuthorizationCodeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oauthAuthorizationCodeInsertCacheMut.RLock()
+	cache, cached := oauthAuthorizationCodeInsertCache[key]
+	oauthAuthorizationCodeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oauthAuthorizationCodeAllColumns,
+			oauthAuthorizationCodeColumnsWithDefault,
+			oauthAuthorizationCodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(oauthAuthorizationCodeType, oauthAuthorizationCodeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oauthAuthorizationCodeType, oauthAuthorizationCodeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"oauth_authorization_codes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"oauth_authorization_codes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into oauth_authorization_codes")
+	}
+
+	if !cached {
+		oauthAuthorizationCodeInsertCacheMut.Lock()
+		oauthAuthorizationCodeInsertCache[key] = cache
+		oauthAuthorizationCodeInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the OauthAuthorizationCode.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OauthAuthorizationCode) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	oauthAuthorizationCodeUpdateCacheMut.RLock()
+	cache, cached := oauthAuthorizationCodeUpdateCache[key]
+	oauthAuthorizationCodeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oauthAuthorizationCodeAllColumns,
+			oauthAuthorizationCodePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update oauth_authorization_codes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"oauth_authorization_codes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, oauthAuthorizationCodePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oauthAuthorizationCodeType, oauthAuthorizationCodeMapping, append(wl, oauthAuthorizationCodePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update oauth_authorization_codes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for oauth_authorization_codes")
+	}
+
+	if !cached {
+		oauthAuthorizationCodeUpdateCacheMut.Lock()
+		oauthAuthorizationCodeUpdateCache[key] = cache
+		oauthAuthorizationCodeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oauthAuthorizationCodeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for oauth_authorization_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for oauth_authorization_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OauthAuthorizationCodeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oauthAuthorizationCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"oauth_authorization_codes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, oauthAuthorizationCodePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in oauthAuthorizationCode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all oauthAuthorizationCode")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OauthAuthorizationCode) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oauth_authorization_codes provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oauthAuthorizationCodeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oauthAuthorizationCodeUpsertCacheMut.RLock()
+	cache, cached := oauthAuthorizationCodeUpsertCache[key]
+	oauthAuthorizationCodeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			oauthAuthorizationCodeAllColumns,
+			oauthAuthorizationCodeColumnsWithDefault,
+			oauthAuthorizationCodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			oauthAuthorizationCodeAllColumns,
+			oauthAuthorizationCodePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert oauth_authorization_codes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(oauthAuthorizationCodePrimaryKeyColumns))
+			copy(conflict, oauthAuthorizationCodePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"oauth_authorization_codes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(oauthAuthorizationCodeType, oauthAuthorizationCodeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oauthAuthorizationCodeType, oauthAuthorizationCodeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert oauth_authorization_codes")
+	}
+
+	if !cached {
+		oauthAuthorizationCodeUpsertCacheMut.Lock()
+		oauthAuthorizationCodeUpsertCache[key] = cache
+		oauthAuthorizationCodeUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single OauthAuthorizationCode record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OauthAuthorizationCode) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OauthAuthorizationCode provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oauthAuthorizationCodePrimaryKeyMapping)
+	sql := "DELETE FROM \"oauth_authorization_codes\" WHERE \"code\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from oauth_authorization_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for oauth_authorization_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q oauthAuthorizationCodeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no oauthAuthorizationCodeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oauth_authorization_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oauth_authorization_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OauthAuthorizationCodeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oauthAuthorizationCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"oauth_authorization_codes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oauthAuthorizationCodePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oauthAuthorizationCode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oauth_authorization_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OauthAuthorizationCode) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOauthAuthorizationCode(ctx, exec, o.Code)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OauthAuthorizationCodeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OauthAuthorizationCodeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oauthAuthorizationCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"oauth_authorization_codes\".* FROM \"oauth_authorization_codes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oauthAuthorizationCodePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OauthAuthorizationCodeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OauthAuthorizationCodeExists checks if the OauthAuthorizationCode row exists.
+func OauthAuthorizationCodeExists(ctx context.Context, exec boil.ContextExecutor, code string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"oauth_authorization_codes\" where \"code\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, code)
+	}
+	row := exec.QueryRowContext(ctx, sql, code)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if oauth_authorization_codes exists")
+	}
+
+	return exists, nil
+}
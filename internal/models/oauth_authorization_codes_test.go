@@ -0,0 +1,808 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOauthAuthorizationCodes(t *testing.T) {
+	t.Parallel()
+
+	query := OauthAuthorizationCodes()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOauthAuthorizationCodesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OauthAuthorizationCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOauthAuthorizationCodesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OauthAuthorizationCodes().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OauthAuthorizationCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOauthAuthorizationCodesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OauthAuthorizationCodeSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OauthAuthorizationCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOauthAuthorizationCodesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OauthAuthorizationCodeExists(ctx, tx, o.Code)
+	if err != nil {
+		t.Errorf("Unable to check if OauthAuthorizationCode exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OauthAuthorizationCodeExists to return true, but got false.")
+	}
+}
+
+func testOauthAuthorizationCodesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	oauthAuthorizationCodeFound, err := FindOauthAuthorizationCode(ctx, tx, o.Code)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if oauthAuthorizationCodeFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOauthAuthorizationCodesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OauthAuthorizationCodes().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOauthAuthorizationCodesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OauthAuthorizationCodes().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOauthAuthorizationCodesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	oauthAuthorizationCodeOne := &OauthAuthorizationCode{}
+	oauthAuthorizationCodeTwo := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, oauthAuthorizationCodeOne, oauthAuthorizationCodeDBTypes, false, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oauthAuthorizationCodeTwo, oauthAuthorizationCodeDBTypes, false, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oauthAuthorizationCodeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oauthAuthorizationCodeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OauthAuthorizationCodes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOauthAuthorizationCodesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	oauthAuthorizationCodeOne := &OauthAuthorizationCode{}
+	oauthAuthorizationCodeTwo := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, oauthAuthorizationCodeOne, oauthAuthorizationCodeDBTypes, false, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oauthAuthorizationCodeTwo, oauthAuthorizationCodeDBTypes, false, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oauthAuthorizationCodeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oauthAuthorizationCodeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OauthAuthorizationCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testOauthAuthorizationCodesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OauthAuthorizationCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOauthAuthorizationCodesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(oauthAuthorizationCodeColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OauthAuthorizationCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOauthAuthorizationCodeToOneOauthClientUsingOauthClient(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OauthAuthorizationCode
+	var foreign OauthClient
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oauthAuthorizationCodeDBTypes, false, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, oauthClientDBTypes, false, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.OauthClientID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OauthClient().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OauthAuthorizationCodeSlice{&local}
+	if err = local.L.LoadOauthClient(ctx, tx, false, (*[]*OauthAuthorizationCode)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OauthClient == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OauthClient = nil
+	if err = local.L.LoadOauthClient(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OauthClient == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testOauthAuthorizationCodeToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OauthAuthorizationCode
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oauthAuthorizationCodeDBTypes, false, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OauthAuthorizationCodeSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*OauthAuthorizationCode)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testOauthAuthorizationCodeToOneSetOpOauthClientUsingOauthClient(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthAuthorizationCode
+	var b, c OauthClient
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthAuthorizationCodeDBTypes, false, strmangle.SetComplement(oauthAuthorizationCodePrimaryKeyColumns, oauthAuthorizationCodeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*OauthClient{&b, &c} {
+		err = a.SetOauthClient(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OauthClient != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OauthAuthorizationCodes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.OauthClientID != x.ID {
+			t.Error("foreign key was wrong value", a.OauthClientID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OauthClientID))
+		reflect.Indirect(reflect.ValueOf(&a.OauthClientID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.OauthClientID != x.ID {
+			t.Error("foreign key was wrong value", a.OauthClientID, x.ID)
+		}
+	}
+}
+func testOauthAuthorizationCodeToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthAuthorizationCode
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthAuthorizationCodeDBTypes, false, strmangle.SetComplement(oauthAuthorizationCodePrimaryKeyColumns, oauthAuthorizationCodeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OauthAuthorizationCodes[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testOauthAuthorizationCodesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOauthAuthorizationCodesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OauthAuthorizationCodeSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOauthAuthorizationCodesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OauthAuthorizationCodes().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	oauthAuthorizationCodeDBTypes = map[string]string{`Code`: `uuid`, `OauthClientID`: `uuid`, `UserID`: `uuid`, `RedirectURI`: `text`, `Scopes`: `ARRAYtext`, `CodeChallenge`: `text`, `CodeChallengeMethod`: `text`, `ValidUntil`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                             = bytes.MinRead
+)
+
+func testOauthAuthorizationCodesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(oauthAuthorizationCodePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(oauthAuthorizationCodeAllColumns) == len(oauthAuthorizationCodePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OauthAuthorizationCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOauthAuthorizationCodesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(oauthAuthorizationCodeAllColumns) == len(oauthAuthorizationCodePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OauthAuthorizationCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oauthAuthorizationCodeDBTypes, true, oauthAuthorizationCodePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(oauthAuthorizationCodeAllColumns, oauthAuthorizationCodePrimaryKeyColumns) {
+		fields = oauthAuthorizationCodeAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			oauthAuthorizationCodeAllColumns,
+			oauthAuthorizationCodePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OauthAuthorizationCodeSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOauthAuthorizationCodesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(oauthAuthorizationCodeAllColumns) == len(oauthAuthorizationCodePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OauthAuthorizationCode{}
+	if err = randomize.Struct(seed, &o, oauthAuthorizationCodeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OauthAuthorizationCode: %s", err)
+	}
+
+	count, err := OauthAuthorizationCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, oauthAuthorizationCodeDBTypes, false, oauthAuthorizationCodePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OauthAuthorizationCode struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OauthAuthorizationCode: %s", err)
+	}
+
+	count, err = OauthAuthorizationCodes().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1657 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// OauthClient is an object representing the database table.
+type OauthClient struct {
+	ID           string            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name         string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	SecretHash   null.String       `boil:"secret_hash" json:"secret_hash,omitempty" toml:"secret_hash" yaml:"secret_hash,omitempty"`
+	RedirectUris types.StringArray `boil:"redirect_uris" json:"redirect_uris" toml:"redirect_uris" yaml:"redirect_uris"`
+	GrantTypes   types.StringArray `boil:"grant_types" json:"grant_types" toml:"grant_types" yaml:"grant_types"`
+	Scopes       types.StringArray `boil:"scopes" json:"scopes" toml:"scopes" yaml:"scopes"`
+	UserID       null.String       `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	CreatedAt    time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *oauthClientR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oauthClientL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OauthClientColumns = struct {
+	ID           string
+	Name         string
+	SecretHash   string
+	RedirectUris string
+	GrantTypes   string
+	Scopes       string
+	UserID       string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "id",
+	Name:         "name",
+	SecretHash:   "secret_hash",
+	RedirectUris: "redirect_uris",
+	GrantTypes:   "grant_types",
+	Scopes:       "scopes",
+	UserID:       "user_id",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+var OauthClientTableColumns = struct {
+	ID           string
+	Name         string
+	SecretHash   string
+	RedirectUris string
+	GrantTypes   string
+	Scopes       string
+	UserID       string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "oauth_clients.id",
+	Name:         "oauth_clients.name",
+	SecretHash:   "oauth_clients.secret_hash",
+	RedirectUris: "oauth_clients.redirect_uris",
+	GrantTypes:   "oauth_clients.grant_types",
+	Scopes:       "oauth_clients.scopes",
+	UserID:       "oauth_clients.user_id",
+	CreatedAt:    "oauth_clients.created_at",
+	UpdatedAt:    "oauth_clients.updated_at",
+}
+
+// Generated where
+
+var OauthClientWhere = struct {
+	ID           whereHelperstring
+	Name         whereHelperstring
+	SecretHash   whereHelpernull_String
+	RedirectUris whereHelpertypes_StringArray
+	GrantTypes   whereHelpertypes_StringArray
+	Scopes       whereHelpertypes_StringArray
+	UserID       whereHelpernull_String
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperstring{field: "\"oauth_clients\".\"id\""},
+	Name:         whereHelperstring{field: "\"oauth_clients\".\"name\""},
+	SecretHash:   whereHelpernull_String{field: "\"oauth_clients\".\"secret_hash\""},
+	RedirectUris: whereHelpertypes_StringArray{field: "\"oauth_clients\".\"redirect_uris\""},
+	GrantTypes:   whereHelpertypes_StringArray{field: "\"oauth_clients\".\"grant_types\""},
+	Scopes:       whereHelpertypes_StringArray{field: "\"oauth_clients\".\"scopes\""},
+	UserID:       whereHelpernull_String{field: "\"oauth_clients\".\"user_id\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"oauth_clients\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"oauth_clients\".\"updated_at\""},
+}
+
+// OauthClientRels is where relationship names are stored.
+var OauthClientRels = struct {
+	User                    string
+	AccessTokens            string
+	OauthAuthorizationCodes string
+	RefreshTokens           string
+}{
+	User:                    "User",
+	AccessTokens:            "AccessTokens",
+	OauthAuthorizationCodes: "OauthAuthorizationCodes",
+	RefreshTokens:           "RefreshTokens",
+}
+
+// oauthClientR is where relationships are stored.
+type oauthClientR struct {
+	User                    *User                       `boil:"User" json:"User" toml:"User" yaml:"User"`
+	AccessTokens            AccessTokenSlice            `boil:"AccessTokens" json:"AccessTokens" toml:"AccessTokens" yaml:"AccessTokens"`
+	OauthAuthorizationCodes OauthAuthorizationCodeSlice `boil:"OauthAuthorizationCodes" json:"OauthAuthorizationCodes" toml:"OauthAuthorizationCodes" yaml:"OauthAuthorizationCodes"`
+	RefreshTokens           RefreshTokenSlice           `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
+}
+
+// NewStruct creates a new relationship struct
+func (*oauthClientR) NewStruct() *oauthClientR {
+	return &oauthClientR{}
+}
+
+func (r *oauthClientR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *oauthClientR) GetAccessTokens() AccessTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AccessTokens
+}
+
+func (r *oauthClientR) GetOauthAuthorizationCodes() OauthAuthorizationCodeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OauthAuthorizationCodes
+}
+
+func (r *oauthClientR) GetRefreshTokens() RefreshTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RefreshTokens
+}
+
+// oauthClientL is where Load methods for each relationship are stored.
+type oauthClientL struct{}
+
+var (
+	oauthClientAllColumns            = []string{"id", "name", "secret_hash", "redirect_uris", "grant_types", "scopes", "user_id", "created_at", "updated_at"}
+	oauthClientColumnsWithoutDefault = []string{"name", "redirect_uris", "grant_types", "scopes", "created_at", "updated_at"}
+	oauthClientColumnsWithDefault    = []string{"id", "secret_hash", "user_id"}
+	oauthClientPrimaryKeyColumns     = []string{"id"}
+	oauthClientGeneratedColumns      = []string{}
+)
+
+type (
+	// OauthClientSlice is an alias for a slice of pointers to OauthClient.
+	// This should almost always be used instead of []OauthClient.
+	OauthClientSlice []*OauthClient
+
+	oauthClientQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oauthClientType                 = reflect.TypeOf(&OauthClient{})
+	oauthClientMapping              = queries.MakeStructMapping(oauthClientType)
+	oauthClientPrimaryKeyMapping, _ = queries.BindMapping(oauthClientType, oauthClientMapping, oauthClientPrimaryKeyColumns)
+	oauthClientInsertCacheMut       sync.RWMutex
+	oauthClientInsertCache          = make(map[string]insertCache)
+	oauthClientUpdateCacheMut       sync.RWMutex
+	oauthClientUpdateCache          = make(map[string]updateCache)
+	oauthClientUpsertCacheMut       sync.RWMutex
+	oauthClientUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single oauthClient record from the query.
+func (q oauthClientQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OauthClient, error) {
+	o := &OauthClient{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for oauth_clients")
+	}
+
+	return o, nil
+}
+
+// All returns all OauthClient records from the query.
+func (q oauthClientQuery) All(ctx context.Context, exec boil.ContextExecutor) (OauthClientSlice, error) {
+	var o []*OauthClient
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to OauthClient slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OauthClient records in the query.
+func (q oauthClientQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count oauth_clients rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q oauthClientQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if oauth_clients exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *OauthClient) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// AccessTokens retrieves all the access_token's AccessTokens with an executor.
+func (o *OauthClient) AccessTokens(mods ...qm.QueryMod) accessTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"access_tokens\".\"oauth_client_id\"=?", o.ID),
+	)
+
+	return AccessTokens(queryMods...)
+}
+
+// OauthAuthorizationCodes retrieves all the oauth_authorization_code's OauthAuthorizationCodes with an executor.
+func (o *OauthClient) OauthAuthorizationCodes(mods ...qm.QueryMod) oauthAuthorizationCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oauth_authorization_codes\".\"oauth_client_id\"=?", o.ID),
+	)
+
+	return OauthAuthorizationCodes(queryMods...)
+}
+
+// RefreshTokens retrieves all the refresh_token's RefreshTokens with an executor.
+func (o *OauthClient) RefreshTokens(mods ...qm.QueryMod) refreshTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"refresh_tokens\".\"oauth_client_id\"=?", o.ID),
+	)
+
+	return RefreshTokens(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oauthClientL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOauthClient interface{}, mods queries.Applicator) error {
+	var slice []*OauthClient
+	var object *OauthClient
+
+	if singular {
+		var ok bool
+		object, ok = maybeOauthClient.(*OauthClient)
+		if !ok {
+			object = new(OauthClient)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOauthClient)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOauthClient))
+			}
+		}
+	} else {
+		s, ok := maybeOauthClient.(*[]*OauthClient)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOauthClient)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOauthClient))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oauthClientR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args = append(args, object.UserID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oauthClientR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args = append(args, obj.UserID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OauthClients = append(foreign.R.OauthClients, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OauthClients = append(foreign.R.OauthClients, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAccessTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (oauthClientL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOauthClient interface{}, mods queries.Applicator) error {
+	var slice []*OauthClient
+	var object *OauthClient
+
+	if singular {
+		var ok bool
+		object, ok = maybeOauthClient.(*OauthClient)
+		if !ok {
+			object = new(OauthClient)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOauthClient)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOauthClient))
+			}
+		}
+	} else {
+		s, ok := maybeOauthClient.(*[]*OauthClient)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOauthClient)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOauthClient))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oauthClientR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oauthClientR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`access_tokens`),
+		qm.WhereIn(`access_tokens.oauth_client_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load access_tokens")
+	}
+
+	var resultSlice []*AccessToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice access_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on access_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for access_tokens")
+	}
+
+	if singular {
+		object.R.AccessTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accessTokenR{}
+			}
+			foreign.R.OauthClient = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.OauthClientID) {
+				local.R.AccessTokens = append(local.R.AccessTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &accessTokenR{}
+				}
+				foreign.R.OauthClient = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOauthAuthorizationCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (oauthClientL) LoadOauthAuthorizationCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOauthClient interface{}, mods queries.Applicator) error {
+	var slice []*OauthClient
+	var object *OauthClient
+
+	if singular {
+		var ok bool
+		object, ok = maybeOauthClient.(*OauthClient)
+		if !ok {
+			object = new(OauthClient)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOauthClient)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOauthClient))
+			}
+		}
+	} else {
+		s, ok := maybeOauthClient.(*[]*OauthClient)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOauthClient)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOauthClient))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oauthClientR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oauthClientR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oauth_authorization_codes`),
+		qm.WhereIn(`oauth_authorization_codes.oauth_client_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oauth_authorization_codes")
+	}
+
+	var resultSlice []*OauthAuthorizationCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oauth_authorization_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oauth_authorization_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oauth_authorization_codes")
+	}
+
+	if singular {
+		object.R.OauthAuthorizationCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oauthAuthorizationCodeR{}
+			}
+			foreign.R.OauthClient = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.OauthClientID {
+				local.R.OauthAuthorizationCodes = append(local.R.OauthAuthorizationCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &oauthAuthorizationCodeR{}
+				}
+				foreign.R.OauthClient = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (oauthClientL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOauthClient interface{}, mods queries.Applicator) error {
+	var slice []*OauthClient
+	var object *OauthClient
+
+	if singular {
+		var ok bool
+		object, ok = maybeOauthClient.(*OauthClient)
+		if !ok {
+			object = new(OauthClient)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOauthClient)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOauthClient))
+			}
+		}
+	} else {
+		s, ok := maybeOauthClient.(*[]*OauthClient)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOauthClient)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOauthClient))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oauthClientR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oauthClientR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`refresh_tokens`),
+		qm.WhereIn(`refresh_tokens.oauth_client_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load refresh_tokens")
+	}
+
+	var resultSlice []*RefreshToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+	}
+
+	if singular {
+		object.R.RefreshTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &refreshTokenR{}
+			}
+			foreign.R.OauthClient = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.OauthClientID) {
+				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &refreshTokenR{}
+				}
+				foreign.R.OauthClient = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the oauthClient to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.OauthClients.
+func (o *OauthClient) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oauth_clients\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, oauthClientPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &oauthClientR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OauthClients: OauthClientSlice{o},
+		}
+	} else {
+		related.R.OauthClients = append(related.R.OauthClients, o)
+	}
+
+	return nil
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *OauthClient) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.OauthClients {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.OauthClients)
+		if ln > 1 && i < ln-1 {
+			related.R.OauthClients[i] = related.R.OauthClients[ln-1]
+		}
+		related.R.OauthClients = related.R.OauthClients[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddAccessTokens adds the given related objects to the existing relationships
+// of the oauth_client, optionally inserting them as new records.
+// Appends related to o.R.AccessTokens.
+// Sets related.R.OauthClient appropriately.
+func (o *OauthClient) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccessToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.OauthClientID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"access_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"oauth_client_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accessTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.OauthClientID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &oauthClientR{
+			AccessTokens: related,
+		}
+	} else {
+		o.R.AccessTokens = append(o.R.AccessTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accessTokenR{
+				OauthClient: o,
+			}
+		} else {
+			rel.R.OauthClient = o
+		}
+	}
+	return nil
+}
+
+// SetAccessTokens removes all previously related items of the
+// oauth_client replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.OauthClient's AccessTokens accordingly.
+// Replaces o.R.AccessTokens with related.
+// Sets related.R.OauthClient's AccessTokens accordingly.
+func (o *OauthClient) SetAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccessToken) error {
+	query := "update \"access_tokens\" set \"oauth_client_id\" = null where \"oauth_client_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AccessTokens {
+			queries.SetScanner(&rel.OauthClientID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.OauthClient = nil
+		}
+		o.R.AccessTokens = nil
+	}
+
+	return o.AddAccessTokens(ctx, exec, insert, related...)
+}
+
+// RemoveAccessTokens relationships from objects passed in.
+// Removes related items from R.AccessTokens (uses pointer comparison, removal does not keep order)
+// Sets related.R.OauthClient.
+func (o *OauthClient) RemoveAccessTokens(ctx context.Context, exec boil.ContextExecutor, related ...*AccessToken) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.OauthClientID, nil)
+		if rel.R != nil {
+			rel.R.OauthClient = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("oauth_client_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AccessTokens {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AccessTokens)
+			if ln > 1 && i < ln-1 {
+				o.R.AccessTokens[i] = o.R.AccessTokens[ln-1]
+			}
+			o.R.AccessTokens = o.R.AccessTokens[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddOauthAuthorizationCodes adds the given related objects to the existing relationships
+// of the oauth_client, optionally inserting them as new records.
+// Appends related to o.R.OauthAuthorizationCodes.
+// Sets related.R.OauthClient appropriately.
+func (o *OauthClient) AddOauthAuthorizationCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OauthAuthorizationCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OauthClientID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oauth_authorization_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"oauth_client_id"}),
+				strmangle.WhereClause("\"", "\"", 2, oauthAuthorizationCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Code}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OauthClientID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &oauthClientR{
+			OauthAuthorizationCodes: related,
+		}
+	} else {
+		o.R.OauthAuthorizationCodes = append(o.R.OauthAuthorizationCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oauthAuthorizationCodeR{
+				OauthClient: o,
+			}
+		} else {
+			rel.R.OauthClient = o
+		}
+	}
+	return nil
+}
+
+// AddRefreshTokens adds the given related objects to the existing relationships
+// of the oauth_client, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokens.
+// Sets related.R.OauthClient appropriately.
+func (o *OauthClient) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.OauthClientID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"oauth_client_id"}),
+				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.OauthClientID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &oauthClientR{
+			RefreshTokens: related,
+		}
+	} else {
+		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &refreshTokenR{
+				OauthClient: o,
+			}
+		} else {
+			rel.R.OauthClient = o
+		}
+	}
+	return nil
+}
+
+// SetRefreshTokens removes all previously related items of the
+// oauth_client replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.OauthClient's RefreshTokens accordingly.
+// Replaces o.R.RefreshTokens with related.
+// Sets related.R.OauthClient's RefreshTokens accordingly.
+func (o *OauthClient) SetRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
+	query := "update \"refresh_tokens\" set \"oauth_client_id\" = null where \"oauth_client_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.RefreshTokens {
+			queries.SetScanner(&rel.OauthClientID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.OauthClient = nil
+		}
+		o.R.RefreshTokens = nil
+	}
+
+	return o.AddRefreshTokens(ctx, exec, insert, related...)
+}
+
+// RemoveRefreshTokens relationships from objects passed in.
+// Removes related items from R.RefreshTokens (uses pointer comparison, removal does not keep order)
+// Sets related.R.OauthClient.
+func (o *OauthClient) RemoveRefreshTokens(ctx context.Context, exec boil.ContextExecutor, related ...*RefreshToken) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.OauthClientID, nil)
+		if rel.R != nil {
+			rel.R.OauthClient = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("oauth_client_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RefreshTokens {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RefreshTokens)
+			if ln > 1 && i < ln-1 {
+				o.R.RefreshTokens[i] = o.R.RefreshTokens[ln-1]
+			}
+			o.R.RefreshTokens = o.R.RefreshTokens[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// OauthClients retrieves all the records using an executor.
+func OauthClients(mods ...qm.QueryMod) oauthClientQuery {
+	mods = append(mods, qm.From("\"oauth_clients\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"oauth_clients\".*"})
+	}
+
+	return oauthClientQuery{q}
+}
+
+// FindOauthClient retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOauthClient(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*OauthClient, error) {
+	oauthClientObj := &OauthClient{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"oauth_clients\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, oauthClientObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from oauth_clients")
+	}
+
+	return oauthClientObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OauthClient) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oauth_clients provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oauthClientColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oauthClientInsertCacheMut.RLock()
+	cache, cached := oauthClientInsertCache[key]
+	oauthClientInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oauthClientAllColumns,
+			oauthClientColumnsWithDefault,
+			oauthClientColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(oauthClientType, oauthClientMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oauthClientType, oauthClientMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"oauth_clients\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"oauth_clients\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into oauth_clients")
+	}
+
+	if !cached {
+		oauthClientInsertCacheMut.Lock()
+		oauthClientInsertCache[key] = cache
+		oauthClientInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the OauthClient.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OauthClient) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	oauthClientUpdateCacheMut.RLock()
+	cache, cached := oauthClientUpdateCache[key]
+	oauthClientUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oauthClientAllColumns,
+			oauthClientPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update oauth_clients, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"oauth_clients\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, oauthClientPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oauthClientType, oauthClientMapping, append(wl, oauthClientPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update oauth_clients row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for oauth_clients")
+	}
+
+	if !cached {
+		oauthClientUpdateCacheMut.Lock()
+		oauthClientUpdateCache[key] = cache
+		oauthClientUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oauthClientQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for oauth_clients")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for oauth_clients")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OauthClientSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oauthClientPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"oauth_clients\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, oauthClientPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in oauthClient slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all oauthClient")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OauthClient) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no oauth_clients provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oauthClientColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oauthClientUpsertCacheMut.RLock()
+	cache, cached := oauthClientUpsertCache[key]
+	oauthClientUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			oauthClientAllColumns,
+			oauthClientColumnsWithDefault,
+			oauthClientColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			oauthClientAllColumns,
+			oauthClientPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert oauth_clients, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(oauthClientPrimaryKeyColumns))
+			copy(conflict, oauthClientPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"oauth_clients\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(oauthClientType, oauthClientMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oauthClientType, oauthClientMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert oauth_clients")
+	}
+
+	if !cached {
+		oauthClientUpsertCacheMut.Lock()
+		oauthClientUpsertCache[key] = cache
+		oauthClientUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single OauthClient record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OauthClient) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no OauthClient provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oauthClientPrimaryKeyMapping)
+	sql := "DELETE FROM \"oauth_clients\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from oauth_clients")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for oauth_clients")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q oauthClientQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no oauthClientQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oauth_clients")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oauth_clients")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OauthClientSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oauthClientPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"oauth_clients\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oauthClientPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from oauthClient slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for oauth_clients")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OauthClient) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOauthClient(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OauthClientSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OauthClientSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oauthClientPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"oauth_clients\".* FROM \"oauth_clients\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oauthClientPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in OauthClientSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OauthClientExists checks if the OauthClient row exists.
+func OauthClientExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"oauth_clients\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if oauth_clients exists")
+	}
+
+	return exists, nil
+}
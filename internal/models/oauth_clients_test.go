@@ -0,0 +1,1560 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOauthClients(t *testing.T) {
+	t.Parallel()
+
+	query := OauthClients()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOauthClientsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OauthClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOauthClientsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OauthClients().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OauthClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOauthClientsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OauthClientSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OauthClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOauthClientsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OauthClientExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OauthClient exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OauthClientExists to return true, but got false.")
+	}
+}
+
+func testOauthClientsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	oauthClientFound, err := FindOauthClient(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if oauthClientFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOauthClientsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OauthClients().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOauthClientsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OauthClients().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOauthClientsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	oauthClientOne := &OauthClient{}
+	oauthClientTwo := &OauthClient{}
+	if err = randomize.Struct(seed, oauthClientOne, oauthClientDBTypes, false, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oauthClientTwo, oauthClientDBTypes, false, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oauthClientOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oauthClientTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OauthClients().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOauthClientsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	oauthClientOne := &OauthClient{}
+	oauthClientTwo := &OauthClient{}
+	if err = randomize.Struct(seed, oauthClientOne, oauthClientDBTypes, false, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oauthClientTwo, oauthClientDBTypes, false, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oauthClientOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oauthClientTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OauthClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testOauthClientsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OauthClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOauthClientsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(oauthClientColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OauthClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOauthClientToManyAccessTokens(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthClient
+	var b, c AccessToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, accessTokenDBTypes, false, accessTokenColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, accessTokenDBTypes, false, accessTokenColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.OauthClientID, a.ID)
+	queries.Assign(&c.OauthClientID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.AccessTokens().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.OauthClientID, b.OauthClientID) {
+			bFound = true
+		}
+		if queries.Equal(v.OauthClientID, c.OauthClientID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OauthClientSlice{&a}
+	if err = a.L.LoadAccessTokens(ctx, tx, false, (*[]*OauthClient)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.AccessTokens); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.AccessTokens = nil
+	if err = a.L.LoadAccessTokens(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.AccessTokens); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOauthClientToManyOauthAuthorizationCodes(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthClient
+	var b, c OauthAuthorizationCode
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, oauthAuthorizationCodeDBTypes, false, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oauthAuthorizationCodeDBTypes, false, oauthAuthorizationCodeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.OauthClientID = a.ID
+	c.OauthClientID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OauthAuthorizationCodes().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.OauthClientID == b.OauthClientID {
+			bFound = true
+		}
+		if v.OauthClientID == c.OauthClientID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OauthClientSlice{&a}
+	if err = a.L.LoadOauthAuthorizationCodes(ctx, tx, false, (*[]*OauthClient)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OauthAuthorizationCodes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OauthAuthorizationCodes = nil
+	if err = a.L.LoadOauthAuthorizationCodes(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OauthAuthorizationCodes); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOauthClientToManyRefreshTokens(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthClient
+	var b, c RefreshToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, refreshTokenDBTypes, false, refreshTokenColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, refreshTokenDBTypes, false, refreshTokenColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.OauthClientID, a.ID)
+	queries.Assign(&c.OauthClientID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RefreshTokens().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.OauthClientID, b.OauthClientID) {
+			bFound = true
+		}
+		if queries.Equal(v.OauthClientID, c.OauthClientID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OauthClientSlice{&a}
+	if err = a.L.LoadRefreshTokens(ctx, tx, false, (*[]*OauthClient)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RefreshTokens); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RefreshTokens = nil
+	if err = a.L.LoadRefreshTokens(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RefreshTokens); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOauthClientToManyAddOpAccessTokens(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthClient
+	var b, c, d, e AccessToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AccessToken{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accessTokenDBTypes, false, strmangle.SetComplement(accessTokenPrimaryKeyColumns, accessTokenColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*AccessToken{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddAccessTokens(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.OauthClientID) {
+			t.Error("foreign key was wrong value", a.ID, first.OauthClientID)
+		}
+		if !queries.Equal(a.ID, second.OauthClientID) {
+			t.Error("foreign key was wrong value", a.ID, second.OauthClientID)
+		}
+
+		if first.R.OauthClient != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.OauthClient != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.AccessTokens[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.AccessTokens[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.AccessTokens().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testOauthClientToManySetOpAccessTokens(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthClient
+	var b, c, d, e AccessToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AccessToken{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accessTokenDBTypes, false, strmangle.SetComplement(accessTokenPrimaryKeyColumns, accessTokenColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetAccessTokens(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.AccessTokens().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetAccessTokens(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.AccessTokens().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.OauthClientID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.OauthClientID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.OauthClientID) {
+		t.Error("foreign key was wrong value", a.ID, d.OauthClientID)
+	}
+	if !queries.Equal(a.ID, e.OauthClientID) {
+		t.Error("foreign key was wrong value", a.ID, e.OauthClientID)
+	}
+
+	if b.R.OauthClient != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.OauthClient != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.OauthClient != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.OauthClient != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.AccessTokens[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.AccessTokens[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testOauthClientToManyRemoveOpAccessTokens(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthClient
+	var b, c, d, e AccessToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*AccessToken{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, accessTokenDBTypes, false, strmangle.SetComplement(accessTokenPrimaryKeyColumns, accessTokenColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddAccessTokens(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.AccessTokens().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveAccessTokens(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.AccessTokens().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.OauthClientID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.OauthClientID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.OauthClient != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.OauthClient != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.OauthClient != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.OauthClient != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.AccessTokens) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.AccessTokens[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.AccessTokens[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testOauthClientToManyAddOpOauthAuthorizationCodes(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthClient
+	var b, c, d, e OauthAuthorizationCode
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OauthAuthorizationCode{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, oauthAuthorizationCodeDBTypes, false, strmangle.SetComplement(oauthAuthorizationCodePrimaryKeyColumns, oauthAuthorizationCodeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*OauthAuthorizationCode{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOauthAuthorizationCodes(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.OauthClientID {
+			t.Error("foreign key was wrong value", a.ID, first.OauthClientID)
+		}
+		if a.ID != second.OauthClientID {
+			t.Error("foreign key was wrong value", a.ID, second.OauthClientID)
+		}
+
+		if first.R.OauthClient != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.OauthClient != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.OauthAuthorizationCodes[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.OauthAuthorizationCodes[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.OauthAuthorizationCodes().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testOauthClientToManyAddOpRefreshTokens(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthClient
+	var b, c, d, e RefreshToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*RefreshToken{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, refreshTokenDBTypes, false, strmangle.SetComplement(refreshTokenPrimaryKeyColumns, refreshTokenColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*RefreshToken{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddRefreshTokens(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.OauthClientID) {
+			t.Error("foreign key was wrong value", a.ID, first.OauthClientID)
+		}
+		if !queries.Equal(a.ID, second.OauthClientID) {
+			t.Error("foreign key was wrong value", a.ID, second.OauthClientID)
+		}
+
+		if first.R.OauthClient != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.OauthClient != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.RefreshTokens[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.RefreshTokens[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.RefreshTokens().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testOauthClientToManySetOpRefreshTokens(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthClient
+	var b, c, d, e RefreshToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*RefreshToken{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, refreshTokenDBTypes, false, strmangle.SetComplement(refreshTokenPrimaryKeyColumns, refreshTokenColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetRefreshTokens(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.RefreshTokens().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetRefreshTokens(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.RefreshTokens().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.OauthClientID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.OauthClientID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.OauthClientID) {
+		t.Error("foreign key was wrong value", a.ID, d.OauthClientID)
+	}
+	if !queries.Equal(a.ID, e.OauthClientID) {
+		t.Error("foreign key was wrong value", a.ID, e.OauthClientID)
+	}
+
+	if b.R.OauthClient != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.OauthClient != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.OauthClient != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.OauthClient != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.RefreshTokens[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.RefreshTokens[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testOauthClientToManyRemoveOpRefreshTokens(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthClient
+	var b, c, d, e RefreshToken
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*RefreshToken{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, refreshTokenDBTypes, false, strmangle.SetComplement(refreshTokenPrimaryKeyColumns, refreshTokenColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddRefreshTokens(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.RefreshTokens().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveRefreshTokens(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.RefreshTokens().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.OauthClientID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.OauthClientID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.OauthClient != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.OauthClient != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.OauthClient != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.OauthClient != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.RefreshTokens) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.RefreshTokens[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.RefreshTokens[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testOauthClientToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OauthClient
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OauthClientSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*OauthClient)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testOauthClientToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthClient
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OauthClients[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testOauthClientToOneRemoveOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OauthClient
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.User().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.User != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.UserID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.OauthClients) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testOauthClientsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOauthClientsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OauthClientSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOauthClientsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OauthClients().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	oauthClientDBTypes = map[string]string{`ID`: `uuid`, `Name`: `text`, `SecretHash`: `text`, `RedirectUris`: `ARRAYtext`, `GrantTypes`: `ARRAYtext`, `Scopes`: `ARRAYtext`, `UserID`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                  = bytes.MinRead
+)
+
+func testOauthClientsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(oauthClientPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(oauthClientAllColumns) == len(oauthClientPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OauthClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOauthClientsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(oauthClientAllColumns) == len(oauthClientPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OauthClient{}
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OauthClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oauthClientDBTypes, true, oauthClientPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(oauthClientAllColumns, oauthClientPrimaryKeyColumns) {
+		fields = oauthClientAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			oauthClientAllColumns,
+			oauthClientPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OauthClientSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOauthClientsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(oauthClientAllColumns) == len(oauthClientPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OauthClient{}
+	if err = randomize.Struct(seed, &o, oauthClientDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OauthClient: %s", err)
+	}
+
+	count, err := OauthClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, oauthClientDBTypes, false, oauthClientPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OauthClient: %s", err)
+	}
+
+	count, err = OauthClients().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -8,13 +8,55 @@ import "testing"
 func TestUpsert(t *testing.T) {
 	t.Run("AccessTokens", testAccessTokensUpsert)
 
+	t.Run("APIKeys", testAPIKeysUpsert)
+
 	t.Run("AppUserProfiles", testAppUserProfilesUpsert)
 
+	t.Run("AuthAttempts", testAuthAttemptsUpsert)
+
+	t.Run("EmailChangeRevertTokens", testEmailChangeRevertTokensUpsert)
+
+	t.Run("EmailChangeTokens", testEmailChangeTokensUpsert)
+
+	t.Run("EmailVerificationTokens", testEmailVerificationTokensUpsert)
+
+	t.Run("Identities", testIdentitiesUpsert)
+
+	t.Run("LegalAcceptances", testLegalAcceptancesUpsert)
+
+	t.Run("LegalDocuments", testLegalDocumentsUpsert)
+
+	t.Run("MfaChallenges", testMfaChallengesUpsert)
+
+	t.Run("OauthAuthorizationCodes", testOauthAuthorizationCodesUpsert)
+
+	t.Run("OauthClients", testOauthClientsUpsert)
+
 	t.Run("PasswordResetTokens", testPasswordResetTokensUpsert)
 
+	t.Run("PushCampaigns", testPushCampaignsUpsert)
+
+	t.Run("PushDeliveries", testPushDeliveriesUpsert)
+
+	t.Run("PushMessages", testPushMessagesUpsert)
+
 	t.Run("PushTokens", testPushTokensUpsert)
 
+	t.Run("RateLimitCounters", testRateLimitCountersUpsert)
+
+	t.Run("RecoveryCodes", testRecoveryCodesUpsert)
+
 	t.Run("RefreshTokens", testRefreshTokensUpsert)
 
+	t.Run("Roles", testRolesUpsert)
+
+	t.Run("Sessions", testSessionsUpsert)
+
+	t.Run("TotpCredentials", testTotpCredentialsUpsert)
+
+	t.Run("Uploads", testUploadsUpsert)
+
+	t.Run("UserRoles", testUserRolesUpsert)
+
 	t.Run("Users", testUsersUpsert)
 }
@@ -0,0 +1,1040 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// PushCampaign is an object representing the database table.
+type PushCampaign struct {
+	ID                string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Segment           string      `boil:"segment" json:"segment" toml:"segment" yaml:"segment"`
+	SegmentValue      null.String `boil:"segment_value" json:"segment_value,omitempty" toml:"segment_value" yaml:"segment_value,omitempty"`
+	Payload           types.JSON  `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	UsersTargeted     int         `boil:"users_targeted" json:"users_targeted" toml:"users_targeted" yaml:"users_targeted"`
+	TokensTargeted    int         `boil:"tokens_targeted" json:"tokens_targeted" toml:"tokens_targeted" yaml:"tokens_targeted"`
+	DeliveredCount    int         `boil:"delivered_count" json:"delivered_count" toml:"delivered_count" yaml:"delivered_count"`
+	InvalidTokenCount int         `boil:"invalid_token_count" json:"invalid_token_count" toml:"invalid_token_count" yaml:"invalid_token_count"`
+	FailedCount       int         `boil:"failed_count" json:"failed_count" toml:"failed_count" yaml:"failed_count"`
+	EnqueuedAt        null.Time   `boil:"enqueued_at" json:"enqueued_at,omitempty" toml:"enqueued_at" yaml:"enqueued_at,omitempty"`
+	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *pushCampaignR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pushCampaignL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PushCampaignColumns = struct {
+	ID                string
+	Segment           string
+	SegmentValue      string
+	Payload           string
+	UsersTargeted     string
+	TokensTargeted    string
+	DeliveredCount    string
+	InvalidTokenCount string
+	FailedCount       string
+	EnqueuedAt        string
+	CreatedAt         string
+	UpdatedAt         string
+}{
+	ID:                "id",
+	Segment:           "segment",
+	SegmentValue:      "segment_value",
+	Payload:           "payload",
+	UsersTargeted:     "users_targeted",
+	TokensTargeted:    "tokens_targeted",
+	DeliveredCount:    "delivered_count",
+	InvalidTokenCount: "invalid_token_count",
+	FailedCount:       "failed_count",
+	EnqueuedAt:        "enqueued_at",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+}
+
+var PushCampaignTableColumns = struct {
+	ID                string
+	Segment           string
+	SegmentValue      string
+	Payload           string
+	UsersTargeted     string
+	TokensTargeted    string
+	DeliveredCount    string
+	InvalidTokenCount string
+	FailedCount       string
+	EnqueuedAt        string
+	CreatedAt         string
+	UpdatedAt         string
+}{
+	ID:                "push_campaigns.id",
+	Segment:           "push_campaigns.segment",
+	SegmentValue:      "push_campaigns.segment_value",
+	Payload:           "push_campaigns.payload",
+	UsersTargeted:     "push_campaigns.users_targeted",
+	TokensTargeted:    "push_campaigns.tokens_targeted",
+	DeliveredCount:    "push_campaigns.delivered_count",
+	InvalidTokenCount: "push_campaigns.invalid_token_count",
+	FailedCount:       "push_campaigns.failed_count",
+	EnqueuedAt:        "push_campaigns.enqueued_at",
+	CreatedAt:         "push_campaigns.created_at",
+	UpdatedAt:         "push_campaigns.updated_at",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var PushCampaignWhere = struct {
+	ID                whereHelperstring
+	Segment           whereHelperstring
+	SegmentValue      whereHelpernull_String
+	Payload           whereHelpertypes_JSON
+	UsersTargeted     whereHelperint
+	TokensTargeted    whereHelperint
+	DeliveredCount    whereHelperint
+	InvalidTokenCount whereHelperint
+	FailedCount       whereHelperint
+	EnqueuedAt        whereHelpernull_Time
+	CreatedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpertime_Time
+}{
+	ID:                whereHelperstring{field: "\"push_campaigns\".\"id\""},
+	Segment:           whereHelperstring{field: "\"push_campaigns\".\"segment\""},
+	SegmentValue:      whereHelpernull_String{field: "\"push_campaigns\".\"segment_value\""},
+	Payload:           whereHelpertypes_JSON{field: "\"push_campaigns\".\"payload\""},
+	UsersTargeted:     whereHelperint{field: "\"push_campaigns\".\"users_targeted\""},
+	TokensTargeted:    whereHelperint{field: "\"push_campaigns\".\"tokens_targeted\""},
+	DeliveredCount:    whereHelperint{field: "\"push_campaigns\".\"delivered_count\""},
+	InvalidTokenCount: whereHelperint{field: "\"push_campaigns\".\"invalid_token_count\""},
+	FailedCount:       whereHelperint{field: "\"push_campaigns\".\"failed_count\""},
+	EnqueuedAt:        whereHelpernull_Time{field: "\"push_campaigns\".\"enqueued_at\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"push_campaigns\".\"created_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"push_campaigns\".\"updated_at\""},
+}
+
+// PushCampaignRels is where relationship names are stored.
+var PushCampaignRels = struct {
+	PushMessages string
+}{
+	PushMessages: "PushMessages",
+}
+
+// pushCampaignR is where relationships are stored.
+type pushCampaignR struct {
+	PushMessages PushMessageSlice `boil:"PushMessages" json:"PushMessages" toml:"PushMessages" yaml:"PushMessages"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pushCampaignR) NewStruct() *pushCampaignR {
+	return &pushCampaignR{}
+}
+
+func (r *pushCampaignR) GetPushMessages() PushMessageSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PushMessages
+}
+
+// pushCampaignL is where Load methods for each relationship are stored.
+type pushCampaignL struct{}
+
+var (
+	pushCampaignAllColumns            = []string{"id", "segment", "segment_value", "payload", "users_targeted", "tokens_targeted", "delivered_count", "invalid_token_count", "failed_count", "enqueued_at", "created_at", "updated_at"}
+	pushCampaignColumnsWithoutDefault = []string{"segment", "payload", "created_at", "updated_at"}
+	pushCampaignColumnsWithDefault    = []string{"id", "segment_value", "users_targeted", "tokens_targeted", "delivered_count", "invalid_token_count", "failed_count", "enqueued_at"}
+	pushCampaignPrimaryKeyColumns     = []string{"id"}
+	pushCampaignGeneratedColumns      = []string{}
+)
+
+type (
+	// PushCampaignSlice is an alias for a slice of pointers to PushCampaign.
+	// This should almost always be used instead of []PushCampaign.
+	PushCampaignSlice []*PushCampaign
+
+	pushCampaignQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pushCampaignType                 = reflect.TypeOf(&PushCampaign{})
+	pushCampaignMapping              = queries.MakeStructMapping(pushCampaignType)
+	pushCampaignPrimaryKeyMapping, _ = queries.BindMapping(pushCampaignType, pushCampaignMapping, pushCampaignPrimaryKeyColumns)
+	pushCampaignInsertCacheMut       sync.RWMutex
+	pushCampaignInsertCache          = make(map[string]insertCache)
+	pushCampaignUpdateCacheMut       sync.RWMutex
+	pushCampaignUpdateCache          = make(map[string]updateCache)
+	pushCampaignUpsertCacheMut       sync.RWMutex
+	pushCampaignUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single pushCampaign record from the query.
+func (q pushCampaignQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PushCampaign, error) {
+	o := &PushCampaign{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for push_campaigns")
+	}
+
+	return o, nil
+}
+
+// All returns all PushCampaign records from the query.
+func (q pushCampaignQuery) All(ctx context.Context, exec boil.ContextExecutor) (PushCampaignSlice, error) {
+	var o []*PushCampaign
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PushCampaign slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PushCampaign records in the query.
+func (q pushCampaignQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count push_campaigns rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pushCampaignQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if push_campaigns exists")
+	}
+
+	return count > 0, nil
+}
+
+// PushMessages retrieves all the push_message's PushMessages with an executor.
+func (o *PushCampaign) PushMessages(mods ...qm.QueryMod) pushMessageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"push_messages\".\"push_campaign_id\"=?", o.ID),
+	)
+
+	return PushMessages(queryMods...)
+}
+
+// LoadPushMessages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pushCampaignL) LoadPushMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybePushCampaign interface{}, mods queries.Applicator) error {
+	var slice []*PushCampaign
+	var object *PushCampaign
+
+	if singular {
+		var ok bool
+		object, ok = maybePushCampaign.(*PushCampaign)
+		if !ok {
+			object = new(PushCampaign)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePushCampaign)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePushCampaign))
+			}
+		}
+	} else {
+		s, ok := maybePushCampaign.(*[]*PushCampaign)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePushCampaign)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePushCampaign))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &pushCampaignR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pushCampaignR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`push_messages`),
+		qm.WhereIn(`push_messages.push_campaign_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load push_messages")
+	}
+
+	var resultSlice []*PushMessage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice push_messages")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on push_messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_messages")
+	}
+
+	if singular {
+		object.R.PushMessages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pushMessageR{}
+			}
+			foreign.R.PushCampaign = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.PushCampaignID) {
+				local.R.PushMessages = append(local.R.PushMessages, foreign)
+				if foreign.R == nil {
+					foreign.R = &pushMessageR{}
+				}
+				foreign.R.PushCampaign = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddPushMessages adds the given related objects to the existing relationships
+// of the push_campaign, optionally inserting them as new records.
+// Appends related to o.R.PushMessages.
+// Sets related.R.PushCampaign appropriately.
+func (o *PushCampaign) AddPushMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushMessage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.PushCampaignID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"push_messages\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"push_campaign_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pushMessagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.PushCampaignID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pushCampaignR{
+			PushMessages: related,
+		}
+	} else {
+		o.R.PushMessages = append(o.R.PushMessages, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pushMessageR{
+				PushCampaign: o,
+			}
+		} else {
+			rel.R.PushCampaign = o
+		}
+	}
+	return nil
+}
+
+// SetPushMessages removes all previously related items of the
+// push_campaign replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.PushCampaign's PushMessages accordingly.
+// Replaces o.R.PushMessages with related.
+// Sets related.R.PushCampaign's PushMessages accordingly.
+func (o *PushCampaign) SetPushMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushMessage) error {
+	query := "update \"push_messages\" set \"push_campaign_id\" = null where \"push_campaign_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.PushMessages {
+			queries.SetScanner(&rel.PushCampaignID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.PushCampaign = nil
+		}
+		o.R.PushMessages = nil
+	}
+
+	return o.AddPushMessages(ctx, exec, insert, related...)
+}
+
+// RemovePushMessages relationships from objects passed in.
+// Removes related items from R.PushMessages (uses pointer comparison, removal does not keep order)
+// Sets related.R.PushCampaign.
+func (o *PushCampaign) RemovePushMessages(ctx context.Context, exec boil.ContextExecutor, related ...*PushMessage) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.PushCampaignID, nil)
+		if rel.R != nil {
+			rel.R.PushCampaign = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("push_campaign_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.PushMessages {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.PushMessages)
+			if ln > 1 && i < ln-1 {
+				o.R.PushMessages[i] = o.R.PushMessages[ln-1]
+			}
+			o.R.PushMessages = o.R.PushMessages[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// PushCampaigns retrieves all the records using an executor.
+func PushCampaigns(mods ...qm.QueryMod) pushCampaignQuery {
+	mods = append(mods, qm.From("\"push_campaigns\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"push_campaigns\".*"})
+	}
+
+	return pushCampaignQuery{q}
+}
+
+// FindPushCampaign retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPushCampaign(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*PushCampaign, error) {
+	pushCampaignObj := &PushCampaign{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"push_campaigns\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, pushCampaignObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from push_campaigns")
+	}
+
+	return pushCampaignObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PushCampaign) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no push_campaigns provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pushCampaignColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pushCampaignInsertCacheMut.RLock()
+	cache, cached := pushCampaignInsertCache[key]
+	pushCampaignInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pushCampaignAllColumns,
+			pushCampaignColumnsWithDefault,
+			pushCampaignColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(pushCampaignType, pushCampaignMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pushCampaignType, pushCampaignMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"push_campaigns\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"push_campaigns\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into push_campaigns")
+	}
+
+	if !cached {
+		pushCampaignInsertCacheMut.Lock()
+		pushCampaignInsertCache[key] = cache
+		pushCampaignInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the PushCampaign.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PushCampaign) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	pushCampaignUpdateCacheMut.RLock()
+	cache, cached := pushCampaignUpdateCache[key]
+	pushCampaignUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pushCampaignAllColumns,
+			pushCampaignPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update push_campaigns, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"push_campaigns\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pushCampaignPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pushCampaignType, pushCampaignMapping, append(wl, pushCampaignPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update push_campaigns row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for push_campaigns")
+	}
+
+	if !cached {
+		pushCampaignUpdateCacheMut.Lock()
+		pushCampaignUpdateCache[key] = cache
+		pushCampaignUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pushCampaignQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for push_campaigns")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for push_campaigns")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PushCampaignSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushCampaignPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"push_campaigns\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pushCampaignPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in pushCampaign slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all pushCampaign")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PushCampaign) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no push_campaigns provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pushCampaignColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pushCampaignUpsertCacheMut.RLock()
+	cache, cached := pushCampaignUpsertCache[key]
+	pushCampaignUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			pushCampaignAllColumns,
+			pushCampaignColumnsWithDefault,
+			pushCampaignColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pushCampaignAllColumns,
+			pushCampaignPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert push_campaigns, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(pushCampaignPrimaryKeyColumns))
+			copy(conflict, pushCampaignPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"push_campaigns\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(pushCampaignType, pushCampaignMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pushCampaignType, pushCampaignMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert push_campaigns")
+	}
+
+	if !cached {
+		pushCampaignUpsertCacheMut.Lock()
+		pushCampaignUpsertCache[key] = cache
+		pushCampaignUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single PushCampaign record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PushCampaign) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PushCampaign provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pushCampaignPrimaryKeyMapping)
+	sql := "DELETE FROM \"push_campaigns\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from push_campaigns")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for push_campaigns")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pushCampaignQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no pushCampaignQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from push_campaigns")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for push_campaigns")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PushCampaignSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushCampaignPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"push_campaigns\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pushCampaignPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from pushCampaign slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for push_campaigns")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PushCampaign) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPushCampaign(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PushCampaignSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PushCampaignSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushCampaignPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"push_campaigns\".* FROM \"push_campaigns\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pushCampaignPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PushCampaignSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PushCampaignExists checks if the PushCampaign row exists.
+func PushCampaignExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"push_campaigns\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if push_campaigns exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,919 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPushCampaigns(t *testing.T) {
+	t.Parallel()
+
+	query := PushCampaigns()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPushCampaignsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushCampaigns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushCampaignsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PushCampaigns().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushCampaigns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushCampaignsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PushCampaignSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushCampaigns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushCampaignsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PushCampaignExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PushCampaign exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PushCampaignExists to return true, but got false.")
+	}
+}
+
+func testPushCampaignsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	pushCampaignFound, err := FindPushCampaign(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pushCampaignFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPushCampaignsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PushCampaigns().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushCampaignsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PushCampaigns().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPushCampaignsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	pushCampaignOne := &PushCampaign{}
+	pushCampaignTwo := &PushCampaign{}
+	if err = randomize.Struct(seed, pushCampaignOne, pushCampaignDBTypes, false, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pushCampaignTwo, pushCampaignDBTypes, false, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pushCampaignOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pushCampaignTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PushCampaigns().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPushCampaignsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	pushCampaignOne := &PushCampaign{}
+	pushCampaignTwo := &PushCampaign{}
+	if err = randomize.Struct(seed, pushCampaignOne, pushCampaignDBTypes, false, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pushCampaignTwo, pushCampaignDBTypes, false, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pushCampaignOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pushCampaignTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushCampaigns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testPushCampaignsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushCampaigns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPushCampaignsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(pushCampaignColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushCampaigns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPushCampaignToManyPushMessages(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushCampaign
+	var b, c PushMessage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, pushMessageDBTypes, false, pushMessageColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, pushMessageDBTypes, false, pushMessageColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.PushCampaignID, a.ID)
+	queries.Assign(&c.PushCampaignID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PushMessages().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.PushCampaignID, b.PushCampaignID) {
+			bFound = true
+		}
+		if queries.Equal(v.PushCampaignID, c.PushCampaignID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := PushCampaignSlice{&a}
+	if err = a.L.LoadPushMessages(ctx, tx, false, (*[]*PushCampaign)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PushMessages); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PushMessages = nil
+	if err = a.L.LoadPushMessages(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PushMessages); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testPushCampaignToManyAddOpPushMessages(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushCampaign
+	var b, c, d, e PushMessage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushCampaignDBTypes, false, strmangle.SetComplement(pushCampaignPrimaryKeyColumns, pushCampaignColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*PushMessage{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, pushMessageDBTypes, false, strmangle.SetComplement(pushMessagePrimaryKeyColumns, pushMessageColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*PushMessage{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPushMessages(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.PushCampaignID) {
+			t.Error("foreign key was wrong value", a.ID, first.PushCampaignID)
+		}
+		if !queries.Equal(a.ID, second.PushCampaignID) {
+			t.Error("foreign key was wrong value", a.ID, second.PushCampaignID)
+		}
+
+		if first.R.PushCampaign != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.PushCampaign != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PushMessages[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PushMessages[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.PushMessages().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testPushCampaignToManySetOpPushMessages(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushCampaign
+	var b, c, d, e PushMessage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushCampaignDBTypes, false, strmangle.SetComplement(pushCampaignPrimaryKeyColumns, pushCampaignColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*PushMessage{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, pushMessageDBTypes, false, strmangle.SetComplement(pushMessagePrimaryKeyColumns, pushMessageColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetPushMessages(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetPushMessages(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.PushCampaignID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.PushCampaignID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.PushCampaignID) {
+		t.Error("foreign key was wrong value", a.ID, d.PushCampaignID)
+	}
+	if !queries.Equal(a.ID, e.PushCampaignID) {
+		t.Error("foreign key was wrong value", a.ID, e.PushCampaignID)
+	}
+
+	if b.R.PushCampaign != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.PushCampaign != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.PushCampaign != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.PushCampaign != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.PushMessages[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.PushMessages[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testPushCampaignToManyRemoveOpPushMessages(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushCampaign
+	var b, c, d, e PushMessage
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushCampaignDBTypes, false, strmangle.SetComplement(pushCampaignPrimaryKeyColumns, pushCampaignColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*PushMessage{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, pushMessageDBTypes, false, strmangle.SetComplement(pushMessagePrimaryKeyColumns, pushMessageColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddPushMessages(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemovePushMessages(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.PushCampaignID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.PushCampaignID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.PushCampaign != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.PushCampaign != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.PushCampaign != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.PushCampaign != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.PushMessages) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.PushMessages[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.PushMessages[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testPushCampaignsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushCampaignsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PushCampaignSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushCampaignsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PushCampaigns().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	pushCampaignDBTypes = map[string]string{`ID`: `uuid`, `Segment`: `enum.push_campaign_segment('all','language','sql')`, `SegmentValue`: `text`, `Payload`: `jsonb`, `UsersTargeted`: `integer`, `TokensTargeted`: `integer`, `DeliveredCount`: `integer`, `InvalidTokenCount`: `integer`, `FailedCount`: `integer`, `EnqueuedAt`: `timestamp with time zone`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                   = bytes.MinRead
+)
+
+func testPushCampaignsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(pushCampaignPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(pushCampaignAllColumns) == len(pushCampaignPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushCampaigns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPushCampaignsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(pushCampaignAllColumns) == len(pushCampaignPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushCampaign{}
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushCampaigns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pushCampaignDBTypes, true, pushCampaignPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(pushCampaignAllColumns, pushCampaignPrimaryKeyColumns) {
+		fields = pushCampaignAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			pushCampaignAllColumns,
+			pushCampaignPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PushCampaignSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPushCampaignsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(pushCampaignAllColumns) == len(pushCampaignPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PushCampaign{}
+	if err = randomize.Struct(seed, &o, pushCampaignDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PushCampaign: %s", err)
+	}
+
+	count, err := PushCampaigns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, pushCampaignDBTypes, false, pushCampaignPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PushCampaign: %s", err)
+	}
+
+	count, err = PushCampaigns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,933 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PushDelivery is an object representing the database table.
+type PushDelivery struct {
+	ID            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PushMessageID string      `boil:"push_message_id" json:"push_message_id" toml:"push_message_id" yaml:"push_message_id"`
+	Provider      string      `boil:"provider" json:"provider" toml:"provider" yaml:"provider"`
+	Token         string      `boil:"token" json:"token" toml:"token" yaml:"token"`
+	Status        string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts      int         `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	NextAttemptAt time.Time   `boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	LastError     null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	DeliveredAt   null.Time   `boil:"delivered_at" json:"delivered_at,omitempty" toml:"delivered_at" yaml:"delivered_at,omitempty"`
+	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *pushDeliveryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pushDeliveryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PushDeliveryColumns = struct {
+	ID            string
+	PushMessageID string
+	Provider      string
+	Token         string
+	Status        string
+	Attempts      string
+	NextAttemptAt string
+	LastError     string
+	DeliveredAt   string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "id",
+	PushMessageID: "push_message_id",
+	Provider:      "provider",
+	Token:         "token",
+	Status:        "status",
+	Attempts:      "attempts",
+	NextAttemptAt: "next_attempt_at",
+	LastError:     "last_error",
+	DeliveredAt:   "delivered_at",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+}
+
+var PushDeliveryTableColumns = struct {
+	ID            string
+	PushMessageID string
+	Provider      string
+	Token         string
+	Status        string
+	Attempts      string
+	NextAttemptAt string
+	LastError     string
+	DeliveredAt   string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	ID:            "push_deliveries.id",
+	PushMessageID: "push_deliveries.push_message_id",
+	Provider:      "push_deliveries.provider",
+	Token:         "push_deliveries.token",
+	Status:        "push_deliveries.status",
+	Attempts:      "push_deliveries.attempts",
+	NextAttemptAt: "push_deliveries.next_attempt_at",
+	LastError:     "push_deliveries.last_error",
+	DeliveredAt:   "push_deliveries.delivered_at",
+	CreatedAt:     "push_deliveries.created_at",
+	UpdatedAt:     "push_deliveries.updated_at",
+}
+
+// Generated where
+
+var PushDeliveryWhere = struct {
+	ID            whereHelperstring
+	PushMessageID whereHelperstring
+	Provider      whereHelperstring
+	Token         whereHelperstring
+	Status        whereHelperstring
+	Attempts      whereHelperint
+	NextAttemptAt whereHelpertime_Time
+	LastError     whereHelpernull_String
+	DeliveredAt   whereHelpernull_Time
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "\"push_deliveries\".\"id\""},
+	PushMessageID: whereHelperstring{field: "\"push_deliveries\".\"push_message_id\""},
+	Provider:      whereHelperstring{field: "\"push_deliveries\".\"provider\""},
+	Token:         whereHelperstring{field: "\"push_deliveries\".\"token\""},
+	Status:        whereHelperstring{field: "\"push_deliveries\".\"status\""},
+	Attempts:      whereHelperint{field: "\"push_deliveries\".\"attempts\""},
+	NextAttemptAt: whereHelpertime_Time{field: "\"push_deliveries\".\"next_attempt_at\""},
+	LastError:     whereHelpernull_String{field: "\"push_deliveries\".\"last_error\""},
+	DeliveredAt:   whereHelpernull_Time{field: "\"push_deliveries\".\"delivered_at\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"push_deliveries\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"push_deliveries\".\"updated_at\""},
+}
+
+// PushDeliveryRels is where relationship names are stored.
+var PushDeliveryRels = struct {
+	PushMessage string
+}{
+	PushMessage: "PushMessage",
+}
+
+// pushDeliveryR is where relationships are stored.
+type pushDeliveryR struct {
+	PushMessage *PushMessage `boil:"PushMessage" json:"PushMessage" toml:"PushMessage" yaml:"PushMessage"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pushDeliveryR) NewStruct() *pushDeliveryR {
+	return &pushDeliveryR{}
+}
+
+func (r *pushDeliveryR) GetPushMessage() *PushMessage {
+	if r == nil {
+		return nil
+	}
+	return r.PushMessage
+}
+
+// pushDeliveryL is where Load methods for each relationship are stored.
+type pushDeliveryL struct{}
+
+var (
+	pushDeliveryAllColumns            = []string{"id", "push_message_id", "provider", "token", "status", "attempts", "next_attempt_at", "last_error", "delivered_at", "created_at", "updated_at"}
+	pushDeliveryColumnsWithoutDefault = []string{"push_message_id", "provider", "token", "next_attempt_at", "created_at", "updated_at"}
+	pushDeliveryColumnsWithDefault    = []string{"id", "status", "attempts", "last_error", "delivered_at"}
+	pushDeliveryPrimaryKeyColumns     = []string{"id"}
+	pushDeliveryGeneratedColumns      = []string{}
+)
+
+type (
+	// PushDeliverySlice is an alias for a slice of pointers to PushDelivery.
+	// This should almost always be used instead of []PushDelivery.
+	PushDeliverySlice []*PushDelivery
+
+	pushDeliveryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pushDeliveryType                 = reflect.TypeOf(&PushDelivery{})
+	pushDeliveryMapping              = queries.MakeStructMapping(pushDeliveryType)
+	pushDeliveryPrimaryKeyMapping, _ = queries.BindMapping(pushDeliveryType, pushDeliveryMapping, pushDeliveryPrimaryKeyColumns)
+	pushDeliveryInsertCacheMut       sync.RWMutex
+	pushDeliveryInsertCache          = make(map[string]insertCache)
+	pushDeliveryUpdateCacheMut       sync.RWMutex
+	pushDeliveryUpdateCache          = make(map[string]updateCache)
+	pushDeliveryUpsertCacheMut       sync.RWMutex
+	pushDeliveryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single pushDelivery record from the query.
+func (q pushDeliveryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PushDelivery, error) {
+	o := &PushDelivery{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for push_deliveries")
+	}
+
+	return o, nil
+}
+
+// All returns all PushDelivery records from the query.
+func (q pushDeliveryQuery) All(ctx context.Context, exec boil.ContextExecutor) (PushDeliverySlice, error) {
+	var o []*PushDelivery
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PushDelivery slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PushDelivery records in the query.
+func (q pushDeliveryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count push_deliveries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pushDeliveryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if push_deliveries exists")
+	}
+
+	return count > 0, nil
+}
+
+// PushMessage pointed to by the foreign key.
+func (o *PushDelivery) PushMessage(mods ...qm.QueryMod) pushMessageQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PushMessageID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return PushMessages(queryMods...)
+}
+
+// LoadPushMessage allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pushDeliveryL) LoadPushMessage(ctx context.Context, e boil.ContextExecutor, singular bool, maybePushDelivery interface{}, mods queries.Applicator) error {
+	var slice []*PushDelivery
+	var object *PushDelivery
+
+	if singular {
+		var ok bool
+		object, ok = maybePushDelivery.(*PushDelivery)
+		if !ok {
+			object = new(PushDelivery)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePushDelivery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePushDelivery))
+			}
+		}
+	} else {
+		s, ok := maybePushDelivery.(*[]*PushDelivery)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePushDelivery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePushDelivery))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &pushDeliveryR{}
+		}
+		args = append(args, object.PushMessageID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pushDeliveryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PushMessageID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PushMessageID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`push_messages`),
+		qm.WhereIn(`push_messages.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PushMessage")
+	}
+
+	var resultSlice []*PushMessage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PushMessage")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for push_messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_messages")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PushMessage = foreign
+		if foreign.R == nil {
+			foreign.R = &pushMessageR{}
+		}
+		foreign.R.PushDeliveries = append(foreign.R.PushDeliveries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PushMessageID == foreign.ID {
+				local.R.PushMessage = foreign
+				if foreign.R == nil {
+					foreign.R = &pushMessageR{}
+				}
+				foreign.R.PushDeliveries = append(foreign.R.PushDeliveries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPushMessage of the pushDelivery to the related item.
+// Sets o.R.PushMessage to related.
+// Adds o to related.R.PushDeliveries.
+func (o *PushDelivery) SetPushMessage(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PushMessage) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"push_deliveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"push_message_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pushDeliveryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PushMessageID = related.ID
+	if o.R == nil {
+		o.R = &pushDeliveryR{
+			PushMessage: related,
+		}
+	} else {
+		o.R.PushMessage = related
+	}
+
+	if related.R == nil {
+		related.R = &pushMessageR{
+			PushDeliveries: PushDeliverySlice{o},
+		}
+	} else {
+		related.R.PushDeliveries = append(related.R.PushDeliveries, o)
+	}
+
+	return nil
+}
+
+// PushDeliveries retrieves all the records using an executor.
+func PushDeliveries(mods ...qm.QueryMod) pushDeliveryQuery {
+	mods = append(mods, qm.From("\"push_deliveries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"push_deliveries\".*"})
+	}
+
+	return pushDeliveryQuery{q}
+}
+
+// FindPushDelivery retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPushDelivery(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*PushDelivery, error) {
+	pushDeliveryObj := &PushDelivery{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"push_deliveries\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, pushDeliveryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from push_deliveries")
+	}
+
+	return pushDeliveryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PushDelivery) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no push_deliveries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pushDeliveryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pushDeliveryInsertCacheMut.RLock()
+	cache, cached := pushDeliveryInsertCache[key]
+	pushDeliveryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pushDeliveryAllColumns,
+			pushDeliveryColumnsWithDefault,
+			pushDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(pushDeliveryType, pushDeliveryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pushDeliveryType, pushDeliveryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"push_deliveries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"push_deliveries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into push_deliveries")
+	}
+
+	if !cached {
+		pushDeliveryInsertCacheMut.Lock()
+		pushDeliveryInsertCache[key] = cache
+		pushDeliveryInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the PushDelivery.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PushDelivery) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	pushDeliveryUpdateCacheMut.RLock()
+	cache, cached := pushDeliveryUpdateCache[key]
+	pushDeliveryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pushDeliveryAllColumns,
+			pushDeliveryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update push_deliveries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"push_deliveries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pushDeliveryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pushDeliveryType, pushDeliveryMapping, append(wl, pushDeliveryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update push_deliveries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for push_deliveries")
+	}
+
+	if !cached {
+		pushDeliveryUpdateCacheMut.Lock()
+		pushDeliveryUpdateCache[key] = cache
+		pushDeliveryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pushDeliveryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for push_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for push_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PushDeliverySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"push_deliveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pushDeliveryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in pushDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all pushDelivery")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PushDelivery) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no push_deliveries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pushDeliveryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pushDeliveryUpsertCacheMut.RLock()
+	cache, cached := pushDeliveryUpsertCache[key]
+	pushDeliveryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			pushDeliveryAllColumns,
+			pushDeliveryColumnsWithDefault,
+			pushDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pushDeliveryAllColumns,
+			pushDeliveryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert push_deliveries, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(pushDeliveryPrimaryKeyColumns))
+			copy(conflict, pushDeliveryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"push_deliveries\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(pushDeliveryType, pushDeliveryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pushDeliveryType, pushDeliveryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert push_deliveries")
+	}
+
+	if !cached {
+		pushDeliveryUpsertCacheMut.Lock()
+		pushDeliveryUpsertCache[key] = cache
+		pushDeliveryUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single PushDelivery record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PushDelivery) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PushDelivery provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pushDeliveryPrimaryKeyMapping)
+	sql := "DELETE FROM \"push_deliveries\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from push_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for push_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pushDeliveryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no pushDeliveryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from push_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for push_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PushDeliverySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"push_deliveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pushDeliveryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from pushDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for push_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PushDelivery) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPushDelivery(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PushDeliverySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PushDeliverySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"push_deliveries\".* FROM \"push_deliveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pushDeliveryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PushDeliverySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PushDeliveryExists checks if the PushDelivery row exists.
+func PushDeliveryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"push_deliveries\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if push_deliveries exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1337 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// PushMessage is an object representing the database table.
+type PushMessage struct {
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID         null.String `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	Payload        types.JSON  `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	PushCampaignID null.String `boil:"push_campaign_id" json:"push_campaign_id,omitempty" toml:"push_campaign_id" yaml:"push_campaign_id,omitempty"`
+
+	R *pushMessageR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L pushMessageL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PushMessageColumns = struct {
+	ID             string
+	UserID         string
+	Payload        string
+	CreatedAt      string
+	UpdatedAt      string
+	PushCampaignID string
+}{
+	ID:             "id",
+	UserID:         "user_id",
+	Payload:        "payload",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+	PushCampaignID: "push_campaign_id",
+}
+
+var PushMessageTableColumns = struct {
+	ID             string
+	UserID         string
+	Payload        string
+	CreatedAt      string
+	UpdatedAt      string
+	PushCampaignID string
+}{
+	ID:             "push_messages.id",
+	UserID:         "push_messages.user_id",
+	Payload:        "push_messages.payload",
+	CreatedAt:      "push_messages.created_at",
+	UpdatedAt:      "push_messages.updated_at",
+	PushCampaignID: "push_messages.push_campaign_id",
+}
+
+// Generated where
+
+var PushMessageWhere = struct {
+	ID             whereHelperstring
+	UserID         whereHelpernull_String
+	Payload        whereHelpertypes_JSON
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+	PushCampaignID whereHelpernull_String
+}{
+	ID:             whereHelperstring{field: "\"push_messages\".\"id\""},
+	UserID:         whereHelpernull_String{field: "\"push_messages\".\"user_id\""},
+	Payload:        whereHelpertypes_JSON{field: "\"push_messages\".\"payload\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"push_messages\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"push_messages\".\"updated_at\""},
+	PushCampaignID: whereHelpernull_String{field: "\"push_messages\".\"push_campaign_id\""},
+}
+
+// PushMessageRels is where relationship names are stored.
+var PushMessageRels = struct {
+	PushCampaign   string
+	User           string
+	PushDeliveries string
+}{
+	PushCampaign:   "PushCampaign",
+	User:           "User",
+	PushDeliveries: "PushDeliveries",
+}
+
+// pushMessageR is where relationships are stored.
+type pushMessageR struct {
+	PushCampaign   *PushCampaign     `boil:"PushCampaign" json:"PushCampaign" toml:"PushCampaign" yaml:"PushCampaign"`
+	User           *User             `boil:"User" json:"User" toml:"User" yaml:"User"`
+	PushDeliveries PushDeliverySlice `boil:"PushDeliveries" json:"PushDeliveries" toml:"PushDeliveries" yaml:"PushDeliveries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*pushMessageR) NewStruct() *pushMessageR {
+	return &pushMessageR{}
+}
+
+func (r *pushMessageR) GetPushCampaign() *PushCampaign {
+	if r == nil {
+		return nil
+	}
+	return r.PushCampaign
+}
+
+func (r *pushMessageR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *pushMessageR) GetPushDeliveries() PushDeliverySlice {
+	if r == nil {
+		return nil
+	}
+	return r.PushDeliveries
+}
+
+// pushMessageL is where Load methods for each relationship are stored.
+type pushMessageL struct{}
+
+var (
+	pushMessageAllColumns            = []string{"id", "user_id", "payload", "created_at", "updated_at", "push_campaign_id"}
+	pushMessageColumnsWithoutDefault = []string{"payload", "created_at", "updated_at"}
+	pushMessageColumnsWithDefault    = []string{"id", "user_id", "push_campaign_id"}
+	pushMessagePrimaryKeyColumns     = []string{"id"}
+	pushMessageGeneratedColumns      = []string{}
+)
+
+type (
+	// PushMessageSlice is an alias for a slice of pointers to PushMessage.
+	// This should almost always be used instead of []PushMessage.
+	PushMessageSlice []*PushMessage
+
+	pushMessageQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	pushMessageType                 = reflect.TypeOf(&PushMessage{})
+	pushMessageMapping              = queries.MakeStructMapping(pushMessageType)
+	pushMessagePrimaryKeyMapping, _ = queries.BindMapping(pushMessageType, pushMessageMapping, pushMessagePrimaryKeyColumns)
+	pushMessageInsertCacheMut       sync.RWMutex
+	pushMessageInsertCache          = make(map[string]insertCache)
+	pushMessageUpdateCacheMut       sync.RWMutex
+	pushMessageUpdateCache          = make(map[string]updateCache)
+	pushMessageUpsertCacheMut       sync.RWMutex
+	pushMessageUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single pushMessage record from the query.
+func (q pushMessageQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PushMessage, error) {
+	o := &PushMessage{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for push_messages")
+	}
+
+	return o, nil
+}
+
+// All returns all PushMessage records from the query.
+func (q pushMessageQuery) All(ctx context.Context, exec boil.ContextExecutor) (PushMessageSlice, error) {
+	var o []*PushMessage
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to PushMessage slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PushMessage records in the query.
+func (q pushMessageQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count push_messages rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q pushMessageQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if push_messages exists")
+	}
+
+	return count > 0, nil
+}
+
+// PushCampaign pointed to by the foreign key.
+func (o *PushMessage) PushCampaign(mods ...qm.QueryMod) pushCampaignQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PushCampaignID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return PushCampaigns(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *PushMessage) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// PushDeliveries retrieves all the push_delivery's PushDeliveries with an executor.
+func (o *PushMessage) PushDeliveries(mods ...qm.QueryMod) pushDeliveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"push_deliveries\".\"push_message_id\"=?", o.ID),
+	)
+
+	return PushDeliveries(queryMods...)
+}
+
+// LoadPushCampaign allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pushMessageL) LoadPushCampaign(ctx context.Context, e boil.ContextExecutor, singular bool, maybePushMessage interface{}, mods queries.Applicator) error {
+	var slice []*PushMessage
+	var object *PushMessage
+
+	if singular {
+		var ok bool
+		object, ok = maybePushMessage.(*PushMessage)
+		if !ok {
+			object = new(PushMessage)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePushMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePushMessage))
+			}
+		}
+	} else {
+		s, ok := maybePushMessage.(*[]*PushMessage)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePushMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePushMessage))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &pushMessageR{}
+		}
+		if !queries.IsNil(object.PushCampaignID) {
+			args = append(args, object.PushCampaignID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pushMessageR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.PushCampaignID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.PushCampaignID) {
+				args = append(args, obj.PushCampaignID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`push_campaigns`),
+		qm.WhereIn(`push_campaigns.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PushCampaign")
+	}
+
+	var resultSlice []*PushCampaign
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PushCampaign")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for push_campaigns")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_campaigns")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PushCampaign = foreign
+		if foreign.R == nil {
+			foreign.R = &pushCampaignR{}
+		}
+		foreign.R.PushMessages = append(foreign.R.PushMessages, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.PushCampaignID, foreign.ID) {
+				local.R.PushCampaign = foreign
+				if foreign.R == nil {
+					foreign.R = &pushCampaignR{}
+				}
+				foreign.R.PushMessages = append(foreign.R.PushMessages, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (pushMessageL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePushMessage interface{}, mods queries.Applicator) error {
+	var slice []*PushMessage
+	var object *PushMessage
+
+	if singular {
+		var ok bool
+		object, ok = maybePushMessage.(*PushMessage)
+		if !ok {
+			object = new(PushMessage)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePushMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePushMessage))
+			}
+		}
+	} else {
+		s, ok := maybePushMessage.(*[]*PushMessage)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePushMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePushMessage))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &pushMessageR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args = append(args, object.UserID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pushMessageR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.UserID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args = append(args, obj.UserID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PushMessages = append(foreign.R.PushMessages, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PushMessages = append(foreign.R.PushMessages, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPushDeliveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (pushMessageL) LoadPushDeliveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybePushMessage interface{}, mods queries.Applicator) error {
+	var slice []*PushMessage
+	var object *PushMessage
+
+	if singular {
+		var ok bool
+		object, ok = maybePushMessage.(*PushMessage)
+		if !ok {
+			object = new(PushMessage)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePushMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePushMessage))
+			}
+		}
+	} else {
+		s, ok := maybePushMessage.(*[]*PushMessage)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePushMessage)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePushMessage))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &pushMessageR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &pushMessageR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`push_deliveries`),
+		qm.WhereIn(`push_deliveries.push_message_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load push_deliveries")
+	}
+
+	var resultSlice []*PushDelivery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice push_deliveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on push_deliveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_deliveries")
+	}
+
+	if singular {
+		object.R.PushDeliveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pushDeliveryR{}
+			}
+			foreign.R.PushMessage = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PushMessageID {
+				local.R.PushDeliveries = append(local.R.PushDeliveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &pushDeliveryR{}
+				}
+				foreign.R.PushMessage = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPushCampaign of the pushMessage to the related item.
+// Sets o.R.PushCampaign to related.
+// Adds o to related.R.PushMessages.
+func (o *PushMessage) SetPushCampaign(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PushCampaign) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"push_messages\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"push_campaign_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pushMessagePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.PushCampaignID, related.ID)
+	if o.R == nil {
+		o.R = &pushMessageR{
+			PushCampaign: related,
+		}
+	} else {
+		o.R.PushCampaign = related
+	}
+
+	if related.R == nil {
+		related.R = &pushCampaignR{
+			PushMessages: PushMessageSlice{o},
+		}
+	} else {
+		related.R.PushMessages = append(related.R.PushMessages, o)
+	}
+
+	return nil
+}
+
+// RemovePushCampaign relationship.
+// Sets o.R.PushCampaign to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *PushMessage) RemovePushCampaign(ctx context.Context, exec boil.ContextExecutor, related *PushCampaign) error {
+	var err error
+
+	queries.SetScanner(&o.PushCampaignID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("push_campaign_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.PushCampaign = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.PushMessages {
+		if queries.Equal(o.PushCampaignID, ri.PushCampaignID) {
+			continue
+		}
+
+		ln := len(related.R.PushMessages)
+		if ln > 1 && i < ln-1 {
+			related.R.PushMessages[i] = related.R.PushMessages[ln-1]
+		}
+		related.R.PushMessages = related.R.PushMessages[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUser of the pushMessage to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PushMessages.
+func (o *PushMessage) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"push_messages\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, pushMessagePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &pushMessageR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PushMessages: PushMessageSlice{o},
+		}
+	} else {
+		related.R.PushMessages = append(related.R.PushMessages, o)
+	}
+
+	return nil
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *PushMessage) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.PushMessages {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.PushMessages)
+		if ln > 1 && i < ln-1 {
+			related.R.PushMessages[i] = related.R.PushMessages[ln-1]
+		}
+		related.R.PushMessages = related.R.PushMessages[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddPushDeliveries adds the given related objects to the existing relationships
+// of the push_message, optionally inserting them as new records.
+// Appends related to o.R.PushDeliveries.
+// Sets related.R.PushMessage appropriately.
+func (o *PushMessage) AddPushDeliveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushDelivery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PushMessageID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"push_deliveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"push_message_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pushDeliveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PushMessageID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &pushMessageR{
+			PushDeliveries: related,
+		}
+	} else {
+		o.R.PushDeliveries = append(o.R.PushDeliveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pushDeliveryR{
+				PushMessage: o,
+			}
+		} else {
+			rel.R.PushMessage = o
+		}
+	}
+	return nil
+}
+
+// PushMessages retrieves all the records using an executor.
+func PushMessages(mods ...qm.QueryMod) pushMessageQuery {
+	mods = append(mods, qm.From("\"push_messages\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"push_messages\".*"})
+	}
+
+	return pushMessageQuery{q}
+}
+
+// FindPushMessage retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPushMessage(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*PushMessage, error) {
+	pushMessageObj := &PushMessage{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"push_messages\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, pushMessageObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from push_messages")
+	}
+
+	return pushMessageObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PushMessage) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no push_messages provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pushMessageColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	pushMessageInsertCacheMut.RLock()
+	cache, cached := pushMessageInsertCache[key]
+	pushMessageInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			pushMessageAllColumns,
+			pushMessageColumnsWithDefault,
+			pushMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(pushMessageType, pushMessageMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(pushMessageType, pushMessageMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"push_messages\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"push_messages\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into push_messages")
+	}
+
+	if !cached {
+		pushMessageInsertCacheMut.Lock()
+		pushMessageInsertCache[key] = cache
+		pushMessageInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the PushMessage.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PushMessage) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	pushMessageUpdateCacheMut.RLock()
+	cache, cached := pushMessageUpdateCache[key]
+	pushMessageUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			pushMessageAllColumns,
+			pushMessagePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update push_messages, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"push_messages\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, pushMessagePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(pushMessageType, pushMessageMapping, append(wl, pushMessagePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update push_messages row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for push_messages")
+	}
+
+	if !cached {
+		pushMessageUpdateCacheMut.Lock()
+		pushMessageUpdateCache[key] = cache
+		pushMessageUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q pushMessageQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for push_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for push_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PushMessageSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"push_messages\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, pushMessagePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in pushMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all pushMessage")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PushMessage) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no push_messages provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(pushMessageColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	pushMessageUpsertCacheMut.RLock()
+	cache, cached := pushMessageUpsertCache[key]
+	pushMessageUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			pushMessageAllColumns,
+			pushMessageColumnsWithDefault,
+			pushMessageColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			pushMessageAllColumns,
+			pushMessagePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert push_messages, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(pushMessagePrimaryKeyColumns))
+			copy(conflict, pushMessagePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"push_messages\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(pushMessageType, pushMessageMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(pushMessageType, pushMessageMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert push_messages")
+	}
+
+	if !cached {
+		pushMessageUpsertCacheMut.Lock()
+		pushMessageUpsertCache[key] = cache
+		pushMessageUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single PushMessage record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PushMessage) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no PushMessage provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), pushMessagePrimaryKeyMapping)
+	sql := "DELETE FROM \"push_messages\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from push_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for push_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q pushMessageQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no pushMessageQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from push_messages")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for push_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PushMessageSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"push_messages\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pushMessagePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from pushMessage slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for push_messages")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PushMessage) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPushMessage(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PushMessageSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PushMessageSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), pushMessagePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"push_messages\".* FROM \"push_messages\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, pushMessagePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in PushMessageSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PushMessageExists checks if the PushMessage row exists.
+func PushMessageExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"push_messages\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if push_messages exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1064 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPushMessages(t *testing.T) {
+	t.Parallel()
+
+	query := PushMessages()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPushMessagesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushMessagesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PushMessages().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushMessagesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PushMessageSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPushMessagesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PushMessageExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PushMessage exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PushMessageExists to return true, but got false.")
+	}
+}
+
+func testPushMessagesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	pushMessageFound, err := FindPushMessage(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if pushMessageFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPushMessagesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PushMessages().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushMessagesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PushMessages().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPushMessagesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	pushMessageOne := &PushMessage{}
+	pushMessageTwo := &PushMessage{}
+	if err = randomize.Struct(seed, pushMessageOne, pushMessageDBTypes, false, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pushMessageTwo, pushMessageDBTypes, false, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pushMessageOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pushMessageTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PushMessages().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPushMessagesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	pushMessageOne := &PushMessage{}
+	pushMessageTwo := &PushMessage{}
+	if err = randomize.Struct(seed, pushMessageOne, pushMessageDBTypes, false, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+	if err = randomize.Struct(seed, pushMessageTwo, pushMessageDBTypes, false, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = pushMessageOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = pushMessageTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testPushMessagesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPushMessagesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(pushMessageColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPushMessageToManyPushDeliveries(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushMessage
+	var b, c PushDelivery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, pushDeliveryDBTypes, false, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, pushDeliveryDBTypes, false, pushDeliveryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.PushMessageID = a.ID
+	c.PushMessageID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.PushDeliveries().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.PushMessageID == b.PushMessageID {
+			bFound = true
+		}
+		if v.PushMessageID == c.PushMessageID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := PushMessageSlice{&a}
+	if err = a.L.LoadPushDeliveries(ctx, tx, false, (*[]*PushMessage)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PushDeliveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.PushDeliveries = nil
+	if err = a.L.LoadPushDeliveries(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.PushDeliveries); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testPushMessageToManyAddOpPushDeliveries(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushMessage
+	var b, c, d, e PushDelivery
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushMessageDBTypes, false, strmangle.SetComplement(pushMessagePrimaryKeyColumns, pushMessageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*PushDelivery{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, pushDeliveryDBTypes, false, strmangle.SetComplement(pushDeliveryPrimaryKeyColumns, pushDeliveryColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*PushDelivery{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPushDeliveries(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.PushMessageID {
+			t.Error("foreign key was wrong value", a.ID, first.PushMessageID)
+		}
+		if a.ID != second.PushMessageID {
+			t.Error("foreign key was wrong value", a.ID, second.PushMessageID)
+		}
+
+		if first.R.PushMessage != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.PushMessage != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.PushDeliveries[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.PushDeliveries[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.PushDeliveries().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testPushMessageToOnePushCampaignUsingPushCampaign(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PushMessage
+	var foreign PushCampaign
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, pushCampaignDBTypes, false, pushCampaignColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushCampaign struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.PushCampaignID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.PushCampaign().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PushMessageSlice{&local}
+	if err = local.L.LoadPushCampaign(ctx, tx, false, (*[]*PushMessage)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PushCampaign == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.PushCampaign = nil
+	if err = local.L.LoadPushCampaign(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.PushCampaign == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testPushMessageToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PushMessage
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.UserID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PushMessageSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*PushMessage)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testPushMessageToOneSetOpPushCampaignUsingPushCampaign(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushMessage
+	var b, c PushCampaign
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushMessageDBTypes, false, strmangle.SetComplement(pushMessagePrimaryKeyColumns, pushMessageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, pushCampaignDBTypes, false, strmangle.SetComplement(pushCampaignPrimaryKeyColumns, pushCampaignColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, pushCampaignDBTypes, false, strmangle.SetComplement(pushCampaignPrimaryKeyColumns, pushCampaignColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*PushCampaign{&b, &c} {
+		err = a.SetPushCampaign(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.PushCampaign != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PushMessages[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.PushCampaignID, x.ID) {
+			t.Error("foreign key was wrong value", a.PushCampaignID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.PushCampaignID))
+		reflect.Indirect(reflect.ValueOf(&a.PushCampaignID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.PushCampaignID, x.ID) {
+			t.Error("foreign key was wrong value", a.PushCampaignID, x.ID)
+		}
+	}
+}
+
+func testPushMessageToOneRemoveOpPushCampaignUsingPushCampaign(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushMessage
+	var b PushCampaign
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushMessageDBTypes, false, strmangle.SetComplement(pushMessagePrimaryKeyColumns, pushMessageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, pushCampaignDBTypes, false, strmangle.SetComplement(pushCampaignPrimaryKeyColumns, pushCampaignColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetPushCampaign(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemovePushCampaign(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.PushCampaign().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.PushCampaign != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.PushCampaignID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.PushMessages) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testPushMessageToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushMessage
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushMessageDBTypes, false, strmangle.SetComplement(pushMessagePrimaryKeyColumns, pushMessageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.PushMessages[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.UserID))
+		reflect.Indirect(reflect.ValueOf(&a.UserID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.UserID, x.ID) {
+			t.Error("foreign key was wrong value", a.UserID, x.ID)
+		}
+	}
+}
+
+func testPushMessageToOneRemoveOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PushMessage
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, pushMessageDBTypes, false, strmangle.SetComplement(pushMessagePrimaryKeyColumns, pushMessageColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.User().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.User != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.UserID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.PushMessages) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testPushMessagesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushMessagesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PushMessageSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPushMessagesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PushMessages().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	pushMessageDBTypes = map[string]string{`ID`: `uuid`, `UserID`: `uuid`, `Payload`: `jsonb`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `PushCampaignID`: `uuid`}
+	_                  = bytes.MinRead
+)
+
+func testPushMessagesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(pushMessagePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(pushMessageAllColumns) == len(pushMessagePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessagePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPushMessagesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(pushMessageAllColumns) == len(pushMessagePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PushMessage{}
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessageColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, pushMessageDBTypes, true, pushMessagePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(pushMessageAllColumns, pushMessagePrimaryKeyColumns) {
+		fields = pushMessageAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			pushMessageAllColumns,
+			pushMessagePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PushMessageSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPushMessagesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(pushMessageAllColumns) == len(pushMessagePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PushMessage{}
+	if err = randomize.Struct(seed, &o, pushMessageDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PushMessage: %s", err)
+	}
+
+	count, err := PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, pushMessageDBTypes, false, pushMessagePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PushMessage struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PushMessage: %s", err)
+	}
+
+	count, err = PushMessages().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
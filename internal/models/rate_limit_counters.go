@@ -0,0 +1,716 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// RateLimitCounter is an object representing the database table.
+type RateLimitCounter struct {
+	Key           string    `boil:"key" json:"key" toml:"key" yaml:"key"`
+	WindowStart   time.Time `boil:"window_start" json:"window_start" toml:"window_start" yaml:"window_start"`
+	Count         int       `boil:"count" json:"count" toml:"count" yaml:"count"`
+	PreviousCount int       `boil:"previous_count" json:"previous_count" toml:"previous_count" yaml:"previous_count"`
+	CreatedAt     time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *rateLimitCounterR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rateLimitCounterL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RateLimitCounterColumns = struct {
+	Key           string
+	WindowStart   string
+	Count         string
+	PreviousCount string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	Key:           "key",
+	WindowStart:   "window_start",
+	Count:         "count",
+	PreviousCount: "previous_count",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+}
+
+var RateLimitCounterTableColumns = struct {
+	Key           string
+	WindowStart   string
+	Count         string
+	PreviousCount string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	Key:           "rate_limit_counters.key",
+	WindowStart:   "rate_limit_counters.window_start",
+	Count:         "rate_limit_counters.count",
+	PreviousCount: "rate_limit_counters.previous_count",
+	CreatedAt:     "rate_limit_counters.created_at",
+	UpdatedAt:     "rate_limit_counters.updated_at",
+}
+
+// Generated where
+
+var RateLimitCounterWhere = struct {
+	Key           whereHelperstring
+	WindowStart   whereHelpertime_Time
+	Count         whereHelperint
+	PreviousCount whereHelperint
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+}{
+	Key:           whereHelperstring{field: "\"rate_limit_counters\".\"key\""},
+	WindowStart:   whereHelpertime_Time{field: "\"rate_limit_counters\".\"window_start\""},
+	Count:         whereHelperint{field: "\"rate_limit_counters\".\"count\""},
+	PreviousCount: whereHelperint{field: "\"rate_limit_counters\".\"previous_count\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"rate_limit_counters\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"rate_limit_counters\".\"updated_at\""},
+}
+
+// RateLimitCounterRels is where relationship names are stored.
+var RateLimitCounterRels = struct {
+}{}
+
+// rateLimitCounterR is where relationships are stored.
+type rateLimitCounterR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*rateLimitCounterR) NewStruct() *rateLimitCounterR {
+	return &rateLimitCounterR{}
+}
+
+// rateLimitCounterL is where Load methods for each relationship are stored.
+type rateLimitCounterL struct{}
+
+var (
+	rateLimitCounterAllColumns            = []string{"key", "window_start", "count", "previous_count", "created_at", "updated_at"}
+	rateLimitCounterColumnsWithoutDefault = []string{"key", "window_start", "created_at", "updated_at"}
+	rateLimitCounterColumnsWithDefault    = []string{"count", "previous_count"}
+	rateLimitCounterPrimaryKeyColumns     = []string{"key"}
+	rateLimitCounterGeneratedColumns      = []string{}
+)
+
+type (
+	// RateLimitCounterSlice is an alias for a slice of pointers to RateLimitCounter.
+	// This should almost always be used instead of []RateLimitCounter.
+	RateLimitCounterSlice []*RateLimitCounter
+
+	rateLimitCounterQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rateLimitCounterType                 = reflect.TypeOf(&RateLimitCounter{})
+	rateLimitCounterMapping              = queries.MakeStructMapping(rateLimitCounterType)
+	rateLimitCounterPrimaryKeyMapping, _ = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, rateLimitCounterPrimaryKeyColumns)
+	rateLimitCounterInsertCacheMut       sync.RWMutex
+	rateLimitCounterInsertCache          = make(map[string]insertCache)
+	rateLimitCounterUpdateCacheMut       sync.RWMutex
+	rateLimitCounterUpdateCache          = make(map[string]updateCache)
+	rateLimitCounterUpsertCacheMut       sync.RWMutex
+	rateLimitCounterUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+// One returns a single rateLimitCounter record from the query.
+func (q rateLimitCounterQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RateLimitCounter, error) {
+	o := &RateLimitCounter{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for rate_limit_counters")
+	}
+
+	return o, nil
+}
+
+// All returns all RateLimitCounter records from the query.
+func (q rateLimitCounterQuery) All(ctx context.Context, exec boil.ContextExecutor) (RateLimitCounterSlice, error) {
+	var o []*RateLimitCounter
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to RateLimitCounter slice")
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RateLimitCounter records in the query.
+func (q rateLimitCounterQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count rate_limit_counters rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q rateLimitCounterQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if rate_limit_counters exists")
+	}
+
+	return count > 0, nil
+}
+
+// RateLimitCounters retrieves all the records using an executor.
+func RateLimitCounters(mods ...qm.QueryMod) rateLimitCounterQuery {
+	mods = append(mods, qm.From("\"rate_limit_counters\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"rate_limit_counters\".*"})
+	}
+
+	return rateLimitCounterQuery{q}
+}
+
+// FindRateLimitCounter retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRateLimitCounter(ctx context.Context, exec boil.ContextExecutor, key string, selectCols ...string) (*RateLimitCounter, error) {
+	rateLimitCounterObj := &RateLimitCounter{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"rate_limit_counters\" where \"key\"=$1", sel,
+	)
+
+	q := queries.Raw(query, key)
+
+	err := q.Bind(ctx, exec, rateLimitCounterObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from rate_limit_counters")
+	}
+
+	return rateLimitCounterObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RateLimitCounter) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rate_limit_counters provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rateLimitCounterColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rateLimitCounterInsertCacheMut.RLock()
+	cache, cached := rateLimitCounterInsertCache[key]
+	rateLimitCounterInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rateLimitCounterAllColumns,
+			rateLimitCounterColumnsWithDefault,
+			rateLimitCounterColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"rate_limit_counters\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"rate_limit_counters\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into rate_limit_counters")
+	}
+
+	if !cached {
+		rateLimitCounterInsertCacheMut.Lock()
+		rateLimitCounterInsertCache[key] = cache
+		rateLimitCounterInsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Update uses an executor to update the RateLimitCounter.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RateLimitCounter) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	key := makeCacheKey(columns, nil)
+	rateLimitCounterUpdateCacheMut.RLock()
+	cache, cached := rateLimitCounterUpdateCache[key]
+	rateLimitCounterUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rateLimitCounterAllColumns,
+			rateLimitCounterPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update rate_limit_counters, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"rate_limit_counters\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rateLimitCounterPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, append(wl, rateLimitCounterPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update rate_limit_counters row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for rate_limit_counters")
+	}
+
+	if !cached {
+		rateLimitCounterUpdateCacheMut.Lock()
+		rateLimitCounterUpdateCache[key] = cache
+		rateLimitCounterUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rateLimitCounterQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for rate_limit_counters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for rate_limit_counters")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RateLimitCounterSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rateLimitCounterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"rate_limit_counters\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rateLimitCounterPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in rateLimitCounter slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all rateLimitCounter")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RateLimitCounter) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no rate_limit_counters provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rateLimitCounterColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	rateLimitCounterUpsertCacheMut.RLock()
+	cache, cached := rateLimitCounterUpsertCache[key]
+	rateLimitCounterUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			rateLimitCounterAllColumns,
+			rateLimitCounterColumnsWithDefault,
+			rateLimitCounterColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			rateLimitCounterAllColumns,
+			rateLimitCounterPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert rate_limit_counters, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(rateLimitCounterPrimaryKeyColumns))
+			copy(conflict, rateLimitCounterPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"rate_limit_counters\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(rateLimitCounterType, rateLimitCounterMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert rate_limit_counters")
+	}
+
+	if !cached {
+		rateLimitCounterUpsertCacheMut.Lock()
+		rateLimitCounterUpsertCache[key] = cache
+		rateLimitCounterUpsertCacheMut.Unlock()
+	}
+
+	return nil
+}
+
+// Delete deletes a single RateLimitCounter record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RateLimitCounter) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no RateLimitCounter provided for delete")
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rateLimitCounterPrimaryKeyMapping)
+	sql := "DELETE FROM \"rate_limit_counters\" WHERE \"key\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from rate_limit_counters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for rate_limit_counters")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q rateLimitCounterQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no rateLimitCounterQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rate_limit_counters")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rate_limit_counters")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RateLimitCounterSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rateLimitCounterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"rate_limit_counters\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rateLimitCounterPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from rateLimitCounter slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for rate_limit_counters")
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RateLimitCounter) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRateLimitCounter(ctx, exec, o.Key)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RateLimitCounterSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RateLimitCounterSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rateLimitCounterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"rate_limit_counters\".* FROM \"rate_limit_counters\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rateLimitCounterPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in RateLimitCounterSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RateLimitCounterExists checks if the RateLimitCounter row exists.
+func RateLimitCounterExists(ctx context.Context, exec boil.ContextExecutor, key string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"rate_limit_counters\" where \"key\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, key)
+	}
+	row := exec.QueryRowContext(ctx, sql, key)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if rate_limit_counters exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,591 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testRateLimitCounters(t *testing.T) {
+	t.Parallel()
+
+	query := RateLimitCounters()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testRateLimitCountersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RateLimitCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRateLimitCountersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := RateLimitCounters().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RateLimitCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRateLimitCountersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RateLimitCounterSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := RateLimitCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testRateLimitCountersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := RateLimitCounterExists(ctx, tx, o.Key)
+	if err != nil {
+		t.Errorf("Unable to check if RateLimitCounter exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected RateLimitCounterExists to return true, but got false.")
+	}
+}
+
+func testRateLimitCountersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	rateLimitCounterFound, err := FindRateLimitCounter(ctx, tx, o.Key)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if rateLimitCounterFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testRateLimitCountersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = RateLimitCounters().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRateLimitCountersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := RateLimitCounters().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testRateLimitCountersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	rateLimitCounterOne := &RateLimitCounter{}
+	rateLimitCounterTwo := &RateLimitCounter{}
+	if err = randomize.Struct(seed, rateLimitCounterOne, rateLimitCounterDBTypes, false, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rateLimitCounterTwo, rateLimitCounterDBTypes, false, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rateLimitCounterOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rateLimitCounterTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RateLimitCounters().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testRateLimitCountersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	rateLimitCounterOne := &RateLimitCounter{}
+	rateLimitCounterTwo := &RateLimitCounter{}
+	if err = randomize.Struct(seed, rateLimitCounterOne, rateLimitCounterDBTypes, false, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+	if err = randomize.Struct(seed, rateLimitCounterTwo, rateLimitCounterDBTypes, false, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = rateLimitCounterOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = rateLimitCounterTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RateLimitCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testRateLimitCountersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RateLimitCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRateLimitCountersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(rateLimitCounterColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RateLimitCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testRateLimitCountersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRateLimitCountersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := RateLimitCounterSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testRateLimitCountersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := RateLimitCounters().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	rateLimitCounterDBTypes = map[string]string{`Key`: `text`, `WindowStart`: `timestamp with time zone`, `Count`: `integer`, `PreviousCount`: `integer`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                       = bytes.MinRead
+)
+
+func testRateLimitCountersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(rateLimitCounterPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(rateLimitCounterAllColumns) == len(rateLimitCounterPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RateLimitCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testRateLimitCountersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(rateLimitCounterAllColumns) == len(rateLimitCounterPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &RateLimitCounter{}
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := RateLimitCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, rateLimitCounterDBTypes, true, rateLimitCounterPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(rateLimitCounterAllColumns, rateLimitCounterPrimaryKeyColumns) {
+		fields = rateLimitCounterAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			rateLimitCounterAllColumns,
+			rateLimitCounterPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := RateLimitCounterSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testRateLimitCountersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(rateLimitCounterAllColumns) == len(rateLimitCounterPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := RateLimitCounter{}
+	if err = randomize.Struct(seed, &o, rateLimitCounterDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RateLimitCounter: %s", err)
+	}
+
+	count, err := RateLimitCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, rateLimitCounterDBTypes, false, rateLimitCounterPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize RateLimitCounter struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert RateLimitCounter: %s", err)
+	}
+
+	count, err = RateLimitCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -14,72 +14,112 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/strmangle"
 )
 
 // RefreshToken is an object representing the database table.
 type RefreshToken struct {
-	Token     string    `boil:"token" json:"token" toml:"token" yaml:"token"`
-	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Token         string            `boil:"token" json:"token" toml:"token" yaml:"token"`
+	UserID        string            `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	CreatedAt     time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt     time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	FamilyID      string            `boil:"family_id" json:"family_id" toml:"family_id" yaml:"family_id"`
+	RotatedAt     null.Time         `boil:"rotated_at" json:"rotated_at,omitempty" toml:"rotated_at" yaml:"rotated_at,omitempty"`
+	RevokedAt     null.Time         `boil:"revoked_at" json:"revoked_at,omitempty" toml:"revoked_at" yaml:"revoked_at,omitempty"`
+	OauthClientID null.String       `boil:"oauth_client_id" json:"oauth_client_id,omitempty" toml:"oauth_client_id" yaml:"oauth_client_id,omitempty"`
+	Scopes        types.StringArray `boil:"scopes" json:"scopes,omitempty" toml:"scopes" yaml:"scopes,omitempty"`
 
 	R *refreshTokenR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L refreshTokenL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var RefreshTokenColumns = struct {
-	Token     string
-	UserID    string
-	CreatedAt string
-	UpdatedAt string
+	Token         string
+	UserID        string
+	CreatedAt     string
+	UpdatedAt     string
+	FamilyID      string
+	RotatedAt     string
+	RevokedAt     string
+	OauthClientID string
+	Scopes        string
 }{
-	Token:     "token",
-	UserID:    "user_id",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
+	Token:         "token",
+	UserID:        "user_id",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+	FamilyID:      "family_id",
+	RotatedAt:     "rotated_at",
+	RevokedAt:     "revoked_at",
+	OauthClientID: "oauth_client_id",
+	Scopes:        "scopes",
 }
 
 var RefreshTokenTableColumns = struct {
-	Token     string
-	UserID    string
-	CreatedAt string
-	UpdatedAt string
+	Token         string
+	UserID        string
+	CreatedAt     string
+	UpdatedAt     string
+	FamilyID      string
+	RotatedAt     string
+	RevokedAt     string
+	OauthClientID string
+	Scopes        string
 }{
-	Token:     "refresh_tokens.token",
-	UserID:    "refresh_tokens.user_id",
-	CreatedAt: "refresh_tokens.created_at",
-	UpdatedAt: "refresh_tokens.updated_at",
+	Token:         "refresh_tokens.token",
+	UserID:        "refresh_tokens.user_id",
+	CreatedAt:     "refresh_tokens.created_at",
+	UpdatedAt:     "refresh_tokens.updated_at",
+	FamilyID:      "refresh_tokens.family_id",
+	RotatedAt:     "refresh_tokens.rotated_at",
+	RevokedAt:     "refresh_tokens.revoked_at",
+	OauthClientID: "refresh_tokens.oauth_client_id",
+	Scopes:        "refresh_tokens.scopes",
 }
 
 // Generated where
 
 var RefreshTokenWhere = struct {
-	Token     whereHelperstring
-	UserID    whereHelperstring
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
+	Token         whereHelperstring
+	UserID        whereHelperstring
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+	FamilyID      whereHelperstring
+	RotatedAt     whereHelpernull_Time
+	RevokedAt     whereHelpernull_Time
+	OauthClientID whereHelpernull_String
+	Scopes        whereHelpertypes_StringArray
 }{
-	Token:     whereHelperstring{field: "\"refresh_tokens\".\"token\""},
-	UserID:    whereHelperstring{field: "\"refresh_tokens\".\"user_id\""},
-	CreatedAt: whereHelpertime_Time{field: "\"refresh_tokens\".\"created_at\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"refresh_tokens\".\"updated_at\""},
+	Token:         whereHelperstring{field: "\"refresh_tokens\".\"token\""},
+	UserID:        whereHelperstring{field: "\"refresh_tokens\".\"user_id\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"refresh_tokens\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"refresh_tokens\".\"updated_at\""},
+	FamilyID:      whereHelperstring{field: "\"refresh_tokens\".\"family_id\""},
+	RotatedAt:     whereHelpernull_Time{field: "\"refresh_tokens\".\"rotated_at\""},
+	RevokedAt:     whereHelpernull_Time{field: "\"refresh_tokens\".\"revoked_at\""},
+	OauthClientID: whereHelpernull_String{field: "\"refresh_tokens\".\"oauth_client_id\""},
+	Scopes:        whereHelpertypes_StringArray{field: "\"refresh_tokens\".\"scopes\""},
 }
 
 // RefreshTokenRels is where relationship names are stored.
 var RefreshTokenRels = struct {
-	User string
+	OauthClient string
+	User        string
 }{
-	User: "User",
+	OauthClient: "OauthClient",
+	User:        "User",
 }
 
 // refreshTokenR is where relationships are stored.
 type refreshTokenR struct {
-	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	OauthClient *OauthClient `boil:"OauthClient" json:"OauthClient" toml:"OauthClient" yaml:"OauthClient"`
+	User        *User        `boil:"User" json:"User" toml:"User" yaml:"User"`
 }
 
 // NewStruct creates a new relationship struct
@@ -87,6 +127,13 @@ func (*refreshTokenR) NewStruct() *refreshTokenR {
 	return &refreshTokenR{}
 }
 
+func (r *refreshTokenR) GetOauthClient() *OauthClient {
+	if r == nil {
+		return nil
+	}
+	return r.OauthClient
+}
+
 func (r *refreshTokenR) GetUser() *User {
 	if r == nil {
 		return nil
@@ -98,9 +145,9 @@ func (r *refreshTokenR) GetUser() *User {
 type refreshTokenL struct{}
 
 var (
-	refreshTokenAllColumns            = []string{"token", "user_id", "created_at", "updated_at"}
+	refreshTokenAllColumns            = []string{"token", "user_id", "created_at", "updated_at", "family_id", "rotated_at", "revoked_at", "oauth_client_id", "scopes"}
 	refreshTokenColumnsWithoutDefault = []string{"user_id", "created_at", "updated_at"}
-	refreshTokenColumnsWithDefault    = []string{"token"}
+	refreshTokenColumnsWithDefault    = []string{"token", "family_id", "rotated_at", "revoked_at", "oauth_client_id", "scopes"}
 	refreshTokenPrimaryKeyColumns     = []string{"token"}
 	refreshTokenGeneratedColumns      = []string{}
 )
@@ -196,6 +243,17 @@ func (q refreshTokenQuery) Exists(ctx context.Context, exec boil.ContextExecutor
 	return count > 0, nil
 }
 
+// OauthClient pointed to by the foreign key.
+func (o *RefreshToken) OauthClient(mods ...qm.QueryMod) oauthClientQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OauthClientID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return OauthClients(queryMods...)
+}
+
 // User pointed to by the foreign key.
 func (o *RefreshToken) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -207,6 +265,122 @@ func (o *RefreshToken) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// LoadOauthClient allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (refreshTokenL) LoadOauthClient(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRefreshToken interface{}, mods queries.Applicator) error {
+	var slice []*RefreshToken
+	var object *RefreshToken
+
+	if singular {
+		var ok bool
+		object, ok = maybeRefreshToken.(*RefreshToken)
+		if !ok {
+			object = new(RefreshToken)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRefreshToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRefreshToken))
+			}
+		}
+	} else {
+		s, ok := maybeRefreshToken.(*[]*RefreshToken)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRefreshToken)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRefreshToken))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &refreshTokenR{}
+		}
+		if !queries.IsNil(object.OauthClientID) {
+			args = append(args, object.OauthClientID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &refreshTokenR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.OauthClientID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.OauthClientID) {
+				args = append(args, obj.OauthClientID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oauth_clients`),
+		qm.WhereIn(`oauth_clients.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load OauthClient")
+	}
+
+	var resultSlice []*OauthClient
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice OauthClient")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for oauth_clients")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oauth_clients")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OauthClient = foreign
+		if foreign.R == nil {
+			foreign.R = &oauthClientR{}
+		}
+		foreign.R.RefreshTokens = append(foreign.R.RefreshTokens, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.OauthClientID, foreign.ID) {
+				local.R.OauthClient = foreign
+				if foreign.R == nil {
+					foreign.R = &oauthClientR{}
+				}
+				foreign.R.RefreshTokens = append(foreign.R.RefreshTokens, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (refreshTokenL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRefreshToken interface{}, mods queries.Applicator) error {
@@ -319,6 +493,86 @@ func (refreshTokenL) LoadUser(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
+// SetOauthClient of the refreshToken to the related item.
+// Sets o.R.OauthClient to related.
+// Adds o to related.R.RefreshTokens.
+func (o *RefreshToken) SetOauthClient(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OauthClient) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"refresh_tokens\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"oauth_client_id"}),
+		strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Token}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OauthClientID, related.ID)
+	if o.R == nil {
+		o.R = &refreshTokenR{
+			OauthClient: related,
+		}
+	} else {
+		o.R.OauthClient = related
+	}
+
+	if related.R == nil {
+		related.R = &oauthClientR{
+			RefreshTokens: RefreshTokenSlice{o},
+		}
+	} else {
+		related.R.RefreshTokens = append(related.R.RefreshTokens, o)
+	}
+
+	return nil
+}
+
+// RemoveOauthClient relationship.
+// Sets o.R.OauthClient to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *RefreshToken) RemoveOauthClient(ctx context.Context, exec boil.ContextExecutor, related *OauthClient) error {
+	var err error
+
+	queries.SetScanner(&o.OauthClientID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("oauth_client_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.OauthClient = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.RefreshTokens {
+		if queries.Equal(o.OauthClientID, ri.OauthClientID) {
+			continue
+		}
+
+		ln := len(related.R.RefreshTokens)
+		if ln > 1 && i < ln-1 {
+			related.R.RefreshTokens[i] = related.R.RefreshTokens[ln-1]
+		}
+		related.R.RefreshTokens = related.R.RefreshTokens[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetUser of the refreshToken to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.RefreshTokens.
@@ -353,6 +353,57 @@ func testRefreshTokensInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testRefreshTokenToOneOauthClientUsingOauthClient(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local RefreshToken
+	var foreign OauthClient
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, refreshTokenDBTypes, true, refreshTokenColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize RefreshToken struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, oauthClientDBTypes, false, oauthClientColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OauthClient struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.OauthClientID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OauthClient().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := RefreshTokenSlice{&local}
+	if err = local.L.LoadOauthClient(ctx, tx, false, (*[]*RefreshToken)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OauthClient == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OauthClient = nil
+	if err = local.L.LoadOauthClient(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OauthClient == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
 func testRefreshTokenToOneUserUsingUser(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -404,6 +455,115 @@ func testRefreshTokenToOneUserUsingUser(t *testing.T) {
 	}
 }
 
+func testRefreshTokenToOneSetOpOauthClientUsingOauthClient(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RefreshToken
+	var b, c OauthClient
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, refreshTokenDBTypes, false, strmangle.SetComplement(refreshTokenPrimaryKeyColumns, refreshTokenColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*OauthClient{&b, &c} {
+		err = a.SetOauthClient(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OauthClient != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RefreshTokens[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.OauthClientID, x.ID) {
+			t.Error("foreign key was wrong value", a.OauthClientID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.OauthClientID))
+		reflect.Indirect(reflect.ValueOf(&a.OauthClientID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.OauthClientID, x.ID) {
+			t.Error("foreign key was wrong value", a.OauthClientID, x.ID)
+		}
+	}
+}
+
+func testRefreshTokenToOneRemoveOpOauthClientUsingOauthClient(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a RefreshToken
+	var b OauthClient
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, refreshTokenDBTypes, false, strmangle.SetComplement(refreshTokenPrimaryKeyColumns, refreshTokenColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, oauthClientDBTypes, false, strmangle.SetComplement(oauthClientPrimaryKeyColumns, oauthClientColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetOauthClient(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveOauthClient(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.OauthClient().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.OauthClient != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.OauthClientID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.RefreshTokens) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
 func testRefreshTokenToOneSetOpUserUsingUser(t *testing.T) {
 	var err error
 
@@ -536,7 +696,7 @@ func testRefreshTokensSelect(t *testing.T) {
 }
 
 var (
-	refreshTokenDBTypes = map[string]string{`Token`: `uuid`, `UserID`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	refreshTokenDBTypes = map[string]string{`Token`: `uuid`, `UserID`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `FamilyID`: `uuid`, `RotatedAt`: `timestamp with time zone`, `RevokedAt`: `timestamp with time zone`, `OauthClientID`: `uuid`, `Scopes`: `ARRAYtext`}
 	_                   = bytes.MinRead
 )
 
@@ -0,0 +1,696 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testTotpCredentials(t *testing.T) {
+	t.Parallel()
+
+	query := TotpCredentials()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testTotpCredentialsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TotpCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTotpCredentialsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := TotpCredentials().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TotpCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTotpCredentialsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TotpCredentialSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := TotpCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testTotpCredentialsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := TotpCredentialExists(ctx, tx, o.UserID)
+	if err != nil {
+		t.Errorf("Unable to check if TotpCredential exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected TotpCredentialExists to return true, but got false.")
+	}
+}
+
+func testTotpCredentialsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	totpCredentialFound, err := FindTotpCredential(ctx, tx, o.UserID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if totpCredentialFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testTotpCredentialsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = TotpCredentials().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTotpCredentialsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := TotpCredentials().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testTotpCredentialsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	totpCredentialOne := &TotpCredential{}
+	totpCredentialTwo := &TotpCredential{}
+	if err = randomize.Struct(seed, totpCredentialOne, totpCredentialDBTypes, false, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+	if err = randomize.Struct(seed, totpCredentialTwo, totpCredentialDBTypes, false, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = totpCredentialOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = totpCredentialTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TotpCredentials().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testTotpCredentialsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	totpCredentialOne := &TotpCredential{}
+	totpCredentialTwo := &TotpCredential{}
+	if err = randomize.Struct(seed, totpCredentialOne, totpCredentialDBTypes, false, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+	if err = randomize.Struct(seed, totpCredentialTwo, totpCredentialDBTypes, false, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = totpCredentialOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = totpCredentialTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TotpCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func testTotpCredentialsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TotpCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTotpCredentialsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(totpCredentialColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TotpCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testTotpCredentialToOneUserUsingUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local TotpCredential
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, totpCredentialDBTypes, false, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.UserID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.User().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := TotpCredentialSlice{&local}
+	if err = local.L.LoadUser(ctx, tx, false, (*[]*TotpCredential)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.User = nil
+	if err = local.L.LoadUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.User == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testTotpCredentialToOneSetOpUserUsingUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a TotpCredential
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, totpCredentialDBTypes, false, strmangle.SetComplement(totpCredentialPrimaryKeyColumns, totpCredentialColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.User != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.TotpCredential != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.UserID != x.ID {
+			t.Error("foreign key was wrong value", a.UserID)
+		}
+
+		if exists, err := TotpCredentialExists(ctx, tx, a.UserID); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testTotpCredentialsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTotpCredentialsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := TotpCredentialSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testTotpCredentialsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := TotpCredentials().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	totpCredentialDBTypes = map[string]string{`UserID`: `uuid`, `Secret`: `text`, `ConfirmedAt`: `timestamp with time zone`, `LastUsedStep`: `bigint`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	_                     = bytes.MinRead
+)
+
+func testTotpCredentialsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(totpCredentialPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(totpCredentialAllColumns) == len(totpCredentialPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TotpCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testTotpCredentialsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(totpCredentialAllColumns) == len(totpCredentialPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &TotpCredential{}
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := TotpCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, totpCredentialDBTypes, true, totpCredentialPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(totpCredentialAllColumns, totpCredentialPrimaryKeyColumns) {
+		fields = totpCredentialAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			totpCredentialAllColumns,
+			totpCredentialPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := TotpCredentialSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testTotpCredentialsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(totpCredentialAllColumns) == len(totpCredentialPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := TotpCredential{}
+	if err = randomize.Struct(seed, &o, totpCredentialDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TotpCredential: %s", err)
+	}
+
+	count, err := TotpCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, totpCredentialDBTypes, false, totpCredentialPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize TotpCredential struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert TotpCredential: %s", err)
+	}
+
+	count, err = TotpCredentials().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
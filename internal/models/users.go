@@ -25,171 +25,176 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID                  string            `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Username            null.String       `boil:"username" json:"username,omitempty" toml:"username" yaml:"username,omitempty"`
-	Password            null.String       `boil:"password" json:"password,omitempty" toml:"password" yaml:"password,omitempty"`
-	IsActive            bool              `boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
-	Scopes              types.StringArray `boil:"scopes" json:"scopes" toml:"scopes" yaml:"scopes"`
-	LastAuthenticatedAt null.Time         `boil:"last_authenticated_at" json:"last_authenticated_at,omitempty" toml:"last_authenticated_at" yaml:"last_authenticated_at,omitempty"`
-	CreatedAt           time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt           time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                    string            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Username              null.String       `boil:"username" json:"username,omitempty" toml:"username" yaml:"username,omitempty"`
+	Password              null.String       `boil:"password" json:"password,omitempty" toml:"password" yaml:"password,omitempty"`
+	IsActive              bool              `boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
+	Scopes                types.StringArray `boil:"scopes" json:"scopes" toml:"scopes" yaml:"scopes"`
+	LastAuthenticatedAt   null.Time         `boil:"last_authenticated_at" json:"last_authenticated_at,omitempty" toml:"last_authenticated_at" yaml:"last_authenticated_at,omitempty"`
+	CreatedAt             time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt             time.Time         `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	EmailVerifiedAt       null.Time         `boil:"email_verified_at" json:"email_verified_at,omitempty" toml:"email_verified_at" yaml:"email_verified_at,omitempty"`
+	PasswordResetRequired bool              `boil:"password_reset_required" json:"password_reset_required" toml:"password_reset_required" yaml:"password_reset_required"`
+	SearchVector          null.String       `boil:"search_vector" json:"search_vector,omitempty" toml:"search_vector" yaml:"search_vector,omitempty"`
+	DeletedAt             null.Time         `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID                  string
-	Username            string
-	Password            string
-	IsActive            string
-	Scopes              string
-	LastAuthenticatedAt string
-	CreatedAt           string
-	UpdatedAt           string
+	ID                    string
+	Username              string
+	Password              string
+	IsActive              string
+	Scopes                string
+	LastAuthenticatedAt   string
+	CreatedAt             string
+	UpdatedAt             string
+	EmailVerifiedAt       string
+	PasswordResetRequired string
+	SearchVector          string
+	DeletedAt             string
 }{
-	ID:                  "id",
-	Username:            "username",
-	Password:            "password",
-	IsActive:            "is_active",
-	Scopes:              "scopes",
-	LastAuthenticatedAt: "last_authenticated_at",
-	CreatedAt:           "created_at",
-	UpdatedAt:           "updated_at",
+	ID:                    "id",
+	Username:              "username",
+	Password:              "password",
+	IsActive:              "is_active",
+	Scopes:                "scopes",
+	LastAuthenticatedAt:   "last_authenticated_at",
+	CreatedAt:             "created_at",
+	UpdatedAt:             "updated_at",
+	EmailVerifiedAt:       "email_verified_at",
+	PasswordResetRequired: "password_reset_required",
+	SearchVector:          "search_vector",
+	DeletedAt:             "deleted_at",
 }
 
 var UserTableColumns = struct {
-	ID                  string
-	Username            string
-	Password            string
-	IsActive            string
-	Scopes              string
-	LastAuthenticatedAt string
-	CreatedAt           string
-	UpdatedAt           string
+	ID                    string
+	Username              string
+	Password              string
+	IsActive              string
+	Scopes                string
+	LastAuthenticatedAt   string
+	CreatedAt             string
+	UpdatedAt             string
+	EmailVerifiedAt       string
+	PasswordResetRequired string
+	SearchVector          string
+	DeletedAt             string
 }{
-	ID:                  "users.id",
-	Username:            "users.username",
-	Password:            "users.password",
-	IsActive:            "users.is_active",
-	Scopes:              "users.scopes",
-	LastAuthenticatedAt: "users.last_authenticated_at",
-	CreatedAt:           "users.created_at",
-	UpdatedAt:           "users.updated_at",
+	ID:                    "users.id",
+	Username:              "users.username",
+	Password:              "users.password",
+	IsActive:              "users.is_active",
+	Scopes:                "users.scopes",
+	LastAuthenticatedAt:   "users.last_authenticated_at",
+	CreatedAt:             "users.created_at",
+	UpdatedAt:             "users.updated_at",
+	EmailVerifiedAt:       "users.email_verified_at",
+	PasswordResetRequired: "users.password_reset_required",
+	SearchVector:          "users.search_vector",
+	DeletedAt:             "users.deleted_at",
 }
 
 // Generated where
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelperbool struct{ field string }
-
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-
-type whereHelpertypes_StringArray struct{ field string }
-
-func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var UserWhere = struct {
-	ID                  whereHelperstring
-	Username            whereHelpernull_String
-	Password            whereHelpernull_String
-	IsActive            whereHelperbool
-	Scopes              whereHelpertypes_StringArray
-	LastAuthenticatedAt whereHelpernull_Time
-	CreatedAt           whereHelpertime_Time
-	UpdatedAt           whereHelpertime_Time
+	ID                    whereHelperstring
+	Username              whereHelpernull_String
+	Password              whereHelpernull_String
+	IsActive              whereHelperbool
+	Scopes                whereHelpertypes_StringArray
+	LastAuthenticatedAt   whereHelpernull_Time
+	CreatedAt             whereHelpertime_Time
+	UpdatedAt             whereHelpertime_Time
+	EmailVerifiedAt       whereHelpernull_Time
+	PasswordResetRequired whereHelperbool
+	SearchVector          whereHelpernull_String
+	DeletedAt             whereHelpernull_Time
 }{
-	ID:                  whereHelperstring{field: "\"users\".\"id\""},
-	Username:            whereHelpernull_String{field: "\"users\".\"username\""},
-	Password:            whereHelpernull_String{field: "\"users\".\"password\""},
-	IsActive:            whereHelperbool{field: "\"users\".\"is_active\""},
-	Scopes:              whereHelpertypes_StringArray{field: "\"users\".\"scopes\""},
-	LastAuthenticatedAt: whereHelpernull_Time{field: "\"users\".\"last_authenticated_at\""},
-	CreatedAt:           whereHelpertime_Time{field: "\"users\".\"created_at\""},
-	UpdatedAt:           whereHelpertime_Time{field: "\"users\".\"updated_at\""},
+	ID:                    whereHelperstring{field: "\"users\".\"id\""},
+	Username:              whereHelpernull_String{field: "\"users\".\"username\""},
+	Password:              whereHelpernull_String{field: "\"users\".\"password\""},
+	IsActive:              whereHelperbool{field: "\"users\".\"is_active\""},
+	Scopes:                whereHelpertypes_StringArray{field: "\"users\".\"scopes\""},
+	LastAuthenticatedAt:   whereHelpernull_Time{field: "\"users\".\"last_authenticated_at\""},
+	CreatedAt:             whereHelpertime_Time{field: "\"users\".\"created_at\""},
+	UpdatedAt:             whereHelpertime_Time{field: "\"users\".\"updated_at\""},
+	EmailVerifiedAt:       whereHelpernull_Time{field: "\"users\".\"email_verified_at\""},
+	PasswordResetRequired: whereHelperbool{field: "\"users\".\"password_reset_required\""},
+	SearchVector:          whereHelpernull_String{field: "\"users\".\"search_vector\""},
+	DeletedAt:             whereHelpernull_Time{field: "\"users\".\"deleted_at\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	AppUserProfile      string
-	AccessTokens        string
-	PasswordResetTokens string
-	PushTokens          string
-	RefreshTokens       string
+	AppUserProfile          string
+	TotpCredential          string
+	AccessTokens            string
+	APIKeys                 string
+	EmailChangeRevertTokens string
+	EmailChangeTokens       string
+	EmailVerificationTokens string
+	Identities              string
+	LegalAcceptances        string
+	MfaChallenges           string
+	OauthAuthorizationCodes string
+	OauthClients            string
+	PasswordResetTokens     string
+	PushMessages            string
+	PushTokens              string
+	RecoveryCodes           string
+	RefreshTokens           string
+	Sessions                string
+	Uploads                 string
+	UserRoles               string
 }{
-	AppUserProfile:      "AppUserProfile",
-	AccessTokens:        "AccessTokens",
-	PasswordResetTokens: "PasswordResetTokens",
-	PushTokens:          "PushTokens",
-	RefreshTokens:       "RefreshTokens",
+	AppUserProfile:          "AppUserProfile",
+	TotpCredential:          "TotpCredential",
+	AccessTokens:            "AccessTokens",
+	APIKeys:                 "APIKeys",
+	EmailChangeRevertTokens: "EmailChangeRevertTokens",
+	EmailChangeTokens:       "EmailChangeTokens",
+	EmailVerificationTokens: "EmailVerificationTokens",
+	Identities:              "Identities",
+	LegalAcceptances:        "LegalAcceptances",
+	MfaChallenges:           "MfaChallenges",
+	OauthAuthorizationCodes: "OauthAuthorizationCodes",
+	OauthClients:            "OauthClients",
+	PasswordResetTokens:     "PasswordResetTokens",
+	PushMessages:            "PushMessages",
+	PushTokens:              "PushTokens",
+	RecoveryCodes:           "RecoveryCodes",
+	RefreshTokens:           "RefreshTokens",
+	Sessions:                "Sessions",
+	Uploads:                 "Uploads",
+	UserRoles:               "UserRoles",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	AppUserProfile      *AppUserProfile         `boil:"AppUserProfile" json:"AppUserProfile" toml:"AppUserProfile" yaml:"AppUserProfile"`
-	AccessTokens        AccessTokenSlice        `boil:"AccessTokens" json:"AccessTokens" toml:"AccessTokens" yaml:"AccessTokens"`
-	PasswordResetTokens PasswordResetTokenSlice `boil:"PasswordResetTokens" json:"PasswordResetTokens" toml:"PasswordResetTokens" yaml:"PasswordResetTokens"`
-	PushTokens          PushTokenSlice          `boil:"PushTokens" json:"PushTokens" toml:"PushTokens" yaml:"PushTokens"`
-	RefreshTokens       RefreshTokenSlice       `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
+	AppUserProfile          *AppUserProfile             `boil:"AppUserProfile" json:"AppUserProfile" toml:"AppUserProfile" yaml:"AppUserProfile"`
+	TotpCredential          *TotpCredential             `boil:"TotpCredential" json:"TotpCredential" toml:"TotpCredential" yaml:"TotpCredential"`
+	AccessTokens            AccessTokenSlice            `boil:"AccessTokens" json:"AccessTokens" toml:"AccessTokens" yaml:"AccessTokens"`
+	APIKeys                 APIKeySlice                 `boil:"APIKeys" json:"APIKeys" toml:"APIKeys" yaml:"APIKeys"`
+	EmailChangeRevertTokens EmailChangeRevertTokenSlice `boil:"EmailChangeRevertTokens" json:"EmailChangeRevertTokens" toml:"EmailChangeRevertTokens" yaml:"EmailChangeRevertTokens"`
+	EmailChangeTokens       EmailChangeTokenSlice       `boil:"EmailChangeTokens" json:"EmailChangeTokens" toml:"EmailChangeTokens" yaml:"EmailChangeTokens"`
+	EmailVerificationTokens EmailVerificationTokenSlice `boil:"EmailVerificationTokens" json:"EmailVerificationTokens" toml:"EmailVerificationTokens" yaml:"EmailVerificationTokens"`
+	Identities              IdentitySlice               `boil:"Identities" json:"Identities" toml:"Identities" yaml:"Identities"`
+	LegalAcceptances        LegalAcceptanceSlice        `boil:"LegalAcceptances" json:"LegalAcceptances" toml:"LegalAcceptances" yaml:"LegalAcceptances"`
+	MfaChallenges           MfaChallengeSlice           `boil:"MfaChallenges" json:"MfaChallenges" toml:"MfaChallenges" yaml:"MfaChallenges"`
+	OauthAuthorizationCodes OauthAuthorizationCodeSlice `boil:"OauthAuthorizationCodes" json:"OauthAuthorizationCodes" toml:"OauthAuthorizationCodes" yaml:"OauthAuthorizationCodes"`
+	OauthClients            OauthClientSlice            `boil:"OauthClients" json:"OauthClients" toml:"OauthClients" yaml:"OauthClients"`
+	PasswordResetTokens     PasswordResetTokenSlice     `boil:"PasswordResetTokens" json:"PasswordResetTokens" toml:"PasswordResetTokens" yaml:"PasswordResetTokens"`
+	PushMessages            PushMessageSlice            `boil:"PushMessages" json:"PushMessages" toml:"PushMessages" yaml:"PushMessages"`
+	PushTokens              PushTokenSlice              `boil:"PushTokens" json:"PushTokens" toml:"PushTokens" yaml:"PushTokens"`
+	RecoveryCodes           RecoveryCodeSlice           `boil:"RecoveryCodes" json:"RecoveryCodes" toml:"RecoveryCodes" yaml:"RecoveryCodes"`
+	RefreshTokens           RefreshTokenSlice           `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
+	Sessions                SessionSlice                `boil:"Sessions" json:"Sessions" toml:"Sessions" yaml:"Sessions"`
+	Uploads                 UploadSlice                 `boil:"Uploads" json:"Uploads" toml:"Uploads" yaml:"Uploads"`
+	UserRoles               UserRoleSlice               `boil:"UserRoles" json:"UserRoles" toml:"UserRoles" yaml:"UserRoles"`
 }
 
 // NewStruct creates a new relationship struct
@@ -204,6 +209,13 @@ func (r *userR) GetAppUserProfile() *AppUserProfile {
 	return r.AppUserProfile
 }
 
+func (r *userR) GetTotpCredential() *TotpCredential {
+	if r == nil {
+		return nil
+	}
+	return r.TotpCredential
+}
+
 func (r *userR) GetAccessTokens() AccessTokenSlice {
 	if r == nil {
 		return nil
@@ -211,6 +223,69 @@ func (r *userR) GetAccessTokens() AccessTokenSlice {
 	return r.AccessTokens
 }
 
+func (r *userR) GetAPIKeys() APIKeySlice {
+	if r == nil {
+		return nil
+	}
+	return r.APIKeys
+}
+
+func (r *userR) GetEmailChangeRevertTokens() EmailChangeRevertTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.EmailChangeRevertTokens
+}
+
+func (r *userR) GetEmailChangeTokens() EmailChangeTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.EmailChangeTokens
+}
+
+func (r *userR) GetEmailVerificationTokens() EmailVerificationTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.EmailVerificationTokens
+}
+
+func (r *userR) GetIdentities() IdentitySlice {
+	if r == nil {
+		return nil
+	}
+	return r.Identities
+}
+
+func (r *userR) GetLegalAcceptances() LegalAcceptanceSlice {
+	if r == nil {
+		return nil
+	}
+	return r.LegalAcceptances
+}
+
+func (r *userR) GetMfaChallenges() MfaChallengeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MfaChallenges
+}
+
+func (r *userR) GetOauthAuthorizationCodes() OauthAuthorizationCodeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OauthAuthorizationCodes
+}
+
+func (r *userR) GetOauthClients() OauthClientSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OauthClients
+}
+
 func (r *userR) GetPasswordResetTokens() PasswordResetTokenSlice {
 	if r == nil {
 		return nil
@@ -218,6 +293,13 @@ func (r *userR) GetPasswordResetTokens() PasswordResetTokenSlice {
 	return r.PasswordResetTokens
 }
 
+func (r *userR) GetPushMessages() PushMessageSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PushMessages
+}
+
 func (r *userR) GetPushTokens() PushTokenSlice {
 	if r == nil {
 		return nil
@@ -225,6 +307,13 @@ func (r *userR) GetPushTokens() PushTokenSlice {
 	return r.PushTokens
 }
 
+func (r *userR) GetRecoveryCodes() RecoveryCodeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.RecoveryCodes
+}
+
 func (r *userR) GetRefreshTokens() RefreshTokenSlice {
 	if r == nil {
 		return nil
@@ -232,15 +321,36 @@ func (r *userR) GetRefreshTokens() RefreshTokenSlice {
 	return r.RefreshTokens
 }
 
+func (r *userR) GetSessions() SessionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Sessions
+}
+
+func (r *userR) GetUploads() UploadSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Uploads
+}
+
+func (r *userR) GetUserRoles() UserRoleSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserRoles
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "username", "password", "is_active", "scopes", "last_authenticated_at", "created_at", "updated_at"}
+	userAllColumns            = []string{"id", "username", "password", "is_active", "scopes", "last_authenticated_at", "created_at", "updated_at", "email_verified_at", "password_reset_required", "search_vector", "deleted_at"}
 	userColumnsWithoutDefault = []string{"is_active", "scopes", "created_at", "updated_at"}
-	userColumnsWithDefault    = []string{"id", "username", "password", "last_authenticated_at"}
+	userColumnsWithDefault    = []string{"id", "username", "password", "last_authenticated_at", "email_verified_at", "password_reset_required", "search_vector", "deleted_at"}
 	userPrimaryKeyColumns     = []string{"id"}
-	userGeneratedColumns      = []string{}
+	userGeneratedColumns      = []string{"search_vector"}
 )
 
 type (
@@ -345,6 +455,17 @@ func (o *User) AppUserProfile(mods ...qm.QueryMod) appUserProfileQuery {
 	return AppUserProfiles(queryMods...)
 }
 
+// TotpCredential pointed to by the foreign key.
+func (o *User) TotpCredential(mods ...qm.QueryMod) totpCredentialQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TotpCredentials(queryMods...)
+}
+
 // AccessTokens retrieves all the access_token's AccessTokens with an executor.
 func (o *User) AccessTokens(mods ...qm.QueryMod) accessTokenQuery {
 	var queryMods []qm.QueryMod
@@ -359,6 +480,132 @@ func (o *User) AccessTokens(mods ...qm.QueryMod) accessTokenQuery {
 	return AccessTokens(queryMods...)
 }
 
+// APIKeys retrieves all the api_key's APIKeys with an executor.
+func (o *User) APIKeys(mods ...qm.QueryMod) apiKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"api_keys\".\"user_id\"=?", o.ID),
+	)
+
+	return APIKeys(queryMods...)
+}
+
+// EmailChangeRevertTokens retrieves all the email_change_revert_token's EmailChangeRevertTokens with an executor.
+func (o *User) EmailChangeRevertTokens(mods ...qm.QueryMod) emailChangeRevertTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"email_change_revert_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return EmailChangeRevertTokens(queryMods...)
+}
+
+// EmailChangeTokens retrieves all the email_change_token's EmailChangeTokens with an executor.
+func (o *User) EmailChangeTokens(mods ...qm.QueryMod) emailChangeTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"email_change_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return EmailChangeTokens(queryMods...)
+}
+
+// EmailVerificationTokens retrieves all the email_verification_token's EmailVerificationTokens with an executor.
+func (o *User) EmailVerificationTokens(mods ...qm.QueryMod) emailVerificationTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"email_verification_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return EmailVerificationTokens(queryMods...)
+}
+
+// Identities retrieves all the identity's Identities with an executor.
+func (o *User) Identities(mods ...qm.QueryMod) identityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"identities\".\"user_id\"=?", o.ID),
+	)
+
+	return Identities(queryMods...)
+}
+
+// LegalAcceptances retrieves all the legal_acceptance's LegalAcceptances with an executor.
+func (o *User) LegalAcceptances(mods ...qm.QueryMod) legalAcceptanceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"legal_acceptances\".\"user_id\"=?", o.ID),
+	)
+
+	return LegalAcceptances(queryMods...)
+}
+
+// MfaChallenges retrieves all the mfa_challenge's MfaChallenges with an executor.
+func (o *User) MfaChallenges(mods ...qm.QueryMod) mfaChallengeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"mfa_challenges\".\"user_id\"=?", o.ID),
+	)
+
+	return MfaChallenges(queryMods...)
+}
+
+// OauthAuthorizationCodes retrieves all the oauth_authorization_code's OauthAuthorizationCodes with an executor.
+func (o *User) OauthAuthorizationCodes(mods ...qm.QueryMod) oauthAuthorizationCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oauth_authorization_codes\".\"user_id\"=?", o.ID),
+	)
+
+	return OauthAuthorizationCodes(queryMods...)
+}
+
+// OauthClients retrieves all the oauth_client's OauthClients with an executor.
+func (o *User) OauthClients(mods ...qm.QueryMod) oauthClientQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oauth_clients\".\"user_id\"=?", o.ID),
+	)
+
+	return OauthClients(queryMods...)
+}
+
 // PasswordResetTokens retrieves all the password_reset_token's PasswordResetTokens with an executor.
 func (o *User) PasswordResetTokens(mods ...qm.QueryMod) passwordResetTokenQuery {
 	var queryMods []qm.QueryMod
@@ -373,6 +620,20 @@ func (o *User) PasswordResetTokens(mods ...qm.QueryMod) passwordResetTokenQuery
 	return PasswordResetTokens(queryMods...)
 }
 
+// PushMessages retrieves all the push_message's PushMessages with an executor.
+func (o *User) PushMessages(mods ...qm.QueryMod) pushMessageQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"push_messages\".\"user_id\"=?", o.ID),
+	)
+
+	return PushMessages(queryMods...)
+}
+
 // PushTokens retrieves all the push_token's PushTokens with an executor.
 func (o *User) PushTokens(mods ...qm.QueryMod) pushTokenQuery {
 	var queryMods []qm.QueryMod
@@ -387,6 +648,20 @@ func (o *User) PushTokens(mods ...qm.QueryMod) pushTokenQuery {
 	return PushTokens(queryMods...)
 }
 
+// RecoveryCodes retrieves all the recovery_code's RecoveryCodes with an executor.
+func (o *User) RecoveryCodes(mods ...qm.QueryMod) recoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"recovery_codes\".\"user_id\"=?", o.ID),
+	)
+
+	return RecoveryCodes(queryMods...)
+}
+
 // RefreshTokens retrieves all the refresh_token's RefreshTokens with an executor.
 func (o *User) RefreshTokens(mods ...qm.QueryMod) refreshTokenQuery {
 	var queryMods []qm.QueryMod
@@ -401,6 +676,48 @@ func (o *User) RefreshTokens(mods ...qm.QueryMod) refreshTokenQuery {
 	return RefreshTokens(queryMods...)
 }
 
+// Sessions retrieves all the session's Sessions with an executor.
+func (o *User) Sessions(mods ...qm.QueryMod) sessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"sessions\".\"user_id\"=?", o.ID),
+	)
+
+	return Sessions(queryMods...)
+}
+
+// Uploads retrieves all the upload's Uploads with an executor.
+func (o *User) Uploads(mods ...qm.QueryMod) uploadQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"uploads\".\"user_id\"=?", o.ID),
+	)
+
+	return Uploads(queryMods...)
+}
+
+// UserRoles retrieves all the user_role's UserRoles with an executor.
+func (o *User) UserRoles(mods ...qm.QueryMod) userRoleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_roles\".\"user_id\"=?", o.ID),
+	)
+
+	return UserRoles(queryMods...)
+}
+
 // LoadAppUserProfile allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
 func (userL) LoadAppUserProfile(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -510,9 +827,9 @@ func (userL) LoadAppUserProfile(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// LoadAccessTokens allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// LoadTotpCredential allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadTotpCredential(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -566,8 +883,8 @@ func (userL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.From(`access_tokens`),
-		qm.WhereIn(`access_tokens.user_id in ?`, args...),
+		qm.From(`totp_credentials`),
+		qm.WhereIn(`totp_credentials.user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -575,16 +892,125 @@ func (userL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load access_tokens")
+		return errors.Wrap(err, "failed to eager load TotpCredential")
 	}
 
-	var resultSlice []*AccessToken
+	var resultSlice []*TotpCredential
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice access_tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice TotpCredential")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on access_tokens")
+		return errors.Wrap(err, "failed to close results of eager load for totp_credentials")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for totp_credentials")
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TotpCredential = foreign
+		if foreign.R == nil {
+			foreign.R = &totpCredentialR{}
+		}
+		foreign.R.User = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.TotpCredential = foreign
+				if foreign.R == nil {
+					foreign.R = &totpCredentialR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAccessTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`access_tokens`),
+		qm.WhereIn(`access_tokens.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load access_tokens")
+	}
+
+	var resultSlice []*AccessToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice access_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on access_tokens")
 	}
 	if err = results.Err(); err != nil {
 		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for access_tokens")
@@ -617,9 +1043,9 @@ func (userL) LoadAccessTokens(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadPasswordResetTokens allows an eager lookup of values, cached into the
+// LoadAPIKeys allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAPIKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -673,8 +1099,8 @@ func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor
 	}
 
 	query := NewQuery(
-		qm.From(`password_reset_tokens`),
-		qm.WhereIn(`password_reset_tokens.user_id in ?`, args...),
+		qm.From(`api_keys`),
+		qm.WhereIn(`api_keys.user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -682,26 +1108,26 @@ func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load password_reset_tokens")
+		return errors.Wrap(err, "failed to eager load api_keys")
 	}
 
-	var resultSlice []*PasswordResetToken
+	var resultSlice []*APIKey
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice password_reset_tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice api_keys")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on password_reset_tokens")
+		return errors.Wrap(err, "failed to close results in eager load on api_keys")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_reset_tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for api_keys")
 	}
 
 	if singular {
-		object.R.PasswordResetTokens = resultSlice
+		object.R.APIKeys = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &passwordResetTokenR{}
+				foreign.R = &apiKeyR{}
 			}
 			foreign.R.User = object
 		}
@@ -711,9 +1137,9 @@ func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.PasswordResetTokens = append(local.R.PasswordResetTokens, foreign)
+				local.R.APIKeys = append(local.R.APIKeys, foreign)
 				if foreign.R == nil {
-					foreign.R = &passwordResetTokenR{}
+					foreign.R = &apiKeyR{}
 				}
 				foreign.R.User = local
 				break
@@ -724,9 +1150,9 @@ func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// LoadPushTokens allows an eager lookup of values, cached into the
+// LoadEmailChangeRevertTokens allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadEmailChangeRevertTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -780,8 +1206,8 @@ func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`push_tokens`),
-		qm.WhereIn(`push_tokens.user_id in ?`, args...),
+		qm.From(`email_change_revert_tokens`),
+		qm.WhereIn(`email_change_revert_tokens.user_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -789,26 +1215,26 @@ func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load push_tokens")
+		return errors.Wrap(err, "failed to eager load email_change_revert_tokens")
 	}
 
-	var resultSlice []*PushToken
+	var resultSlice []*EmailChangeRevertToken
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice push_tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice email_change_revert_tokens")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on push_tokens")
+		return errors.Wrap(err, "failed to close results in eager load on email_change_revert_tokens")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for email_change_revert_tokens")
 	}
 
 	if singular {
-		object.R.PushTokens = resultSlice
+		object.R.EmailChangeRevertTokens = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &pushTokenR{}
+				foreign.R = &emailChangeRevertTokenR{}
 			}
 			foreign.R.User = object
 		}
@@ -818,9 +1244,9 @@ func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singula
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.PushTokens = append(local.R.PushTokens, foreign)
+				local.R.EmailChangeRevertTokens = append(local.R.EmailChangeRevertTokens, foreign)
 				if foreign.R == nil {
-					foreign.R = &pushTokenR{}
+					foreign.R = &emailChangeRevertTokenR{}
 				}
 				foreign.R.User = local
 				break
@@ -831,9 +1257,116 @@ func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// LoadRefreshTokens allows an eager lookup of values, cached into the
+// LoadEmailChangeTokens allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadEmailChangeTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`email_change_tokens`),
+		qm.WhereIn(`email_change_tokens.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load email_change_tokens")
+	}
+
+	var resultSlice []*EmailChangeToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice email_change_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on email_change_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for email_change_tokens")
+	}
+
+	if singular {
+		object.R.EmailChangeTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &emailChangeTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.EmailChangeTokens = append(local.R.EmailChangeTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &emailChangeTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadEmailVerificationTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadEmailVerificationTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -859,140 +1392,2471 @@ func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, sing
 		}
 	}
 
-	args := make([]interface{}, 0, 1)
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args = append(args, object.ID)
-	} else {
-	Outer:
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`email_verification_tokens`),
+		qm.WhereIn(`email_verification_tokens.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load email_verification_tokens")
+	}
+
+	var resultSlice []*EmailVerificationToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice email_verification_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on email_verification_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for email_verification_tokens")
+	}
+
+	if singular {
+		object.R.EmailVerificationTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &emailVerificationTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.EmailVerificationTokens = append(local.R.EmailVerificationTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &emailVerificationTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadIdentities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadIdentities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`identities`),
+		qm.WhereIn(`identities.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load identities")
+	}
+
+	var resultSlice []*Identity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice identities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on identities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for identities")
+	}
+
+	if singular {
+		object.R.Identities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &identityR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Identities = append(local.R.Identities, foreign)
+				if foreign.R == nil {
+					foreign.R = &identityR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLegalAcceptances allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadLegalAcceptances(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`legal_acceptances`),
+		qm.WhereIn(`legal_acceptances.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load legal_acceptances")
+	}
+
+	var resultSlice []*LegalAcceptance
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice legal_acceptances")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on legal_acceptances")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for legal_acceptances")
+	}
+
+	if singular {
+		object.R.LegalAcceptances = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &legalAcceptanceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.LegalAcceptances = append(local.R.LegalAcceptances, foreign)
+				if foreign.R == nil {
+					foreign.R = &legalAcceptanceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMfaChallenges allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMfaChallenges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`mfa_challenges`),
+		qm.WhereIn(`mfa_challenges.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mfa_challenges")
+	}
+
+	var resultSlice []*MfaChallenge
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mfa_challenges")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mfa_challenges")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mfa_challenges")
+	}
+
+	if singular {
+		object.R.MfaChallenges = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &mfaChallengeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.MfaChallenges = append(local.R.MfaChallenges, foreign)
+				if foreign.R == nil {
+					foreign.R = &mfaChallengeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOauthAuthorizationCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOauthAuthorizationCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oauth_authorization_codes`),
+		qm.WhereIn(`oauth_authorization_codes.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oauth_authorization_codes")
+	}
+
+	var resultSlice []*OauthAuthorizationCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oauth_authorization_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oauth_authorization_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oauth_authorization_codes")
+	}
+
+	if singular {
+		object.R.OauthAuthorizationCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oauthAuthorizationCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.OauthAuthorizationCodes = append(local.R.OauthAuthorizationCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &oauthAuthorizationCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOauthClients allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOauthClients(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oauth_clients`),
+		qm.WhereIn(`oauth_clients.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oauth_clients")
+	}
+
+	var resultSlice []*OauthClient
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oauth_clients")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oauth_clients")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oauth_clients")
+	}
+
+	if singular {
+		object.R.OauthClients = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oauthClientR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.OauthClients = append(local.R.OauthClients, foreign)
+				if foreign.R == nil {
+					foreign.R = &oauthClientR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPasswordResetTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`password_reset_tokens`),
+		qm.WhereIn(`password_reset_tokens.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load password_reset_tokens")
+	}
+
+	var resultSlice []*PasswordResetToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice password_reset_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on password_reset_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_reset_tokens")
+	}
+
+	if singular {
+		object.R.PasswordResetTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &passwordResetTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PasswordResetTokens = append(local.R.PasswordResetTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &passwordResetTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPushMessages allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPushMessages(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`push_messages`),
+		qm.WhereIn(`push_messages.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load push_messages")
+	}
+
+	var resultSlice []*PushMessage
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice push_messages")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on push_messages")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_messages")
+	}
+
+	if singular {
+		object.R.PushMessages = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pushMessageR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.PushMessages = append(local.R.PushMessages, foreign)
+				if foreign.R == nil {
+					foreign.R = &pushMessageR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPushTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPushTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`push_tokens`),
+		qm.WhereIn(`push_tokens.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load push_tokens")
+	}
+
+	var resultSlice []*PushToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice push_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on push_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for push_tokens")
+	}
+
+	if singular {
+		object.R.PushTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pushTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PushTokens = append(local.R.PushTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &pushTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRecoveryCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`recovery_codes`),
+		qm.WhereIn(`recovery_codes.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load recovery_codes")
+	}
+
+	var resultSlice []*RecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice recovery_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on recovery_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for recovery_codes")
+	}
+
+	if singular {
+		object.R.RecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &recoveryCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RecoveryCodes = append(local.R.RecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &recoveryCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`refresh_tokens`),
+		qm.WhereIn(`refresh_tokens.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load refresh_tokens")
+	}
+
+	var resultSlice []*RefreshToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+	}
+
+	if singular {
+		object.R.RefreshTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &refreshTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &refreshTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`sessions`),
+		qm.WhereIn(`sessions.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sessions")
+	}
+
+	var resultSlice []*Session
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sessions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sessions")
+	}
+
+	if singular {
+		object.R.Sessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &sessionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Sessions = append(local.R.Sessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &sessionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUploads allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUploads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`uploads`),
+		qm.WhereIn(`uploads.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load uploads")
+	}
+
+	var resultSlice []*Upload
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice uploads")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on uploads")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for uploads")
+	}
+
+	if singular {
+		object.R.Uploads = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &uploadR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Uploads = append(local.R.Uploads, foreign)
+				if foreign.R == nil {
+					foreign.R = &uploadR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_roles`),
+		qm.WhereIn(`user_roles.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_roles")
+	}
+
+	var resultSlice []*UserRole
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_roles")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_roles")
+	}
+
+	if singular {
+		object.R.UserRoles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userRoleR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserRoles = append(local.R.UserRoles, foreign)
+				if foreign.R == nil {
+					foreign.R = &userRoleR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAppUserProfile of the user to the related item.
+// Sets o.R.AppUserProfile to related.
+// Adds o to related.R.User.
+func (o *User) SetAppUserProfile(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AppUserProfile) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"app_user_profiles\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, appUserProfilePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AppUserProfile: related,
+		}
+	} else {
+		o.R.AppUserProfile = related
+	}
+
+	if related.R == nil {
+		related.R = &appUserProfileR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// SetTotpCredential of the user to the related item.
+// Sets o.R.TotpCredential to related.
+// Adds o to related.R.User.
+func (o *User) SetTotpCredential(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TotpCredential) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"totp_credentials\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, totpCredentialPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			TotpCredential: related,
+		}
+	} else {
+		o.R.TotpCredential = related
+	}
+
+	if related.R == nil {
+		related.R = &totpCredentialR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddAccessTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AccessTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccessToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"access_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accessTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AccessTokens: related,
+		}
+	} else {
+		o.R.AccessTokens = append(o.R.AccessTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accessTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddAPIKeys adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.APIKeys.
+// Sets related.R.User appropriately.
+func (o *User) AddAPIKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*APIKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"api_keys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, apiKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			APIKeys: related,
+		}
+	} else {
+		o.R.APIKeys = append(o.R.APIKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &apiKeyR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddEmailChangeRevertTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.EmailChangeRevertTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddEmailChangeRevertTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EmailChangeRevertToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"email_change_revert_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, emailChangeRevertTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			EmailChangeRevertTokens: related,
+		}
+	} else {
+		o.R.EmailChangeRevertTokens = append(o.R.EmailChangeRevertTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &emailChangeRevertTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddEmailChangeTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.EmailChangeTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddEmailChangeTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EmailChangeToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"email_change_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, emailChangeTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			EmailChangeTokens: related,
+		}
+	} else {
+		o.R.EmailChangeTokens = append(o.R.EmailChangeTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &emailChangeTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddEmailVerificationTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.EmailVerificationTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddEmailVerificationTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EmailVerificationToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"email_verification_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, emailVerificationTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			EmailVerificationTokens: related,
+		}
+	} else {
+		o.R.EmailVerificationTokens = append(o.R.EmailVerificationTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &emailVerificationTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddIdentities adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Identities.
+// Sets related.R.User appropriately.
+func (o *User) AddIdentities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Identity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"identities\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, identityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Identities: related,
+		}
+	} else {
+		o.R.Identities = append(o.R.Identities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &identityR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddLegalAcceptances adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.LegalAcceptances.
+// Sets related.R.User appropriately.
+func (o *User) AddLegalAcceptances(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LegalAcceptance) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"legal_acceptances\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, legalAcceptancePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			LegalAcceptances: related,
+		}
+	} else {
+		o.R.LegalAcceptances = append(o.R.LegalAcceptances, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &legalAcceptanceR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddMfaChallenges adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.MfaChallenges.
+// Sets related.R.User appropriately.
+func (o *User) AddMfaChallenges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MfaChallenge) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"mfa_challenges\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, mfaChallengePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			MfaChallenges: related,
+		}
+	} else {
+		o.R.MfaChallenges = append(o.R.MfaChallenges, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mfaChallengeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddOauthAuthorizationCodes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OauthAuthorizationCodes.
+// Sets related.R.User appropriately.
+func (o *User) AddOauthAuthorizationCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OauthAuthorizationCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oauth_authorization_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, oauthAuthorizationCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Code}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OauthAuthorizationCodes: related,
+		}
+	} else {
+		o.R.OauthAuthorizationCodes = append(o.R.OauthAuthorizationCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oauthAuthorizationCodeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddOauthClients adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OauthClients.
+// Sets related.R.User appropriately.
+func (o *User) AddOauthClients(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OauthClient) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oauth_clients\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, oauthClientPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OauthClients: related,
+		}
+	} else {
+		o.R.OauthClients = append(o.R.OauthClients, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oauthClientR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetOauthClients removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's OauthClients accordingly.
+// Replaces o.R.OauthClients with related.
+// Sets related.R.User's OauthClients accordingly.
+func (o *User) SetOauthClients(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OauthClient) error {
+	query := "update \"oauth_clients\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.OauthClients {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.OauthClients = nil
+	}
+
+	return o.AddOauthClients(ctx, exec, insert, related...)
+}
+
+// RemoveOauthClients relationships from objects passed in.
+// Removes related items from R.OauthClients (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveOauthClients(ctx context.Context, exec boil.ContextExecutor, related ...*OauthClient) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.OauthClients {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.OauthClients)
+			if ln > 1 && i < ln-1 {
+				o.R.OauthClients[i] = o.R.OauthClients[ln-1]
+			}
+			o.R.OauthClients = o.R.OauthClients[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddPasswordResetTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PasswordResetTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"password_reset_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, passwordResetTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Token}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PasswordResetTokens: related,
+		}
+	} else {
+		o.R.PasswordResetTokens = append(o.R.PasswordResetTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &passwordResetTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPushMessages adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PushMessages.
+// Sets related.R.User appropriately.
+func (o *User) AddPushMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushMessage) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"push_messages\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pushMessagePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-			for _, a := range args {
-				if a == obj.ID {
-					continue Outer
-				}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			args = append(args, obj.ID)
+			queries.Assign(&rel.UserID, o.ID)
 		}
 	}
 
-	if len(args) == 0 {
-		return nil
+	if o.R == nil {
+		o.R = &userR{
+			PushMessages: related,
+		}
+	} else {
+		o.R.PushMessages = append(o.R.PushMessages, related...)
 	}
 
-	query := NewQuery(
-		qm.From(`refresh_tokens`),
-		qm.WhereIn(`refresh_tokens.user_id in ?`, args...),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pushMessageR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
 	}
+	return nil
+}
 
-	results, err := query.QueryContext(ctx, e)
+// SetPushMessages removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's PushMessages accordingly.
+// Replaces o.R.PushMessages with related.
+// Sets related.R.User's PushMessages accordingly.
+func (o *User) SetPushMessages(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushMessage) error {
+	query := "update \"push_messages\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load refresh_tokens")
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	var resultSlice []*RefreshToken
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
-	}
+	if o.R != nil {
+		for _, rel := range o.R.PushMessages {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
+			rel.R.User = nil
+		}
+		o.R.PushMessages = nil
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+
+	return o.AddPushMessages(ctx, exec, insert, related...)
+}
+
+// RemovePushMessages relationships from objects passed in.
+// Removes related items from R.PushMessages (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemovePushMessages(ctx context.Context, exec boil.ContextExecutor, related ...*PushMessage) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if singular {
-		object.R.RefreshTokens = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &refreshTokenR{}
-			}
-			foreign.R.User = object
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
 		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
-				if foreign.R == nil {
-					foreign.R = &refreshTokenR{}
-				}
-				foreign.R.User = local
-				break
+	for _, rel := range related {
+		for i, ri := range o.R.PushMessages {
+			if rel != ri {
+				continue
 			}
+
+			ln := len(o.R.PushMessages)
+			if ln > 1 && i < ln-1 {
+				o.R.PushMessages[i] = o.R.PushMessages[ln-1]
+			}
+			o.R.PushMessages = o.R.PushMessages[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// SetAppUserProfile of the user to the related item.
-// Sets o.R.AppUserProfile to related.
-// Adds o to related.R.User.
-func (o *User) SetAppUserProfile(ctx context.Context, exec boil.ContextExecutor, insert bool, related *AppUserProfile) error {
+// AddPushTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PushTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushToken) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"push_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pushTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if insert {
-		related.UserID = o.ID
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+			rel.UserID = o.ID
 		}
-	} else {
-		updateQuery := fmt.Sprintf(
-			"UPDATE \"app_user_profiles\" SET %s WHERE %s",
-			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-			strmangle.WhereClause("\"", "\"", 2, appUserProfilePrimaryKeyColumns),
-		)
-		values := []interface{}{o.ID, related.UserID}
+	}
 
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, updateQuery)
-			fmt.Fprintln(writer, values)
+	if o.R == nil {
+		o.R = &userR{
+			PushTokens: related,
 		}
-		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-			return errors.Wrap(err, "failed to update foreign table")
+	} else {
+		o.R.PushTokens = append(o.R.PushTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pushTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
 		}
+	}
+	return nil
+}
 
-		related.UserID = o.ID
+// AddRecoveryCodes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RecoveryCodes.
+// Sets related.R.User appropriately.
+func (o *User) AddRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RecoveryCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"recovery_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, recoveryCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			AppUserProfile: related,
+			RecoveryCodes: related,
 		}
 	} else {
-		o.R.AppUserProfile = related
+		o.R.RecoveryCodes = append(o.R.RecoveryCodes, related...)
 	}
 
-	if related.R == nil {
-		related.R = &appUserProfileR{
-			User: o,
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &recoveryCodeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
 		}
-	} else {
-		related.R.User = o
 	}
 	return nil
 }
 
-// AddAccessTokens adds the given related objects to the existing relationships
+// AddRefreshTokens adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.AccessTokens.
+// Appends related to o.R.RefreshTokens.
 // Sets related.R.User appropriately.
-func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccessToken) error {
+func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1002,9 +3866,9 @@ func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, i
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"access_tokens\" SET %s WHERE %s",
+				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, accessTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.Token}
 
@@ -1023,15 +3887,15 @@ func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, i
 
 	if o.R == nil {
 		o.R = &userR{
-			AccessTokens: related,
+			RefreshTokens: related,
 		}
 	} else {
-		o.R.AccessTokens = append(o.R.AccessTokens, related...)
+		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &accessTokenR{
+			rel.R = &refreshTokenR{
 				User: o,
 			}
 		} else {
@@ -1041,11 +3905,11 @@ func (o *User) AddAccessTokens(ctx context.Context, exec boil.ContextExecutor, i
 	return nil
 }
 
-// AddPasswordResetTokens adds the given related objects to the existing relationships
+// AddSessions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.PasswordResetTokens.
+// Appends related to o.R.Sessions.
 // Sets related.R.User appropriately.
-func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetToken) error {
+func (o *User) AddSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Session) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1055,11 +3919,11 @@ func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExec
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"password_reset_tokens\" SET %s WHERE %s",
+				"UPDATE \"sessions\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, passwordResetTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, sessionPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.Token}
+			values := []interface{}{o.ID, rel.ID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1076,15 +3940,15 @@ func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExec
 
 	if o.R == nil {
 		o.R = &userR{
-			PasswordResetTokens: related,
+			Sessions: related,
 		}
 	} else {
-		o.R.PasswordResetTokens = append(o.R.PasswordResetTokens, related...)
+		o.R.Sessions = append(o.R.Sessions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &passwordResetTokenR{
+			rel.R = &sessionR{
 				User: o,
 			}
 		} else {
@@ -1094,11 +3958,11 @@ func (o *User) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// AddPushTokens adds the given related objects to the existing relationships
+// AddUploads adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.PushTokens.
+// Appends related to o.R.Uploads.
 // Sets related.R.User appropriately.
-func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PushToken) error {
+func (o *User) AddUploads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Upload) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1108,9 +3972,9 @@ func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, ins
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"push_tokens\" SET %s WHERE %s",
+				"UPDATE \"uploads\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, pushTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, uploadPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1129,15 +3993,15 @@ func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, ins
 
 	if o.R == nil {
 		o.R = &userR{
-			PushTokens: related,
+			Uploads: related,
 		}
 	} else {
-		o.R.PushTokens = append(o.R.PushTokens, related...)
+		o.R.Uploads = append(o.R.Uploads, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &pushTokenR{
+			rel.R = &uploadR{
 				User: o,
 			}
 		} else {
@@ -1147,11 +4011,11 @@ func (o *User) AddPushTokens(ctx context.Context, exec boil.ContextExecutor, ins
 	return nil
 }
 
-// AddRefreshTokens adds the given related objects to the existing relationships
+// AddUserRoles adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.RefreshTokens.
+// Appends related to o.R.UserRoles.
 // Sets related.R.User appropriately.
-func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
+func (o *User) AddUserRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserRole) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1161,11 +4025,11 @@ func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor,
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
+				"UPDATE \"user_roles\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, userRolePrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.Token}
+			values := []interface{}{o.ID, rel.UserID, rel.RoleID}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -1182,15 +4046,15 @@ func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor,
 
 	if o.R == nil {
 		o.R = &userR{
-			RefreshTokens: related,
+			UserRoles: related,
 		}
 	} else {
-		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
+		o.R.UserRoles = append(o.R.UserRoles, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &refreshTokenR{
+			rel.R = &userRoleR{
 				User: o,
 			}
 		} else {
@@ -1270,6 +4134,7 @@ func (o *User) Insert(ctx context.Context, exec boil.ContextExecutor, columns bo
 			userColumnsWithoutDefault,
 			nzDefaults,
 		)
+		wl = strmangle.SetComplement(wl, userGeneratedColumns)
 
 		cache.valueMapping, err = queries.BindMapping(userType, userMapping, wl)
 		if err != nil {
@@ -1343,6 +4208,7 @@ func (o *User) Update(ctx context.Context, exec boil.ContextExecutor, columns bo
 			userAllColumns,
 			userPrimaryKeyColumns,
 		)
+		wl = strmangle.SetComplement(wl, userGeneratedColumns)
 
 		if !columns.IsWhitelist() {
 			wl = strmangle.SetComplement(wl, []string{"created_at"})
@@ -1517,6 +4383,9 @@ func (o *User) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnCo
 			userPrimaryKeyColumns,
 		)
 
+		insert = strmangle.SetComplement(insert, userGeneratedColumns)
+		update = strmangle.SetComplement(update, userGeneratedColumns)
+
 		if updateOnConflict && len(update) == 0 {
 			return errors.New("models: unable to upsert users, could not build update column list")
 		}
@@ -0,0 +1,124 @@
+package oidc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// leeway tolerated for clock skew between us and the provider when validating timestamps
+	leeway = time.Minute
+)
+
+var (
+	ErrTokenExpired        = jwt.ErrTokenExpired
+	ErrTokenIssuedInFuture = jwt.ErrTokenUsedBeforeIssued
+	ErrMissingSubject      = errors.New("ID token is missing subject")
+)
+
+// Audience represents the `aud` claim, which may either be a single string or an array of strings.
+type Audience []string
+
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return fmt.Errorf("invalid audience: %w", err)
+	}
+
+	*a = multiple
+
+	return nil
+}
+
+func (a Audience) Contains(aud string) bool {
+	for _, v := range a {
+		if v == aud {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Bool represents boolean claims, which some providers (e.g. Apple) encode as strings.
+type Bool bool
+
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		v, err := strconv.ParseBool(str)
+		if err != nil {
+			return fmt.Errorf("invalid boolean: %w", err)
+		}
+
+		*b = Bool(v)
+		return nil
+	}
+
+	var v bool
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("invalid boolean: %w", err)
+	}
+
+	*b = Bool(v)
+
+	return nil
+}
+
+// Claims represents the claims of an OpenID Connect ID token relevant to us.
+type Claims struct {
+	Issuer        string           `json:"iss"`
+	Subject       string           `json:"sub"`
+	Audience      Audience         `json:"aud"`
+	ExpiresAt     *jwt.NumericDate `json:"exp"`
+	IssuedAt      *jwt.NumericDate `json:"iat"`
+	Nonce         string           `json:"nonce,omitempty"`
+	Email         string           `json:"email,omitempty"`
+	EmailVerified Bool             `json:"email_verified,omitempty"`
+}
+
+// GetExpirationTime and the following getters satisfy jwt.Claims.
+func (c Claims) GetExpirationTime() (*jwt.NumericDate, error) {
+	return c.ExpiresAt, nil
+}
+
+func (c Claims) GetIssuedAt() (*jwt.NumericDate, error) {
+	return c.IssuedAt, nil
+}
+
+func (c Claims) GetNotBefore() (*jwt.NumericDate, error) {
+	return nil, nil
+}
+
+func (c Claims) GetIssuer() (string, error) {
+	return c.Issuer, nil
+}
+
+func (c Claims) GetSubject() (string, error) {
+	return c.Subject, nil
+}
+
+func (c Claims) GetAudience() (jwt.ClaimStrings, error) {
+	return jwt.ClaimStrings(c.Audience), nil
+}
+
+// Validate validates the presence of a subject, satisfying jwt.ClaimsValidator. Time based claims are validated
+// by the parser, issuer and audience are validated separately as they depend on the provider.
+func (c Claims) Validate() error {
+	if len(c.Subject) == 0 {
+		return ErrMissingSubject
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrUnknownIssuer    = errors.New("unknown OIDC issuer")
+	ErrAudienceMismatch = errors.New("ID token audience does not match any client ID")
+	ErrNonceMismatch    = errors.New("ID token nonce does not match")
+	ErrMissingKeyID     = errors.New("ID token is missing key ID")
+)
+
+// Service verifies ID tokens issued by the configured OpenID Connect providers.
+type Service struct {
+	providers []*Provider
+	parser    *jwt.Parser
+}
+
+func New(cfg config.AuthServerOIDC, client *http.Client) *Service {
+	s := &Service{
+		providers: make([]*Provider, 0),
+		parser: jwt.NewParser(
+			// only asymmetric signing methods, as ID tokens are verified using the provider's public keys
+			jwt.WithValidMethods([]string{
+				jwt.SigningMethodRS256.Alg(), jwt.SigningMethodRS384.Alg(), jwt.SigningMethodRS512.Alg(),
+				jwt.SigningMethodES256.Alg(), jwt.SigningMethodES384.Alg(), jwt.SigningMethodES512.Alg(),
+			}),
+			jwt.WithExpirationRequired(),
+			jwt.WithIssuedAt(),
+			jwt.WithLeeway(leeway),
+		),
+	}
+
+	for _, p := range cfg.Providers() {
+		s.providers = append(s.providers, newProvider(p, client, cfg.JWKSCacheTTL))
+	}
+
+	return s
+}
+
+func (s *Service) GetProviderCount() int {
+	return len(s.providers)
+}
+
+// Verify validates the given ID token's signature and claims, returning its claims along with the provider
+// that issued it. If nonce is not empty, it must match the token's nonce claim.
+func (s *Service) Verify(ctx context.Context, rawIDToken string, nonce string) (*Claims, *Provider, error) {
+	var unverified Claims
+	if _, _, err := s.parser.ParseUnverified(rawIDToken, &unverified); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse ID token: %w", err)
+	}
+
+	provider := s.providerByIssuer(unverified.Issuer)
+	if provider == nil {
+		return nil, nil, ErrUnknownIssuer
+	}
+
+	var claims Claims
+	if _, err := s.parser.ParseWithClaims(rawIDToken, &claims, func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok || len(kid) == 0 {
+			return nil, ErrMissingKeyID
+		}
+
+		return provider.Key(ctx, kid)
+	}); err != nil {
+		return nil, nil, fmt.Errorf("failed to verify ID token: %w", err)
+	}
+
+	if !provider.AcceptsIssuer(claims.Issuer) {
+		return nil, nil, ErrUnknownIssuer
+	}
+
+	if !provider.AcceptsAudience(claims.Audience) {
+		return nil, nil, ErrAudienceMismatch
+	}
+
+	if len(nonce) > 0 && claims.Nonce != nonce {
+		return nil, nil, ErrNonceMismatch
+	}
+
+	return &claims, provider, nil
+}
+
+func (s *Service) providerByIssuer(iss string) *Provider {
+	for _, p := range s.providers {
+		if p.AcceptsIssuer(iss) {
+			return p
+		}
+	}
+
+	return nil
+}
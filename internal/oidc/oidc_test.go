@@ -0,0 +1,138 @@
+package oidc_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/oidc"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestService(issuer *test.FakeOIDCIssuer) *oidc.Service {
+	return oidc.New(config.AuthServerOIDC{
+		Generic:      issuer.Config(),
+		JWKSCacheTTL: time.Hour,
+	}, http.DefaultClient)
+}
+
+func TestVerify(t *testing.T) {
+	issuer := test.NewFakeOIDCIssuer(t)
+	s := newTestService(issuer)
+	require.Equal(t, 1, s.GetProviderCount())
+
+	claims, provider, err := s.Verify(context.Background(), issuer.IDToken(t, "subject-1", "user@example.com", jwt.MapClaims{"nonce": "n-0S6_WzA2Mj"}), "n-0S6_WzA2Mj")
+	require.NoError(t, err)
+
+	assert.Equal(t, "test", provider.Name())
+	assert.Equal(t, issuer.Issuer(), provider.Issuer())
+	assert.Equal(t, issuer.Issuer(), claims.Issuer)
+	assert.Equal(t, "subject-1", claims.Subject)
+	assert.Equal(t, "user@example.com", claims.Email)
+	assert.True(t, bool(claims.EmailVerified))
+	assert.Equal(t, oidc.Audience{test.TestOIDCClientID}, claims.Audience)
+}
+
+func TestVerifyAudienceArrayAndStringBool(t *testing.T) {
+	issuer := test.NewFakeOIDCIssuer(t)
+	s := newTestService(issuer)
+
+	claims, _, err := s.Verify(context.Background(), issuer.IDToken(t, "subject-1", "user@example.com", jwt.MapClaims{
+		"aud":            []string{"other-client", test.TestOIDCClientID},
+		"email_verified": "false",
+	}), "")
+	require.NoError(t, err)
+
+	assert.False(t, bool(claims.EmailVerified))
+}
+
+func TestVerifyInvalid(t *testing.T) {
+	issuer := test.NewFakeOIDCIssuer(t)
+	otherIssuer := test.NewFakeOIDCIssuer(t)
+	s := newTestService(issuer)
+
+	forgedToken := otherIssuer.IDToken(t, "subject-1", "user@example.com", jwt.MapClaims{"iss": issuer.Issuer()})
+
+	hs256Token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": issuer.Issuer(),
+		"sub": "subject-1",
+		"aud": test.TestOIDCClientID,
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte("not-so-secret"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+		nonce string
+		err   error
+	}{
+		{"UnknownIssuer", otherIssuer.IDToken(t, "subject-1", "user@example.com", nil), "", oidc.ErrUnknownIssuer},
+		{"WrongAudience", issuer.IDToken(t, "subject-1", "user@example.com", jwt.MapClaims{"aud": "other-client"}), "", oidc.ErrAudienceMismatch},
+		{"NonceMismatch", issuer.IDToken(t, "subject-1", "user@example.com", jwt.MapClaims{"nonce": "other"}), "n-0S6_WzA2Mj", oidc.ErrNonceMismatch},
+		{"Expired", issuer.IDToken(t, "subject-1", "user@example.com", jwt.MapClaims{"exp": time.Now().Add(-time.Hour).Unix()}), "", oidc.ErrTokenExpired},
+		{"MissingSubject", issuer.IDToken(t, "", "user@example.com", nil), "", oidc.ErrMissingSubject},
+		{"ForgedSignature", forgedToken, "", nil},
+		{"SymmetricAlgorithm", hs256Token, "", nil},
+		{"Malformed", "not-a-jwt", "", nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			claims, provider, err := s.Verify(context.Background(), tt.token, tt.nonce)
+			require.Error(t, err)
+			assert.Nil(t, claims)
+			assert.Nil(t, provider)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestVerifyIssuerAlias(t *testing.T) {
+	issuer := test.NewFakeOIDCIssuer(t)
+	cfg := issuer.Config()
+	cfg.IssuerAliases = []string{"fake-issuer.example.com"}
+
+	s := oidc.New(config.AuthServerOIDC{
+		Google:       cfg,
+		JWKSCacheTTL: time.Hour,
+	}, http.DefaultClient)
+
+	claims, provider, err := s.Verify(context.Background(), issuer.IDToken(t, "subject-1", "user@example.com", jwt.MapClaims{"iss": "fake-issuer.example.com"}), "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "fake-issuer.example.com", claims.Issuer)
+	assert.Equal(t, issuer.Issuer(), provider.Issuer())
+}
+
+func TestVerifyKeyRotation(t *testing.T) {
+	issuer := test.NewFakeOIDCIssuer(t)
+	s := newTestService(issuer)
+
+	_, _, err := s.Verify(context.Background(), issuer.IDToken(t, "subject-1", "user@example.com", nil), "")
+	require.NoError(t, err)
+
+	// tokens signed with unknown keys are rejected until the keys may be refreshed again
+	issuer.KeyID = "test-key-2"
+	_, _, err = s.Verify(context.Background(), issuer.IDToken(t, "subject-1", "user@example.com", nil), "")
+	assert.ErrorIs(t, err, oidc.ErrUnknownKeyID)
+}
+
+func TestNoProviders(t *testing.T) {
+	issuer := test.NewFakeOIDCIssuer(t)
+	s := oidc.New(config.AuthServerOIDC{}, http.DefaultClient)
+	assert.Equal(t, 0, s.GetProviderCount())
+
+	_, _, err := s.Verify(context.Background(), issuer.IDToken(t, "subject-1", "user@example.com", nil), "")
+	assert.ErrorIs(t, err, oidc.ErrUnknownIssuer)
+}
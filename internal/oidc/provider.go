@@ -0,0 +1,252 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	// minKeyRefreshInterval prevents tokens with unknown key IDs from causing the keys to be fetched over and over again
+	minKeyRefreshInterval = time.Second * 10
+)
+
+var (
+	ErrUnknownKeyID     = errors.New("unknown OIDC key ID")
+	ErrIssuerMismatch   = errors.New("OIDC discovery document issuer does not match configured issuer")
+	ErrUnsupportedCurve = errors.New("unsupported elliptic curve")
+)
+
+type discoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// Provider verifies ID tokens of a single OpenID Connect provider. Its signing keys are discovered
+// via the provider's discovery document and cached, being refreshed once expired or if a token
+// references an unknown key ID, e.g. after the provider rotated its keys.
+type Provider struct {
+	sync.Mutex
+	config    config.AuthServerOIDCProvider
+	client    *http.Client
+	cacheTTL  time.Duration
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+func newProvider(cfg config.AuthServerOIDCProvider, client *http.Client, cacheTTL time.Duration) *Provider {
+	return &Provider{
+		Mutex:     sync.Mutex{},
+		config:    cfg,
+		client:    client,
+		cacheTTL:  cacheTTL,
+		keys:      nil,
+		fetchedAt: time.Time{},
+	}
+}
+
+func (p *Provider) Name() string {
+	return p.config.Name
+}
+
+func (p *Provider) Issuer() string {
+	return p.config.Issuer
+}
+
+// AcceptsIssuer reports whether the given `iss` claim belongs to this provider.
+func (p *Provider) AcceptsIssuer(iss string) bool {
+	if iss == p.config.Issuer {
+		return true
+	}
+
+	for _, alias := range p.config.IssuerAliases {
+		if iss == alias {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AcceptsAudience reports whether any of the given `aud` claim's values is one of our client IDs.
+func (p *Provider) AcceptsAudience(aud Audience) bool {
+	for _, clientID := range p.config.ClientIDs {
+		if aud.Contains(clientID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Key returns the provider's public key with the given ID.
+func (p *Provider) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	key, ok := p.keys[kid]
+	expired := time.Since(p.fetchedAt) > p.cacheTTL
+
+	if ok && !expired {
+		return key, nil
+	}
+
+	if !expired && time.Since(p.fetchedAt) < minKeyRefreshInterval {
+		return nil, ErrUnknownKeyID
+	}
+
+	keys, err := p.fetchKeys(ctx)
+	if err != nil {
+		// keep using cached keys if the provider is temporarily unavailable
+		if ok {
+			return key, nil
+		}
+
+		return nil, err
+	}
+
+	p.keys = keys
+	p.fetchedAt = time.Now()
+
+	key, ok = p.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+
+	return key, nil
+}
+
+func (p *Provider) fetchKeys(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	var discovery discoveryDocument
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.config.Issuer, "/")+discoveryPath, &discovery); err != nil {
+		return nil, fmt.Errorf("failed to fetch discovery document: %w", err)
+	}
+
+	if discovery.Issuer != p.config.Issuer {
+		return nil, ErrIssuerMismatch
+	}
+
+	var jwks jsonWebKeySet
+	if err := p.getJSON(ctx, discovery.JWKSURI, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if len(jwk.Use) > 0 && jwk.Use != "sig" {
+			continue
+		}
+
+		key, err := jwk.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse key %q: %w", jwk.Kid, err)
+		}
+
+		if key != nil {
+			keys[jwk.Kid] = key
+		}
+	}
+
+	return keys, nil
+}
+
+func (p *Provider) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+// publicKey returns the RSA or ECDSA public key represented by the JWK, or nil if its key type is not supported.
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, ErrUnsupportedCurve
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,357 @@
+package push
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"golang.org/x/text/language"
+)
+
+type SegmentType string
+
+const (
+	// SegmentTypeAll targets all users granted the SegmentScope
+	SegmentTypeAll SegmentType = SegmentType(models.PushCampaignSegmentAll)
+	// SegmentTypeLanguage targets all users granted the SegmentScope whose locale matches the segment's language
+	SegmentTypeLanguage SegmentType = SegmentType(models.PushCampaignSegmentLanguage)
+	// SegmentTypeSQL targets all users returned by the segment's SQL query
+	SegmentTypeSQL SegmentType = SegmentType(models.PushCampaignSegmentSQL)
+)
+
+// SegmentScope is the scope users of SegmentTypeAll and SegmentTypeLanguage need to be granted (auth.AuthScopeApp).
+const SegmentScope = "app"
+
+var ErrSegmentInvalid = errors.New("invalid push segment")
+
+const (
+	// segmentQueryName and segmentQueryColumn name the common table expression the query of SQL segments is embedded as
+	segmentQueryName   = "segment"
+	segmentQueryColumn = "user_id"
+)
+
+// Segment defines the users a campaign is sent to. Inactive and deleted users are never targeted.
+type Segment struct {
+	Type SegmentType
+	// Language of SegmentTypeLanguage, matching all regions of the language (e.g. "de" matches "de-AT")
+	Language string
+	// Query of SegmentTypeSQL selecting the IDs of the targeted users, e.g. "SELECT user_id FROM app_user_profiles".
+	// The query must be a single statement and is executed in a read only transaction limited by the SegmentQueryTimeout.
+	// As it is executed as is, SQL segments must only be accepted from trusted operators.
+	SQL string
+}
+
+// Validate ensures the segment's type is known and its language or query is set.
+func (s Segment) Validate() error {
+	switch s.Type {
+	case SegmentTypeAll:
+		return nil
+	case SegmentTypeLanguage:
+		if len(s.Language) == 0 {
+			return fmt.Errorf("%w: language is empty", ErrSegmentInvalid)
+		}
+		if _, err := language.Parse(s.Language); err != nil {
+			return fmt.Errorf("%w: failed to parse language: %v", ErrSegmentInvalid, err)
+		}
+		return nil
+	case SegmentTypeSQL:
+		if len(strings.TrimSpace(s.SQL)) == 0 {
+			return fmt.Errorf("%w: SQL query is empty", ErrSegmentInvalid)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrSegmentInvalid, s.Type)
+	}
+}
+
+func (s Segment) value() null.String {
+	switch s.Type {
+	case SegmentTypeLanguage:
+		return null.StringFrom(s.Language)
+	case SegmentTypeSQL:
+		return null.StringFrom(s.SQL)
+	default:
+		return null.String{}
+	}
+}
+
+// CampaignStats counts the outcome of the deliveries of a campaign.
+type CampaignStats struct {
+	Delivered    int
+	InvalidToken int
+	Failed       int
+}
+
+func (c *CampaignStats) count(status string) {
+	switch status {
+	case models.PushDeliveryStatusDelivered:
+		c.Delivered++
+	case models.PushDeliveryStatusInvalidToken:
+		c.InvalidToken++
+	case models.PushDeliveryStatusDeadLetter:
+		c.Failed++
+	}
+}
+
+// CampaignPending returns the number of deliveries of the campaign which have not been completed yet.
+func CampaignPending(campaign *models.PushCampaign) int {
+	// deliveries of a page may complete before the page is counted as targeted
+	pending := campaign.TokensTargeted - campaign.DeliveredCount - campaign.InvalidTokenCount - campaign.FailedCount
+	if pending < 0 {
+		return 0
+	}
+
+	return pending
+}
+
+// segmentToken is a push token of a segment along with the locale of its user.
+type segmentToken struct {
+	models.PushToken `boil:",bind"`
+	Locale           null.String `boil:"locale"`
+}
+
+// SendToSegment enqueues the message for delivery to all devices of the users in the segment with a push token of a
+// registered provider. Push tokens are streamed in pages of BroadcastPageSize, each page is enqueued as a campaign
+// message per language of the users with the i18n keys of the message's notification resolved. The outcome of the
+// deliveries is counted on the returned campaign as they are processed by the workers (see RunWorkers).
+// If enqueuing fails midway, the campaign is returned along with the error, its EnqueuedAt is left unset.
+func (s *Service) SendToSegment(ctx context.Context, segment Segment, msg Message) (*models.PushCampaign, error) {
+	if s.GetProviderCount() < 1 {
+		return nil, errors.New("No provider found")
+	}
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := segment.Validate(); err != nil {
+		return nil, err
+	}
+
+	providerTypes := make([]string, 0, len(s.provider))
+	for k := range s.provider {
+		providerTypes = append(providerTypes, string(k))
+	}
+
+	// the first page is loaded before creating the campaign, so invalid SQL segments are rejected without one
+	page, err := s.segmentPage(ctx, segment, providerTypes, nil)
+	if err != nil {
+		var pqErr *pq.Error
+		if segment.Type == SegmentTypeSQL && errors.As(err, &pqErr) && isSegmentQueryError(pqErr) {
+			return nil, fmt.Errorf("%w: %v", ErrSegmentInvalid, err)
+		}
+
+		return nil, err
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode push message: %w", err)
+	}
+
+	campaign := &models.PushCampaign{
+		Segment:      string(segment.Type),
+		SegmentValue: segment.value(),
+		Payload:      payload,
+	}
+	if err := campaign.Insert(ctx, s.DB, boil.Infer()); err != nil {
+		return nil, fmt.Errorf("failed to insert push campaign: %w", err)
+	}
+
+	log := util.LogFromContext(ctx).With().Str("push_campaign_id", campaign.ID).Logger()
+
+	var lastUserID string
+	for len(page) > 0 {
+		// tokens of users without a locale or of messages without i18n keys are grouped as language.Und
+		tokensByLanguage := make(map[language.Tag]models.PushTokenSlice)
+		for _, token := range page {
+			if token.UserID != lastUserID {
+				campaign.UsersTargeted++
+				lastUserID = token.UserID
+			}
+
+			lang := language.Und
+			if msg.Notification != nil && msg.Notification.HasKeys() {
+				lang = parseLocale(ctx, token.UserID, token.Locale)
+			}
+
+			pushToken := token.PushToken
+			tokensByLanguage[lang] = append(tokensByLanguage[lang], &pushToken)
+		}
+
+		for lang, pushTokens := range tokensByLanguage {
+			pushMessage := &models.PushMessage{
+				PushCampaignID: null.StringFrom(campaign.ID),
+			}
+			if err := s.enqueue(ctx, pushMessage, msg.Localized(s.translator, lang), pushTokens); err != nil {
+				return campaign, err
+			}
+
+			campaign.TokensTargeted += len(pushTokens)
+		}
+
+		// the counts are updated per page so the progress of large campaigns can be followed
+		if _, err := campaign.Update(ctx, s.DB, boil.Whitelist(
+			models.PushCampaignColumns.UsersTargeted,
+			models.PushCampaignColumns.TokensTargeted,
+			models.PushCampaignColumns.UpdatedAt,
+		)); err != nil {
+			return campaign, fmt.Errorf("failed to update push campaign: %w", err)
+		}
+
+		log.Debug().Int("tokens", len(page)).Int("tokens_targeted", campaign.TokensTargeted).Msg("Enqueued page of push campaign")
+
+		if len(page) < s.config.BroadcastPageSize {
+			break
+		}
+
+		if page, err = s.segmentPage(ctx, segment, providerTypes, &page[len(page)-1]); err != nil {
+			return campaign, err
+		}
+	}
+
+	campaign.EnqueuedAt = null.TimeFrom(time.Now())
+	if _, err := campaign.Update(ctx, s.DB, boil.Whitelist(models.PushCampaignColumns.EnqueuedAt, models.PushCampaignColumns.UpdatedAt)); err != nil {
+		return campaign, fmt.Errorf("failed to update push campaign: %w", err)
+	}
+
+	log.Info().Int("users_targeted", campaign.UsersTargeted).Int("tokens_targeted", campaign.TokensTargeted).Msg("Enqueued push campaign")
+
+	return campaign, nil
+}
+
+// segmentPage loads the next page of push tokens of the segment following the given token, ordered by user and
+// push token. Pages are loaded within a read only transaction limited by the SegmentQueryTimeout as SQL segments are
+// embedded into the query.
+func (s *Service) segmentPage(ctx context.Context, segment Segment, providerTypes []string, after *segmentToken) ([]segmentToken, error) {
+	q := []qm.QueryMod{
+		qm.Select(
+			models.PushTokenTableColumns.ID,
+			models.PushTokenTableColumns.UserID,
+			models.PushTokenTableColumns.Token,
+			models.PushTokenTableColumns.Provider,
+			models.AppUserProfileTableColumns.Locale,
+		),
+		db.InnerJoin(models.TableNames.PushTokens, models.PushTokenColumns.UserID, models.TableNames.Users, models.UserColumns.ID),
+		db.LeftOuterJoin(models.TableNames.PushTokens, models.PushTokenColumns.UserID, models.TableNames.AppUserProfiles, models.AppUserProfileColumns.UserID),
+		models.UserWhere.IsActive.EQ(true),
+		models.UserWhere.DeletedAt.IsNull(),
+		models.PushTokenWhere.Provider.IN(providerTypes),
+		qm.OrderBy(fmt.Sprintf("%s, %s", models.PushTokenTableColumns.UserID, models.PushTokenTableColumns.ID)),
+		qm.Limit(s.config.BroadcastPageSize),
+	}
+
+	if after != nil {
+		q = append(q, qm.Where(fmt.Sprintf("(%s, %s) > (?, ?)", models.PushTokenTableColumns.UserID, models.PushTokenTableColumns.ID), after.UserID, after.ID))
+	}
+
+	switch segment.Type {
+	case SegmentTypeAll:
+		q = append(q, qm.Where(fmt.Sprintf("? = ANY(%s)", models.UserTableColumns.Scopes), SegmentScope))
+	case SegmentTypeLanguage:
+		lang := strings.ToLower(language.Make(segment.Language).String())
+		q = append(q,
+			qm.Where(fmt.Sprintf("? = ANY(%s)", models.UserTableColumns.Scopes), SegmentScope),
+			qm.Where(fmt.Sprintf("(lower(%[1]s) = ? OR lower(%[1]s) LIKE ?)", models.AppUserProfileTableColumns.Locale), lang, lang+"-%"),
+		)
+	case SegmentTypeSQL:
+		q = append(q, qm.Where(fmt.Sprintf("%s IN (SELECT %s FROM %s)", models.UserTableColumns.ID, segmentQueryColumn, segmentQueryName)))
+	}
+
+	var page []segmentToken
+	if err := db.WithConfiguredTransaction(ctx, s.DB, &sql.TxOptions{ReadOnly: true}, func(tx boil.ContextExecutor) error {
+		if s.config.SegmentQueryTimeout > 0 {
+			if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", s.config.SegmentQueryTimeout.Milliseconds())); err != nil {
+				return fmt.Errorf("failed to set statement timeout: %w", err)
+			}
+		}
+
+		if segment.Type != SegmentTypeSQL {
+			return models.PushTokens(q...).Bind(ctx, tx, &page)
+		}
+
+		// The segment's query is executed as is, the page query is thus built upfront instead of passing the segment's query
+		// through query mods, which would replace question marks with placeholders
+		query, args := queries.BuildQuery(models.PushTokens(q...).Query)
+		if err := validateSegmentQuery(ctx, tx, segment.SQL); err != nil {
+			return err
+		}
+
+		query = fmt.Sprintf("WITH %s (%s) AS (\n%s\n) %s", segmentQueryName, segmentQueryColumn, segment.SQL, query)
+
+		return queries.Raw(query, args...).Bind(ctx, tx, &page)
+	}); err != nil {
+		return nil, fmt.Errorf("failed to load push tokens of segment: %w", err)
+	}
+
+	return page, nil
+}
+
+// validateSegmentQuery prepares the query of a SQL segment on its own, ensuring it is a single, syntactically valid statement
+// before it is embedded into the page query. Queries with unbalanced parentheses (or comments and quotes), which might escape
+// the common table expression they are embedded in, are thus rejected without being executed.
+func validateSegmentQuery(ctx context.Context, exec boil.ContextExecutor, query string) error {
+	preparer, ok := exec.(interface {
+		PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	})
+	if !ok {
+		return errors.New("executor does not support prepared statements")
+	}
+
+	stmt, err := preparer.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	return stmt.Close()
+}
+
+// isSegmentQueryError reports whether the error was caused by the query of a SQL segment, e.g. syntax errors,
+// unknown columns, attempts to modify data or queries exceeding the SegmentQueryTimeout.
+func isSegmentQueryError(err *pq.Error) bool {
+	switch err.Code.Class() {
+	case "42", // syntax error or access rule violation
+		"0A", // feature not supported, e.g. data modifying statements in subqueries
+		"22": // data exception, e.g. invalid input syntax
+		return true
+	}
+
+	switch err.Code {
+	case "25006", // read only sql transaction
+		"57014": // query canceled, e.g. due to the statement timeout
+		return true
+	}
+
+	return false
+}
+
+// recordCampaignStats adds the outcome of deliveries to the counts of the campaign. Failures are only logged, the
+// counts are informational.
+func (s *Service) recordCampaignStats(ctx context.Context, campaignID string, stats CampaignStats) {
+	if stats == (CampaignStats{}) {
+		return
+	}
+
+	if _, err := s.DB.ExecContext(ctx, fmt.Sprintf(`UPDATE %s SET %[2]s = %[2]s + $1, %[3]s = %[3]s + $2, %[4]s = %[4]s + $3, %[5]s = $4 WHERE %[6]s = $5`,
+		models.TableNames.PushCampaigns,
+		models.PushCampaignColumns.DeliveredCount,
+		models.PushCampaignColumns.InvalidTokenCount,
+		models.PushCampaignColumns.FailedCount,
+		models.PushCampaignColumns.UpdatedAt,
+		models.PushCampaignColumns.ID,
+	), stats.Delivered, stats.InvalidToken, stats.Failed, time.Now(), campaignID); err != nil {
+		util.LogFromContext(ctx).Error().Err(err).Str("push_campaign_id", campaignID).Msg("Failed to record push campaign stats")
+	}
+}
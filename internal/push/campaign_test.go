@@ -0,0 +1,218 @@
+package push_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"allaboutapps.dev/aw/go-starter/internal/push/provider"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// insertCampaignTestTokens adds count valid FCM push tokens to the user.
+func insertCampaignTestTokens(t *testing.T, db *sql.DB, user *models.User, count int) {
+	t.Helper()
+
+	for i := 0; i < count; i++ {
+		pushToken := &models.PushToken{
+			UserID:   user.ID,
+			Token:    fmt.Sprintf("campaign-test-token-%s-%d-0123456789abcdefghijklmnopqrstuvwxyz", user.ID, i),
+			Provider: models.ProviderTypeFCM,
+		}
+		require.NoError(t, pushToken.Insert(context.Background(), db, boil.Infer()))
+	}
+}
+
+func TestSendToSegmentAll(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		// small pages ensure tokens of a user spanning multiple pages are handled
+		p := newOutboxTestService(t, db, func(cfg *push.OutboxConfig) {
+			cfg.BroadcastPageSize = 2
+		})
+
+		insertCampaignTestTokens(t, db, fixtures.User2, 4)
+		insertCampaignTestTokens(t, db, fixtures.UserDeactivated, 1)
+
+		campaign, err := p.SendToSegment(ctx, push.Segment{Type: push.SegmentTypeAll}, helloMessage)
+		require.NoError(t, err)
+
+		// User1 has a single FCM token, deactivated users are skipped
+		assert.Equal(t, 2, campaign.UsersTargeted)
+		assert.Equal(t, 5, campaign.TokensTargeted)
+		assert.True(t, campaign.EnqueuedAt.Valid)
+		assert.Equal(t, 5, push.CampaignPending(campaign))
+
+		pushMessages, err := campaign.PushMessages().All(ctx, db)
+		require.NoError(t, err)
+		for _, pushMessage := range pushMessages {
+			assert.False(t, pushMessage.UserID.Valid)
+		}
+
+		processed, err := p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 5, processed)
+
+		require.NoError(t, campaign.Reload(ctx, db))
+		assert.Equal(t, 4, campaign.DeliveredCount)
+		// the fixture token of User1 is too short for the mock provider
+		assert.Equal(t, 1, campaign.InvalidTokenCount)
+		assert.Equal(t, 0, campaign.FailedCount)
+		assert.Equal(t, 0, push.CampaignPending(campaign))
+	})
+}
+
+func TestSendToSegmentLanguage(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		p := newOutboxTestService(t, db, nil)
+		mockProvider := provider.NewMock(push.ProviderTypeFCM)
+		p.RegisterProvider(mockProvider)
+
+		insertCampaignTestTokens(t, db, fixtures.User1, 1)
+		insertCampaignTestTokens(t, db, fixtures.User2, 1)
+
+		fixtures.User1AppUserProfile.Locale = null.StringFrom("de-AT")
+		_, err := fixtures.User1AppUserProfile.Update(ctx, db, boil.Whitelist(models.AppUserProfileColumns.Locale))
+		require.NoError(t, err)
+
+		fixtures.User2AppUserProfile.Locale = null.StringFrom("en")
+		_, err = fixtures.User2AppUserProfile.Update(ctx, db, boil.Whitelist(models.AppUserProfileColumns.Locale))
+		require.NoError(t, err)
+
+		msg := push.Message{Notification: &push.Notification{TitleKey: "Push.Title"}}
+
+		campaign, err := p.SendToSegment(ctx, push.Segment{Type: push.SegmentTypeLanguage, Language: "de"}, msg)
+		require.NoError(t, err)
+		assert.Equal(t, 1, campaign.UsersTargeted)
+		assert.Equal(t, 2, campaign.TokensTargeted)
+
+		_, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+
+		sent := mockProvider.SentMessages()
+		require.Len(t, sent, 2)
+		for _, s := range sent {
+			assert.Equal(t, "de-AT:Push.Title", s.Message.Notification.Title)
+		}
+
+		// messages are localized per language of the users, the invalid fixture token of User1 has been deleted
+		campaign, err = p.SendToSegment(ctx, push.Segment{Type: push.SegmentTypeAll}, msg)
+		require.NoError(t, err)
+		assert.Equal(t, 2, campaign.UsersTargeted)
+
+		pushMessageCount, err := campaign.PushMessages().Count(ctx, db)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), pushMessageCount)
+
+		_, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+
+		titles := make([]string, 0)
+		for _, s := range mockProvider.SentMessages()[2:] {
+			titles = append(titles, s.Message.Notification.Title)
+		}
+		sort.Strings(titles)
+		assert.Equal(t, []string{"de-AT:Push.Title", "en:Push.Title"}, titles)
+	})
+}
+
+func TestSendToSegmentSQL(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		p := newOutboxTestService(t, db, nil)
+
+		insertCampaignTestTokens(t, db, fixtures.User2, 2)
+
+		campaign, err := p.SendToSegment(ctx, push.Segment{
+			Type: push.SegmentTypeSQL,
+			SQL:  fmt.Sprintf("SELECT id FROM users WHERE username = '%s'", fixtures.User2.Username.String),
+		}, helloMessage)
+		require.NoError(t, err)
+		assert.Equal(t, 1, campaign.UsersTargeted)
+		assert.Equal(t, 2, campaign.TokensTargeted)
+		assert.Equal(t, models.PushCampaignSegmentSQL, campaign.Segment)
+
+		// data modifying statements are rejected
+		_, err = p.SendToSegment(ctx, push.Segment{
+			Type: push.SegmentTypeSQL,
+			SQL:  "WITH deleted AS (DELETE FROM users RETURNING id) SELECT id FROM deleted",
+		}, helloMessage)
+		assert.ErrorIs(t, err, push.ErrSegmentInvalid)
+
+		_, err = p.SendToSegment(ctx, push.Segment{Type: push.SegmentTypeSQL, SQL: "SELECT nope FROM"}, helloMessage)
+		assert.ErrorIs(t, err, push.ErrSegmentInvalid)
+
+		// queries must be a single statement, unbalanced parentheses cannot escape the expression the query is embedded as
+		for _, query := range []string{
+			"SELECT id FROM users; SELECT id FROM users",
+			"SELECT id FROM users) OR (true",
+			"SELECT id FROM users WHERE true /*",
+		} {
+			_, err = p.SendToSegment(ctx, push.Segment{Type: push.SegmentTypeSQL, SQL: query}, helloMessage)
+			assert.ErrorIs(t, err, push.ErrSegmentInvalid, query)
+		}
+
+		userCount, err := models.Users().Count(ctx, db)
+		require.NoError(t, err)
+		assert.NotZero(t, userCount)
+
+		// invalid segments do not create a campaign
+		campaignCount, err := models.PushCampaigns().Count(ctx, db)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), campaignCount)
+
+		// queries are executed as is, question marks are not replaced with placeholders and trailing comments are supported
+		campaign, err = p.SendToSegment(ctx, push.Segment{
+			Type: push.SegmentTypeSQL,
+			SQL:  fmt.Sprintf("SELECT id FROM users WHERE username = '%s' AND '?' <> '' -- User2 only", fixtures.User2.Username.String),
+		}, helloMessage)
+		require.NoError(t, err)
+		assert.Equal(t, 1, campaign.UsersTargeted)
+	})
+}
+
+func TestSendToSegmentSQLTimeout(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+
+		p := newOutboxTestService(t, db, func(cfg *push.OutboxConfig) {
+			cfg.SegmentQueryTimeout = 100 * time.Millisecond
+		})
+
+		_, err := p.SendToSegment(ctx, push.Segment{
+			Type: push.SegmentTypeSQL,
+			SQL:  "SELECT id FROM users WHERE pg_sleep(1) IS NOT NULL",
+		}, helloMessage)
+		assert.ErrorIs(t, err, push.ErrSegmentInvalid)
+
+		campaignCount, err := models.PushCampaigns().Count(ctx, db)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), campaignCount)
+	})
+}
+
+func TestSegmentValidate(t *testing.T) {
+	assert.NoError(t, push.Segment{Type: push.SegmentTypeAll}.Validate())
+	assert.NoError(t, push.Segment{Type: push.SegmentTypeLanguage, Language: "de"}.Validate())
+	assert.NoError(t, push.Segment{Type: push.SegmentTypeSQL, SQL: "SELECT id FROM users"}.Validate())
+
+	assert.ErrorIs(t, push.Segment{Type: push.SegmentTypeLanguage, Language: "§$%"}.Validate(), push.ErrSegmentInvalid)
+	assert.ErrorIs(t, push.Segment{Type: push.SegmentTypeSQL, SQL: "  "}.Validate(), push.ErrSegmentInvalid)
+	assert.ErrorIs(t, push.Segment{Type: "topic"}.Validate(), push.ErrSegmentInvalid)
+}
@@ -0,0 +1,132 @@
+package push
+
+import (
+	"errors"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+type Priority string
+
+const (
+	// PriorityDefault leaves the priority up to the provider's configuration
+	PriorityDefault Priority = ""
+	// PriorityNormal messages may be delayed to preserve the device's battery
+	PriorityNormal Priority = "normal"
+	// PriorityHigh messages are delivered immediately, possibly waking up the device
+	PriorityHigh Priority = "high"
+)
+
+// DataKeyLink is the data key deep links of notifications are delivered with to the app.
+const DataKeyLink = "link"
+
+var ErrMessageEmpty = errors.New("push message requires a notification or data")
+
+// Message is sent to the devices of users via all registered providers.
+// Messages without a notification are delivered silently as data messages.
+type Message struct {
+	Notification *Notification `json:"notification,omitempty"`
+	// Custom key-value pairs delivered to the app
+	Data map[string]string `json:"data,omitempty"`
+	// Duration the message is stored for delivery while the device is offline, 0 uses the provider's default
+	TTL time.Duration `json:"ttl,omitempty"`
+	// Undelivered messages having the same collapse key are replaced by the latest one
+	CollapseKey string   `json:"collapse_key,omitempty"`
+	Priority    Priority `json:"priority,omitempty"`
+
+	// Platform specific overrides, only used by the respective providers
+	Android *AndroidOverrides `json:"android,omitempty"`
+	APNs    *APNsOverrides    `json:"apns,omitempty"`
+}
+
+type Notification struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+	// i18n keys resolved in the language of the receiving user, take precedence over Title and Body
+	TitleKey string `json:"title_key,omitempty"`
+	BodyKey  string `json:"body_key,omitempty"`
+	// Template data used to translate TitleKey and BodyKey
+	TemplateData map[string]string `json:"template_data,omitempty"`
+	// URL of an image displayed within the notification
+	ImageURL string `json:"image_url,omitempty"`
+	// Deep link opened when tapping the notification, delivered as data with the key DataKeyLink
+	Link string `json:"link,omitempty"`
+	// Name of the sound played, "default" for the platform's default sound
+	Sound string `json:"sound,omitempty"`
+	// Badge count displayed on the app icon, nil leaves it unchanged
+	Badge *int `json:"badge,omitempty"`
+}
+
+type AndroidOverrides struct {
+	ChannelID   string `json:"channel_id,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+	Color       string `json:"color,omitempty"`
+	ClickAction string `json:"click_action,omitempty"`
+	// Notifications having the same tag replace each other in the notification drawer
+	Tag string `json:"tag,omitempty"`
+}
+
+type APNsOverrides struct {
+	Subtitle string `json:"subtitle,omitempty"`
+	Category string `json:"category,omitempty"`
+	ThreadID string `json:"thread_id,omitempty"`
+	// Allows a notification service extension to modify the notification (e.g. to download images)
+	MutableContent bool `json:"mutable_content,omitempty"`
+}
+
+// Translator resolves i18n keys, it is implemented by *i18n.Service.
+type Translator interface {
+	Translate(key string, lang language.Tag, data ...map[string]string) string
+}
+
+// Validate ensures the message carries a notification or data.
+func (m Message) Validate() error {
+	if m.Notification == nil && len(m.Data) == 0 {
+		return ErrMessageEmpty
+	}
+
+	return nil
+}
+
+// Localized returns a copy of the message with the i18n keys of its notification resolved in the given language.
+func (m Message) Localized(translator Translator, lang language.Tag) Message {
+	if m.Notification == nil || !m.Notification.HasKeys() {
+		return m
+	}
+
+	notification := *m.Notification
+	if len(notification.TitleKey) > 0 {
+		notification.Title = translator.Translate(notification.TitleKey, lang, notification.TemplateData)
+		notification.TitleKey = ""
+	}
+
+	if len(notification.BodyKey) > 0 {
+		notification.Body = translator.Translate(notification.BodyKey, lang, notification.TemplateData)
+		notification.BodyKey = ""
+	}
+
+	m.Notification = &notification
+
+	return m
+}
+
+// HasKeys reports whether the title or body of the notification need to be translated.
+func (n *Notification) HasKeys() bool {
+	return len(n.TitleKey) > 0 || len(n.BodyKey) > 0
+}
+
+// DataWithLink returns the data of the message including the deep link of its notification, if any.
+func (m Message) DataWithLink() map[string]string {
+	if m.Notification == nil || len(m.Notification.Link) == 0 {
+		return m.Data
+	}
+
+	data := make(map[string]string, len(m.Data)+1)
+	for k, v := range m.Data {
+		data[k] = v
+	}
+	data[DataKeyLink] = m.Notification.Link
+
+	return data
+}
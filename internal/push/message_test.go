@@ -0,0 +1,62 @@
+package push_test
+
+import (
+	"testing"
+
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+type testTranslator struct{}
+
+func (testTranslator) Translate(key string, lang language.Tag, data ...map[string]string) string {
+	res := lang.String() + ":" + key
+	if len(data) > 0 {
+		res += ":" + data[0]["Name"]
+	}
+
+	return res
+}
+
+func TestMessageLocalized(t *testing.T) {
+	msg := push.Message{
+		Notification: &push.Notification{
+			Title:        "Fallback",
+			TitleKey:     "Push.Title",
+			Body:         "Body",
+			TemplateData: map[string]string{"Name": "Hans"},
+		},
+	}
+
+	localized := msg.Localized(testTranslator{}, language.German)
+	assert.Equal(t, "de:Push.Title:Hans", localized.Notification.Title)
+	assert.Equal(t, "Body", localized.Notification.Body)
+	assert.False(t, localized.Notification.HasKeys())
+
+	// the original message is left untouched
+	assert.Equal(t, "Fallback", msg.Notification.Title)
+	assert.True(t, msg.Notification.HasKeys())
+
+	data := push.Message{Data: map[string]string{"id": "42"}}
+	assert.Equal(t, data, data.Localized(testTranslator{}, language.German))
+}
+
+func TestMessageValidate(t *testing.T) {
+	assert.ErrorIs(t, push.Message{}.Validate(), push.ErrMessageEmpty)
+	assert.NoError(t, push.Message{Data: map[string]string{"id": "42"}}.Validate())
+	assert.NoError(t, push.Message{Notification: &push.Notification{Title: "Hello"}}.Validate())
+}
+
+func TestMessageDataWithLink(t *testing.T) {
+	msg := push.Message{
+		Notification: &push.Notification{Link: "app://inbox"},
+		Data:         map[string]string{"id": "42"},
+	}
+
+	assert.Equal(t, map[string]string{"id": "42", push.DataKeyLink: "app://inbox"}, msg.DataWithLink())
+	assert.Equal(t, map[string]string{"id": "42"}, msg.Data)
+
+	msg.Notification.Link = ""
+	assert.Equal(t, map[string]string{"id": "42"}, msg.DataWithLink())
+}
@@ -0,0 +1,398 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/util"
+	"allaboutapps.dev/aw/go-starter/internal/util/db"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type OutboxConfig struct {
+	// Number of workers delivering messages concurrently
+	Workers int
+	// Maximum number of deliveries claimed by a worker at once
+	BatchSize int
+	// Interval workers poll for due deliveries if there were none left
+	PollInterval time.Duration
+	// Deliveries failing with errors not caused by their token are retried up to MaxAttempts (including the first
+	// attempt) with exponential backoff between RetryBaseDelay and RetryMaxDelay before being dead lettered
+	MaxAttempts    int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// Duration claimed deliveries are locked for, deliveries of crashed workers are retried afterwards
+	LeaseDuration time.Duration
+	// Messages without pending deliveries are purged after Retention every PurgeInterval, 0 disables purging
+	Retention     time.Duration
+	PurgeInterval time.Duration
+	// Number of push tokens loaded and enqueued at once when sending to a segment
+	BroadcastPageSize int
+	// Statement timeout of the queries loading the push tokens of a segment, limiting expensive SQL segments, 0 disables the timeout
+	SegmentQueryTimeout time.Duration
+}
+
+type MessageStatus string
+
+const (
+	// MessageStatusPending messages have deliveries awaiting their (next) attempt
+	MessageStatusPending MessageStatus = "pending"
+	// MessageStatusDelivered messages have been delivered to all push tokens
+	MessageStatusDelivered MessageStatus = "delivered"
+	// MessageStatusPartiallyDelivered messages have been delivered to some push tokens, the others failed
+	MessageStatusPartiallyDelivered MessageStatus = "partially_delivered"
+	// MessageStatusFailed messages have not been delivered to any push token, e.g. as the user has none
+	MessageStatusFailed MessageStatus = "failed"
+)
+
+func (s MessageStatus) String() string {
+	return string(s)
+}
+
+var (
+	errProviderNotRegistered = errors.New("push provider is not registered")
+	errMissingResponse       = errors.New("push provider returned no response for token")
+)
+
+// MessageStatusOf derives the status of a message from its deliveries.
+func MessageStatusOf(deliveries models.PushDeliverySlice) MessageStatus {
+	delivered := 0
+	for _, delivery := range deliveries {
+		switch delivery.Status {
+		case models.PushDeliveryStatusPending:
+			return MessageStatusPending
+		case models.PushDeliveryStatusDelivered:
+			delivered++
+		}
+	}
+
+	switch {
+	case delivered == 0:
+		return MessageStatusFailed
+	case delivered < len(deliveries):
+		return MessageStatusPartiallyDelivered
+	default:
+		return MessageStatusDelivered
+	}
+}
+
+// GetMessageStatus returns the status of the message with the given ID along with its deliveries.
+func (s *Service) GetMessageStatus(ctx context.Context, messageID string) (MessageStatus, models.PushDeliverySlice, error) {
+	deliveries, err := models.PushDeliveries(
+		models.PushDeliveryWhere.PushMessageID.EQ(messageID),
+		qm.OrderBy(models.PushDeliveryColumns.CreatedAt),
+	).All(ctx, s.DB)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to load push deliveries: %w", err)
+	}
+
+	return MessageStatusOf(deliveries), deliveries, nil
+}
+
+// enqueue stores the message in the outbox along with a pending delivery for each of the given push tokens.
+// The recipient (user or campaign) of the push message needs to be set by the caller.
+func (s *Service) enqueue(ctx context.Context, pushMessage *models.PushMessage, msg Message, pushTokens models.PushTokenSlice) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode push message: %w", err)
+	}
+
+	pushMessage.Payload = payload
+
+	providers := make([]string, 0, len(pushTokens))
+	tokens := make([]string, 0, len(pushTokens))
+	for _, pushToken := range pushTokens {
+		providers = append(providers, pushToken.Provider)
+		tokens = append(tokens, pushToken.Token)
+	}
+
+	return db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+		if err := pushMessage.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert push message: %w", err)
+		}
+
+		if len(tokens) == 0 {
+			return nil
+		}
+
+		// deliveries are inserted at once, as campaign messages are delivered to whole pages of push tokens
+		now := time.Now()
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s, %s, %s, %s)
+			SELECT $1, provider, token, $2, $3, $3, $3 FROM unnest($4::provider_type[], $5::text[]) AS t (provider, token)`,
+			models.TableNames.PushDeliveries,
+			models.PushDeliveryColumns.PushMessageID,
+			models.PushDeliveryColumns.Provider,
+			models.PushDeliveryColumns.Token,
+			models.PushDeliveryColumns.Status,
+			models.PushDeliveryColumns.NextAttemptAt,
+			models.PushDeliveryColumns.CreatedAt,
+			models.PushDeliveryColumns.UpdatedAt,
+		), pushMessage.ID, models.PushDeliveryStatusPending, now, pq.Array(providers), pq.Array(tokens)); err != nil {
+			return fmt.Errorf("failed to insert push deliveries: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ProcessDeliveries claims a batch of due deliveries and sends them via their providers. Tokens reported as invalid
+// are deleted, transiently failed deliveries are rescheduled or dead lettered once out of attempts. Deliveries of
+// messages whose payload cannot be decoded are dead lettered right away. Returns the number of deliveries processed.
+// Deliveries are claimed using SKIP LOCKED, so this is safe to run concurrently.
+func (s *Service) ProcessDeliveries(ctx context.Context) (int, error) {
+	deliveries, err := s.claimDeliveries(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	messageIDs := make([]string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		messageIDs = append(messageIDs, delivery.PushMessageID)
+	}
+
+	pushMessages, err := models.PushMessages(models.PushMessageWhere.ID.IN(messageIDs)).All(ctx, s.DB)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load push messages: %w", err)
+	}
+
+	messages := make(map[string]Message, len(pushMessages))
+	decodeErrs := make(map[string]error)
+	campaignIDs := make(map[string]null.String, len(pushMessages))
+	for _, pushMessage := range pushMessages {
+		campaignIDs[pushMessage.ID] = pushMessage.PushCampaignID
+
+		// messages which cannot be decoded never will, their deliveries are dead lettered without affecting the batch
+		var msg Message
+		if err := json.Unmarshal(pushMessage.Payload, &msg); err != nil {
+			util.LogFromContext(ctx).Error().Err(err).Str("push_message_id", pushMessage.ID).Msg("Failed to decode push message")
+			decodeErrs[pushMessage.ID] = fmt.Errorf("failed to decode push message: %w", err)
+			continue
+		}
+		messages[pushMessage.ID] = msg
+	}
+
+	// deliveries of the same message are sent to all their tokens of a provider at once
+	type group struct {
+		messageID string
+		provider  ProviderType
+	}
+	groups := make(map[group]models.PushDeliverySlice)
+	for _, delivery := range deliveries {
+		key := group{messageID: delivery.PushMessageID, provider: ProviderType(delivery.Provider)}
+		groups[key] = append(groups[key], delivery)
+	}
+
+	for key, groupDeliveries := range groups {
+		var stats CampaignStats
+		if decodeErr, ok := decodeErrs[key.messageID]; ok {
+			for _, delivery := range groupDeliveries {
+				stats.count(s.completeDelivery(ctx, delivery, ProviderSendResponse{Token: delivery.Token, Valid: true, Err: decodeErr}, true))
+			}
+		} else if p, ok := s.provider[key.provider]; ok {
+			tokens := make([]string, 0, len(groupDeliveries))
+			for _, delivery := range groupDeliveries {
+				tokens = append(tokens, delivery.Token)
+			}
+
+			responses := p.SendMulticast(tokens, messages[key.messageID])
+			for i, delivery := range groupDeliveries {
+				if i >= len(responses) {
+					stats.count(s.completeDelivery(ctx, delivery, ProviderSendResponse{Token: delivery.Token, Valid: true, Err: errMissingResponse}, false))
+					continue
+				}
+
+				stats.count(s.completeDelivery(ctx, delivery, responses[i], false))
+			}
+		} else {
+			for _, delivery := range groupDeliveries {
+				stats.count(s.completeDelivery(ctx, delivery, ProviderSendResponse{Token: delivery.Token, Valid: true, Err: errProviderNotRegistered}, true))
+			}
+		}
+
+		if campaignID := campaignIDs[key.messageID]; campaignID.Valid {
+			s.recordCampaignStats(ctx, campaignID.String, stats)
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// claimDeliveries locks up to BatchSize due deliveries by postponing their next attempt by the lease duration.
+func (s *Service) claimDeliveries(ctx context.Context) (models.PushDeliverySlice, error) {
+	var deliveries models.PushDeliverySlice
+	if err := db.WithTransaction(ctx, s.DB, func(tx boil.ContextExecutor) error {
+		var err error
+		deliveries, err = models.PushDeliveries(
+			models.PushDeliveryWhere.Status.EQ(models.PushDeliveryStatusPending),
+			models.PushDeliveryWhere.NextAttemptAt.LTE(time.Now()),
+			qm.OrderBy(models.PushDeliveryColumns.NextAttemptAt),
+			qm.Limit(s.config.BatchSize),
+			qm.For("UPDATE SKIP LOCKED"),
+		).All(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to load due push deliveries: %w", err)
+		}
+
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		if _, err := deliveries.UpdateAll(ctx, tx, models.M{
+			models.PushDeliveryColumns.NextAttemptAt: time.Now().Add(s.config.LeaseDuration),
+			models.PushDeliveryColumns.UpdatedAt:     time.Now(),
+		}); err != nil {
+			return fmt.Errorf("failed to claim push deliveries: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// completeDelivery stores the result of a delivery attempt and returns the resulting status of the delivery. Failures
+// are only logged, as the delivery is simply attempted again once its lease expired.
+func (s *Service) completeDelivery(ctx context.Context, delivery *models.PushDelivery, res ProviderSendResponse, permanent bool) string {
+	log := util.LogFromContext(ctx).With().Str("push_delivery_id", delivery.ID).Str("provider", delivery.Provider).Logger()
+
+	delivery.Attempts++
+	delivery.LastError = null.String{}
+	if res.Err != nil {
+		delivery.LastError = null.StringFrom(res.Err.Error())
+	}
+
+	switch {
+	case !res.Valid:
+		delivery.Status = models.PushDeliveryStatusInvalidToken
+
+		if _, err := models.PushTokens(
+			models.PushTokenWhere.Token.EQ(delivery.Token),
+			models.PushTokenWhere.Provider.EQ(delivery.Provider),
+		).DeleteAll(ctx, s.DB); err != nil {
+			log.Error().Err(err).Msg("Failed to delete invalid push token")
+		}
+	case res.Err == nil:
+		delivery.Status = models.PushDeliveryStatusDelivered
+		delivery.DeliveredAt = null.TimeFrom(time.Now())
+	case permanent || delivery.Attempts >= s.config.MaxAttempts:
+		log.Warn().Err(res.Err).Int("attempts", delivery.Attempts).Msg("Push delivery failed permanently, moving it to dead letters")
+		delivery.Status = models.PushDeliveryStatusDeadLetter
+	default:
+		delay := retryDelay(delivery.Attempts, s.config.RetryBaseDelay, s.config.RetryMaxDelay)
+		log.Debug().Err(res.Err).Int("attempts", delivery.Attempts).Dur("delay", delay).Msg("Push delivery failed, retrying")
+		delivery.NextAttemptAt = time.Now().Add(delay)
+	}
+
+	if _, err := delivery.Update(ctx, s.DB, boil.Whitelist(
+		models.PushDeliveryColumns.Status,
+		models.PushDeliveryColumns.Attempts,
+		models.PushDeliveryColumns.NextAttemptAt,
+		models.PushDeliveryColumns.LastError,
+		models.PushDeliveryColumns.DeliveredAt,
+		models.PushDeliveryColumns.UpdatedAt,
+	)); err != nil {
+		log.Error().Err(err).Msg("Failed to update push delivery")
+		return models.PushDeliveryStatusPending
+	}
+
+	return delivery.Status
+}
+
+// retryDelay returns the exponential backoff delay after the given number of attempts, with equal jitter applied
+// so retries of deliveries failed at the same time are spread out.
+func retryDelay(attempts int, base time.Duration, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+
+	if delay > max {
+		delay = max
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1)) //nolint:gosec // jitter does not need to be unpredictable
+}
+
+// RunWorkers delivers due messages with the configured number of workers until ctx is done.
+func (s *Service) RunWorkers(ctx context.Context) {
+	if s.config.Workers <= 0 {
+		log.Warn().Msg("No push workers configured, push messages will not be delivered")
+		return
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < s.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.runWorker(ctx)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (s *Service) runWorker(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		processed, err := s.ProcessDeliveries(ctx)
+		if err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("Failed to process push deliveries")
+		}
+
+		// continue immediately while there are more due deliveries
+		if err == nil && processed >= s.config.BatchSize {
+			timer.Reset(0)
+		} else {
+			timer.Reset(s.config.PollInterval)
+		}
+	}
+}
+
+// PurgeMessages deletes all messages older than the retention period which have no pending deliveries left.
+// Returns the number of messages purged.
+func (s *Service) PurgeMessages(ctx context.Context) (int64, error) {
+	purged, err := models.PushMessages(
+		models.PushMessageWhere.CreatedAt.LT(time.Now().Add(-s.config.Retention)),
+		qm.Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE %s = %s AND %s = ?)",
+			models.TableNames.PushDeliveries,
+			models.PushDeliveryTableColumns.PushMessageID,
+			models.PushMessageTableColumns.ID,
+			models.PushDeliveryTableColumns.Status,
+		), models.PushDeliveryStatusPending),
+	).DeleteAll(ctx, s.DB)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge push messages: %w", err)
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,277 @@
+package push_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"allaboutapps.dev/aw/go-starter/internal/push/provider"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func newOutboxTestService(t *testing.T, db *sql.DB, modify func(cfg *push.OutboxConfig)) *push.Service {
+	t.Helper()
+
+	cfg := config.DefaultServiceConfigFromEnv().Push.Outbox
+	if modify != nil {
+		modify(&cfg)
+	}
+
+	p := push.New(db, testTranslator{}, cfg)
+	p.RegisterProvider(provider.NewMock(push.ProviderTypeFCM))
+
+	return p
+}
+
+// makeDeliveriesDue resets the next attempt of all pending deliveries, skipping their backoff.
+func makeDeliveriesDue(t *testing.T, db *sql.DB) {
+	t.Helper()
+
+	_, err := models.PushDeliveries(models.PushDeliveryWhere.Status.EQ(models.PushDeliveryStatusPending)).UpdateAll(context.Background(), db, models.M{
+		models.PushDeliveryColumns.NextAttemptAt: time.Now().Add(-time.Second),
+	})
+	require.NoError(t, err)
+}
+
+func TestProcessDeliveriesDeadLetter(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		p := newOutboxTestService(t, db, func(cfg *push.OutboxConfig) {
+			cfg.MaxAttempts = 2
+		})
+
+		pushMessage, err := p.SendToUser(ctx, fixtures.User1, push.Message{Notification: &push.Notification{Title: "other error"}})
+		require.NoError(t, err)
+
+		_, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+
+		// the delivery is not due until its backoff has passed
+		processed, err := p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, processed)
+
+		makeDeliveriesDue(t, db)
+
+		processed, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+
+		deliveries, err := pushMessage.PushDeliveries().All(ctx, db)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, models.PushDeliveryStatusDeadLetter, deliveries[0].Status)
+		assert.Equal(t, 2, deliveries[0].Attempts)
+		assert.Equal(t, "other error", deliveries[0].LastError.String)
+		assert.Equal(t, push.MessageStatusFailed, push.MessageStatusOf(deliveries))
+
+		// dead lettered deliveries are never attempted again
+		makeDeliveriesDue(t, db)
+
+		processed, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, processed)
+	})
+}
+
+func TestProcessDeliveriesProviderNotRegistered(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		p := newOutboxTestService(t, db, nil)
+		p.RegisterProvider(provider.NewMock(push.ProviderTypeAPN))
+
+		pushMessage, err := p.SendToUser(ctx, fixtures.User1, helloMessage)
+		require.NoError(t, err)
+
+		p.ResetProviders()
+		p.RegisterProvider(provider.NewMock(push.ProviderTypeFCM))
+
+		processed, err := p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, processed)
+
+		delivery, err := pushMessage.PushDeliveries(models.PushDeliveryWhere.Provider.EQ(models.ProviderTypeApn)).One(ctx, db)
+		require.NoError(t, err)
+		assert.Equal(t, models.PushDeliveryStatusDeadLetter, delivery.Status)
+		assert.Equal(t, 1, delivery.Attempts)
+
+		status, deliveries, err := p.GetMessageStatus(ctx, pushMessage.ID)
+		require.NoError(t, err)
+		assert.Len(t, deliveries, 2)
+		assert.Equal(t, push.MessageStatusPartiallyDelivered, status)
+	})
+}
+
+func TestProcessDeliveriesCorruptPayload(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		p := newOutboxTestService(t, db, nil)
+
+		corruptMessage, err := p.SendToUser(ctx, fixtures.User1, helloMessage)
+		require.NoError(t, err)
+
+		// valid JSON, which cannot be decoded into a message
+		corruptMessage.Payload = []byte(`{"data": "not an object"}`)
+		_, err = corruptMessage.Update(ctx, db, boil.Whitelist(models.PushMessageColumns.Payload))
+		require.NoError(t, err)
+
+		pushMessage, err := p.SendToUser(ctx, fixtures.User1, helloMessage)
+		require.NoError(t, err)
+
+		processed, err := p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, processed)
+
+		deliveries, err := corruptMessage.PushDeliveries().All(ctx, db)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, models.PushDeliveryStatusDeadLetter, deliveries[0].Status)
+		assert.Equal(t, 1, deliveries[0].Attempts)
+		assert.Contains(t, deliveries[0].LastError.String, "failed to decode push message")
+
+		// the remaining deliveries of the batch are still sent
+		status, _, err := p.GetMessageStatus(ctx, pushMessage.ID)
+		require.NoError(t, err)
+		assert.Equal(t, push.MessageStatusDelivered, status)
+	})
+}
+
+func TestProcessDeliveriesLeaseExpired(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		p := newOutboxTestService(t, db, nil)
+
+		pushMessage, err := p.SendToUser(ctx, fixtures.User1, helloMessage)
+		require.NoError(t, err)
+
+		// simulate a worker which claimed the delivery and crashed before completing it
+		_, err = pushMessage.PushDeliveries().UpdateAll(ctx, db, models.M{
+			models.PushDeliveryColumns.NextAttemptAt: time.Now().Add(time.Minute),
+		})
+		require.NoError(t, err)
+
+		processed, err := p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, processed)
+
+		makeDeliveriesDue(t, db)
+
+		processed, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+	})
+}
+
+func TestRunWorkers(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		p := newOutboxTestService(t, db, func(cfg *push.OutboxConfig) {
+			cfg.Workers = 2
+			cfg.PollInterval = 10 * time.Millisecond
+		})
+
+		workerCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			p.RunWorkers(workerCtx)
+			close(done)
+		}()
+
+		pushMessage, err := p.SendToUser(ctx, fixtures.User1, helloMessage)
+		require.NoError(t, err)
+
+		assert.Eventually(t, func() bool {
+			deliveries, err := pushMessage.PushDeliveries().All(ctx, db)
+			require.NoError(t, err)
+			return push.MessageStatusOf(deliveries) == push.MessageStatusDelivered
+		}, 5*time.Second, 10*time.Millisecond)
+
+		cancel()
+		<-done
+	})
+}
+
+func TestPurgeMessages(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		p := newOutboxTestService(t, db, func(cfg *push.OutboxConfig) {
+			cfg.Retention = time.Hour
+		})
+
+		delivered, err := p.SendToUser(ctx, fixtures.User1, helloMessage)
+		require.NoError(t, err)
+
+		_, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+
+		pending, err := p.SendToUser(ctx, fixtures.User1, helloMessage)
+		require.NoError(t, err)
+
+		recent, err := p.SendToUser(ctx, fixtures.User1, helloMessage)
+		require.NoError(t, err)
+
+		for _, pushMessage := range []*models.PushMessage{delivered, pending} {
+			pushMessage.CreatedAt = time.Now().Add(-2 * time.Hour)
+			_, err = pushMessage.Update(ctx, db, boil.Whitelist(models.PushMessageColumns.CreatedAt))
+			require.NoError(t, err)
+		}
+
+		purged, err := p.PurgeMessages(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), purged)
+
+		exists, err := models.PushMessageExists(ctx, db, delivered.ID)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		// messages with pending deliveries are kept until they are delivered
+		for _, pushMessage := range []*models.PushMessage{pending, recent} {
+			exists, err = models.PushMessageExists(ctx, db, pushMessage.ID)
+			require.NoError(t, err)
+			assert.True(t, exists)
+		}
+	})
+}
+
+func TestMessageStatusOf(t *testing.T) {
+	delivery := func(status string) *models.PushDelivery {
+		return &models.PushDelivery{Status: status}
+	}
+
+	assert.Equal(t, push.MessageStatusFailed, push.MessageStatusOf(nil))
+	assert.Equal(t, push.MessageStatusPending, push.MessageStatusOf(models.PushDeliverySlice{
+		delivery(models.PushDeliveryStatusDelivered),
+		delivery(models.PushDeliveryStatusPending),
+	}))
+	assert.Equal(t, push.MessageStatusDelivered, push.MessageStatusOf(models.PushDeliverySlice{
+		delivery(models.PushDeliveryStatusDelivered),
+		delivery(models.PushDeliveryStatusDelivered),
+	}))
+	assert.Equal(t, push.MessageStatusPartiallyDelivered, push.MessageStatusOf(models.PushDeliverySlice{
+		delivery(models.PushDeliveryStatusDelivered),
+		delivery(models.PushDeliveryStatusInvalidToken),
+	}))
+	assert.Equal(t, push.MessageStatusFailed, push.MessageStatusOf(models.PushDeliverySlice{
+		delivery(models.PushDeliveryStatusDeadLetter),
+		delivery(models.PushDeliveryStatusInvalidToken),
+	}))
+}
@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	APNsEndpointProduction = "https://api.push.apple.com"
+	APNsEndpointSandbox    = "https://api.sandbox.push.apple.com"
+
+	// APNsPriorityImmediate delivers the notification immediately, APNsPriorityConserve schedules it
+	// taking the power considerations of the device into account.
+	APNsPriorityImmediate = 10
+	APNsPriorityConserve  = 5
+
+	// APNs rejects provider tokens older than one hour and throttles refreshing them more often than every 20 minutes.
+	apnsProviderTokenValidity = 50 * time.Minute
+	apnsDefaultTimeout        = 30 * time.Second
+)
+
+// APNsPayloadKeyImageURL is the payload key the image URL of notifications is delivered with, to be attached by the
+// app's notification service extension.
+const APNsPayloadKeyImageURL = "image_url"
+
+var ErrAPNsAuthKeyInvalid = errors.New("APNs auth key must be a PEM encoded ECDSA private key")
+
+// APNs reason codes indicating that the device token is no longer (or never was) valid for the configured topic, see
+// https://developer.apple.com/documentation/usernotifications/handling-notification-responses-from-apns
+var apnsInvalidTokenReasons = map[string]bool{
+	"BadDeviceToken":         true,
+	"DeviceTokenNotForTopic": true,
+	"MissingDeviceToken":     true,
+	"Unregistered":           true,
+}
+
+type APNs struct {
+	Config     APNsConfig
+	client     *http.Client
+	endpoint   string
+	authKey    *ecdsa.PrivateKey
+	tokenMutex sync.Mutex
+	token      string
+	tokenIAT   time.Time
+}
+
+type APNsConfig struct {
+	// PEM encoded contents of the .p8 auth key, takes precedence over AuthKeyFile
+	AuthKey     string `json:"-"` // sensitive
+	AuthKeyFile string
+	KeyID       string
+	TeamID      string
+	// bundle ID of the app notifications are sent to
+	Topic      string
+	Production bool
+	Priority   int
+	// overrides the APNs endpoint selected by Production if set
+	Endpoint string
+	Timeout  time.Duration
+}
+
+type APNsOption func(p *APNs)
+
+// WithAPNsHTTPClient overrides the HTTP client used to connect to APNs. The client must support HTTP/2.
+func WithAPNsHTTPClient(client *http.Client) APNsOption {
+	return func(p *APNs) {
+		p.client = client
+	}
+}
+
+// APNsError is returned if APNs rejected a notification, see
+// https://developer.apple.com/documentation/usernotifications/handling-notification-responses-from-apns
+type APNsError struct {
+	StatusCode int
+	Reason     string
+	// set if the device token is no longer active for the topic (410 Gone)
+	Timestamp time.Time
+}
+
+func (e *APNsError) Error() string {
+	return fmt.Sprintf("APNs rejected notification with status %d: %s", e.StatusCode, e.Reason)
+}
+
+func NewAPNs(config APNsConfig, opts ...APNsOption) (*APNs, error) {
+	authKey := []byte(config.AuthKey)
+	if len(authKey) == 0 {
+		var err error
+		authKey, err = os.ReadFile(config.AuthKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read APNs auth key: %w", err)
+		}
+	}
+
+	key, err := jwt.ParseECPrivateKeyFromPEM(authKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrAPNsAuthKeyInvalid, err)
+	}
+
+	endpoint := config.Endpoint
+	if len(endpoint) == 0 {
+		endpoint = APNsEndpointSandbox
+		if config.Production {
+			endpoint = APNsEndpointProduction
+		}
+	}
+
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = apnsDefaultTimeout
+	}
+
+	p := &APNs{
+		Config:   config,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		authKey:  key,
+		client: &http.Client{
+			Timeout: timeout,
+			// APNs only accepts HTTP/2 connections, which net/http negotiates via TLS ALPN
+			Transport: &http.Transport{
+				Proxy:             http.ProxyFromEnvironment,
+				ForceAttemptHTTP2: true,
+			},
+		},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p, nil
+}
+
+func (p *APNs) GetProviderType() push.ProviderType {
+	return push.ProviderTypeAPN
+}
+
+type apnsErrorResponse struct {
+	Reason    string `json:"reason"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+func (p *APNs) Send(token string, msg push.Message) push.ProviderSendResponse {
+	err := p.send(token, msg)
+
+	valid := true
+	if err != nil {
+		var apnsErr *APNsError
+		if errors.As(err, &apnsErr) {
+			valid = !(apnsErr.StatusCode == http.StatusGone || apnsInvalidTokenReasons[apnsErr.Reason])
+		}
+	}
+
+	return push.ProviderSendResponse{
+		Token: token,
+		Valid: valid,
+		Err:   err,
+	}
+}
+
+func (p *APNs) SendMulticast(tokens []string, msg push.Message) []push.ProviderSendResponse {
+	return sendMulticastWithProvider(p, tokens, msg)
+}
+
+func (p *APNs) send(token string, msg push.Message) error {
+	payload := apnsPayload(msg, true)
+	for k, v := range msg.DataWithLink() {
+		if k != "aps" {
+			payload[k] = v
+		}
+	}
+	if msg.Notification != nil && len(msg.Notification.ImageURL) > 0 {
+		payload[APNsPayloadKeyImageURL] = msg.Notification.ImageURL
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode APNs payload: %w", err)
+	}
+
+	providerToken, err := p.providerToken()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.endpoint+"/3/device/"+token, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "bearer "+providerToken)
+	req.Header.Set("apns-topic", p.Config.Topic)
+	for k, v := range apnsHeaders(msg, p.Config.Priority) {
+		req.Header.Set(k, v)
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send APNs request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		_, _ = io.Copy(io.Discard, res.Body)
+		return nil
+	}
+
+	apnsErr := &APNsError{StatusCode: res.StatusCode}
+
+	var errorResponse apnsErrorResponse
+	if err := json.NewDecoder(res.Body).Decode(&errorResponse); err == nil {
+		apnsErr.Reason = errorResponse.Reason
+		if errorResponse.Timestamp > 0 {
+			apnsErr.Timestamp = time.UnixMilli(errorResponse.Timestamp)
+		}
+	}
+
+	// the provider token was rejected, issue a new one for the next request
+	if apnsErr.Reason == "ExpiredProviderToken" || apnsErr.Reason == "InvalidProviderToken" {
+		p.resetProviderToken()
+	}
+
+	return apnsErr
+}
+
+// providerToken returns the cached ES256 signed provider authentication token, issuing a new one if it is about to expire.
+func (p *APNs) providerToken() (string, error) {
+	p.tokenMutex.Lock()
+	defer p.tokenMutex.Unlock()
+
+	now := time.Now()
+	if len(p.token) > 0 && now.Sub(p.tokenIAT) < apnsProviderTokenValidity {
+		return p.token, nil
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Issuer:   p.Config.TeamID,
+		IssuedAt: jwt.NewNumericDate(now),
+	})
+	token.Header["kid"] = p.Config.KeyID
+
+	signed, err := token.SignedString(p.authKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign APNs provider token: %w", err)
+	}
+
+	p.token = signed
+	p.tokenIAT = now
+
+	return signed, nil
+}
+
+func (p *APNs) resetProviderToken() {
+	p.tokenMutex.Lock()
+	defer p.tokenMutex.Unlock()
+
+	p.token = ""
+}
+
+// apnsPayload returns the payload of the message including its "aps" dictionary, without custom data. The alert may be
+// omitted if it's delivered by other means (e.g. FCM).
+func apnsPayload(msg push.Message, includeAlert bool) map[string]interface{} {
+	aps := make(map[string]interface{})
+
+	if n := msg.Notification; n != nil {
+		alert := make(map[string]string)
+		if includeAlert {
+			if len(n.Title) > 0 {
+				alert["title"] = n.Title
+			}
+			if len(n.Body) > 0 {
+				alert["body"] = n.Body
+			}
+		}
+		if msg.APNs != nil && len(msg.APNs.Subtitle) > 0 {
+			alert["subtitle"] = msg.APNs.Subtitle
+		}
+		if len(alert) > 0 {
+			aps["alert"] = alert
+		}
+
+		if len(n.Sound) > 0 {
+			aps["sound"] = n.Sound
+		}
+		if n.Badge != nil {
+			aps["badge"] = *n.Badge
+		}
+		// images need to be attached by a notification service extension
+		if len(n.ImageURL) > 0 {
+			aps["mutable-content"] = 1
+		}
+	} else {
+		aps["content-available"] = 1
+	}
+
+	if o := msg.APNs; o != nil {
+		if len(o.Category) > 0 {
+			aps["category"] = o.Category
+		}
+		if len(o.ThreadID) > 0 {
+			aps["thread-id"] = o.ThreadID
+		}
+		if o.MutableContent {
+			aps["mutable-content"] = 1
+		}
+	}
+
+	return map[string]interface{}{"aps": aps}
+}
+
+// apnsHeaders returns the APNs request headers of the message. Silent data messages are always sent as background
+// notifications with low priority as required by APNs.
+func apnsHeaders(msg push.Message, defaultPriority int) map[string]string {
+	headers := make(map[string]string)
+
+	priority := defaultPriority
+	switch msg.Priority {
+	case push.PriorityHigh:
+		priority = APNsPriorityImmediate
+	case push.PriorityNormal:
+		priority = APNsPriorityConserve
+	}
+
+	if msg.Notification != nil {
+		headers["apns-push-type"] = "alert"
+	} else {
+		headers["apns-push-type"] = "background"
+		priority = APNsPriorityConserve
+	}
+
+	if priority > 0 {
+		headers["apns-priority"] = strconv.Itoa(priority)
+	}
+
+	if msg.TTL > 0 {
+		headers["apns-expiration"] = strconv.FormatInt(time.Now().Add(msg.TTL).Unix(), 10)
+	}
+
+	if len(msg.CollapseKey) > 0 {
+		headers["apns-collapse-id"] = msg.CollapseKey
+	}
+
+	return headers
+}
@@ -0,0 +1,247 @@
+package provider_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"allaboutapps.dev/aw/go-starter/internal/push/provider"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var helloMessage = push.Message{
+	Notification: &push.Notification{
+		Title: "Hello",
+		Body:  "World",
+	},
+}
+
+func generateAPNsAuthKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+// newAPNsTestServer starts a local HTTP/2 server mimicking APNs, rejecting device tokens with the reasons given.
+func newAPNsTestServer(t *testing.T, key *ecdsa.PrivateKey, rejections map[string]int, reasons map[string]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, 2, r.ProtoMajor)
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "com.example.app", r.Header.Get("apns-topic"))
+		assert.Equal(t, "10", r.Header.Get("apns-priority"))
+		assert.Equal(t, "alert", r.Header.Get("apns-push-type"))
+
+		raw := strings.TrimPrefix(r.Header.Get("Authorization"), "bearer ")
+		token, err := jwt.Parse(raw, func(token *jwt.Token) (interface{}, error) {
+			assert.Equal(t, "ABC123DEFG", token.Header["kid"])
+			return &key.PublicKey, nil
+		})
+		if !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		assert.Equal(t, jwt.SigningMethodES256, token.Method)
+		assert.Equal(t, "TEAM123456", token.Claims.(jwt.MapClaims)["iss"])
+
+		var payload map[string]map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, map[string]interface{}{"title": "Hello", "body": "World"}, payload["aps"]["alert"])
+
+		deviceToken := strings.TrimPrefix(r.URL.Path, "/3/device/")
+		if status, ok := rejections[deviceToken]; ok {
+			w.WriteHeader(status)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"reason": reasons[deviceToken], "timestamp": 1672531200000})
+			return
+		}
+
+		w.Header().Set("apns-id", "EC1BF194-B3B2-424A-89A9-5A918A6E6B5D")
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestAPNsSend(t *testing.T) {
+	key, pemKey := generateAPNsAuthKey(t)
+	server := newAPNsTestServer(t, key, map[string]int{
+		"bad":          http.StatusBadRequest,
+		"unregistered": http.StatusGone,
+		"wrongtopic":   http.StatusBadRequest,
+		"payload":      http.StatusRequestEntityTooLarge,
+		"unavailable":  http.StatusServiceUnavailable,
+	}, map[string]string{
+		"bad":          "BadDeviceToken",
+		"unregistered": "Unregistered",
+		"wrongtopic":   "DeviceTokenNotForTopic",
+		"payload":      "PayloadTooLarge",
+		"unavailable":  "ServiceUnavailable",
+	})
+
+	p, err := provider.NewAPNs(provider.APNsConfig{
+		AuthKey:  pemKey,
+		KeyID:    "ABC123DEFG",
+		TeamID:   "TEAM123456",
+		Topic:    "com.example.app",
+		Priority: provider.APNsPriorityImmediate,
+		Endpoint: server.URL,
+	}, provider.WithAPNsHTTPClient(server.Client()))
+	require.NoError(t, err)
+	assert.Equal(t, push.ProviderTypeAPN, p.GetProviderType())
+
+	res := p.Send("valid", helloMessage)
+	assert.NoError(t, res.Err)
+	assert.True(t, res.Valid)
+	assert.Equal(t, "valid", res.Token)
+
+	tests := []struct {
+		token  string
+		status int
+		reason string
+		valid  bool
+	}{
+		{"bad", http.StatusBadRequest, "BadDeviceToken", false},
+		{"unregistered", http.StatusGone, "Unregistered", false},
+		{"wrongtopic", http.StatusBadRequest, "DeviceTokenNotForTopic", false},
+		{"payload", http.StatusRequestEntityTooLarge, "PayloadTooLarge", true},
+		{"unavailable", http.StatusServiceUnavailable, "ServiceUnavailable", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			res := p.Send(tt.token, helloMessage)
+			assert.Equal(t, tt.valid, res.Valid)
+
+			var apnsErr *provider.APNsError
+			require.ErrorAs(t, res.Err, &apnsErr)
+			assert.Equal(t, tt.status, apnsErr.StatusCode)
+			assert.Equal(t, tt.reason, apnsErr.Reason)
+		})
+	}
+
+	responses := p.SendMulticast([]string{"valid", "unregistered"}, helloMessage)
+	require.Len(t, responses, 2)
+	assert.True(t, responses[0].Valid)
+	assert.False(t, responses[1].Valid)
+}
+
+func TestNewAPNsAuthKey(t *testing.T) {
+	_, pemKey := generateAPNsAuthKey(t)
+
+	path := filepath.Join(t.TempDir(), "AuthKey_ABC123DEFG.p8")
+	require.NoError(t, os.WriteFile(path, []byte(pemKey), 0600))
+
+	p, err := provider.NewAPNs(provider.APNsConfig{AuthKeyFile: path})
+	require.NoError(t, err)
+	assert.NotNil(t, p)
+
+	_, err = provider.NewAPNs(provider.APNsConfig{AuthKey: "not a key"})
+	assert.ErrorIs(t, err, provider.ErrAPNsAuthKeyInvalid)
+
+	_, err = provider.NewAPNs(provider.APNsConfig{AuthKeyFile: filepath.Join(t.TempDir(), "missing.p8")})
+	assert.Error(t, err)
+}
+
+func TestAPNsSendPayload(t *testing.T) {
+	_, pemKey := generateAPNsAuthKey(t)
+
+	var headers http.Header
+	var payload map[string]interface{}
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		payload = nil
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	p, err := provider.NewAPNs(provider.APNsConfig{
+		AuthKey:  pemKey,
+		Topic:    "com.example.app",
+		Priority: provider.APNsPriorityImmediate,
+		Endpoint: server.URL,
+	}, provider.WithAPNsHTTPClient(server.Client()))
+	require.NoError(t, err)
+
+	badge := 3
+	res := p.Send("token", push.Message{
+		Notification: &push.Notification{
+			Title:    "Hello",
+			Body:     "World",
+			ImageURL: "https://example.com/image.png",
+			Link:     "app://inbox",
+			Sound:    "default",
+			Badge:    &badge,
+		},
+		Data:        map[string]string{"id": "42"},
+		TTL:         time.Hour,
+		CollapseKey: "inbox",
+		Priority:    push.PriorityNormal,
+		APNs: &push.APNsOverrides{
+			Subtitle: "Inbox",
+			ThreadID: "inbox",
+		},
+	})
+	require.NoError(t, res.Err)
+
+	assert.Equal(t, "alert", headers.Get("apns-push-type"))
+	assert.Equal(t, "5", headers.Get("apns-priority"))
+	assert.Equal(t, "inbox", headers.Get("apns-collapse-id"))
+	expiration, err := strconv.ParseInt(headers.Get("apns-expiration"), 10, 64)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Now().Add(time.Hour).Unix(), expiration, 5)
+
+	assert.Equal(t, map[string]interface{}{
+		"aps": map[string]interface{}{
+			"alert":           map[string]interface{}{"title": "Hello", "body": "World", "subtitle": "Inbox"},
+			"sound":           "default",
+			"badge":           float64(3),
+			"mutable-content": float64(1),
+			"thread-id":       "inbox",
+		},
+		"id":                            "42",
+		push.DataKeyLink:                "app://inbox",
+		provider.APNsPayloadKeyImageURL: "https://example.com/image.png",
+	}, payload)
+
+	// data messages are delivered silently
+	res = p.Send("token", push.Message{
+		Data:     map[string]string{"sync": "true"},
+		Priority: push.PriorityHigh,
+	})
+	require.NoError(t, res.Err)
+
+	assert.Equal(t, "background", headers.Get("apns-push-type"))
+	assert.Equal(t, "5", headers.Get("apns-priority"))
+	assert.Empty(t, headers.Get("apns-expiration"))
+	assert.Equal(t, map[string]interface{}{
+		"aps":  map[string]interface{}{"content-available": float64(1)},
+		"sync": "true",
+	}, payload)
+}
@@ -2,10 +2,17 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"os"
+	"strconv"
 
 	"allaboutapps.dev/aw/go-starter/internal/push"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/time/rate"
 	"google.golang.org/api/fcm/v1"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
@@ -14,24 +21,54 @@ import (
 type FCM struct {
 	Config  FCMConfig
 	service *fcm.Service
+	limiter *rate.Limiter
 }
 
 type FCMConfig struct {
 	GoogleApplicationCredentials string `json:"-"` // sensitive
 	ProjectID                    string
 	ValidateOnly                 bool
+	// Number of concurrent sends used by SendMulticast
+	Workers int
+	// Maximum number of sends per second to the project, 0 disables limiting
+	QPS int
 }
 
+// NewFCM creates a FCM provider. If GoogleApplicationCredentials is set, the service account credentials are loaded
+// from the given file, otherwise the application default credentials are used. Additional options (e.g. a custom
+// endpoint) are applied after the credentials.
 func NewFCM(config FCMConfig, opts ...option.ClientOption) (*FCM, error) {
 	ctx := context.Background()
+
+	if len(config.GoogleApplicationCredentials) > 0 {
+		credentialsJSON, err := os.ReadFile(config.GoogleApplicationCredentials)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read FCM credentials file: %w", err)
+		}
+
+		credentials, err := google.CredentialsFromJSON(ctx, credentialsJSON, fcm.FirebaseMessagingScope)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse FCM credentials: %w", err)
+		}
+
+		// the OAuth token is shared by all sends and only refreshed once expired
+		opts = append([]option.ClientOption{option.WithTokenSource(oauth2.ReuseTokenSource(nil, credentials.TokenSource))}, opts...)
+	}
+
 	fcmService, err := fcm.NewService(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	var limiter *rate.Limiter
+	if config.QPS > 0 {
+		limiter = rate.NewLimiter(rate.Limit(config.QPS), config.QPS)
+	}
+
 	return &FCM{
 		Config:  config,
 		service: fcmService,
+		limiter: limiter,
 	}, nil
 }
 
@@ -39,21 +76,24 @@ func (p *FCM) GetProviderType() push.ProviderType {
 	return push.ProviderTypeFCM
 }
 
-func (p *FCM) Send(token string, title string, message string) push.ProviderSendResponse {
+func (p *FCM) Send(token string, msg push.Message) push.ProviderSendResponse {
 	// https: //godoc.org/google.golang.org/api/fcm/v1#SendMessageRequest
 	// https://firebase.google.com/docs/cloud-messaging/send-message#rest
+	fcmMessage, err := fcmMessageFromMessage(token, msg)
+	if err != nil {
+		return push.ProviderSendResponse{
+			Token: token,
+			Valid: true,
+			Err:   err,
+		}
+	}
+
 	messageRequest := &fcm.SendMessageRequest{
 		ValidateOnly: p.Config.ValidateOnly,
-		Message: &fcm.Message{
-			Token: token,
-			Notification: &fcm.Notification{
-				Title: title,
-				Body:  message,
-			},
-		},
+		Message:      fcmMessage,
 	}
 
-	_, err := p.service.Projects.Messages.Send("projects/"+p.Config.ProjectID, messageRequest).Do()
+	_, err = p.service.Projects.Messages.Send("projects/"+p.Config.ProjectID, messageRequest).Do()
 	valid := true
 	if err != nil {
 
@@ -71,6 +111,67 @@ func (p *FCM) Send(token string, title string, message string) push.ProviderSend
 	}
 }
 
-func (p *FCM) SendMulticast(tokens []string, title, message string) []push.ProviderSendResponse {
-	return sendMulticastWithProvider(p, tokens, title, message)
+// SendMulticast sends the message to all tokens using up to Config.Workers concurrent requests, limited to Config.QPS
+// requests per second. The FCM v1 API has no batch endpoint, so each token is sent individually.
+func (p *FCM) SendMulticast(tokens []string, msg push.Message) []push.ProviderSendResponse {
+	return sendMulticastConcurrently(p, tokens, msg, p.Config.Workers, p.limiter)
+}
+
+// fcmMessageFromMessage maps the message to the FCM v1 message format, including the Android and APNs specific configs.
+func fcmMessageFromMessage(token string, msg push.Message) (*fcm.Message, error) {
+	fcmMessage := &fcm.Message{
+		Token: token,
+		Data:  msg.DataWithLink(),
+		Android: &fcm.AndroidConfig{
+			CollapseKey: msg.CollapseKey,
+		},
+	}
+
+	switch msg.Priority {
+	case push.PriorityHigh:
+		fcmMessage.Android.Priority = "HIGH"
+	case push.PriorityNormal:
+		fcmMessage.Android.Priority = "NORMAL"
+	}
+
+	if msg.TTL > 0 {
+		// durations are encoded in seconds with up to nine fractional digits, e.g. "3.5s"
+		fcmMessage.Android.Ttl = strconv.FormatFloat(msg.TTL.Seconds(), 'f', -1, 64) + "s"
+	}
+
+	if n := msg.Notification; n != nil {
+		fcmMessage.Notification = &fcm.Notification{
+			Title: n.Title,
+			Body:  n.Body,
+			Image: n.ImageURL,
+		}
+
+		androidNotification := &fcm.AndroidNotification{
+			Sound: n.Sound,
+		}
+		if n.Badge != nil {
+			androidNotification.NotificationCount = int64(*n.Badge)
+		}
+		if o := msg.Android; o != nil {
+			androidNotification.ChannelId = o.ChannelID
+			androidNotification.Icon = o.Icon
+			androidNotification.Color = o.Color
+			androidNotification.ClickAction = o.ClickAction
+			androidNotification.Tag = o.Tag
+		}
+		fcmMessage.Android.Notification = androidNotification
+	}
+
+	// the alert is built by FCM from the notification, the payload only holds the remaining fields of the aps dictionary
+	payload, err := json.Marshal(apnsPayload(msg, false))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode APNs payload: %w", err)
+	}
+
+	fcmMessage.Apns = &fcm.ApnsConfig{
+		Headers: apnsHeaders(msg, 0),
+		Payload: payload,
+	}
+
+	return fcmMessage, nil
 }
@@ -0,0 +1,296 @@
+package provider_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"allaboutapps.dev/aw/go-starter/internal/push/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+)
+
+type fcmTestRequest struct {
+	ValidateOnly bool `json:"validateOnly"`
+	Message      struct {
+		Token        string            `json:"token"`
+		Data         map[string]string `json:"data"`
+		Notification struct {
+			Title string `json:"title"`
+			Body  string `json:"body"`
+			Image string `json:"image"`
+		} `json:"notification"`
+		Android struct {
+			CollapseKey  string `json:"collapseKey"`
+			Priority     string `json:"priority"`
+			TTL          string `json:"ttl"`
+			Notification struct {
+				ChannelID         string `json:"channelId"`
+				Sound             string `json:"sound"`
+				NotificationCount int64  `json:"notificationCount"`
+			} `json:"notification"`
+		} `json:"android"`
+		APNs struct {
+			Headers map[string]string      `json:"headers"`
+			Payload map[string]interface{} `json:"payload"`
+		} `json:"apns"`
+	} `json:"message"`
+}
+
+// newFCMTestServer starts a local server mimicking the FCM v1 API, rejecting the device token "unregistered".
+func newFCMTestServer(t *testing.T, requests chan<- fcmTestRequest) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/projects/test-project/messages:send", r.URL.Path)
+
+		var req fcmTestRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests <- req
+
+		w.Header().Set("Content-Type", "application/json")
+		if req.Message.Token == "unregistered" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Requested entity was not found.","status":"NOT_FOUND"}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"name":"projects/test-project/messages/1"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestFCMSend(t *testing.T) {
+	requests := make(chan fcmTestRequest, 4)
+	server := newFCMTestServer(t, requests)
+
+	p, err := provider.NewFCM(provider.FCMConfig{ProjectID: "test-project", ValidateOnly: true}, option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	require.NoError(t, err)
+
+	badge := 2
+	res := p.Send("token", push.Message{
+		Notification: &push.Notification{
+			Title:    "Hello",
+			Body:     "World",
+			ImageURL: "https://example.com/image.png",
+			Link:     "app://inbox",
+			Sound:    "default",
+			Badge:    &badge,
+		},
+		Data:        map[string]string{"id": "42"},
+		TTL:         90 * time.Second,
+		CollapseKey: "inbox",
+		Priority:    push.PriorityHigh,
+		Android:     &push.AndroidOverrides{ChannelID: "messages"},
+	})
+	require.NoError(t, res.Err)
+	assert.True(t, res.Valid)
+
+	req := <-requests
+	assert.True(t, req.ValidateOnly)
+	assert.Equal(t, "token", req.Message.Token)
+	assert.Equal(t, map[string]string{"id": "42", push.DataKeyLink: "app://inbox"}, req.Message.Data)
+	assert.Equal(t, "Hello", req.Message.Notification.Title)
+	assert.Equal(t, "World", req.Message.Notification.Body)
+	assert.Equal(t, "https://example.com/image.png", req.Message.Notification.Image)
+	assert.Equal(t, "inbox", req.Message.Android.CollapseKey)
+	assert.Equal(t, "HIGH", req.Message.Android.Priority)
+	assert.Equal(t, "90s", req.Message.Android.TTL)
+	assert.Equal(t, "messages", req.Message.Android.Notification.ChannelID)
+	assert.Equal(t, "default", req.Message.Android.Notification.Sound)
+	assert.Equal(t, int64(2), req.Message.Android.Notification.NotificationCount)
+	assert.Equal(t, "alert", req.Message.APNs.Headers["apns-push-type"])
+	assert.Equal(t, "10", req.Message.APNs.Headers["apns-priority"])
+	assert.Equal(t, "inbox", req.Message.APNs.Headers["apns-collapse-id"])
+	assert.Equal(t, map[string]interface{}{
+		"aps": map[string]interface{}{
+			"sound":           "default",
+			"badge":           float64(2),
+			"mutable-content": float64(1),
+		},
+	}, req.Message.APNs.Payload)
+
+	// data messages are delivered silently
+	res = p.Send("token", push.Message{Data: map[string]string{"sync": "true"}})
+	require.NoError(t, res.Err)
+
+	req = <-requests
+	assert.Empty(t, req.Message.Notification.Title)
+	assert.Equal(t, map[string]string{"sync": "true"}, req.Message.Data)
+	assert.Equal(t, "background", req.Message.APNs.Headers["apns-push-type"])
+	assert.Equal(t, map[string]interface{}{"aps": map[string]interface{}{"content-available": float64(1)}}, req.Message.APNs.Payload)
+
+	res = p.Send("unregistered", helloMessage)
+	assert.Error(t, res.Err)
+	assert.False(t, res.Valid)
+}
+
+// newFCMMulticastTestServer starts a local server mimicking the FCM v1 API which rejects device tokens prefixed with
+// "unregistered", requires the bearer token "test-access-token" if auth is set and tracks the maximum number of
+// concurrent requests.
+func newFCMMulticastTestServer(t *testing.T, auth bool, maxConcurrent *int32) *httptest.Server {
+	t.Helper()
+
+	var concurrent int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&concurrent, 1)
+		defer atomic.AddInt32(&concurrent, -1)
+		for {
+			max := atomic.LoadInt32(maxConcurrent)
+			if current <= max || atomic.CompareAndSwapInt32(maxConcurrent, max, current) {
+				break
+			}
+		}
+
+		if auth && !assert.Equal(t, "Bearer test-access-token", r.Header.Get("Authorization")) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var req fcmTestRequest
+		if !assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// keep the request in flight for a moment to provoke concurrent requests
+		time.Sleep(10 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasPrefix(req.Message.Token, "unregistered") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Requested entity was not found.","status":"NOT_FOUND"}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"name":"projects/test-project/messages/1"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestFCMSendMulticast(t *testing.T) {
+	var maxConcurrent int32
+	server := newFCMMulticastTestServer(t, false, &maxConcurrent)
+
+	p, err := provider.NewFCM(provider.FCMConfig{ProjectID: "test-project", Workers: 4}, option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	require.NoError(t, err)
+
+	tokens := make([]string, 0, 20)
+	for i := 0; i < 20; i++ {
+		if i%3 == 0 {
+			tokens = append(tokens, fmt.Sprintf("unregistered-%d", i))
+		} else {
+			tokens = append(tokens, fmt.Sprintf("token-%d", i))
+		}
+	}
+
+	res := p.SendMulticast(tokens, helloMessage)
+	require.Len(t, res, len(tokens))
+
+	// responses keep the order of the tokens
+	for i, token := range tokens {
+		assert.Equal(t, token, res[i].Token)
+		if strings.HasPrefix(token, "unregistered") {
+			assert.Error(t, res[i].Err)
+			assert.False(t, res[i].Valid)
+		} else {
+			assert.NoError(t, res[i].Err)
+			assert.True(t, res[i].Valid)
+		}
+	}
+
+	assert.Greater(t, atomic.LoadInt32(&maxConcurrent), int32(1))
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxConcurrent), int32(4))
+
+	assert.Empty(t, p.SendMulticast(nil, helloMessage))
+}
+
+func TestFCMSendMulticastQPS(t *testing.T) {
+	var maxConcurrent int32
+	server := newFCMMulticastTestServer(t, false, &maxConcurrent)
+
+	p, err := provider.NewFCM(provider.FCMConfig{ProjectID: "test-project", Workers: 10, QPS: 20}, option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	require.NoError(t, err)
+
+	tokens := make([]string, 30)
+	for i := range tokens {
+		tokens[i] = fmt.Sprintf("token-%d", i)
+	}
+
+	// a burst of 20 sends is allowed, the remaining 10 are spread over half a second
+	start := time.Now()
+	res := p.SendMulticast(tokens, helloMessage)
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+
+	for i := range res {
+		assert.NoError(t, res[i].Err)
+	}
+}
+
+func TestFCMReusesAccessToken(t *testing.T) {
+	var tokenRequests int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&tokenRequests, 1)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"test-access-token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	t.Cleanup(tokenServer.Close)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	credentials, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     "test-project",
+		"private_key_id": "test-key",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+		"client_email":   "push@test-project.iam.gserviceaccount.com",
+		"token_uri":      tokenServer.URL,
+	})
+	require.NoError(t, err)
+
+	credentialsFile := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(credentialsFile, credentials, 0600))
+
+	var maxConcurrent int32
+	server := newFCMMulticastTestServer(t, true, &maxConcurrent)
+
+	p, err := provider.NewFCM(provider.FCMConfig{
+		GoogleApplicationCredentials: credentialsFile,
+		ProjectID:                    "test-project",
+		Workers:                      4,
+	}, option.WithEndpoint(server.URL))
+	require.NoError(t, err)
+
+	tokens := make([]string, 10)
+	for i := range tokens {
+		tokens[i] = fmt.Sprintf("token-%d", i)
+	}
+
+	for _, res := range p.SendMulticast(tokens, helloMessage) {
+		assert.NoError(t, res.Err)
+	}
+	res := p.Send("token", helloMessage)
+	assert.NoError(t, res.Err)
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&tokenRequests))
+}
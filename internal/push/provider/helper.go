@@ -1,13 +1,64 @@
 package provider
 
-import "allaboutapps.dev/aw/go-starter/internal/push"
+import (
+	"context"
+	"sync"
 
-func sendMulticastWithProvider(p push.Provider, tokens []string, title, message string) []push.ProviderSendResponse {
+	"allaboutapps.dev/aw/go-starter/internal/push"
+	"golang.org/x/time/rate"
+)
+
+func sendMulticastWithProvider(p push.Provider, tokens []string, msg push.Message) []push.ProviderSendResponse {
 	responseSlice := make([]push.ProviderSendResponse, 0)
 
 	for _, token := range tokens {
-		responseSlice = append(responseSlice, p.Send(token, title, message))
+		responseSlice = append(responseSlice, p.Send(token, msg))
+	}
+
+	return responseSlice
+}
+
+// sendMulticastConcurrently sends the message to the tokens using up to workers concurrent sends, waiting for the
+// limiter (if any) before each send. Responses are returned in the same order as the tokens.
+func sendMulticastConcurrently(p push.Provider, tokens []string, msg push.Message, workers int, limiter *rate.Limiter) []push.ProviderSendResponse {
+	responseSlice := make([]push.ProviderSendResponse, len(tokens))
+
+	if workers > len(tokens) {
+		workers = len(tokens)
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	indices := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range indices {
+				if limiter != nil {
+					if err := limiter.Wait(context.Background()); err != nil {
+						responseSlice[i] = push.ProviderSendResponse{
+							Token: tokens[i],
+							Valid: true,
+							Err:   err,
+						}
+						continue
+					}
+				}
+
+				responseSlice[i] = p.Send(tokens[i], msg)
+			}
+		}()
+	}
+
+	for i := range tokens {
+		indices <- i
 	}
+	close(indices)
+	wg.Wait()
 
 	return responseSlice
 }
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"errors"
+	"sync"
 
 	"allaboutapps.dev/aw/go-starter/internal/push"
 	"github.com/rs/zerolog/log"
@@ -9,6 +10,14 @@ import (
 
 type Mock struct {
 	Type push.ProviderType
+
+	sentMutex sync.Mutex
+	sent      []MockSentMessage
+}
+
+type MockSentMessage struct {
+	Token   string
+	Message push.Message
 }
 
 func NewMock(providerType push.ProviderType) *Mock {
@@ -21,7 +30,13 @@ func (p *Mock) GetProviderType() push.ProviderType {
 	return p.Type
 }
 
-func (p *Mock) Send(token string, title string, message string) push.ProviderSendResponse {
+func (p *Mock) Send(token string, msg push.Message) push.ProviderSendResponse {
+	var title, body string
+	if msg.Notification != nil {
+		title = msg.Notification.Title
+		body = msg.Notification.Body
+	}
+
 	valid := true
 	var err error
 	if len(token) < 40 {
@@ -33,7 +48,11 @@ func (p *Mock) Send(token string, title string, message string) push.ProviderSen
 		err = errors.New("other error")
 	}
 
-	log.Info().Str("token", token).Str("title", title).Str("message", message).Msg("Mock Push Notification")
+	log.Info().Str("token", token).Str("title", title).Str("message", body).Interface("data", msg.DataWithLink()).Msg("Mock Push Notification")
+
+	p.sentMutex.Lock()
+	p.sent = append(p.sent, MockSentMessage{Token: token, Message: msg})
+	p.sentMutex.Unlock()
 
 	return push.ProviderSendResponse{
 		Token: token,
@@ -42,6 +61,14 @@ func (p *Mock) Send(token string, title string, message string) push.ProviderSen
 	}
 }
 
-func (p *Mock) SendMulticast(tokens []string, title, message string) []push.ProviderSendResponse {
-	return sendMulticastWithProvider(p, tokens, title, message)
+func (p *Mock) SendMulticast(tokens []string, msg push.Message) []push.ProviderSendResponse {
+	return sendMulticastWithProvider(p, tokens, msg)
+}
+
+// SentMessages returns all messages sent via the mock provider so far, including ones sent to invalid tokens.
+func (p *Mock) SentMessages() []MockSentMessage {
+	p.sentMutex.Lock()
+	defer p.sentMutex.Unlock()
+
+	return append([]MockSentMessage{}, p.sent...)
 }
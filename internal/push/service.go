@@ -7,6 +7,8 @@ import (
 
 	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/util"
+	"github.com/volatiletech/null/v8"
+	"golang.org/x/text/language"
 )
 
 type ProviderType string
@@ -17,8 +19,10 @@ const (
 )
 
 type Service struct {
-	DB       *sql.DB
-	provider map[ProviderType]Provider
+	DB         *sql.DB
+	config     OutboxConfig
+	translator Translator
+	provider   map[ProviderType]Provider
 }
 
 type ProviderSendResponse struct {
@@ -34,15 +38,17 @@ type ProviderSendResponse struct {
 }
 
 type Provider interface {
-	Send(token string, title string, message string) ProviderSendResponse
-	SendMulticast(tokens []string, title, message string) []ProviderSendResponse
+	Send(token string, msg Message) ProviderSendResponse
+	SendMulticast(tokens []string, msg Message) []ProviderSendResponse
 	GetProviderType() ProviderType
 }
 
-func New(db *sql.DB) *Service {
+func New(db *sql.DB, translator Translator, config OutboxConfig) *Service {
 	return &Service{
-		DB:       db,
-		provider: make(map[ProviderType]Provider),
+		DB:         db,
+		config:     config,
+		translator: translator,
+		provider:   make(map[ProviderType]Provider),
 	}
 }
 
@@ -58,42 +64,76 @@ func (s *Service) GetProviderCount() int {
 	return len(s.provider)
 }
 
-func (s *Service) SendToUser(ctx context.Context, user *models.User, title string, message string) error {
+// SendToUser enqueues the message for delivery to all devices of the user with a push token of a registered provider.
+// The i18n keys of the message's notification are resolved in the user's locale. Messages are delivered
+// asynchronously by the workers (see RunWorkers), their status can be queried via the deliveries of the returned message.
+func (s *Service) SendToUser(ctx context.Context, user *models.User, msg Message) (*models.PushMessage, error) {
 	if s.GetProviderCount() < 1 {
-		return errors.New("No provider found")
+		return nil, errors.New("No provider found")
 	}
-	log := util.LogFromContext(ctx)
 
-	for k, p := range s.provider {
-		// get all registered tokens for provider
-		pushTokens, err := user.PushTokens(models.PushTokenWhere.Provider.EQ(string(k))).All(ctx, s.DB)
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if msg.Notification != nil && msg.Notification.HasKeys() {
+		lang, err := s.userLanguage(ctx, user)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		var tokens []string
-		for _, token := range pushTokens {
-			tokens = append(tokens, token.Token)
-		}
+		msg = msg.Localized(s.translator, lang)
+	}
 
-		responseSlice := p.SendMulticast(tokens, title, message)
-		tokenToDelete := make([]string, 0)
-		for _, res := range responseSlice {
-			if res.Err != nil && res.Valid {
-				log.Debug().Err(res.Err).Str("token", res.Token).Str("provider", string(p.GetProviderType())).Msgf("Error while sending push message to provider with valid token.")
-			}
+	providerTypes := make([]string, 0, len(s.provider))
+	for k := range s.provider {
+		providerTypes = append(providerTypes, string(k))
+	}
 
-			if !res.Valid {
-				tokenToDelete = append(tokenToDelete, res.Token)
-			}
-		}
-		// delete invalid tokens
-		_, err = user.PushTokens(models.PushTokenWhere.Token.IN(tokenToDelete)).DeleteAll(ctx, s.DB)
-		if err != nil {
-			log.Debug().Err(err).Str("provider", string(p.GetProviderType())).Msg("Could not delete invalid tokens for provider")
-			return err
+	pushTokens, err := user.PushTokens(models.PushTokenWhere.Provider.IN(providerTypes)).All(ctx, s.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	pushMessage := &models.PushMessage{
+		UserID: null.StringFrom(user.ID),
+	}
+	if err := s.enqueue(ctx, pushMessage, msg, pushTokens); err != nil {
+		return nil, err
+	}
+
+	util.LogFromContext(ctx).Debug().Str("user_id", user.ID).Str("push_message_id", pushMessage.ID).Int("deliveries", len(pushTokens)).Msg("Enqueued push message")
+
+	return pushMessage, nil
+}
+
+// userLanguage returns the language of the user's locale, falling back to language.Und (resolved to the default
+// language by the translator) if the user has no profile or locale set.
+func (s *Service) userLanguage(ctx context.Context, user *models.User) (language.Tag, error) {
+	profile, err := user.AppUserProfile().One(ctx, s.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return language.Und, nil
 		}
+
+		return language.Und, err
+	}
+
+	return parseLocale(ctx, user.ID, profile.Locale), nil
+}
+
+// parseLocale returns the language of the given locale of a user, falling back to language.Und if it is not set
+// or invalid.
+func parseLocale(ctx context.Context, userID string, locale null.String) language.Tag {
+	if !locale.Valid {
+		return language.Und
+	}
+
+	lang, err := language.Parse(locale.String)
+	if err != nil {
+		util.LogFromContext(ctx).Debug().Err(err).Str("user_id", userID).Str("locale", locale.String).Msg("Failed to parse locale of user, using default language")
+		return language.Und
 	}
 
-	return nil
+	return lang
 }
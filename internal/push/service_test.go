@@ -3,17 +3,31 @@ package push_test
 import (
 	"context"
 	"database/sql"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/i18n"
 	"allaboutapps.dev/aw/go-starter/internal/models"
 	"allaboutapps.dev/aw/go-starter/internal/push"
 	"allaboutapps.dev/aw/go-starter/internal/push/provider"
 	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"golang.org/x/text/language"
 )
 
+var helloMessage = push.Message{
+	Notification: &push.Notification{
+		Title: "Hello",
+		Body:  "World",
+	},
+}
+
 func TestSendMessageSuccess(t *testing.T) {
 	test.WithTestPusher(t, func(p *push.Service, db *sql.DB) {
 		ctx := context.Background()
@@ -21,8 +35,34 @@ func TestSendMessageSuccess(t *testing.T) {
 
 		user1 := fixtures.User1
 
-		err := p.SendToUser(ctx, user1, "Hello", "World")
-		assert.NoError(t, err)
+		pushMessage, err := p.SendToUser(ctx, user1, helloMessage)
+		require.NoError(t, err)
+
+		// messages are delivered asynchronously
+		deliveries, err := pushMessage.PushDeliveries().All(ctx, db)
+		require.NoError(t, err)
+		// only tokens of registered providers receive the message
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, fixtures.User1PushToken.Token, deliveries[0].Token)
+		assert.Equal(t, push.MessageStatusPending, push.MessageStatusOf(deliveries))
+
+		processed, err := p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+
+		deliveries, err = pushMessage.PushDeliveries().All(ctx, db)
+		require.NoError(t, err)
+		assert.Equal(t, push.MessageStatusDelivered, push.MessageStatusOf(deliveries))
+		for _, delivery := range deliveries {
+			assert.Equal(t, models.PushDeliveryStatusDelivered, delivery.Status)
+			assert.Equal(t, 1, delivery.Attempts)
+			assert.True(t, delivery.DeliveredAt.Valid)
+		}
+
+		// nothing left to deliver
+		processed, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, processed)
 
 		tokenCount, err2 := user1.PushTokens().Count(ctx, db)
 		require.NoError(t, err2)
@@ -38,8 +78,22 @@ func TestSendMessageSuccessWithGenericError(t *testing.T) {
 		user1 := fixtures.User1
 
 		// provoke error from mock provider
-		err := p.SendToUser(ctx, user1, "other error", "World")
-		assert.NoError(t, err)
+		pushMessage, err := p.SendToUser(ctx, user1, push.Message{Notification: &push.Notification{Title: "other error", Body: "World"}})
+		require.NoError(t, err)
+
+		_, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+
+		// transient errors are retried later
+		deliveries, err := pushMessage.PushDeliveries().All(ctx, db)
+		require.NoError(t, err)
+		assert.Equal(t, push.MessageStatusPending, push.MessageStatusOf(deliveries))
+		for _, delivery := range deliveries {
+			assert.Equal(t, models.PushDeliveryStatusPending, delivery.Status)
+			assert.Equal(t, 1, delivery.Attempts)
+			assert.Equal(t, "other error", delivery.LastError.String)
+			assert.True(t, delivery.NextAttemptAt.After(time.Now()))
+		}
 
 		tokenCount, err2 := user1.PushTokens().Count(ctx, db)
 		require.NoError(t, err2)
@@ -66,8 +120,19 @@ func TestSendMessageWithInvalidToken(t *testing.T) {
 		require.NoError(t, err2)
 		require.Equal(t, int64(3), tokenCount)
 
-		err = p.SendToUser(ctx, user1, "Hello", "World")
-		assert.NoError(t, err)
+		pushMessage, err := p.SendToUser(ctx, user1, helloMessage)
+		require.NoError(t, err)
+
+		_, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+
+		invalidDelivery, err := pushMessage.PushDeliveries(models.PushDeliveryWhere.Token.EQ(user1InvalidPushToken.Token)).One(ctx, db)
+		require.NoError(t, err)
+		assert.Equal(t, models.PushDeliveryStatusInvalidToken, invalidDelivery.Status)
+
+		deliveries, err := pushMessage.PushDeliveries().All(ctx, db)
+		require.NoError(t, err)
+		assert.Equal(t, push.MessageStatusPartiallyDelivered, push.MessageStatusOf(deliveries))
 
 		tokenCount, err2 = user1.PushTokens().Count(ctx, db)
 		require.NoError(t, err2)
@@ -86,7 +151,7 @@ func TestSendMessageWithNoProvider(t *testing.T) {
 
 		user1 := fixtures.User1
 
-		err := p.SendToUser(ctx, user1, "Hello", "World")
+		_, err := p.SendToUser(ctx, user1, helloMessage)
 		assert.Error(t, err)
 
 		tokenCount, err2 := user1.PushTokens().Count(ctx, db)
@@ -109,11 +174,79 @@ func TestSendMessageWithMultipleProvider(t *testing.T) {
 		p.RegisterProvider(mockProviderFCM)
 		user1 := fixtures.User1
 
-		err := p.SendToUser(ctx, user1, "Hello", "World")
-		assert.NoError(t, err)
+		_, err := p.SendToUser(ctx, user1, helloMessage)
+		require.NoError(t, err)
+
+		_, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
 
 		tokenCount, err2 := user1.PushTokens().Count(ctx, db)
 		require.NoError(t, err2)
 		assert.Equal(t, int64(1), tokenCount)
 	})
 }
+
+func TestSendMessageEmpty(t *testing.T) {
+	test.WithTestPusher(t, func(p *push.Service, db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		_, err := p.SendToUser(ctx, fixtures.User1, push.Message{})
+		assert.ErrorIs(t, err, push.ErrMessageEmpty)
+	})
+}
+
+func TestSendMessageLocalized(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+
+		translator, err := i18n.New(config.I18n{
+			DefaultLanguage: language.English,
+			BundleDirAbs:    filepath.Join(util.GetProjectRootDir(), "/internal/i18n/testdata/i18n"),
+		})
+		require.NoError(t, err)
+
+		p := push.New(db, translator, config.DefaultServiceConfigFromEnv().Push.Outbox)
+		mockProvider := provider.NewMock(push.ProviderTypeFCM)
+		p.RegisterProvider(mockProvider)
+
+		msg := push.Message{
+			Notification: &push.Notification{
+				TitleKey:     "Test.Welcome",
+				BodyKey:      "Test.Body",
+				TemplateData: map[string]string{"Name": "Hans"},
+				Link:         "app://inbox",
+			},
+			Data: map[string]string{"id": "42"},
+		}
+
+		// users without locale receive messages in the default language
+		_, err = p.SendToUser(ctx, fixtures.User1, msg)
+		require.NoError(t, err)
+		_, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+
+		sent := mockProvider.SentMessages()
+		require.NotEmpty(t, sent)
+		assert.Equal(t, "Welcome Hans", sent[0].Message.Notification.Title)
+		assert.Equal(t, "This is a test", sent[0].Message.Notification.Body)
+		assert.Equal(t, map[string]string{"id": "42", push.DataKeyLink: "app://inbox"}, sent[0].Message.DataWithLink())
+
+		fixtures.User1AppUserProfile.Locale = null.StringFrom("de-AT")
+		_, err = fixtures.User1AppUserProfile.Update(ctx, db, boil.Whitelist(models.AppUserProfileColumns.Locale))
+		require.NoError(t, err)
+
+		_, err = p.SendToUser(ctx, fixtures.User1, msg)
+		require.NoError(t, err)
+		_, err = p.ProcessDeliveries(ctx)
+		require.NoError(t, err)
+
+		sent = mockProvider.SentMessages()
+		assert.Equal(t, "Guten Tag Hans", sent[len(sent)-1].Message.Notification.Title)
+		assert.Equal(t, "Das ist ein Test", sent[len(sent)-1].Message.Notification.Body)
+
+		// the message passed is not modified
+		assert.Empty(t, msg.Notification.Title)
+	})
+}
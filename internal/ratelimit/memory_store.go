@@ -0,0 +1,61 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memoryCounter struct {
+	windowStart time.Time
+	counts      Counts
+}
+
+// MemoryStore keeps request counters in memory. As counters are not shared between replicas,
+// it is only suitable for tests and single instance deployments.
+type MemoryStore struct {
+	sync.Mutex
+	counters map[string]memoryCounter
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		Mutex:    sync.Mutex{},
+		counters: make(map[string]memoryCounter),
+	}
+}
+
+func (m *MemoryStore) Increment(_ context.Context, key string, windowStart time.Time, period time.Duration) (Counts, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	c := m.counters[key]
+	switch {
+	case c.windowStart.Equal(windowStart):
+		c.counts.Current++
+	case c.windowStart.Equal(windowStart.Add(-period)):
+		c.counts = Counts{Current: 1, Previous: c.counts.Current}
+	default:
+		c.counts = Counts{Current: 1, Previous: 0}
+	}
+
+	c.windowStart = windowStart
+	m.counters[key] = c
+
+	return c.counts, nil
+}
+
+func (m *MemoryStore) Purge(_ context.Context, windowStartBefore time.Time) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	var purged int64
+	for key, c := range m.counters {
+		if c.windowStart.Before(windowStartBefore) {
+			delete(m.counters, key)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
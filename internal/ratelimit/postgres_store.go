@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+)
+
+// PostgresStore keeps request counters in the rate_limit_counters table, sharing them between all replicas.
+type PostgresStore struct {
+	db *sql.DB
+}
+
+func NewPostgresStore(db *sql.DB) *PostgresStore {
+	return &PostgresStore{
+		db: db,
+	}
+}
+
+// Increment atomically upserts the counter of the given key, so concurrent requests are never lost.
+func (p *PostgresStore) Increment(ctx context.Context, key string, windowStart time.Time, period time.Duration) (Counts, error) {
+	var counts Counts
+
+	if err := p.db.QueryRowContext(ctx, `
+		INSERT INTO rate_limit_counters (key, window_start, count, previous_count, created_at, updated_at)
+			VALUES ($1, $2, 1, 0, $4, $4)
+		ON CONFLICT (key)
+			DO UPDATE SET
+				previous_count = CASE WHEN rate_limit_counters.window_start = $2 THEN rate_limit_counters.previous_count
+					WHEN rate_limit_counters.window_start = $3 THEN rate_limit_counters.count
+					ELSE 0 END,
+				count = CASE WHEN rate_limit_counters.window_start = $2 THEN rate_limit_counters.count + 1 ELSE 1 END,
+				window_start = $2,
+				updated_at = $4
+		RETURNING count, previous_count;`,
+		key, windowStart, windowStart.Add(-period), time.Now(),
+	).Scan(&counts.Current, &counts.Previous); err != nil {
+		return Counts{}, err
+	}
+
+	return counts, nil
+}
+
+func (p *PostgresStore) Purge(ctx context.Context, windowStartBefore time.Time) (int64, error) {
+	return models.RateLimitCounters(models.RateLimitCounterWhere.WindowStart.LT(windowStartBefore)).DeleteAll(ctx, p.db)
+}
@@ -0,0 +1,67 @@
+package ratelimit_test
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/ratelimit"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresStoreIncrement(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		store := ratelimit.NewPostgresStore(db)
+		windowStart := time.Now().Truncate(time.Minute)
+
+		counts, err := store.Increment(ctx, "auth:ip:203.0.113.42", windowStart, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, ratelimit.Counts{Current: 1, Previous: 0}, counts)
+
+		counts, err = store.Increment(ctx, "auth:ip:203.0.113.42", windowStart, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, ratelimit.Counts{Current: 2, Previous: 0}, counts)
+
+		counts, err = store.Increment(ctx, "auth:ip:203.0.113.42", windowStart.Add(time.Minute), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, ratelimit.Counts{Current: 1, Previous: 2}, counts)
+
+		counts, err = store.Increment(ctx, "auth:ip:203.0.113.42", windowStart.Add(time.Minute*3), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, ratelimit.Counts{Current: 1, Previous: 0}, counts)
+	})
+}
+
+func TestPostgresStoreConcurrentIncrements(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		store := ratelimit.NewPostgresStore(db)
+		windowStart := time.Now().Truncate(time.Minute)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := store.Increment(ctx, "push:user:1", windowStart, time.Minute)
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		counts, err := store.Increment(ctx, "push:user:1", windowStart, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, 11, counts.Current)
+	})
+}
+
+func TestPostgresStorePurge(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		testLimiterPurge(t, ratelimit.NewPostgresStore(db))
+	})
+}
@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+)
+
+// Result describes the state of a key's limit after a request has been counted.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Time until the current window ends
+	Reset time.Duration
+}
+
+// Limiter enforces rate limits using a sliding window, approximated by weighting the previous
+// fixed window's count by its overlap with the sliding window. Rejected requests are counted as well,
+// so clients ignoring the limit stay limited.
+type Limiter struct {
+	store Store
+}
+
+func New(store Store) *Limiter {
+	return &Limiter{
+		store: store,
+	}
+}
+
+// Allow counts a request of the given key at the given time and reports whether it is within the policy's limit.
+func (l *Limiter) Allow(ctx context.Context, key string, policy config.RateLimitPolicy, now time.Time) (Result, error) {
+	windowStart := now.Truncate(policy.Period)
+
+	counts, err := l.store.Increment(ctx, key, windowStart, policy.Period)
+	if err != nil {
+		return Result{}, err
+	}
+
+	elapsed := now.Sub(windowStart)
+	estimated := float64(counts.Previous)*(1-float64(elapsed)/float64(policy.Period)) + float64(counts.Current)
+
+	remaining := int(math.Floor(float64(policy.Limit) - estimated))
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return Result{
+		Allowed:   estimated <= float64(policy.Limit),
+		Limit:     policy.Limit,
+		Remaining: remaining,
+		Reset:     policy.Period - elapsed,
+	}, nil
+}
+
+// Purge removes the counters of all keys which no longer affect any limit at the given time, returning the number of
+// keys purged. Counters are only considered by the sliding window until two periods after their window started,
+// so maxPeriod must be at least the longest period of all policies in use.
+func (l *Limiter) Purge(ctx context.Context, now time.Time, maxPeriod time.Duration) (int64, error) {
+	return l.store.Purge(ctx, now.Add(-2*maxPeriod))
+}
@@ -0,0 +1,122 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiterAllow(t *testing.T) {
+	ctx := context.Background()
+	l := ratelimit.New(ratelimit.NewMemoryStore())
+	policy := config.RateLimitPolicy{Limit: 3, Period: time.Minute}
+	windowStart := time.Now().Truncate(time.Minute)
+	now := windowStart.Add(time.Second * 15)
+
+	for i := 0; i < 3; i++ {
+		res, err := l.Allow(ctx, "ip:203.0.113.42", policy, now)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, 3, res.Limit)
+		assert.Equal(t, 2-i, res.Remaining)
+		assert.Equal(t, time.Second*45, res.Reset)
+	}
+
+	res, err := l.Allow(ctx, "ip:203.0.113.42", policy, now)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+
+	// other keys are counted separately
+	res, err = l.Allow(ctx, "ip:198.51.100.7", policy, now)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 2, res.Remaining)
+}
+
+func TestLimiterSlidingWindow(t *testing.T) {
+	ctx := context.Background()
+	l := ratelimit.New(ratelimit.NewMemoryStore())
+	policy := config.RateLimitPolicy{Limit: 4, Period: time.Minute}
+	windowStart := time.Now().Truncate(time.Minute)
+
+	for i := 0; i < 4; i++ {
+		res, err := l.Allow(ctx, "user:1", policy, windowStart.Add(time.Second*50))
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+	}
+
+	// a quarter into the next window, three quarters of the previous window's requests are still counted
+	res, err := l.Allow(ctx, "user:1", policy, windowStart.Add(time.Second*75))
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+
+	res, err = l.Allow(ctx, "user:1", policy, windowStart.Add(time.Second*75))
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+
+	// once the previous window has passed completely, its requests are forgotten
+	res, err = l.Allow(ctx, "user:1", policy, windowStart.Add(time.Second*180))
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 3, res.Remaining)
+}
+
+func TestMemoryStoreIncrement(t *testing.T) {
+	ctx := context.Background()
+	store := ratelimit.NewMemoryStore()
+	windowStart := time.Now().Truncate(time.Minute)
+
+	counts, err := store.Increment(ctx, "auth:ip:203.0.113.42", windowStart, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, ratelimit.Counts{Current: 1, Previous: 0}, counts)
+
+	counts, err = store.Increment(ctx, "auth:ip:203.0.113.42", windowStart, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, ratelimit.Counts{Current: 2, Previous: 0}, counts)
+
+	counts, err = store.Increment(ctx, "auth:ip:203.0.113.42", windowStart.Add(time.Minute), time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, ratelimit.Counts{Current: 1, Previous: 2}, counts)
+
+	counts, err = store.Increment(ctx, "auth:ip:203.0.113.42", windowStart.Add(time.Minute*3), time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, ratelimit.Counts{Current: 1, Previous: 0}, counts)
+}
+
+// testLimiterPurge ensures the limiter only purges counters of the given store which no longer affect any limit.
+func testLimiterPurge(t *testing.T, store ratelimit.Store) {
+	t.Helper()
+
+	ctx := context.Background()
+	l := ratelimit.New(store)
+	policy := config.RateLimitPolicy{Limit: 2, Period: time.Minute}
+	now := time.Now()
+
+	_, err := l.Allow(ctx, "auth:ip:203.0.113.1", policy, now.Add(-time.Minute*3))
+	require.NoError(t, err)
+
+	// the previous window of this key is still weighted into the sliding window
+	for i := 0; i < 2; i++ {
+		_, err = l.Allow(ctx, "auth:ip:203.0.113.2", policy, now.Add(-time.Minute))
+		require.NoError(t, err)
+	}
+
+	purged, err := l.Purge(ctx, now, policy.Period)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), purged)
+
+	res, err := l.Allow(ctx, "auth:ip:203.0.113.2", policy, now.Truncate(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+}
+
+func TestLimiterPurge(t *testing.T) {
+	testLimiterPurge(t, ratelimit.NewMemoryStore())
+}
@@ -0,0 +1,21 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Counts holds the number of requests of a key within the current and the previous fixed window.
+type Counts struct {
+	Current  int
+	Previous int
+}
+
+type Store interface {
+	// Increment counts a request of the given key within the window starting at windowStart,
+	// rolling the counts over if a new window of the given period has started since the last request.
+	Increment(ctx context.Context, key string, windowStart time.Time, period time.Duration) (Counts, error)
+	// Purge removes the counters of all keys whose current window started before the given time,
+	// returning the number of keys purged.
+	Purge(ctx context.Context, windowStartBefore time.Time) (int64, error)
+}
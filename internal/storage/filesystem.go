@@ -0,0 +1,222 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/sys/unix"
+)
+
+var (
+	ErrSignatureInvalid = errors.New("signature invalid")
+	ErrSignatureExpired = errors.New("signature expired")
+)
+
+const (
+	SignedURLQueryKey       = "key"
+	SignedURLQueryExpires   = "expires"
+	SignedURLQuerySignature = "signature"
+)
+
+// Filesystem stores blobs as files below a base directory. As the filesystem cannot hand out URLs itself,
+// signed URLs point to the server, which verifies them using VerifySignedURL before serving the blob.
+type Filesystem struct {
+	config     config.StorageFilesystem
+	signingKey []byte
+}
+
+func NewFilesystem(cfg config.StorageFilesystem) (*Filesystem, error) {
+	if len(cfg.BaseDirAbs) == 0 {
+		return nil, errors.New("storage filesystem base directory is not set")
+	}
+
+	signingKey := []byte(cfg.SigningKey)
+	if len(signingKey) == 0 {
+		log.Warn().Msg("Storage filesystem signing key is not set, using a random key. Signed URLs will not be valid across restarts or replicas.")
+
+		signingKey = make([]byte, 32)
+		if _, err := rand.Read(signingKey); err != nil {
+			return nil, fmt.Errorf("failed to generate storage filesystem signing key: %w", err)
+		}
+	}
+
+	return &Filesystem{
+		config:     cfg,
+		signingKey: signingKey,
+	}, nil
+}
+
+func (f *Filesystem) Put(_ context.Context, key string, r io.Reader, _ int64, _ string) error {
+	filePath, err := f.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	// write to a temporary file first, so readers never observe partially written blobs
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".tmp-"+filepath.Base(filePath)+"-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary blob file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary blob file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return fmt.Errorf("failed to move blob into place: %w", err)
+	}
+
+	return nil
+}
+
+func (f *Filesystem) Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	info, err := f.Stat(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filePath, err := f.path(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil, ErrNotFound
+		}
+
+		return nil, nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+
+	return file, info, nil
+}
+
+// Stat returns the info of the blob with the given key. As no metadata is stored alongside files,
+// the content type is detected from the content.
+func (f *Filesystem) Stat(_ context.Context, key string) (*BlobInfo, error) {
+	filePath, err := f.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := os.Stat(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to stat blob: %w", err)
+	}
+
+	if stat.IsDir() {
+		return nil, ErrNotFound
+	}
+
+	mime, err := mimetype.DetectFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect blob content type: %w", err)
+	}
+
+	return &BlobInfo{
+		Key:         key,
+		Size:        stat.Size(),
+		ContentType: mime.String(),
+		ModTime:     stat.ModTime(),
+	}, nil
+}
+
+func (f *Filesystem) Delete(_ context.Context, key string) error {
+	filePath, err := f.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+
+	return nil
+}
+
+func (f *Filesystem) SignedURL(_ context.Context, key string, expiry time.Duration) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+
+	expires := strconv.FormatInt(time.Now().Add(expiry).Unix(), 10)
+
+	query := url.Values{}
+	query.Set(SignedURLQueryKey, key)
+	query.Set(SignedURLQueryExpires, expires)
+	query.Set(SignedURLQuerySignature, f.sign(key, expires))
+
+	return f.config.SignedURLBase + "?" + query.Encode(), nil
+}
+
+// VerifySignedURL checks the query parameters of a URL returned by SignedURL.
+func (f *Filesystem) VerifySignedURL(key string, expires string, signature string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+
+	if !hmac.Equal([]byte(f.sign(key, expires)), []byte(signature)) {
+		return ErrSignatureInvalid
+	}
+
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrSignatureInvalid
+	}
+
+	if time.Now().Unix() > expiresAt {
+		return ErrSignatureExpired
+	}
+
+	return nil
+}
+
+// Probe checks whether the base directory is writeable.
+func (f *Filesystem) Probe(_ context.Context) error {
+	return unix.Access(f.config.BaseDirAbs, unix.W_OK)
+}
+
+func (f *Filesystem) path(key string) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(f.config.BaseDirAbs, filepath.FromSlash(key)), nil
+}
+
+func (f *Filesystem) sign(key string, expires string) string {
+	mac := hmac.New(sha256.New, f.signingKey)
+	mac.Write([]byte(key + "\n" + expires))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
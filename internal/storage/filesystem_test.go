@@ -0,0 +1,141 @@
+package storage_test
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFilesystem(t *testing.T) (*storage.Filesystem, string) {
+	t.Helper()
+
+	baseDir := t.TempDir()
+	fs, err := storage.NewFilesystem(config.StorageFilesystem{
+		BaseDirAbs:    baseDir,
+		SignedURLBase: "http://localhost:8080/api/v1/storage",
+		SigningKey:    "test-signing-key",
+	})
+	require.NoError(t, err)
+
+	return fs, baseDir
+}
+
+func TestFilesystemPutGetStatDelete(t *testing.T) {
+	ctx := context.Background()
+	fs, baseDir := newTestFilesystem(t)
+
+	err := fs.Put(ctx, "avatars/test.txt", strings.NewReader("hello world"), -1, "")
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(baseDir, "avatars", "test.txt"))
+
+	info, err := fs.Stat(ctx, "avatars/test.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "avatars/test.txt", info.Key)
+	assert.Equal(t, int64(11), info.Size)
+	assert.Equal(t, "text/plain; charset=utf-8", info.ContentType)
+	assert.WithinDuration(t, time.Now(), info.ModTime, time.Minute)
+
+	r, info, err := fs.Get(ctx, "avatars/test.txt")
+	require.NoError(t, err)
+	content, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, "hello world", string(content))
+	assert.Equal(t, int64(11), info.Size)
+
+	// put replaces existing blobs without leaving temporary files behind
+	err = fs.Put(ctx, "avatars/test.txt", strings.NewReader("bye"), 3, "text/plain")
+	require.NoError(t, err)
+	entries, err := os.ReadDir(filepath.Join(baseDir, "avatars"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	err = fs.Delete(ctx, "avatars/test.txt")
+	require.NoError(t, err)
+	assert.NoFileExists(t, filepath.Join(baseDir, "avatars", "test.txt"))
+
+	_, err = fs.Stat(ctx, "avatars/test.txt")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+	_, _, err = fs.Get(ctx, "avatars/test.txt")
+	assert.ErrorIs(t, err, storage.ErrNotFound)
+
+	// deleting non-existent blobs is not an error
+	err = fs.Delete(ctx, "avatars/test.txt")
+	assert.NoError(t, err)
+}
+
+func TestFilesystemInvalidKey(t *testing.T) {
+	ctx := context.Background()
+	fs, _ := newTestFilesystem(t)
+
+	keys := []string{"", "/etc/passwd", "../outside.txt", "avatars/../../outside.txt", "avatars/", "avatars//test.txt", "./test.txt", `avatars\..\test.txt`}
+	for _, key := range keys {
+		err := fs.Put(ctx, key, strings.NewReader("test"), 4, "")
+		assert.ErrorIs(t, err, storage.ErrInvalidKey, key)
+
+		_, err = fs.Stat(ctx, key)
+		assert.ErrorIs(t, err, storage.ErrInvalidKey, key)
+
+		_, err = fs.SignedURL(ctx, key, time.Minute)
+		assert.ErrorIs(t, err, storage.ErrInvalidKey, key)
+	}
+}
+
+func TestFilesystemSignedURL(t *testing.T) {
+	ctx := context.Background()
+	fs, _ := newTestFilesystem(t)
+
+	signedURL, err := fs.SignedURL(ctx, "avatars/my avatar.jpg", time.Minute)
+	require.NoError(t, err)
+
+	u, err := url.Parse(signedURL)
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:8080", u.Host)
+	assert.Equal(t, "/api/v1/storage", u.Path)
+	assert.Equal(t, "avatars/my avatar.jpg", u.Query().Get(storage.SignedURLQueryKey))
+
+	expires := u.Query().Get(storage.SignedURLQueryExpires)
+	signature := u.Query().Get(storage.SignedURLQuerySignature)
+	require.NotEmpty(t, expires)
+	require.NotEmpty(t, signature)
+
+	err = fs.VerifySignedURL("avatars/my avatar.jpg", expires, signature)
+	assert.NoError(t, err)
+
+	err = fs.VerifySignedURL("avatars/other.jpg", expires, signature)
+	assert.ErrorIs(t, err, storage.ErrSignatureInvalid)
+
+	err = fs.VerifySignedURL("avatars/my avatar.jpg", "9999999999", signature)
+	assert.ErrorIs(t, err, storage.ErrSignatureInvalid)
+
+	expiredURL, err := fs.SignedURL(ctx, "avatars/my avatar.jpg", -time.Minute)
+	require.NoError(t, err)
+	u, err = url.Parse(expiredURL)
+	require.NoError(t, err)
+
+	err = fs.VerifySignedURL("avatars/my avatar.jpg", u.Query().Get(storage.SignedURLQueryExpires), u.Query().Get(storage.SignedURLQuerySignature))
+	assert.ErrorIs(t, err, storage.ErrSignatureExpired)
+}
+
+func TestFilesystemProbe(t *testing.T) {
+	ctx := context.Background()
+	fs, _ := newTestFilesystem(t)
+
+	assert.NoError(t, fs.Probe(ctx))
+
+	fs, err := storage.NewFilesystem(config.StorageFilesystem{
+		BaseDirAbs: "/this/path/does/not/exist",
+	})
+	require.NoError(t, err)
+	assert.Error(t, fs.Probe(ctx))
+}
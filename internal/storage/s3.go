@@ -0,0 +1,251 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"github.com/gabriel-vasile/mimetype"
+)
+
+// S3 stores blobs as objects in a bucket of an S3-compatible object storage (e.g. AWS S3, MinIO).
+// Requests are authenticated using AWS Signature Version 4, signed URLs are presigned GET requests.
+type S3 struct {
+	config   config.StorageS3
+	endpoint *url.URL
+	signer   *sigV4Signer
+	client   *http.Client
+}
+
+func NewS3(cfg config.StorageS3) (*S3, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse storage S3 endpoint: %w", err)
+	}
+
+	if len(endpoint.Scheme) == 0 || len(endpoint.Host) == 0 {
+		return nil, fmt.Errorf("storage S3 endpoint must be an absolute URL: %s", cfg.Endpoint)
+	}
+
+	if len(cfg.Bucket) == 0 {
+		return nil, errors.New("storage S3 bucket is not set")
+	}
+
+	return &S3{
+		config:   cfg,
+		endpoint: endpoint,
+		signer: &sigV4Signer{
+			accessKeyID:     cfg.AccessKeyID,
+			secretAccessKey: cfg.SecretAccessKey,
+			region:          cfg.Region,
+		},
+		client: &http.Client{
+			Timeout: cfg.HTTPTimeout,
+		},
+	}, nil
+}
+
+func (s *S3) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+
+	if len(contentType) == 0 {
+		// mimetype only inspects the first bytes of the content, replay them in front of the remaining reader
+		header := make([]byte, 3072)
+		n, err := io.ReadFull(r, header)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return fmt.Errorf("failed to read blob: %w", err)
+		}
+
+		contentType = mimetype.Detect(header[:n]).String()
+		r = io.MultiReader(bytes.NewReader(header[:n]), r)
+	}
+
+	if size < 0 {
+		// S3 requires the content length to be known upfront
+		var buf bytes.Buffer
+		if _, err := io.Copy(&buf, r); err != nil {
+			return fmt.Errorf("failed to read blob: %w", err)
+		}
+
+		r = &buf
+		size = int64(buf.Len())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), r)
+	if err != nil {
+		return fmt.Errorf("failed to create put object request: %w", err)
+	}
+
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+func (s *S3) Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key).String(), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create get object request: %w", err)
+	}
+
+	res, err := s.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Body, blobInfoFromResponse(key, res), nil
+}
+
+func (s *S3) Stat(ctx context.Context, key string) (*BlobInfo, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.objectURL(key).String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create head object request: %w", err)
+	}
+
+	res, err := s.do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	return blobInfoFromResponse(key, res), nil
+}
+
+func (s *S3) Delete(ctx context.Context, key string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create delete object request: %w", err)
+	}
+
+	res, err := s.do(req)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+func (s *S3) SignedURL(_ context.Context, key string, expiry time.Duration) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+
+	return s.signer.presign(http.MethodGet, s.objectURL(key), expiry, time.Now()).String(), nil
+}
+
+// Probe checks whether the bucket exists and is accessible with the credentials configured.
+func (s *S3) Probe(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.bucketURL().String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create head bucket request: %w", err)
+	}
+
+	res, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+func (s *S3) bucketURL() *url.URL {
+	u := *s.endpoint
+	u.RawQuery = ""
+
+	basePath := strings.TrimSuffix(s.endpoint.Path, "/")
+	if s.config.UsePathStyle {
+		u.Path = basePath + "/" + s.config.Bucket + "/"
+	} else {
+		u.Host = s.config.Bucket + "." + s.endpoint.Host
+		u.Path = basePath + "/"
+	}
+
+	u.RawPath = awsURIEncode(u.Path, false)
+
+	return &u
+}
+
+func (s *S3) objectURL(key string) *url.URL {
+	u := s.bucketURL()
+	u.Path += key
+	u.RawPath = awsURIEncode(u.Path, false)
+
+	return u
+}
+
+// do signs and sends the given request, mapping unsuccessful responses to errors.
+func (s *S3) do(req *http.Request) (*http.Response, error) {
+	s.signer.signRequest(req, time.Now())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s request to storage S3 endpoint: %w", req.Method, err)
+	}
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return res, nil
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+
+	return nil, fmt.Errorf("storage S3 endpoint responded to %s request with status %d: %s", req.Method, res.StatusCode, strings.TrimSpace(string(body)))
+}
+
+func blobInfoFromResponse(key string, res *http.Response) *BlobInfo {
+	info := &BlobInfo{
+		Key:         key,
+		Size:        res.ContentLength,
+		ContentType: res.Header.Get("Content-Type"),
+	}
+
+	// HEAD responses carry the size in the header only
+	if size, err := strconv.ParseInt(res.Header.Get("Content-Length"), 10, 64); err == nil {
+		info.Size = size
+	}
+
+	if modTime, err := http.ParseTime(res.Header.Get("Last-Modified")); err == nil {
+		info.ModTime = modTime
+	}
+
+	return info
+}
@@ -0,0 +1,193 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AWS Signature Version 4, see https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-authenticating-requests.html
+const (
+	sigV4Algorithm       = "AWS4-HMAC-SHA256"
+	sigV4Service         = "s3"
+	sigV4Request         = "aws4_request"
+	sigV4TimeFormat      = "20060102T150405Z"
+	sigV4DateFormat      = "20060102"
+	sigV4UnsignedPayload = "UNSIGNED-PAYLOAD"
+
+	sigV4HeaderDate          = "X-Amz-Date"
+	sigV4HeaderContentSHA256 = "X-Amz-Content-Sha256"
+
+	sigV4QueryAlgorithm     = "X-Amz-Algorithm"
+	sigV4QueryCredential    = "X-Amz-Credential"
+	sigV4QueryDate          = "X-Amz-Date"
+	sigV4QueryExpires       = "X-Amz-Expires"
+	sigV4QuerySignedHeaders = "X-Amz-SignedHeaders"
+	sigV4QuerySignature     = "X-Amz-Signature"
+)
+
+type sigV4Signer struct {
+	accessKeyID     string
+	secretAccessKey string
+	region          string
+}
+
+// signRequest adds the authorization header to the given request. The payload is not signed,
+// so requests may stream their bodies.
+func (s *sigV4Signer) signRequest(req *http.Request, t time.Time) {
+	t = t.UTC()
+
+	req.Header.Set(sigV4HeaderDate, t.Format(sigV4TimeFormat))
+	req.Header.Set(sigV4HeaderContentSHA256, sigV4UnsignedPayload)
+
+	headers := map[string]string{
+		"host": req.URL.Host,
+	}
+	for name := range req.Header {
+		lower := strings.ToLower(name)
+		if lower == "content-type" || lower == "content-md5" || strings.HasPrefix(lower, "x-amz-") {
+			headers[lower] = req.Header.Get(name)
+		}
+	}
+
+	signedHeaders := sortedKeys(headers)
+	signature := s.signature(req.Method, req.URL.EscapedPath(), req.URL.Query(), headers, signedHeaders, sigV4UnsignedPayload, t)
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		sigV4Algorithm,
+		s.accessKeyID,
+		s.scope(t),
+		strings.Join(signedHeaders, ";"),
+		signature,
+	))
+}
+
+// presign returns the given URL with query parameters granting a request of the given method until the expiry elapsed.
+func (s *sigV4Signer) presign(method string, u *url.URL, expiry time.Duration, t time.Time) *url.URL {
+	t = t.UTC()
+
+	query := u.Query()
+	query.Set(sigV4QueryAlgorithm, sigV4Algorithm)
+	query.Set(sigV4QueryCredential, s.accessKeyID+"/"+s.scope(t))
+	query.Set(sigV4QueryDate, t.Format(sigV4TimeFormat))
+	query.Set(sigV4QueryExpires, strconv.FormatInt(int64(expiry/time.Second), 10))
+	query.Set(sigV4QuerySignedHeaders, "host")
+
+	headers := map[string]string{
+		"host": u.Host,
+	}
+
+	signature := s.signature(method, u.EscapedPath(), query, headers, []string{"host"}, sigV4UnsignedPayload, t)
+
+	presigned := *u
+	presigned.RawQuery = canonicalQuery(query) + "&" + sigV4QuerySignature + "=" + signature
+
+	return &presigned
+}
+
+func (s *sigV4Signer) signature(method string, escapedPath string, query url.Values, headers map[string]string, signedHeaders []string, payloadHash string, t time.Time) string {
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", name, strings.TrimSpace(headers[name]))
+	}
+
+	if len(escapedPath) == 0 {
+		escapedPath = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		escapedPath,
+		canonicalQuery(query),
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		t.Format(sigV4TimeFormat),
+		s.scope(t),
+		hashHex(canonicalRequest),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), t.Format(sigV4DateFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, sigV4Service)
+	key = hmacSHA256(key, sigV4Request)
+
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func (s *sigV4Signer) scope(t time.Time) string {
+	return strings.Join([]string{t.Format(sigV4DateFormat), s.region, sigV4Service, sigV4Request}, "/")
+}
+
+// canonicalQuery encodes the given query sorted by key, excluding any signature.
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		if key != sigV4QuerySignature {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values := append([]string{}, query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, awsURIEncode(key, true)+"="+awsURIEncode(value, true))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// awsURIEncode percent-encodes every byte except unreserved characters as required by AWS,
+// optionally encoding slashes as well.
+func awsURIEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			b.WriteByte(c)
+			continue
+		}
+
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+
+	return b.String()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func hashHex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+)
+
+var (
+	ErrNotFound   = errors.New("blob not found")
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// BlobInfo describes a stored blob.
+type BlobInfo struct {
+	Key         string
+	Size        int64
+	ContentType string
+	ModTime     time.Time
+}
+
+// Blobstore persists user uploads and other binary data by key. Keys are slash separated paths
+// relative to the root of the store, e.g. "avatars/<file name>". Implementations must be safe for concurrent use.
+type Blobstore interface {
+	// Put stores the content read from r with the given key, replacing any blob previously stored with it.
+	// The size may be -1 if unknown, the content type is detected from the content if empty.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get returns the content and info of the blob with the given key. The caller must close the returned reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, *BlobInfo, error)
+	// Stat returns the info of the blob with the given key.
+	Stat(ctx context.Context, key string) (*BlobInfo, error)
+	// Delete removes the blob with the given key, deleting a non-existent blob is not an error.
+	Delete(ctx context.Context, key string) error
+	// SignedURL returns a URL granting read access to the blob with the given key until the given expiry elapsed.
+	SignedURL(ctx context.Context, key string, expiry time.Duration) (string, error)
+	// Probe checks whether the store is available for reading and writing.
+	Probe(ctx context.Context) error
+}
+
+// New initializes the blobstore of the backend configured.
+func New(cfg config.Storage) (Blobstore, error) {
+	switch config.StorageBackend(cfg.Backend) {
+	case config.StorageBackendFilesystem:
+		return NewFilesystem(cfg.Filesystem)
+	case config.StorageBackendS3:
+		return NewS3(cfg.S3)
+	default:
+		return nil, fmt.Errorf("Unsupported storage backend: %s", cfg.Backend)
+	}
+}
+
+// ValidateKey ensures the given key is a clean relative path, so it cannot escape the root of the store.
+func ValidateKey(key string) error {
+	if len(key) == 0 || strings.HasPrefix(key, "/") || strings.HasSuffix(key, "/") {
+		return ErrInvalidKey
+	}
+
+	for _, segment := range strings.Split(key, "/") {
+		if len(segment) == 0 || segment == "." || segment == ".." || strings.ContainsRune(segment, '\\') {
+			return ErrInvalidKey
+		}
+	}
+
+	return nil
+}
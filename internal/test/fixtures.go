@@ -28,6 +28,7 @@ type FixtureMap struct {
 	User1AppUserProfile           *models.AppUserProfile
 	User1AccessToken1             *models.AccessToken
 	User1RefreshToken1            *models.RefreshToken
+	User1Session1                 *models.Session
 	User2                         *models.User
 	User2AppUserProfile           *models.AppUserProfile
 	User2AccessToken1             *models.AccessToken
@@ -47,11 +48,12 @@ func Fixtures() FixtureMap {
 	f := FixtureMap{}
 
 	f.User1 = &models.User{
-		ID:       "f6ede5d8-e22a-4ca5-aa12-67821865a3e5",
-		IsActive: true,
-		Username: null.StringFrom("user1@example.com"),
-		Password: null.StringFrom(HashedTestUserPassword),
-		Scopes:   []string{"app"},
+		ID:              "f6ede5d8-e22a-4ca5-aa12-67821865a3e5",
+		IsActive:        true,
+		Username:        null.StringFrom("user1@example.com"),
+		Password:        null.StringFrom(HashedTestUserPassword),
+		Scopes:          []string{"app"},
+		EmailVerifiedAt: null.TimeFrom(now.Add(time.Hour * -24)),
 	}
 
 	f.User1AppUserProfile = &models.AppUserProfile{
@@ -60,22 +62,34 @@ func Fixtures() FixtureMap {
 	}
 
 	f.User1AccessToken1 = &models.AccessToken{
-		Token:      "1cfc27d7-a178-4051-802b-f3ff3967c95c",
-		ValidUntil: now.Add(10 * 365 * 24 * time.Hour),
-		UserID:     f.User1.ID,
+		Token:                "1cfc27d7-a178-4051-802b-f3ff3967c95c",
+		ValidUntil:           now.Add(10 * 365 * 24 * time.Hour),
+		UserID:               f.User1.ID,
+		RefreshTokenFamilyID: null.StringFrom("0b8e3d4b-7c2f-4b53-9a58-3c0f0f1e6d21"),
 	}
 
 	f.User1RefreshToken1 = &models.RefreshToken{
-		Token:  "66412eaf-2b89-404d-bbb5-46c3b8bf1a53",
-		UserID: f.User1.ID,
+		Token:    "66412eaf-2b89-404d-bbb5-46c3b8bf1a53",
+		UserID:   f.User1.ID,
+		FamilyID: "0b8e3d4b-7c2f-4b53-9a58-3c0f0f1e6d21",
+	}
+
+	f.User1Session1 = &models.Session{
+		ID:         f.User1RefreshToken1.FamilyID,
+		UserID:     f.User1.ID,
+		DeviceName: null.StringFrom("Pixel 7"),
+		UserAgent:  null.StringFrom("Mozilla/5.0 (Linux; Android 13; Pixel 7)"),
+		IPAddress:  null.StringFrom("203.0.113.42"),
+		LastSeenAt: now.Add(time.Minute * -5),
 	}
 
 	f.User2 = &models.User{
-		ID:       "76a79a2b-fbd8-45a0-b35b-671a28a87acf",
-		IsActive: true,
-		Username: null.StringFrom("user2@example.com"),
-		Password: null.StringFrom(HashedTestUserPassword),
-		Scopes:   []string{"app"},
+		ID:              "76a79a2b-fbd8-45a0-b35b-671a28a87acf",
+		IsActive:        true,
+		Username:        null.StringFrom("user2@example.com"),
+		Password:        null.StringFrom(HashedTestUserPassword),
+		Scopes:          []string{"app"},
+		EmailVerifiedAt: null.TimeFrom(now.Add(time.Hour * -24)),
 	}
 
 	f.User2AppUserProfile = &models.AppUserProfile{
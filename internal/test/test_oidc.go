@@ -0,0 +1,124 @@
+package test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	TestOIDCClientID = "go-starter-test-client"
+	TestOIDCKeyID    = "test-key-1"
+)
+
+// FakeOIDCIssuer is a local OpenID Connect provider serving its discovery document and JWKS,
+// issuing RS256 signed ID tokens for tests.
+type FakeOIDCIssuer struct {
+	Server *httptest.Server
+	Key    *rsa.PrivateKey
+	KeyID  string
+}
+
+// NewFakeOIDCIssuer starts a new fake OIDC issuer, which is closed once the test has finished.
+func NewFakeOIDCIssuer(t *testing.T) *FakeOIDCIssuer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate fake OIDC issuer key: %v", err)
+	}
+
+	f := &FakeOIDCIssuer{
+		Key:   key,
+		KeyID: TestOIDCKeyID,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, map[string]interface{}{
+			"issuer":   f.Issuer(),
+			"jwks_uri": f.Issuer() + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, map[string]interface{}{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA",
+					"kid": f.KeyID,
+					"use": "sig",
+					"alg": jwt.SigningMethodRS256.Alg(),
+					"n":   base64.RawURLEncoding.EncodeToString(f.Key.PublicKey.N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(f.Key.PublicKey.E)).Bytes()),
+				},
+			},
+		})
+	})
+
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Server.Close)
+
+	return f
+}
+
+func (f *FakeOIDCIssuer) Issuer() string {
+	return f.Server.URL
+}
+
+// Config returns the provider config accepting ID tokens of the fake issuer for TestOIDCClientID.
+func (f *FakeOIDCIssuer) Config() config.AuthServerOIDCProvider {
+	return config.AuthServerOIDCProvider{
+		Name:      "test",
+		Issuer:    f.Issuer(),
+		ClientIDs: []string{TestOIDCClientID},
+	}
+}
+
+// IDToken returns an ID token signed by the fake issuer for the given subject and email. Additional claims
+// may be provided, overriding the defaults (e.g. to issue tokens for other audiences or already expired tokens).
+func (f *FakeOIDCIssuer) IDToken(t *testing.T, subject string, email string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	now := time.Now()
+	c := jwt.MapClaims{
+		"iss":            f.Issuer(),
+		"sub":            subject,
+		"aud":            TestOIDCClientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+		"email":          email,
+		"email_verified": true,
+	}
+
+	for k, v := range claims {
+		c[k] = v
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, c)
+	token.Header["kid"] = f.KeyID
+
+	signed, err := token.SignedString(f.Key)
+	if err != nil {
+		t.Fatalf("Failed to sign fake ID token: %v", err)
+	}
+
+	return signed
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v interface{}) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Errorf("Failed to write fake OIDC issuer response: %v", err)
+	}
+}
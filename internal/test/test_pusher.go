@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"testing"
 
+	"allaboutapps.dev/aw/go-starter/internal/config"
+	"allaboutapps.dev/aw/go-starter/internal/i18n"
 	"allaboutapps.dev/aw/go-starter/internal/push"
 	"allaboutapps.dev/aw/go-starter/internal/push/provider"
 )
@@ -20,7 +22,14 @@ func WithTestPusher(t *testing.T, closure func(p *push.Service, db *sql.DB)) {
 func NewTestPusher(t *testing.T, db *sql.DB) *push.Service {
 	t.Helper()
 
-	pushService := push.New(db)
+	config := config.DefaultServiceConfigFromEnv()
+
+	i18nService, err := i18n.New(config.I18n)
+	if err != nil {
+		t.Fatalf("Failed to init i18n service: %v", err)
+	}
+
+	pushService := push.New(db, i18nService, config.Push.Outbox)
 	mockProvider := provider.NewMock(push.ProviderTypeFCM)
 	pushService.RegisterProvider(mockProvider)
 
@@ -66,6 +66,10 @@ func execClosureNewTestServer(ctx context.Context, t *testing.T, config config.S
 	// You may use port 0 to indicate you're not specifying an exact port but you want a free, available port selected by the system
 	config.Echo.ListenAddress = ":0"
 
+	// store blobs in a fresh directory per test, removed automatically after running the test
+	config.Storage.Backend = "filesystem"
+	config.Storage.Filesystem.BaseDirAbs = t.TempDir()
+
 	s := api.NewServer(config)
 
 	// attach the already initialized db
@@ -75,11 +79,31 @@ func execClosureNewTestServer(ctx context.Context, t *testing.T, config config.S
 		t.Fatalf("Failed to init mailer: %v", err)
 	}
 
+	if err := s.InitI18n(); err != nil {
+		t.Fatalf("Failed to init i18n service: %v", err)
+	}
+
 	// attach any other mocks
 	s.Push = NewTestPusher(t, db)
 
-	if err := s.InitI18n(); err != nil {
-		t.Fatalf("Failed to init i18n service: %v", err)
+	if err := s.InitJWT(); err != nil {
+		t.Fatalf("Failed to init JWT service: %v", err)
+	}
+
+	if err := s.InitLockout(); err != nil {
+		t.Fatalf("Failed to init auth lockout service: %v", err)
+	}
+
+	if err := s.InitOIDC(); err != nil {
+		t.Fatalf("Failed to init OIDC service: %v", err)
+	}
+
+	if err := s.InitRateLimiter(); err != nil {
+		t.Fatalf("Failed to init rate limiter: %v", err)
+	}
+
+	if err := s.InitBlobstore(); err != nil {
+		t.Fatalf("Failed to init blobstore: %v", err)
 	}
 
 	router.Init(s)
@@ -0,0 +1,414 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetAdminUsersRouteParams creates a new GetAdminUsersRouteParams object
+// with the default values initialized.
+func NewGetAdminUsersRouteParams() GetAdminUsersRouteParams {
+
+	var (
+		// initialize parameters with default values
+
+		limitDefault    = int64(50)
+		offsetDefault   = int64(0)
+		orderByDefault  = string("created_at")
+		orderDirDefault = string("asc")
+	)
+
+	return GetAdminUsersRouteParams{
+		Limit: &limitDefault,
+
+		Offset: &offsetDefault,
+
+		OrderBy: &orderByDefault,
+
+		OrderDir: &orderDirDefault,
+	}
+}
+
+// GetAdminUsersRouteParams contains all the bound params for the get admin users route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetAdminUsersRoute
+type GetAdminUsersRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Filters users by whether they are active
+	  In: query
+	*/
+	IsActive *bool `query:"is_active"`
+	/*Limit used for pagination, number of records to retrieve
+	  Maximum: 500
+	  Minimum: 1
+	  In: query
+	  Default: 50
+	*/
+	Limit *int64 `query:"limit"`
+	/*Offset used for pagination, number of records to skip
+	  Minimum: 0
+	  In: query
+	  Default: 0
+	*/
+	Offset *int64 `query:"offset"`
+	/*Field to order users by, defaults to `created_at` if omitted.
+	  In: query
+	  Default: "created_at"
+	*/
+	OrderBy *string `query:"orderBy"`
+	/*Direction of order applied, defaults to `asc` if omitted. `asc` will sort `NULL` values at the end of the list.
+	  In: query
+	  Default: "asc"
+	*/
+	OrderDir *string `query:"orderDir"`
+	/*Search string, matching users whose username contains words beginning with every word provided, results are ordered by relevance first
+	  In: query
+	*/
+	Search *string `query:"search"`
+	/*Filters users whose username contains the given value (case-insensitive)
+	  In: query
+	*/
+	Username *string `query:"username"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetAdminUsersRouteParams() beforehand.
+func (o *GetAdminUsersRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qIsActive, qhkIsActive, _ := qs.GetOK("is_active")
+	if err := o.bindIsActive(qIsActive, qhkIsActive, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOffset, qhkOffset, _ := qs.GetOK("offset")
+	if err := o.bindOffset(qOffset, qhkOffset, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrderBy, qhkOrderBy, _ := qs.GetOK("orderBy")
+	if err := o.bindOrderBy(qOrderBy, qhkOrderBy, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qOrderDir, qhkOrderDir, _ := qs.GetOK("orderDir")
+	if err := o.bindOrderDir(qOrderDir, qhkOrderDir, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSearch, qhkSearch, _ := qs.GetOK("search")
+	if err := o.bindSearch(qSearch, qhkSearch, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qUsername, qhkUsername, _ := qs.GetOK("username")
+	if err := o.bindUsername(qUsername, qhkUsername, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAdminUsersRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// is_active
+	// Required: false
+	// AllowEmptyValue: false
+
+	// limit
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// offset
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateOffset(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// orderBy
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateOrderBy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// orderDir
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateOrderDir(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// search
+	// Required: false
+	// AllowEmptyValue: false
+
+	// username
+	// Required: false
+	// AllowEmptyValue: false
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindIsActive binds and validates parameter IsActive from query.
+func (o *GetAdminUsersRouteParams) bindIsActive(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("is_active", "query", "bool", raw)
+	}
+	o.IsActive = &value
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *GetAdminUsersRouteParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetAdminUsersRouteParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *GetAdminUsersRouteParams) validateLimit(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.Limit == nil {
+		return nil
+	}
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 500, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOffset binds and validates parameter Offset from query.
+func (o *GetAdminUsersRouteParams) bindOffset(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetAdminUsersRouteParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("offset", "query", "int64", raw)
+	}
+	o.Offset = &value
+
+	if err := o.validateOffset(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOffset carries on validations for parameter Offset
+func (o *GetAdminUsersRouteParams) validateOffset(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.Offset == nil {
+		return nil
+	}
+
+	if err := validate.MinimumInt("offset", "query", *o.Offset, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOrderBy binds and validates parameter OrderBy from query.
+func (o *GetAdminUsersRouteParams) bindOrderBy(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetAdminUsersRouteParams()
+		return nil
+	}
+
+	o.OrderBy = &raw
+
+	if err := o.validateOrderBy(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOrderBy carries on validations for parameter OrderBy
+func (o *GetAdminUsersRouteParams) validateOrderBy(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.OrderBy == nil {
+		return nil
+	}
+
+	if err := validate.EnumCase("orderBy", "query", *o.OrderBy, []interface{}{"username", "created_at", "last_authenticated_at"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindOrderDir binds and validates parameter OrderDir from query.
+func (o *GetAdminUsersRouteParams) bindOrderDir(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetAdminUsersRouteParams()
+		return nil
+	}
+
+	o.OrderDir = &raw
+
+	if err := o.validateOrderDir(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateOrderDir carries on validations for parameter OrderDir
+func (o *GetAdminUsersRouteParams) validateOrderDir(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.OrderDir == nil {
+		return nil
+	}
+
+	if err := validate.EnumCase("orderDir", "query", *o.OrderDir, []interface{}{"asc", "desc"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSearch binds and validates parameter Search from query.
+func (o *GetAdminUsersRouteParams) bindSearch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Search = &raw
+
+	return nil
+}
+
+// bindUsername binds and validates parameter Username from query.
+func (o *GetAdminUsersRouteParams) bindUsername(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Username = &raw
+
+	return nil
+}
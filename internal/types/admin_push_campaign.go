@@ -0,0 +1,313 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AdminPushCampaign admin push campaign
+//
+// swagger:model adminPushCampaign
+type AdminPushCampaign struct {
+
+	// Timestamp the push campaign was created
+	// Example: 2020-06-12T09:03:45.000Z
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at"`
+
+	// Number of push tokens the message was delivered to
+	// Example: 140
+	// Required: true
+	Delivered *int64 `json:"delivered"`
+
+	// Timestamp all push tokens of the segment were enqueued at, unset while enqueuing or if it failed
+	// Example: 2020-06-12T09:03:46.000Z
+	// Format: date-time
+	EnqueuedAt *strfmt.DateTime `json:"enqueued_at,omitempty"`
+
+	// Number of push tokens the message could not be delivered to
+	// Example: 1
+	// Required: true
+	Failed *int64 `json:"failed"`
+
+	// ID of push campaign
+	// Example: 0f7a3e0c-8b6c-4b8e-9b0c-3f1c2d4e5a6b
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// Number of push tokens rejected as invalid by their provider, these have been deleted
+	// Example: 6
+	// Required: true
+	InvalidToken *int64 `json:"invalid_token"`
+
+	// Number of push tokens awaiting the (next) delivery attempt
+	// Example: 3
+	// Required: true
+	Pending *int64 `json:"pending"`
+
+	// Segment of users the message is sent to
+	// Example: language
+	// Required: true
+	// Enum: [all language sql]
+	Segment *string `json:"segment"`
+
+	// Language or SQL query of the segment
+	// Example: de
+	SegmentValue *string `json:"segment_value,omitempty"`
+
+	// Number of push tokens the message was enqueued for
+	// Example: 150
+	// Required: true
+	TokensTargeted *int64 `json:"tokens_targeted"`
+
+	// Timestamp the push campaign was last updated
+	// Example: 2020-06-12T09:05:12.000Z
+	// Required: true
+	// Format: date-time
+	UpdatedAt *strfmt.DateTime `json:"updated_at"`
+
+	// Number of users the message was enqueued for
+	// Example: 120
+	// Required: true
+	UsersTargeted *int64 `json:"users_targeted"`
+}
+
+// Validate validates this admin push campaign
+func (m *AdminPushCampaign) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDelivered(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEnqueuedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFailed(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateInvalidToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePending(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSegment(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTokensTargeted(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUsersTargeted(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AdminPushCampaign) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminPushCampaign) validateDelivered(formats strfmt.Registry) error {
+
+	if err := validate.Required("delivered", "body", m.Delivered); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminPushCampaign) validateEnqueuedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.EnqueuedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("enqueued_at", "body", "date-time", m.EnqueuedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminPushCampaign) validateFailed(formats strfmt.Registry) error {
+
+	if err := validate.Required("failed", "body", m.Failed); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminPushCampaign) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminPushCampaign) validateInvalidToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("invalid_token", "body", m.InvalidToken); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminPushCampaign) validatePending(formats strfmt.Registry) error {
+
+	if err := validate.Required("pending", "body", m.Pending); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var adminPushCampaignTypeSegmentPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["all","language","sql"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		adminPushCampaignTypeSegmentPropEnum = append(adminPushCampaignTypeSegmentPropEnum, v)
+	}
+}
+
+const (
+
+	// AdminPushCampaignSegmentAll captures enum value "all"
+	AdminPushCampaignSegmentAll string = "all"
+
+	// AdminPushCampaignSegmentLanguage captures enum value "language"
+	AdminPushCampaignSegmentLanguage string = "language"
+
+	// AdminPushCampaignSegmentSQL captures enum value "sql"
+	AdminPushCampaignSegmentSQL string = "sql"
+)
+
+// prop value enum
+func (m *AdminPushCampaign) validateSegmentEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, adminPushCampaignTypeSegmentPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AdminPushCampaign) validateSegment(formats strfmt.Registry) error {
+
+	if err := validate.Required("segment", "body", m.Segment); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateSegmentEnum("segment", "body", *m.Segment); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminPushCampaign) validateTokensTargeted(formats strfmt.Registry) error {
+
+	if err := validate.Required("tokens_targeted", "body", m.TokensTargeted); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminPushCampaign) validateUpdatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("updated_at", "body", m.UpdatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminPushCampaign) validateUsersTargeted(formats strfmt.Registry) error {
+
+	if err := validate.Required("users_targeted", "body", m.UsersTargeted); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this admin push campaign based on context it is used
+func (m *AdminPushCampaign) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AdminPushCampaign) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AdminPushCampaign) UnmarshalBinary(b []byte) error {
+	var res AdminPushCampaign
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
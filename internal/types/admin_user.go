@@ -0,0 +1,241 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AdminUser admin user
+//
+// swagger:model adminUser
+type AdminUser struct {
+
+	// Timestamp the user was created
+	// Example: 2020-06-10T12:13:56.000Z
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at"`
+
+	// Timestamp the user deleted their account at, set while the account deletion grace period has not passed yet
+	// Example: 2020-06-12T09:03:46.000Z
+	// Format: date-time
+	DeletedAt *strfmt.DateTime `json:"deleted_at,omitempty"`
+
+	// Whether the user has verified their email address
+	// Example: true
+	// Required: true
+	EmailVerified *bool `json:"email_verified"`
+
+	// ID of user
+	// Example: 891d37d3-c74f-493e-aea8-af73efd92016
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// Whether the user is allowed to authenticate
+	// Example: true
+	// Required: true
+	IsActive *bool `json:"is_active"`
+
+	// Timestamp the user last authenticated at, if ever
+	// Example: 2020-06-12T09:03:46.000Z
+	// Format: date-time
+	LastAuthenticatedAt *strfmt.DateTime `json:"last_authenticated_at,omitempty"`
+
+	// Whether the user is required to reset their password before logging in
+	// Example: false
+	// Required: true
+	PasswordResetRequired *bool `json:"password_reset_required"`
+
+	// Scopes granted to the user
+	// Example: ["app"]
+	// Required: true
+	Scopes []string `json:"scopes"`
+
+	// Timestamp the user was last updated
+	// Example: 2020-06-12T09:03:46.000Z
+	// Required: true
+	// Format: date-time
+	UpdatedAt *strfmt.DateTime `json:"updated_at"`
+
+	// Username of user, only set for local users
+	// Example: user@example.com
+	Username string `json:"username,omitempty"`
+}
+
+// Validate validates this admin user
+func (m *AdminUser) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDeletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEmailVerified(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIsActive(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastAuthenticatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePasswordResetRequired(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScopes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AdminUser) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateDeletedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.DeletedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("deleted_at", "body", "date-time", m.DeletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateEmailVerified(formats strfmt.Registry) error {
+
+	if err := validate.Required("email_verified", "body", m.EmailVerified); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateIsActive(formats strfmt.Registry) error {
+
+	if err := validate.Required("is_active", "body", m.IsActive); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateLastAuthenticatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastAuthenticatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_authenticated_at", "body", "date-time", m.LastAuthenticatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validatePasswordResetRequired(formats strfmt.Registry) error {
+
+	if err := validate.Required("password_reset_required", "body", m.PasswordResetRequired); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateScopes(formats strfmt.Registry) error {
+
+	if err := validate.Required("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AdminUser) validateUpdatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("updated_at", "body", m.UpdatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this admin user based on context it is used
+func (m *AdminUser) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AdminUser) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AdminUser) UnmarshalBinary(b []byte) error {
+	var res AdminUser
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
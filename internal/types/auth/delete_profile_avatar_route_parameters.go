@@ -0,0 +1,94 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package auth
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewDeleteProfileAvatarRouteParams creates a new DeleteProfileAvatarRouteParams object
+// no default values defined in spec.
+func NewDeleteProfileAvatarRouteParams() DeleteProfileAvatarRouteParams {
+
+	return DeleteProfileAvatarRouteParams{}
+}
+
+// DeleteProfileAvatarRouteParams contains all the bound params for the delete profile avatar route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters DeleteProfileAvatarRoute
+type DeleteProfileAvatarRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Entity tag of the profile as returned via the `ETag` header, the request is rejected if the profile has been modified since
+	  Required: true
+	  In: header
+	*/
+	IfMatch string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewDeleteProfileAvatarRouteParams() beforehand.
+func (o *DeleteProfileAvatarRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *DeleteProfileAvatarRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// If-Match
+	// Required: true
+
+	if err := validate.Required("If-Match", "header", o.IfMatch); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *DeleteProfileAvatarRouteParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("If-Match", "header", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+
+	if err := validate.RequiredString("If-Match", "header", raw); err != nil {
+		return err
+	}
+
+	o.IfMatch = raw
+
+	return nil
+}
@@ -0,0 +1,90 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package auth
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetLegalDocumentsRouteParams creates a new GetLegalDocumentsRouteParams object
+// no default values defined in spec.
+func NewGetLegalDocumentsRouteParams() GetLegalDocumentsRouteParams {
+
+	return GetLegalDocumentsRouteParams{}
+}
+
+// GetLegalDocumentsRouteParams contains all the bound params for the get legal documents route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetLegalDocumentsRoute
+type GetLegalDocumentsRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Locale to return documents in, defaults to the `Accept-Language` header if omitted
+	  In: query
+	*/
+	Locale *string `query:"locale"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetLegalDocumentsRouteParams() beforehand.
+func (o *GetLegalDocumentsRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qLocale, qhkLocale, _ := qs.GetOK("locale")
+	if err := o.bindLocale(qLocale, qhkLocale, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetLegalDocumentsRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// locale
+	// Required: false
+	// AllowEmptyValue: false
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindLocale binds and validates parameter Locale from query.
+func (o *GetLegalDocumentsRouteParams) bindLocale(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Locale = &raw
+
+	return nil
+}
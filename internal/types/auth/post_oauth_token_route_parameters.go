@@ -0,0 +1,333 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package auth
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewPostOauthTokenRouteParams creates a new PostOauthTokenRouteParams object
+// no default values defined in spec.
+func NewPostOauthTokenRouteParams() PostOauthTokenRouteParams {
+
+	return PostOauthTokenRouteParams{}
+}
+
+// PostOauthTokenRouteParams contains all the bound params for the post oauth token route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters PostOauthTokenRoute
+type PostOauthTokenRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*ID of OAuth client, if not authenticating via HTTP Basic authentication
+	  In: formData
+	*/
+	ClientID *string `form:"client_id"`
+	/*Secret of OAuth client, if not authenticating via HTTP Basic authentication
+	  In: formData
+	*/
+	ClientSecret *string `form:"client_secret"`
+	/*Authorization code, required for the `authorization_code` grant
+	  In: formData
+	*/
+	Code *string `form:"code"`
+	/*PKCE code verifier, required for the `authorization_code` grant
+	  In: formData
+	*/
+	CodeVerifier *string `form:"code_verifier"`
+	/*OAuth 2.0 grant type
+	  Required: true
+	  In: formData
+	*/
+	GrantType string `form:"grant_type"`
+	/*Redirect URI used for the authorization request, required for the `authorization_code` grant
+	  In: formData
+	*/
+	RedirectURI *string `form:"redirect_uri"`
+	/*Refresh token, required for the `refresh_token` grant
+	  In: formData
+	*/
+	RefreshToken *string `form:"refresh_token"`
+	/*Space separated list of scopes requested for the `client_credentials` grant
+	  In: formData
+	*/
+	Scope *string `form:"scope"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPostOauthTokenRouteParams() beforehand.
+func (o *PostOauthTokenRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		if err != http.ErrNotMultipart {
+			return errors.New(400, "%v", err)
+		} else if err := r.ParseForm(); err != nil {
+			return errors.New(400, "%v", err)
+		}
+	}
+	fds := runtime.Values(r.Form)
+
+	fdClientID, fdhkClientID, _ := fds.GetOK("client_id")
+	if err := o.bindClientID(fdClientID, fdhkClientID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	fdClientSecret, fdhkClientSecret, _ := fds.GetOK("client_secret")
+	if err := o.bindClientSecret(fdClientSecret, fdhkClientSecret, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	fdCode, fdhkCode, _ := fds.GetOK("code")
+	if err := o.bindCode(fdCode, fdhkCode, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	fdCodeVerifier, fdhkCodeVerifier, _ := fds.GetOK("code_verifier")
+	if err := o.bindCodeVerifier(fdCodeVerifier, fdhkCodeVerifier, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	fdGrantType, fdhkGrantType, _ := fds.GetOK("grant_type")
+	if err := o.bindGrantType(fdGrantType, fdhkGrantType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	fdRedirectURI, fdhkRedirectURI, _ := fds.GetOK("redirect_uri")
+	if err := o.bindRedirectURI(fdRedirectURI, fdhkRedirectURI, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	fdRefreshToken, fdhkRefreshToken, _ := fds.GetOK("refresh_token")
+	if err := o.bindRefreshToken(fdRefreshToken, fdhkRefreshToken, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	fdScope, fdhkScope, _ := fds.GetOK("scope")
+	if err := o.bindScope(fdScope, fdhkScope, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PostOauthTokenRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// client_id
+	// Required: false
+
+	// client_secret
+	// Required: false
+
+	// code
+	// Required: false
+
+	// code_verifier
+	// Required: false
+
+	// grant_type
+	// Required: true
+
+	if err := validate.Required("grant_type", "formData", o.GrantType); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateGrantType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// redirect_uri
+	// Required: false
+
+	// refresh_token
+	// Required: false
+
+	// scope
+	// Required: false
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindClientID binds and validates parameter ClientID from formData.
+func (o *PostOauthTokenRouteParams) bindClientID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.ClientID = &raw
+
+	return nil
+}
+
+// bindClientSecret binds and validates parameter ClientSecret from formData.
+func (o *PostOauthTokenRouteParams) bindClientSecret(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.ClientSecret = &raw
+
+	return nil
+}
+
+// bindCode binds and validates parameter Code from formData.
+func (o *PostOauthTokenRouteParams) bindCode(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Code = &raw
+
+	return nil
+}
+
+// bindCodeVerifier binds and validates parameter CodeVerifier from formData.
+func (o *PostOauthTokenRouteParams) bindCodeVerifier(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.CodeVerifier = &raw
+
+	return nil
+}
+
+// bindGrantType binds and validates parameter GrantType from formData.
+func (o *PostOauthTokenRouteParams) bindGrantType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("grant_type", "formData", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+
+	if err := validate.RequiredString("grant_type", "formData", raw); err != nil {
+		return err
+	}
+
+	o.GrantType = raw
+
+	if err := o.validateGrantType(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateGrantType carries on validations for parameter GrantType
+func (o *PostOauthTokenRouteParams) validateGrantType(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("grant_type", "formData", o.GrantType, []interface{}{"authorization_code", "refresh_token", "client_credentials"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindRedirectURI binds and validates parameter RedirectURI from formData.
+func (o *PostOauthTokenRouteParams) bindRedirectURI(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.RedirectURI = &raw
+
+	return nil
+}
+
+// bindRefreshToken binds and validates parameter RefreshToken from formData.
+func (o *PostOauthTokenRouteParams) bindRefreshToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.RefreshToken = &raw
+
+	return nil
+}
+
+// bindScope binds and validates parameter Scope from formData.
+func (o *PostOauthTokenRouteParams) bindScope(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Scope = &raw
+
+	return nil
+}
@@ -0,0 +1,130 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package auth
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewPutProfileAvatarRouteParams creates a new PutProfileAvatarRouteParams object
+// no default values defined in spec.
+func NewPutProfileAvatarRouteParams() PutProfileAvatarRouteParams {
+
+	return PutProfileAvatarRouteParams{}
+}
+
+// PutProfileAvatarRouteParams contains all the bound params for the put profile avatar route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters PutProfileAvatarRoute
+type PutProfileAvatarRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Entity tag of the profile as returned via the `ETag` header, the request is rejected if the profile has been modified since
+	  Required: true
+	  In: header
+	*/
+	IfMatch string
+	/*Avatar image
+	  Required: true
+	  In: formData
+	*/
+	File io.ReadCloser `form:"file"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPutProfileAvatarRouteParams() beforehand.
+func (o *PutProfileAvatarRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		if err != http.ErrNotMultipart {
+			return errors.New(400, "%v", err)
+		} else if err := r.ParseForm(); err != nil {
+			return errors.New(400, "%v", err)
+		}
+	}
+
+	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	file, fileHeader, err := r.FormFile("file")
+	if err != nil {
+		res = append(res, errors.New(400, "reading file %q failed: %v", "file", err))
+	} else if err := o.bindFile(file, fileHeader); err != nil {
+		// Required: true
+		res = append(res, err)
+	} else {
+		o.File = &runtime.File{Data: file, Header: fileHeader}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *PutProfileAvatarRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// If-Match
+	// Required: true
+
+	if err := validate.Required("If-Match", "header", o.IfMatch); err != nil {
+		res = append(res, err)
+	}
+
+	// file
+	// Required: true
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindIfMatch binds and validates parameter IfMatch from header.
+func (o *PutProfileAvatarRouteParams) bindIfMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("If-Match", "header", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+
+	if err := validate.RequiredString("If-Match", "header", raw); err != nil {
+		return err
+	}
+
+	o.IfMatch = raw
+
+	return nil
+}
+
+// bindFile binds file parameter File.
+//
+// The only supported validations on files are MinLength and MaxLength
+func (o *PutProfileAvatarRouteParams) bindFile(file multipart.File, header *multipart.FileHeader) error {
+	return nil
+}
@@ -0,0 +1,174 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package common
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetStorageBlobRouteParams creates a new GetStorageBlobRouteParams object
+// no default values defined in spec.
+func NewGetStorageBlobRouteParams() GetStorageBlobRouteParams {
+
+	return GetStorageBlobRouteParams{}
+}
+
+// GetStorageBlobRouteParams contains all the bound params for the get storage blob route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetStorageBlobRoute
+type GetStorageBlobRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Unix timestamp the signed URL expires at
+	  Required: true
+	  In: query
+	*/
+	Expires string `query:"expires"`
+	/*Key of the blob
+	  Required: true
+	  In: query
+	*/
+	Key string `query:"key"`
+	/*Signature of the signed URL
+	  Required: true
+	  In: query
+	*/
+	Signature string `query:"signature"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetStorageBlobRouteParams() beforehand.
+func (o *GetStorageBlobRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qExpires, qhkExpires, _ := qs.GetOK("expires")
+	if err := o.bindExpires(qExpires, qhkExpires, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qKey, qhkKey, _ := qs.GetOK("key")
+	if err := o.bindKey(qKey, qhkKey, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSignature, qhkSignature, _ := qs.GetOK("signature")
+	if err := o.bindSignature(qSignature, qhkSignature, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetStorageBlobRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// expires
+	// Required: true
+	// AllowEmptyValue: false
+	if err := validate.Required("expires", "query", o.Expires); err != nil {
+		res = append(res, err)
+	}
+
+	// key
+	// Required: true
+	// AllowEmptyValue: false
+	if err := validate.Required("key", "query", o.Key); err != nil {
+		res = append(res, err)
+	}
+
+	// signature
+	// Required: true
+	// AllowEmptyValue: false
+	if err := validate.Required("signature", "query", o.Signature); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindExpires binds and validates parameter Expires from query.
+func (o *GetStorageBlobRouteParams) bindExpires(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("expires", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+	if err := validate.RequiredString("expires", "query", raw); err != nil {
+		return err
+	}
+
+	o.Expires = raw
+
+	return nil
+}
+
+// bindKey binds and validates parameter Key from query.
+func (o *GetStorageBlobRouteParams) bindKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("key", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+	if err := validate.RequiredString("key", "query", raw); err != nil {
+		return err
+	}
+
+	o.Key = raw
+
+	return nil
+}
+
+// bindSignature binds and validates parameter Signature from query.
+func (o *GetStorageBlobRouteParams) bindSignature(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("signature", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+	if err := validate.RequiredString("signature", "query", raw); err != nil {
+		return err
+	}
+
+	o.Signature = raw
+
+	return nil
+}
@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DeleteAccountPayload delete account payload
+//
+// swagger:model deleteAccountPayload
+type DeleteAccountPayload struct {
+
+	// Current password of user, required to confirm the deletion for local users
+	// Example: correct horse battery staple
+	// Max Length: 500
+	// Min Length: 1
+	Password *string `json:"password,omitempty"`
+}
+
+// Validate validates this delete account payload
+func (m *DeleteAccountPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePassword(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DeleteAccountPayload) validatePassword(formats strfmt.Registry) error {
+	if swag.IsZero(m.Password) { // not required
+		return nil
+	}
+
+	if err := validate.MinLength("password", "body", *m.Password, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("password", "body", *m.Password, 500); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this delete account payload based on context it is used
+func (m *DeleteAccountPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DeleteAccountPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DeleteAccountPayload) UnmarshalBinary(b []byte) error {
+	var res DeleteAccountPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
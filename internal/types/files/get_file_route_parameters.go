@@ -0,0 +1,225 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package files
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetFileRouteParams creates a new GetFileRouteParams object
+// no default values defined in spec.
+func NewGetFileRouteParams() GetFileRouteParams {
+
+	return GetFileRouteParams{}
+}
+
+// GetFileRouteParams contains all the bound params for the get file route operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetFileRoute
+type GetFileRouteParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Entity tag of a cached response as returned via the `ETag` header
+	  In: header
+	*/
+	IfNoneMatch *string
+	/*Format to convert the image to, defaults to the format of the uploaded image
+	  In: query
+	*/
+	Format *string `query:"format"`
+	/*ID of upload
+	  Required: true
+	  In: path
+	*/
+	ID strfmt.UUID4 `param:"id"`
+	/*Name of the image variant to return, the original file is returned if omitted
+	  In: query
+	*/
+	Variant *string `query:"variant"`
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetFileRouteParams() beforehand.
+func (o *GetFileRouteParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if err := o.bindIfNoneMatch(r.Header[http.CanonicalHeaderKey("If-None-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFormat, qhkFormat, _ := qs.GetOK("format")
+	if err := o.bindFormat(qFormat, qhkFormat, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rID, rhkID, _ := route.Params.GetOK("id")
+	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qVariant, qhkVariant, _ := qs.GetOK("variant")
+	if err := o.bindVariant(qVariant, qhkVariant, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetFileRouteParams) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// If-None-Match
+	// Required: false
+
+	// format
+	// Required: false
+	// AllowEmptyValue: false
+
+	if err := o.validateFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// id
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	if err := o.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	// variant
+	// Required: false
+	// AllowEmptyValue: false
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindIfNoneMatch binds and validates parameter IfNoneMatch from header.
+func (o *GetFileRouteParams) bindIfNoneMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.IfNoneMatch = &raw
+
+	return nil
+}
+
+// bindFormat binds and validates parameter Format from query.
+func (o *GetFileRouteParams) bindFormat(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Format = &raw
+
+	if err := o.validateFormat(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFormat carries on validations for parameter Format
+func (o *GetFileRouteParams) validateFormat(formats strfmt.Registry) error {
+
+	// Required: false
+	if o.Format == nil {
+		return nil
+	}
+
+	if err := validate.EnumCase("format", "query", *o.Format, []interface{}{"jpeg", "png", "webp"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindID binds and validates parameter ID from path.
+func (o *GetFileRouteParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid4
+	value, err := formats.Parse("uuid4", raw)
+	if err != nil {
+		return errors.InvalidType("id", "path", "strfmt.UUID4", raw)
+	}
+	o.ID = *(value.(*strfmt.UUID4))
+
+	if err := o.validateID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateID carries on validations for parameter ID
+func (o *GetFileRouteParams) validateID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("id", "path", "uuid4", o.ID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindVariant binds and validates parameter Variant from query.
+func (o *GetFileRouteParams) bindVariant(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Variant = &raw
+
+	return nil
+}
@@ -0,0 +1,111 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GetAccountExportResponse get account export response
+//
+// swagger:model getAccountExportResponse
+type GetAccountExportResponse struct {
+
+	// Time the export was created at
+	// Example: 2020-06-10T12:13:56.000Z
+	// Required: true
+	// Format: date-time
+	ExportedAt *strfmt.DateTime `json:"exported_at"`
+
+	// All rows belonging to the user, keyed by table name and column name. Credentials are never exported
+	// Required: true
+	Tables map[string][]interface{} `json:"tables"`
+
+	// Stored user record, keyed by column name. Credentials are never exported
+	// Required: true
+	User interface{} `json:"user"`
+}
+
+// Validate validates this get account export response
+func (m *GetAccountExportResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateExportedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTables(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUser(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetAccountExportResponse) validateExportedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("exported_at", "body", m.ExportedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("exported_at", "body", "date-time", m.ExportedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetAccountExportResponse) validateTables(formats strfmt.Registry) error {
+
+	if err := validate.Required("tables", "body", m.Tables); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetAccountExportResponse) validateUser(formats strfmt.Registry) error {
+
+	if m.User == nil {
+		return errors.Required("user", "body", nil)
+	}
+
+	return nil
+}
+
+// ContextValidate validates this get account export response based on context it is used
+func (m *GetAccountExportResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GetAccountExportResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GetAccountExportResponse) UnmarshalBinary(b []byte) error {
+	var res GetAccountExportResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GetAPIKeysResponse get Api keys response
+//
+// swagger:model getApiKeysResponse
+type GetAPIKeysResponse struct {
+
+	// API keys of the user, most recently created first
+	// Required: true
+	APIKeys []*APIKey `json:"api_keys"`
+}
+
+// Validate validates this get Api keys response
+func (m *GetAPIKeysResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAPIKeys(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetAPIKeysResponse) validateAPIKeys(formats strfmt.Registry) error {
+
+	if err := validate.Required("api_keys", "body", m.APIKeys); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.APIKeys); i++ {
+		if swag.IsZero(m.APIKeys[i]) { // not required
+			continue
+		}
+
+		if m.APIKeys[i] != nil {
+			if err := m.APIKeys[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("api_keys" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("api_keys" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get Api keys response based on the context it is used
+func (m *GetAPIKeysResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAPIKeys(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetAPIKeysResponse) contextValidateAPIKeys(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.APIKeys); i++ {
+
+		if m.APIKeys[i] != nil {
+			if err := m.APIKeys[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("api_keys" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("api_keys" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GetAPIKeysResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GetAPIKeysResponse) UnmarshalBinary(b []byte) error {
+	var res GetAPIKeysResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GetOauthAuthorizationServerMetadataResponse OAuth 2.0 Authorization Server Metadata as specified by RFC 8414
+//
+// swagger:model getOauthAuthorizationServerMetadataResponse
+type GetOauthAuthorizationServerMetadataResponse struct {
+
+	// URL of the authorization endpoint presenting the consent screen to users
+	// Example: https://example.com/oauth/authorize
+	// Required: true
+	AuthorizationEndpoint *string `json:"authorization_endpoint"`
+
+	// PKCE code challenge methods supported
+	// Example: ["S256"]
+	// Required: true
+	CodeChallengeMethodsSupported []string `json:"code_challenge_methods_supported"`
+
+	// OAuth 2.0 grant types supported
+	// Example: ["authorization_code","refresh_token","client_credentials"]
+	// Required: true
+	GrantTypesSupported []string `json:"grant_types_supported"`
+
+	// Authorization server's issuer identifier
+	// Example: https://api.example.com
+	// Required: true
+	Issuer *string `json:"issuer"`
+
+	// OAuth 2.0 response types supported
+	// Example: ["code"]
+	// Required: true
+	ResponseTypesSupported []string `json:"response_types_supported"`
+
+	// Scopes clients may request
+	// Example: ["app"]
+	// Required: true
+	ScopesSupported []string `json:"scopes_supported"`
+
+	// URL of the token endpoint
+	// Example: https://api.example.com/api/v1/auth/oauth/token
+	// Required: true
+	TokenEndpoint *string `json:"token_endpoint"`
+
+	// Client authentication methods supported by the token endpoint
+	// Example: ["client_secret_basic","client_secret_post","none"]
+	// Required: true
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+
+	// URL of the user info endpoint
+	// Example: https://api.example.com/api/v1/auth/userinfo
+	UserinfoEndpoint string `json:"userinfo_endpoint,omitempty"`
+}
+
+// Validate validates this get oauth authorization server metadata response
+func (m *GetOauthAuthorizationServerMetadataResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAuthorizationEndpoint(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCodeChallengeMethodsSupported(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGrantTypesSupported(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIssuer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResponseTypesSupported(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScopesSupported(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTokenEndpoint(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTokenEndpointAuthMethodsSupported(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetOauthAuthorizationServerMetadataResponse) validateAuthorizationEndpoint(formats strfmt.Registry) error {
+
+	if err := validate.Required("authorization_endpoint", "body", m.AuthorizationEndpoint); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetOauthAuthorizationServerMetadataResponse) validateCodeChallengeMethodsSupported(formats strfmt.Registry) error {
+
+	if err := validate.Required("code_challenge_methods_supported", "body", m.CodeChallengeMethodsSupported); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetOauthAuthorizationServerMetadataResponse) validateGrantTypesSupported(formats strfmt.Registry) error {
+
+	if err := validate.Required("grant_types_supported", "body", m.GrantTypesSupported); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetOauthAuthorizationServerMetadataResponse) validateIssuer(formats strfmt.Registry) error {
+
+	if err := validate.Required("issuer", "body", m.Issuer); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetOauthAuthorizationServerMetadataResponse) validateResponseTypesSupported(formats strfmt.Registry) error {
+
+	if err := validate.Required("response_types_supported", "body", m.ResponseTypesSupported); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetOauthAuthorizationServerMetadataResponse) validateScopesSupported(formats strfmt.Registry) error {
+
+	if err := validate.Required("scopes_supported", "body", m.ScopesSupported); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetOauthAuthorizationServerMetadataResponse) validateTokenEndpoint(formats strfmt.Registry) error {
+
+	if err := validate.Required("token_endpoint", "body", m.TokenEndpoint); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *GetOauthAuthorizationServerMetadataResponse) validateTokenEndpointAuthMethodsSupported(formats strfmt.Registry) error {
+
+	if err := validate.Required("token_endpoint_auth_methods_supported", "body", m.TokenEndpointAuthMethodsSupported); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this get oauth authorization server metadata response based on context it is used
+func (m *GetOauthAuthorizationServerMetadataResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GetOauthAuthorizationServerMetadataResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GetOauthAuthorizationServerMetadataResponse) UnmarshalBinary(b []byte) error {
+	var res GetOauthAuthorizationServerMetadataResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GetSessionsResponse get sessions response
+//
+// swagger:model getSessionsResponse
+type GetSessionsResponse struct {
+
+	// Sessions of the user, most recently seen first
+	// Required: true
+	Sessions []*Session `json:"sessions"`
+}
+
+// Validate validates this get sessions response
+func (m *GetSessionsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSessions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetSessionsResponse) validateSessions(formats strfmt.Registry) error {
+
+	if err := validate.Required("sessions", "body", m.Sessions); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Sessions); i++ {
+		if swag.IsZero(m.Sessions[i]) { // not required
+			continue
+		}
+
+		if m.Sessions[i] != nil {
+			if err := m.Sessions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("sessions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("sessions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get sessions response based on the context it is used
+func (m *GetSessionsResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSessions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GetSessionsResponse) contextValidateSessions(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Sessions); i++ {
+
+		if m.Sessions[i] != nil {
+			if err := m.Sessions[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("sessions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("sessions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GetSessionsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GetSessionsResponse) UnmarshalBinary(b []byte) error {
+	var res GetSessionsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -27,6 +27,26 @@ type GetUserInfoResponse struct {
 	// Format: email
 	Email strfmt.Email `json:"email,omitempty"`
 
+	// Whether the user has verified their email address
+	// Example: true
+	EmailVerified bool `json:"email_verified,omitempty"`
+
+	// Family name of user as set in their profile, if available
+	// Example: Mustermann
+	FamilyName string `json:"family_name,omitempty"`
+
+	// Given name of user as set in their profile, if available
+	// Example: Max
+	GivenName string `json:"given_name,omitempty"`
+
+	// Preferred locale of user as set in their profile, if available
+	// Example: de
+	Locale string `json:"locale,omitempty"`
+
+	// Display name of user as set in their profile, if available
+	// Example: Max
+	Name string `json:"name,omitempty"`
+
 	// Auth-Scopes of the user, if available
 	// Example: ["app"]
 	Scopes []string `json:"scopes"`
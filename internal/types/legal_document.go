@@ -0,0 +1,211 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LegalDocument legal document
+//
+// swagger:model legalDocument
+type LegalDocument struct {
+
+	// Timestamp the user accepted this or a newer version of the legal document, if authenticated and accepted
+	// Example: 2020-06-12T09:03:46.000Z
+	// Format: date-time
+	AcceptedAt *strfmt.DateTime `json:"accepted_at,omitempty"`
+
+	// ID of legal document, used to accept it
+	// Example: 7c1d9e2a-4b3f-4e8a-9d61-2f5a8b0c3e47
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// Locale the legal document is written in
+	// Example: en
+	// Required: true
+	Locale *string `json:"locale"`
+
+	// Whether users are required to accept this version of the legal document
+	// Example: true
+	// Required: true
+	Mandatory *bool `json:"mandatory"`
+
+	// Timestamp the legal document was published
+	// Example: 2020-06-10T12:13:56.000Z
+	// Required: true
+	// Format: date-time
+	PublishedAt *strfmt.DateTime `json:"published_at"`
+
+	// Type of legal document
+	// Example: terms_of_service
+	// Required: true
+	Type *string `json:"type"`
+
+	// URL the legal document can be viewed at
+	// Example: https://example.com/legal/terms-of-service/v2/en
+	// Required: true
+	URL *string `json:"url"`
+
+	// Version of legal document, increasing with every revision of the document type
+	// Example: 2
+	// Required: true
+	Version *int64 `json:"version"`
+}
+
+// Validate validates this legal document
+func (m *LegalDocument) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAcceptedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLocale(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMandatory(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePublishedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LegalDocument) validateAcceptedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.AcceptedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("accepted_at", "body", "date-time", m.AcceptedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LegalDocument) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LegalDocument) validateLocale(formats strfmt.Registry) error {
+
+	if err := validate.Required("locale", "body", m.Locale); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LegalDocument) validateMandatory(formats strfmt.Registry) error {
+
+	if err := validate.Required("mandatory", "body", m.Mandatory); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LegalDocument) validatePublishedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("published_at", "body", m.PublishedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("published_at", "body", "date-time", m.PublishedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LegalDocument) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LegalDocument) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("url", "body", m.URL); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LegalDocument) validateVersion(formats strfmt.Registry) error {
+
+	if err := validate.Required("version", "body", m.Version); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this legal document based on context it is used
+func (m *LegalDocument) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LegalDocument) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LegalDocument) UnmarshalBinary(b []byte) error {
+	var res LegalDocument
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,124 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OauthErrorResponse OAuth 2.0 error response as specified by RFC 6749 section 5.2
+//
+// swagger:model oauthErrorResponse
+type OauthErrorResponse struct {
+
+	// OAuth 2.0 error code
+	// Example: invalid_grant
+	// Required: true
+	// Enum: [invalid_request invalid_client invalid_grant unauthorized_client unsupported_grant_type invalid_scope]
+	Error *string `json:"error"`
+
+	// Human readable description of the error
+	// Example: Authorization code is invalid or expired
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// Validate validates this oauth error response
+func (m *OauthErrorResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateError(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var oauthErrorResponseTypeErrorPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["invalid_request","invalid_client","invalid_grant","unauthorized_client","unsupported_grant_type","invalid_scope"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		oauthErrorResponseTypeErrorPropEnum = append(oauthErrorResponseTypeErrorPropEnum, v)
+	}
+}
+
+const (
+
+	// OauthErrorResponseErrorInvalidRequest captures enum value "invalid_request"
+	OauthErrorResponseErrorInvalidRequest string = "invalid_request"
+
+	// OauthErrorResponseErrorInvalidClient captures enum value "invalid_client"
+	OauthErrorResponseErrorInvalidClient string = "invalid_client"
+
+	// OauthErrorResponseErrorInvalidGrant captures enum value "invalid_grant"
+	OauthErrorResponseErrorInvalidGrant string = "invalid_grant"
+
+	// OauthErrorResponseErrorUnauthorizedClient captures enum value "unauthorized_client"
+	OauthErrorResponseErrorUnauthorizedClient string = "unauthorized_client"
+
+	// OauthErrorResponseErrorUnsupportedGrantType captures enum value "unsupported_grant_type"
+	OauthErrorResponseErrorUnsupportedGrantType string = "unsupported_grant_type"
+
+	// OauthErrorResponseErrorInvalidScope captures enum value "invalid_scope"
+	OauthErrorResponseErrorInvalidScope string = "invalid_scope"
+)
+
+// prop value enum
+func (m *OauthErrorResponse) validateErrorEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, oauthErrorResponseTypeErrorPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *OauthErrorResponse) validateError(formats strfmt.Registry) error {
+
+	if err := validate.Required("error", "body", m.Error); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateErrorEnum("error", "body", *m.Error); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this oauth error response based on context it is used
+func (m *OauthErrorResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OauthErrorResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OauthErrorResponse) UnmarshalBinary(b []byte) error {
+	var res OauthErrorResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
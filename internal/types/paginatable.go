@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Paginatable paginatable
+//
+// swagger:model paginatable
+type Paginatable struct {
+
+	// Actual limit applied to request
+	// Required: true
+	Limit *int64 `json:"limit"`
+
+	// Actual offset applied to request
+	// Required: true
+	Offset *int64 `json:"offset"`
+
+	// Total number of records available
+	// Required: true
+	Total *int64 `json:"total"`
+}
+
+// Validate validates this paginatable
+func (m *Paginatable) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLimit(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOffset(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Paginatable) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.Required("limit", "body", m.Limit); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Paginatable) validateOffset(formats strfmt.Registry) error {
+
+	if err := validate.Required("offset", "body", m.Offset); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Paginatable) validateTotal(formats strfmt.Registry) error {
+
+	if err := validate.Required("total", "body", m.Total); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this paginatable based on context it is used
+func (m *Paginatable) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Paginatable) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Paginatable) UnmarshalBinary(b []byte) error {
+	var res Paginatable
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
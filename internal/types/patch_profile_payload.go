@@ -0,0 +1,227 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/allaboutapps/nullable"
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// PatchProfilePayload Fields omitted are left unchanged, fields explicitly set to null are cleared.
+//
+// swagger:model patchProfilePayload
+type PatchProfilePayload struct {
+
+	// display name
+	DisplayName nullable.String `json:"display_name,omitempty"`
+
+	// family name
+	FamilyName nullable.String `json:"family_name,omitempty"`
+
+	// given name
+	GivenName nullable.String `json:"given_name,omitempty"`
+
+	// locale
+	Locale nullable.String `json:"locale,omitempty"`
+}
+
+// Validate validates this patch profile payload
+func (m *PatchProfilePayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDisplayName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFamilyName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGivenName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLocale(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PatchProfilePayload) validateDisplayName(formats strfmt.Registry) error {
+	if swag.IsZero(m.DisplayName) { // not required
+		return nil
+	}
+
+	if err := m.DisplayName.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("display_name")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("display_name")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *PatchProfilePayload) validateFamilyName(formats strfmt.Registry) error {
+	if swag.IsZero(m.FamilyName) { // not required
+		return nil
+	}
+
+	if err := m.FamilyName.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("family_name")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("family_name")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *PatchProfilePayload) validateGivenName(formats strfmt.Registry) error {
+	if swag.IsZero(m.GivenName) { // not required
+		return nil
+	}
+
+	if err := m.GivenName.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("given_name")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("given_name")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *PatchProfilePayload) validateLocale(formats strfmt.Registry) error {
+	if swag.IsZero(m.Locale) { // not required
+		return nil
+	}
+
+	if err := m.Locale.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("locale")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("locale")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this patch profile payload based on the context it is used
+func (m *PatchProfilePayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDisplayName(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateFamilyName(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateGivenName(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateLocale(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PatchProfilePayload) contextValidateDisplayName(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.DisplayName.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("display_name")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("display_name")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *PatchProfilePayload) contextValidateFamilyName(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.FamilyName.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("family_name")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("family_name")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *PatchProfilePayload) contextValidateGivenName(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.GivenName.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("given_name")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("given_name")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *PatchProfilePayload) contextValidateLocale(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Locale.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("locale")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("locale")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PatchProfilePayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PatchProfilePayload) UnmarshalBinary(b []byte) error {
+	var res PatchProfilePayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,88 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostAcceptLegalDocumentsPayload post accept legal documents payload
+//
+// swagger:model postAcceptLegalDocumentsPayload
+type PostAcceptLegalDocumentsPayload struct {
+
+	// IDs of the legal documents to accept
+	// Example: ["7c1d9e2a-4b3f-4e8a-9d61-2f5a8b0c3e47"]
+	// Required: true
+	// Min Items: 1
+	DocumentIds []strfmt.UUID4 `json:"document_ids"`
+}
+
+// Validate validates this post accept legal documents payload
+func (m *PostAcceptLegalDocumentsPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDocumentIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostAcceptLegalDocumentsPayload) validateDocumentIds(formats strfmt.Registry) error {
+
+	if err := validate.Required("document_ids", "body", m.DocumentIds); err != nil {
+		return err
+	}
+
+	iDocumentIdsSize := int64(len(m.DocumentIds))
+
+	if err := validate.MinItems("document_ids", "body", iDocumentIdsSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.DocumentIds); i++ {
+
+		if err := validate.FormatOf("document_ids"+"."+strconv.Itoa(i), "body", "uuid4", m.DocumentIds[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post accept legal documents payload based on context it is used
+func (m *PostAcceptLegalDocumentsPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostAcceptLegalDocumentsPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostAcceptLegalDocumentsPayload) UnmarshalBinary(b []byte) error {
+	var res PostAcceptLegalDocumentsPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,174 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostAdminPushCampaignPayload post admin push campaign payload
+//
+// swagger:model postAdminPushCampaignPayload
+type PostAdminPushCampaignPayload struct {
+
+	// Language of the users targeted by the `language` segment, matching all regions of the language
+	// Example: de
+	Language string `json:"language,omitempty"`
+
+	// message
+	// Required: true
+	Message *PushMessage `json:"message"`
+
+	// Segment of users the message is sent to:
+	// `all` users with the `app` scope, users with the `app` scope in the given `language` or users selected by the given `sql` query
+	// Example: language
+	// Required: true
+	// Enum: [all language sql]
+	Segment *string `json:"segment"`
+
+	// Query selecting the IDs of the users targeted by the `sql` segment, requires the `push:segment_sql` permission.
+	// Must be a single statement, executed in a read only transaction limited by the segment query timeout
+	// Example: SELECT user_id FROM app_user_profiles WHERE given_name IS NOT NULL
+	SQL string `json:"sql,omitempty"`
+}
+
+// Validate validates this post admin push campaign payload
+func (m *PostAdminPushCampaignPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMessage(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSegment(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostAdminPushCampaignPayload) validateMessage(formats strfmt.Registry) error {
+
+	if err := validate.Required("message", "body", m.Message); err != nil {
+		return err
+	}
+
+	if m.Message != nil {
+		if err := m.Message.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("message")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("message")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var postAdminPushCampaignPayloadTypeSegmentPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["all","language","sql"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		postAdminPushCampaignPayloadTypeSegmentPropEnum = append(postAdminPushCampaignPayloadTypeSegmentPropEnum, v)
+	}
+}
+
+const (
+
+	// PostAdminPushCampaignPayloadSegmentAll captures enum value "all"
+	PostAdminPushCampaignPayloadSegmentAll string = "all"
+
+	// PostAdminPushCampaignPayloadSegmentLanguage captures enum value "language"
+	PostAdminPushCampaignPayloadSegmentLanguage string = "language"
+
+	// PostAdminPushCampaignPayloadSegmentSQL captures enum value "sql"
+	PostAdminPushCampaignPayloadSegmentSQL string = "sql"
+)
+
+// prop value enum
+func (m *PostAdminPushCampaignPayload) validateSegmentEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, postAdminPushCampaignPayloadTypeSegmentPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PostAdminPushCampaignPayload) validateSegment(formats strfmt.Registry) error {
+
+	if err := validate.Required("segment", "body", m.Segment); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateSegmentEnum("segment", "body", *m.Segment); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post admin push campaign payload based on the context it is used
+func (m *PostAdminPushCampaignPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMessage(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostAdminPushCampaignPayload) contextValidateMessage(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Message != nil {
+		if err := m.Message.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("message")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("message")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostAdminPushCampaignPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostAdminPushCampaignPayload) UnmarshalBinary(b []byte) error {
+	var res PostAdminPushCampaignPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
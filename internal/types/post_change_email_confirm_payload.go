@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostChangeEmailConfirmPayload post change email confirm payload
+//
+// swagger:model postChangeEmailConfirmPayload
+type PostChangeEmailConfirmPayload struct {
+
+	// Email change token sent via email to the new email address
+	// Example: 3b9e1f5c-2a7d-4c8e-9f60-1d4b7a2e8c35
+	// Required: true
+	// Format: uuid4
+	Token *strfmt.UUID4 `json:"token"`
+}
+
+// Validate validates this post change email confirm payload
+func (m *PostChangeEmailConfirmPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostChangeEmailConfirmPayload) validateToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("token", "body", m.Token); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("token", "body", "uuid4", m.Token.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post change email confirm payload based on context it is used
+func (m *PostChangeEmailConfirmPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostChangeEmailConfirmPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostChangeEmailConfirmPayload) UnmarshalBinary(b []byte) error {
+	var res PostChangeEmailConfirmPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
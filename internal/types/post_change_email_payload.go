@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostChangeEmailPayload post change email payload
+//
+// swagger:model postChangeEmailPayload
+type PostChangeEmailPayload struct {
+
+	// Current password of user, required to confirm the change
+	// Example: correct horse battery staple
+	// Required: true
+	// Max Length: 500
+	// Min Length: 1
+	CurrentPassword *string `json:"currentPassword"`
+
+	// New username (email address) to change to
+	// Example: user@example.com
+	// Required: true
+	// Max Length: 255
+	// Min Length: 1
+	// Format: email
+	Username *strfmt.Email `json:"username"`
+}
+
+// Validate validates this post change email payload
+func (m *PostChangeEmailPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCurrentPassword(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUsername(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostChangeEmailPayload) validateCurrentPassword(formats strfmt.Registry) error {
+
+	if err := validate.Required("currentPassword", "body", m.CurrentPassword); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("currentPassword", "body", *m.CurrentPassword, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("currentPassword", "body", *m.CurrentPassword, 500); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostChangeEmailPayload) validateUsername(formats strfmt.Registry) error {
+
+	if err := validate.Required("username", "body", m.Username); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("username", "body", m.Username.String(), 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("username", "body", m.Username.String(), 255); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("username", "body", "email", m.Username.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post change email payload based on context it is used
+func (m *PostChangeEmailPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostChangeEmailPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostChangeEmailPayload) UnmarshalBinary(b []byte) error {
+	var res PostChangeEmailPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostConfirmTotpPayload post confirm totp payload
+//
+// swagger:model postConfirmTotpPayload
+type PostConfirmTotpPayload struct {
+
+	// Current code generated by the authenticator app
+	// Example: 123456
+	// Required: true
+	// Pattern: ^[0-9]{6}$
+	Code *string `json:"code"`
+}
+
+// Validate validates this post confirm totp payload
+func (m *PostConfirmTotpPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostConfirmTotpPayload) validateCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("code", "body", m.Code); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("code", "body", *m.Code, `^[0-9]{6}$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post confirm totp payload based on context it is used
+func (m *PostConfirmTotpPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostConfirmTotpPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostConfirmTotpPayload) UnmarshalBinary(b []byte) error {
+	var res PostConfirmTotpPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostConfirmTotpResponse post confirm totp response
+//
+// swagger:model postConfirmTotpResponse
+type PostConfirmTotpResponse struct {
+
+	// Single-use recovery codes, which may be used instead of a code in case the authenticator app is lost.
+	// Recovery codes are only returned once and should be stored securely by the user
+	// Required: true
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// Validate validates this post confirm totp response
+func (m *PostConfirmTotpResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRecoveryCodes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostConfirmTotpResponse) validateRecoveryCodes(formats strfmt.Registry) error {
+
+	if err := validate.Required("recovery_codes", "body", m.RecoveryCodes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post confirm totp response based on context it is used
+func (m *PostConfirmTotpResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostConfirmTotpResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostConfirmTotpResponse) UnmarshalBinary(b []byte) error {
+	var res PostConfirmTotpResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostCreateAPIKeyPayload post create Api key payload
+//
+// swagger:model postCreateApiKeyPayload
+type PostCreateAPIKeyPayload struct {
+
+	// Seconds until the API key expires, the key does not expire if omitted
+	// Example: 31536000
+	// Minimum: 1
+	ExpiresIn int64 `json:"expires_in,omitempty"`
+
+	// Name of API key, e.g. the machine client using it
+	// Example: CI pipeline
+	// Required: true
+	// Max Length: 255
+	// Min Length: 1
+	Name *string `json:"name"`
+
+	// Scopes to grant to the API key, defaults to all scopes of the user
+	// Example: ["app"]
+	Scopes []string `json:"scopes"`
+}
+
+// Validate validates this post create Api key payload
+func (m *PostCreateAPIKeyPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateExpiresIn(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostCreateAPIKeyPayload) validateExpiresIn(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExpiresIn) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("expires_in", "body", m.ExpiresIn, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostCreateAPIKeyPayload) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("name", "body", *m.Name, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("name", "body", *m.Name, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post create Api key payload based on context it is used
+func (m *PostCreateAPIKeyPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostCreateAPIKeyPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostCreateAPIKeyPayload) UnmarshalBinary(b []byte) error {
+	var res PostCreateAPIKeyPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,125 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostCreateAPIKeyResponse post create Api key response
+//
+// swagger:model postCreateApiKeyResponse
+type PostCreateAPIKeyResponse struct {
+
+	// api key
+	// Required: true
+	APIKey *APIKey `json:"api_key"`
+
+	// API key to authenticate with using `Authorization: ApiKey <key>`, only returned once on creation
+	// Example: ak_3f9c2a7d1e4b8c60_9d3b7e1a2c4f6b8d0e2a4c6e8b0d2f4a6c8e0b2d4f6a8c0e2b4d6f8a0c2e4b6d8f
+	// Required: true
+	Key *string `json:"key"`
+}
+
+// Validate validates this post create Api key response
+func (m *PostCreateAPIKeyResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAPIKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostCreateAPIKeyResponse) validateAPIKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("api_key", "body", m.APIKey); err != nil {
+		return err
+	}
+
+	if m.APIKey != nil {
+		if err := m.APIKey.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("api_key")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("api_key")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PostCreateAPIKeyResponse) validateKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("key", "body", m.Key); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this post create Api key response based on the context it is used
+func (m *PostCreateAPIKeyResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAPIKey(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostCreateAPIKeyResponse) contextValidateAPIKey(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.APIKey != nil {
+		if err := m.APIKey.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("api_key")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("api_key")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostCreateAPIKeyResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostCreateAPIKeyResponse) UnmarshalBinary(b []byte) error {
+	var res PostCreateAPIKeyResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
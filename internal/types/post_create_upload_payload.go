@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostCreateUploadPayload post create upload payload
+//
+// swagger:model postCreateUploadPayload
+type PostCreateUploadPayload struct {
+
+	// Original name of the file, if known
+	// Example: holiday.jpg
+	// Max Length: 255
+	FileName *string `json:"file_name,omitempty"`
+
+	// MIME type of the file, verified against its content once completed
+	// Example: image/jpeg
+	// Required: true
+	// Min Length: 1
+	MimeType *string `json:"mime_type"`
+
+	// Size of the file in bytes
+	// Example: 1048576
+	// Required: true
+	// Minimum: 1
+	Size *int64 `json:"size"`
+}
+
+// Validate validates this post create upload payload
+func (m *PostCreateUploadPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFileName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMimeType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostCreateUploadPayload) validateFileName(formats strfmt.Registry) error {
+	if swag.IsZero(m.FileName) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("file_name", "body", *m.FileName, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostCreateUploadPayload) validateMimeType(formats strfmt.Registry) error {
+
+	if err := validate.Required("mime_type", "body", m.MimeType); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("mime_type", "body", *m.MimeType, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostCreateUploadPayload) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("size", "body", m.Size); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("size", "body", *m.Size, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post create upload payload based on context it is used
+func (m *PostCreateUploadPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostCreateUploadPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostCreateUploadPayload) UnmarshalBinary(b []byte) error {
+	var res PostCreateUploadPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,90 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostEnrollTotpResponse post enroll totp response
+//
+// swagger:model postEnrollTotpResponse
+type PostEnrollTotpResponse struct {
+
+	// Key URI of the secret, to be presented as a QR code scannable by authenticator apps
+	// Example: otpauth://totp/go-starter:user@example.com?algorithm=SHA1\u0026digits=6\u0026issuer=go-starter\u0026period=30\u0026secret=JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP
+	// Required: true
+	OtpauthURI *string `json:"otpauth_uri"`
+
+	// Base32 encoded secret, for manually entering it into authenticator apps
+	// Example: JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP
+	// Required: true
+	Secret *string `json:"secret"`
+}
+
+// Validate validates this post enroll totp response
+func (m *PostEnrollTotpResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateOtpauthURI(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSecret(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostEnrollTotpResponse) validateOtpauthURI(formats strfmt.Registry) error {
+
+	if err := validate.Required("otpauth_uri", "body", m.OtpauthURI); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostEnrollTotpResponse) validateSecret(formats strfmt.Registry) error {
+
+	if err := validate.Required("secret", "body", m.Secret); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post enroll totp response based on context it is used
+func (m *PostEnrollTotpResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostEnrollTotpResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostEnrollTotpResponse) UnmarshalBinary(b []byte) error {
+	var res PostEnrollTotpResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostLoginMfaPayload post login mfa payload
+//
+// swagger:model postLoginMfaPayload
+type PostLoginMfaPayload struct {
+
+	// Current code generated by the authenticator app or one of the user's unused recovery codes
+	// Example: 123456
+	// Required: true
+	// Max Length: 32
+	// Min Length: 1
+	Code *string `json:"code"`
+
+	// MFA token returned by the login endpoint
+	// Example: 5f3b2e0c-9a47-4c1e-8c61-2b4f0d9f7a13
+	// Required: true
+	// Format: uuid4
+	MfaToken *strfmt.UUID4 `json:"mfa_token"`
+}
+
+// Validate validates this post login mfa payload
+func (m *PostLoginMfaPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMfaToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostLoginMfaPayload) validateCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("code", "body", m.Code); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("code", "body", *m.Code, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("code", "body", *m.Code, 32); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostLoginMfaPayload) validateMfaToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("mfa_token", "body", m.MfaToken); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("mfa_token", "body", "uuid4", m.MfaToken.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post login mfa payload based on context it is used
+func (m *PostLoginMfaPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostLoginMfaPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostLoginMfaPayload) UnmarshalBinary(b []byte) error {
+	var res PostLoginMfaPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostLoginMfaRequiredResponse post login mfa required response
+//
+// swagger:model postLoginMfaRequiredResponse
+type PostLoginMfaRequiredResponse struct {
+
+	// MFA token expiry in seconds
+	// Example: 300
+	// Required: true
+	ExpiresIn *int64 `json:"expires_in"`
+
+	// Short-lived token to exchange for an access and refresh token using the MFA login endpoint
+	// Example: 5f3b2e0c-9a47-4c1e-8c61-2b4f0d9f7a13
+	// Required: true
+	// Format: uuid4
+	MfaToken *strfmt.UUID4 `json:"mfa_token"`
+
+	// Type of second factor required, will always be `totp`
+	// Example: totp
+	// Required: true
+	MfaType *string `json:"mfa_type"`
+}
+
+// Validate validates this post login mfa required response
+func (m *PostLoginMfaRequiredResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateExpiresIn(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMfaToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMfaType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostLoginMfaRequiredResponse) validateExpiresIn(formats strfmt.Registry) error {
+
+	if err := validate.Required("expires_in", "body", m.ExpiresIn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostLoginMfaRequiredResponse) validateMfaToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("mfa_token", "body", m.MfaToken); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("mfa_token", "body", "uuid4", m.MfaToken.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostLoginMfaRequiredResponse) validateMfaType(formats strfmt.Registry) error {
+
+	if err := validate.Required("mfa_type", "body", m.MfaType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post login mfa required response based on context it is used
+func (m *PostLoginMfaRequiredResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostLoginMfaRequiredResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostLoginMfaRequiredResponse) UnmarshalBinary(b []byte) error {
+	var res PostLoginMfaRequiredResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
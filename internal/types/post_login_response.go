@@ -19,11 +19,11 @@ import (
 // swagger:model postLoginResponse
 type PostLoginResponse struct {
 
-	// Access token required for accessing protected API endpoints
+	// Access token required for accessing protected API endpoints.
+	// Depending on the server's configuration either an opaque UUID4 or a signed JWT
 	// Example: c1247d8d-0d65-41c4-bc86-ec041d2ac437
 	// Required: true
-	// Format: uuid4
-	AccessToken *strfmt.UUID4 `json:"access_token"`
+	AccessToken *string `json:"access_token"`
 
 	// Access token expiry in seconds
 	// Example: 86400
@@ -74,10 +74,6 @@ func (m *PostLoginResponse) validateAccessToken(formats strfmt.Registry) error {
 		return err
 	}
 
-	if err := validate.FormatOf("access_token", "body", "uuid4", m.AccessToken.String(), formats); err != nil {
-		return err
-	}
-
 	return nil
 }
 
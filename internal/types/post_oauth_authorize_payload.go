@@ -0,0 +1,276 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostOauthAuthorizePayload post oauth authorize payload
+//
+// swagger:model postOauthAuthorizePayload
+type PostOauthAuthorizePayload struct {
+
+	// ID of OAuth client requesting authorization
+	// Example: 0e1f4a8c-52e5-4f2b-9d6a-3b7c1e2f9a40
+	// Required: true
+	// Format: uuid4
+	ClientID *strfmt.UUID4 `json:"client_id"`
+
+	// PKCE code challenge derived from the client's code verifier
+	// Example: E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM
+	// Required: true
+	// Max Length: 128
+	// Min Length: 43
+	CodeChallenge *string `json:"code_challenge"`
+
+	// PKCE code challenge method, only `S256` is supported
+	// Example: S256
+	// Required: true
+	// Enum: [S256]
+	CodeChallengeMethod *string `json:"code_challenge_method"`
+
+	// Redirect URI registered for the client to return the authorization code to
+	// Example: https://partner.example.com/callback
+	// Required: true
+	// Max Length: 2048
+	// Min Length: 1
+	RedirectURI *string `json:"redirect_uri"`
+
+	// OAuth 2.0 response type, will always be `code`
+	// Example: code
+	// Required: true
+	// Enum: [code]
+	ResponseType *string `json:"response_type"`
+
+	// Space separated list of scopes requested, defaults to all scopes registered for the client
+	// Example: app
+	// Max Length: 1024
+	Scope string `json:"scope,omitempty"`
+
+	// Opaque value passed back to the client with the redirect URI
+	// Example: af0ifjsldkj
+	// Max Length: 1024
+	State string `json:"state,omitempty"`
+}
+
+// Validate validates this post oauth authorize payload
+func (m *PostOauthAuthorizePayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClientID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCodeChallenge(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCodeChallengeMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRedirectURI(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateResponseType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScope(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostOauthAuthorizePayload) validateClientID(formats strfmt.Registry) error {
+
+	if err := validate.Required("client_id", "body", m.ClientID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("client_id", "body", "uuid4", m.ClientID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostOauthAuthorizePayload) validateCodeChallenge(formats strfmt.Registry) error {
+
+	if err := validate.Required("code_challenge", "body", m.CodeChallenge); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("code_challenge", "body", *m.CodeChallenge, 43); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("code_challenge", "body", *m.CodeChallenge, 128); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var postOauthAuthorizePayloadTypeCodeChallengeMethodPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["S256"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		postOauthAuthorizePayloadTypeCodeChallengeMethodPropEnum = append(postOauthAuthorizePayloadTypeCodeChallengeMethodPropEnum, v)
+	}
+}
+
+const (
+
+	// PostOauthAuthorizePayloadCodeChallengeMethodS256 captures enum value "S256"
+	PostOauthAuthorizePayloadCodeChallengeMethodS256 string = "S256"
+)
+
+// prop value enum
+func (m *PostOauthAuthorizePayload) validateCodeChallengeMethodEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, postOauthAuthorizePayloadTypeCodeChallengeMethodPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PostOauthAuthorizePayload) validateCodeChallengeMethod(formats strfmt.Registry) error {
+
+	if err := validate.Required("code_challenge_method", "body", m.CodeChallengeMethod); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateCodeChallengeMethodEnum("code_challenge_method", "body", *m.CodeChallengeMethod); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostOauthAuthorizePayload) validateRedirectURI(formats strfmt.Registry) error {
+
+	if err := validate.Required("redirect_uri", "body", m.RedirectURI); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("redirect_uri", "body", *m.RedirectURI, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("redirect_uri", "body", *m.RedirectURI, 2048); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var postOauthAuthorizePayloadTypeResponseTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["code"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		postOauthAuthorizePayloadTypeResponseTypePropEnum = append(postOauthAuthorizePayloadTypeResponseTypePropEnum, v)
+	}
+}
+
+const (
+
+	// PostOauthAuthorizePayloadResponseTypeCode captures enum value "code"
+	PostOauthAuthorizePayloadResponseTypeCode string = "code"
+)
+
+// prop value enum
+func (m *PostOauthAuthorizePayload) validateResponseTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, postOauthAuthorizePayloadTypeResponseTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PostOauthAuthorizePayload) validateResponseType(formats strfmt.Registry) error {
+
+	if err := validate.Required("response_type", "body", m.ResponseType); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateResponseTypeEnum("response_type", "body", *m.ResponseType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostOauthAuthorizePayload) validateScope(formats strfmt.Registry) error {
+	if swag.IsZero(m.Scope) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("scope", "body", m.Scope, 1024); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostOauthAuthorizePayload) validateState(formats strfmt.Registry) error {
+	if swag.IsZero(m.State) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("state", "body", m.State, 1024); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post oauth authorize payload based on context it is used
+func (m *PostOauthAuthorizePayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostOauthAuthorizePayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostOauthAuthorizePayload) UnmarshalBinary(b []byte) error {
+	var res PostOauthAuthorizePayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
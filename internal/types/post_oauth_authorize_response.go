@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostOauthAuthorizeResponse post oauth authorize response
+//
+// swagger:model postOauthAuthorizeResponse
+type PostOauthAuthorizeResponse struct {
+
+	// Redirect URI the user agent should be sent to, carrying either the authorization code or
+	// an OAuth 2.0 error as query parameters along with the state provided
+	// Example: https://partner.example.com/callback?code=4d1b1e3c-6f6c-4b5e-9a8f-0c2d7e9b1a23\u0026state=af0ifjsldkj
+	// Required: true
+	RedirectURI *string `json:"redirect_uri"`
+}
+
+// Validate validates this post oauth authorize response
+func (m *PostOauthAuthorizeResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRedirectURI(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostOauthAuthorizeResponse) validateRedirectURI(formats strfmt.Registry) error {
+
+	if err := validate.Required("redirect_uri", "body", m.RedirectURI); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post oauth authorize response based on context it is used
+func (m *PostOauthAuthorizeResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostOauthAuthorizeResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostOauthAuthorizeResponse) UnmarshalBinary(b []byte) error {
+	var res PostOauthAuthorizeResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PostOauthTokenResponse OAuth 2.0 access token response as specified by RFC 6749 section 5.1
+//
+// swagger:model postOauthTokenResponse
+type PostOauthTokenResponse struct {
+
+	// Access token required for accessing protected API endpoints, restricted to the scopes granted.
+	// Depending on the server's configuration either an opaque UUID4 or a signed JWT
+	// Example: c1247d8d-0d65-41c4-bc86-ec041d2ac437
+	// Required: true
+	AccessToken *string `json:"access_token"`
+
+	// Access token expiry in seconds
+	// Example: 86400
+	// Required: true
+	ExpiresIn *int64 `json:"expires_in"`
+
+	// Refresh token for refreshing the access token once it expires, not issued for the `client_credentials` grant
+	// Example: 1dadb3bd-50d8-485d-83a3-6111392568f0
+	// Format: uuid4
+	RefreshToken strfmt.UUID4 `json:"refresh_token,omitempty"`
+
+	// Space separated list of scopes granted
+	// Example: app
+	// Required: true
+	Scope *string `json:"scope"`
+
+	// Type of access token, will always be `bearer`
+	// Example: bearer
+	// Required: true
+	TokenType *string `json:"token_type"`
+}
+
+// Validate validates this post oauth token response
+func (m *PostOauthTokenResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAccessToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresIn(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRefreshToken(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScope(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTokenType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PostOauthTokenResponse) validateAccessToken(formats strfmt.Registry) error {
+
+	if err := validate.Required("access_token", "body", m.AccessToken); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostOauthTokenResponse) validateExpiresIn(formats strfmt.Registry) error {
+
+	if err := validate.Required("expires_in", "body", m.ExpiresIn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostOauthTokenResponse) validateRefreshToken(formats strfmt.Registry) error {
+	if swag.IsZero(m.RefreshToken) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("refresh_token", "body", "uuid4", m.RefreshToken.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostOauthTokenResponse) validateScope(formats strfmt.Registry) error {
+
+	if err := validate.Required("scope", "body", m.Scope); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostOauthTokenResponse) validateTokenType(formats strfmt.Registry) error {
+
+	if err := validate.Required("token_type", "body", m.TokenType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this post oauth token response based on context it is used
+func (m *PostOauthTokenResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PostOauthTokenResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PostOauthTokenResponse) UnmarshalBinary(b []byte) error {
+	var res PostOauthTokenResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Profile profile
+//
+// swagger:model profile
+type Profile struct {
+
+	// Name displayed to other users, if set
+	// Example: Max
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// Family name of user, if set
+	// Example: Mustermann
+	FamilyName *string `json:"family_name,omitempty"`
+
+	// Given name of user, if set
+	// Example: Max
+	GivenName *string `json:"given_name,omitempty"`
+
+	// Whether the user has uploaded an avatar, available via `GET /api/v1/auth/profile/avatar`
+	// Example: true
+	// Required: true
+	HasAvatar *bool `json:"has_avatar"`
+
+	// Timestamp the user last accepted legal documents, if ever
+	// Example: 2020-06-10T12:13:56.000Z
+	// Format: date-time
+	LegalAcceptedAt *strfmt.DateTime `json:"legal_accepted_at,omitempty"`
+
+	// Preferred locale of user, if set
+	// Example: de
+	Locale *string `json:"locale,omitempty"`
+
+	// Timestamp the profile was last updated
+	// Example: 2020-06-12T09:03:46.000Z
+	// Required: true
+	// Format: date-time
+	UpdatedAt *strfmt.DateTime `json:"updated_at"`
+}
+
+// Validate validates this profile
+func (m *Profile) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHasAvatar(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLegalAcceptedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Profile) validateHasAvatar(formats strfmt.Registry) error {
+
+	if err := validate.Required("has_avatar", "body", m.HasAvatar); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Profile) validateLegalAcceptedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LegalAcceptedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("legal_accepted_at", "body", "date-time", m.LegalAcceptedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Profile) validateUpdatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("updated_at", "body", m.UpdatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("updated_at", "body", "date-time", m.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this profile based on context it is used
+func (m *Profile) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Profile) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Profile) UnmarshalBinary(b []byte) error {
+	var res Profile
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PushMessage Push message, requires a notification or data
+//
+// swagger:model pushMessage
+type PushMessage struct {
+
+	// Undelivered messages having the same collapse key are replaced by the latest one
+	// Example: news
+	CollapseKey string `json:"collapse_key,omitempty"`
+
+	// Custom key-value pairs delivered to the app
+	// Example: {"id":"42"}
+	Data map[string]string `json:"data,omitempty"`
+
+	// notification
+	Notification *PushNotification `json:"notification,omitempty"`
+
+	// Delivery priority, defaults to the provider's configuration
+	// Example: high
+	// Enum: [normal high]
+	Priority string `json:"priority,omitempty"`
+
+	// Seconds the message is stored for delivery while the device is offline, 0 uses the provider's default
+	// Example: 3600
+	// Minimum: 0
+	TTLSeconds *int64 `json:"ttl_seconds,omitempty"`
+}
+
+// Validate validates this push message
+func (m *PushMessage) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNotification(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePriority(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTTLSeconds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PushMessage) validateNotification(formats strfmt.Registry) error {
+	if swag.IsZero(m.Notification) { // not required
+		return nil
+	}
+
+	if m.Notification != nil {
+		if err := m.Notification.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("notification")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("notification")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var pushMessageTypePriorityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["normal","high"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		pushMessageTypePriorityPropEnum = append(pushMessageTypePriorityPropEnum, v)
+	}
+}
+
+const (
+
+	// PushMessagePriorityNormal captures enum value "normal"
+	PushMessagePriorityNormal string = "normal"
+
+	// PushMessagePriorityHigh captures enum value "high"
+	PushMessagePriorityHigh string = "high"
+)
+
+// prop value enum
+func (m *PushMessage) validatePriorityEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, pushMessageTypePriorityPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PushMessage) validatePriority(formats strfmt.Registry) error {
+	if swag.IsZero(m.Priority) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validatePriorityEnum("priority", "body", m.Priority); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PushMessage) validateTTLSeconds(formats strfmt.Registry) error {
+	if swag.IsZero(m.TTLSeconds) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("ttl_seconds", "body", *m.TTLSeconds, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this push message based on the context it is used
+func (m *PushMessage) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateNotification(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PushMessage) contextValidateNotification(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Notification != nil {
+		if err := m.Notification.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("notification")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("notification")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PushMessage) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PushMessage) UnmarshalBinary(b []byte) error {
+	var res PushMessage
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,82 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// PushNotification push notification
+//
+// swagger:model pushNotification
+type PushNotification struct {
+
+	// Badge count displayed on the app icon, left unchanged if omitted
+	// Example: 1
+	Badge *int64 `json:"badge,omitempty"`
+
+	// Body of the notification
+	// Example: World
+	Body string `json:"body,omitempty"`
+
+	// i18n key of the body, resolved in the language of each receiving user, takes precedence over body
+	// Example: Push.News.Body
+	BodyKey string `json:"body_key,omitempty"`
+
+	// URL of an image displayed within the notification
+	// Example: https://example.com/image.png
+	ImageURL string `json:"image_url,omitempty"`
+
+	// Deep link opened when tapping the notification
+	// Example: app://news/42
+	Link string `json:"link,omitempty"`
+
+	// Name of the sound played, "default" for the platform's default sound
+	// Example: default
+	Sound string `json:"sound,omitempty"`
+
+	// Template data used to translate title_key and body_key
+	TemplateData map[string]string `json:"template_data,omitempty"`
+
+	// Title of the notification
+	// Example: Hello
+	Title string `json:"title,omitempty"`
+
+	// i18n key of the title, resolved in the language of each receiving user, takes precedence over title
+	// Example: Push.News.Title
+	TitleKey string `json:"title_key,omitempty"`
+}
+
+// Validate validates this push notification
+func (m *PushNotification) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this push notification based on context it is used
+func (m *PushNotification) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PushNotification) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PushNotification) UnmarshalBinary(b []byte) error {
+	var res PushNotification
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
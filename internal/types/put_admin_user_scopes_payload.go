@@ -0,0 +1,102 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PutAdminUserScopesPayload put admin user scopes payload
+//
+// swagger:model putAdminUserScopesPayload
+type PutAdminUserScopesPayload struct {
+
+	// Scopes granted to the user, replacing all scopes granted before
+	// Example: ["app","cms"]
+	// Required: true
+	Scopes []string `json:"scopes"`
+}
+
+// Validate validates this put admin user scopes payload
+func (m *PutAdminUserScopesPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateScopes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var putAdminUserScopesPayloadScopesItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["app","cms"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		putAdminUserScopesPayloadScopesItemsEnum = append(putAdminUserScopesPayloadScopesItemsEnum, v)
+	}
+}
+
+func (m *PutAdminUserScopesPayload) validateScopesItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, putAdminUserScopesPayloadScopesItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PutAdminUserScopesPayload) validateScopes(formats strfmt.Registry) error {
+
+	if err := validate.Required("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Scopes); i++ {
+
+		// value enum
+		if err := m.validateScopesItemsEnum("scopes"+"."+strconv.Itoa(i), "body", m.Scopes[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validates this put admin user scopes payload based on context it is used
+func (m *PutAdminUserScopesPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PutAdminUserScopesPayload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PutAdminUserScopesPayload) UnmarshalBinary(b []byte) error {
+	var res PutAdminUserScopesPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
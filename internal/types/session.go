@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Session session
+//
+// swagger:model session
+type Session struct {
+
+	// Timestamp the session was created (login or registration)
+	// Example: 2020-06-10T12:13:56.000Z
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at"`
+
+	// Whether this is the session the request was authenticated with
+	// Example: true
+	// Required: true
+	Current *bool `json:"current"`
+
+	// Name of the device provided during login, if available
+	// Example: Pixel 7
+	DeviceName string `json:"device_name,omitempty"`
+
+	// ID of session
+	// Example: 2f8a5ad5-8b6b-4ba9-a0ce-9e3fd4f2f6c1
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// IP address of the client that last used the session, if available
+	// Example: 203.0.113.42
+	IPAddress string `json:"ip_address,omitempty"`
+
+	// Timestamp the session was last used to obtain new tokens
+	// Example: 2020-06-12T09:03:46.000Z
+	// Required: true
+	// Format: date-time
+	LastSeenAt *strfmt.DateTime `json:"last_seen_at"`
+
+	// User agent of the client that last used the session, if available
+	// Example: Mozilla/5.0 (Linux; Android 13; Pixel 7)
+	UserAgent string `json:"user_agent,omitempty"`
+}
+
+// Validate validates this session
+func (m *Session) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCurrent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastSeenAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Session) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Session) validateCurrent(formats strfmt.Registry) error {
+
+	if err := validate.Required("current", "body", m.Current); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Session) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Session) validateLastSeenAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("last_seen_at", "body", m.LastSeenAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("last_seen_at", "body", "date-time", m.LastSeenAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this session based on context it is used
+func (m *Session) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Session) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Session) UnmarshalBinary(b []byte) error {
+	var res Session
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -36,18 +36,60 @@ func (o *SwaggerSpec) initHandlerCache() {
 	o.Handlers["HEAD"] = make(map[string]bool)
 	o.Handlers["PATCH"] = make(map[string]bool)
 
+	o.Handlers["DELETE"]["/api/v1/auth/account"] = true
+	o.Handlers["DELETE"]["/api/v1/auth/api-keys/{id}"] = true
+	o.Handlers["DELETE"]["/api/v1/auth/profile/avatar"] = true
+	o.Handlers["DELETE"]["/api/v1/auth/sessions/{id}"] = true
+	o.Handlers["GET"]["/api/v1/auth/account/export"] = true
+	o.Handlers["GET"]["/api/v1/admin/push/campaigns/{id}"] = true
+	o.Handlers["GET"]["/api/v1/admin/users/{id}"] = true
+	o.Handlers["GET"]["/api/v1/admin/users"] = true
+	o.Handlers["GET"]["/api/v1/auth/api-keys"] = true
+	o.Handlers["GET"]["/api/v1/files/{id}"] = true
 	o.Handlers["GET"]["/-/healthy"] = true
+	o.Handlers["GET"]["/api/v1/auth/legal-documents"] = true
+	o.Handlers["GET"]["/.well-known/oauth-authorization-server"] = true
+	o.Handlers["GET"]["/api/v1/auth/profile/avatar"] = true
+	o.Handlers["GET"]["/api/v1/auth/profile"] = true
 	o.Handlers["GET"]["/api/v1/push/test"] = true
 	o.Handlers["GET"]["/-/ready"] = true
+	o.Handlers["GET"]["/api/v1/auth/sessions"] = true
+	o.Handlers["GET"]["/api/v1/storage"] = true
 	o.Handlers["GET"]["/swagger.yml"] = true
+	o.Handlers["GET"]["/api/v1/uploads/{id}"] = true
 	o.Handlers["GET"]["/api/v1/auth/userinfo"] = true
 	o.Handlers["GET"]["/-/version"] = true
+	o.Handlers["PATCH"]["/api/v1/auth/profile"] = true
+	o.Handlers["PATCH"]["/api/v1/uploads/{id}"] = true
+	o.Handlers["POST"]["/api/v1/auth/legal-documents/accept"] = true
+	o.Handlers["POST"]["/api/v1/admin/push/campaigns"] = true
+	o.Handlers["POST"]["/api/v1/admin/users/{id}/activate"] = true
+	o.Handlers["POST"]["/api/v1/admin/users/{id}/deactivate"] = true
+	o.Handlers["POST"]["/api/v1/admin/users/{id}/force-password-reset"] = true
+	o.Handlers["POST"]["/api/v1/admin/users/{id}/revoke-tokens"] = true
+	o.Handlers["POST"]["/api/v1/auth/change-email/confirm"] = true
+	o.Handlers["POST"]["/api/v1/auth/change-email/revert"] = true
+	o.Handlers["POST"]["/api/v1/auth/change-email"] = true
 	o.Handlers["POST"]["/api/v1/auth/change-password"] = true
+	o.Handlers["POST"]["/api/v1/uploads/{id}/complete"] = true
+	o.Handlers["POST"]["/api/v1/auth/mfa/totp/confirm"] = true
+	o.Handlers["POST"]["/api/v1/auth/api-keys"] = true
+	o.Handlers["POST"]["/api/v1/uploads"] = true
+	o.Handlers["POST"]["/api/v1/auth/mfa/totp"] = true
 	o.Handlers["POST"]["/api/v1/auth/forgot-password/complete"] = true
 	o.Handlers["POST"]["/api/v1/auth/forgot-password"] = true
+	o.Handlers["POST"]["/api/v1/auth/login/mfa"] = true
+	o.Handlers["POST"]["/api/v1/auth/login/oidc"] = true
 	o.Handlers["POST"]["/api/v1/auth/login"] = true
 	o.Handlers["POST"]["/api/v1/auth/logout"] = true
+	o.Handlers["POST"]["/api/v1/auth/oauth/authorize"] = true
+	o.Handlers["POST"]["/api/v1/auth/oauth/token"] = true
 	o.Handlers["POST"]["/api/v1/auth/refresh"] = true
 	o.Handlers["POST"]["/api/v1/auth/register"] = true
+	o.Handlers["POST"]["/api/v1/auth/resend-verification"] = true
+	o.Handlers["POST"]["/api/v1/auth/sessions/revoke-others"] = true
 	o.Handlers["PUT"]["/api/v1/push/token"] = true
+	o.Handlers["POST"]["/api/v1/auth/verify-email"] = true
+	o.Handlers["PUT"]["/api/v1/admin/users/{id}/scopes"] = true
+	o.Handlers["PUT"]["/api/v1/auth/profile/avatar"] = true
 }
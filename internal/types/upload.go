@@ -0,0 +1,204 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package types
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Upload upload
+//
+// swagger:model upload
+type Upload struct {
+
+	// Timestamp the upload was completed at, if completed
+	// Example: 2020-06-10T12:15:02.000Z
+	// Format: date-time
+	CompletedAt *strfmt.DateTime `json:"completed_at,omitempty"`
+
+	// Timestamp the upload was created at
+	// Example: 2020-06-10T12:13:56.000Z
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"created_at"`
+
+	// Timestamp the upload expires at unless further chunks are received, only set if incomplete
+	// Example: 2020-06-11T12:13:56.000Z
+	// Format: date-time
+	ExpiresAt *strfmt.DateTime `json:"expires_at,omitempty"`
+
+	// Original name of the file, if provided
+	// Example: holiday.jpg
+	FileName *string `json:"file_name,omitempty"`
+
+	// ID of upload
+	// Example: 8d6f2a34-1c7e-4b5a-9e0d-3f2b1a6c4d8e
+	// Required: true
+	// Format: uuid4
+	ID *strfmt.UUID4 `json:"id"`
+
+	// MIME type of the file
+	// Example: image/jpeg
+	// Required: true
+	MimeType *string `json:"mime_type"`
+
+	// Number of bytes received so far
+	// Example: 524288
+	// Required: true
+	Offset *int64 `json:"offset"`
+
+	// Size of the file in bytes
+	// Example: 1048576
+	// Required: true
+	Size *int64 `json:"size"`
+
+	// Signed URL to download the file from, only set if completed. Expires after a configurable duration
+	// Example: https://example.com/api/v1/storage?key=uploads%2Ffile.jpg\u0026expires=1591791302\u0026signature=c0ffee
+	URL *string `json:"url,omitempty"`
+}
+
+// Validate validates this upload
+func (m *Upload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCompletedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMimeType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOffset(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSize(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Upload) validateCompletedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CompletedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("completed_at", "body", "date-time", m.CompletedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Upload) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("created_at", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Upload) validateExpiresAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expires_at", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Upload) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("id", "body", "uuid4", m.ID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Upload) validateMimeType(formats strfmt.Registry) error {
+
+	if err := validate.Required("mime_type", "body", m.MimeType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Upload) validateOffset(formats strfmt.Registry) error {
+
+	if err := validate.Required("offset", "body", m.Offset); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Upload) validateSize(formats strfmt.Registry) error {
+
+	if err := validate.Required("size", "body", m.Size); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this upload based on context it is used
+func (m *Upload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Upload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Upload) UnmarshalBinary(b []byte) error {
+	var res Upload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,179 @@
+package uploads
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	// Dir is the directory below the server's mnt base dir partial files of incomplete uploads are stored in
+	Dir = "uploads"
+
+	partialFileExt = ".part"
+)
+
+var (
+	ErrChunkTooLarge   = errors.New("chunk too large")
+	ErrChunkInProgress = errors.New("chunk in progress")
+)
+
+// PartialFilePath returns the absolute path of the partial file chunks of the given upload are appended to.
+func PartialFilePath(mntBaseDirAbs string, uploadID string) string {
+	return filepath.Join(mntBaseDirAbs, Dir, uploadID+partialFileExt)
+}
+
+// CreatePartialFile creates the empty partial file of the given upload, creating the uploads directory if required.
+func CreatePartialFile(mntBaseDirAbs string, uploadID string) error {
+	path := PartialFilePath(mntBaseDirAbs, uploadID)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create uploads directory: %w", err)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create partial file: %w", err)
+	}
+
+	return f.Close()
+}
+
+// LockPartialFile acquires an exclusive lock on the partial file at the given path, serializing writers of chunks
+// of the same upload without holding any database locks while chunks are streamed. The lock is not waited for:
+// should another chunk currently be written, ErrChunkInProgress is returned. The func returned releases the lock.
+func LockPartialFile(path string) (func() error, error) {
+	f, err := os.OpenFile(path, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open partial file: %w", err)
+	}
+
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		f.Close()
+
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, ErrChunkInProgress
+		}
+
+		return nil, fmt.Errorf("failed to lock partial file: %w", err)
+	}
+
+	// closing the file releases the lock
+	return f.Close, nil
+}
+
+// AppendChunk writes the chunk read from r to the partial file at the given path, starting at the given offset.
+// Chunks exceeding maxSize bytes are rejected with ErrChunkTooLarge. Chunks are written all or nothing: should
+// writing fail, the partial file is truncated back to the offset, so clients can resume from there. As bytes beyond
+// the offset are discarded, callers must hold the lock of the partial file (see LockPartialFile) while verifying the
+// offset and appending the chunk.
+func AppendChunk(path string, offset int64, r io.Reader, maxSize int64) (int64, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open partial file: %w", err)
+	}
+	defer f.Close()
+
+	// discard any bytes of a previously failed write beyond the offset
+	if err := f.Truncate(offset); err != nil {
+		return 0, fmt.Errorf("failed to truncate partial file: %w", err)
+	}
+
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("failed to seek partial file: %w", err)
+	}
+
+	// read a single byte more than allowed to detect chunks exceeding the max size
+	written, err := io.Copy(f, io.LimitReader(r, maxSize+1))
+	if err == nil && written > maxSize {
+		err = ErrChunkTooLarge
+	}
+
+	if err != nil {
+		if truncateErr := f.Truncate(offset); truncateErr != nil {
+			return 0, fmt.Errorf("failed to truncate partial file after failed write: %w", truncateErr)
+		}
+
+		if errors.Is(err, ErrChunkTooLarge) {
+			return 0, err
+		}
+
+		return 0, fmt.Errorf("failed to write chunk: %w", err)
+	}
+
+	if err := f.Sync(); err != nil {
+		return 0, fmt.Errorf("failed to sync partial file: %w", err)
+	}
+
+	return written, nil
+}
+
+// RemovePartialFile removes the partial file of the given upload, ignoring files already removed.
+func RemovePartialFile(mntBaseDirAbs string, uploadID string) error {
+	if err := os.Remove(PartialFilePath(mntBaseDirAbs, uploadID)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove partial file: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeStale deletes all incomplete uploads which have not received any chunks within the given duration and
+// removes their partial files. Partial files without an upload (e.g. as the user has been deleted) are removed
+// after the same duration. Returns the number of uploads purged.
+func PurgeStale(ctx context.Context, exec boil.ContextExecutor, mntBaseDirAbs string, expiresAfter time.Duration) (int64, error) {
+	cutoff := time.Now().Add(-expiresAfter)
+
+	purged, err := models.Uploads(
+		models.UploadWhere.CompletedAt.IsNull(),
+		models.UploadWhere.UpdatedAt.LT(cutoff),
+	).DeleteAll(ctx, exec)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale uploads: %w", err)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(mntBaseDirAbs, Dir))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return purged, nil
+		}
+
+		return purged, fmt.Errorf("failed to read uploads directory: %w", err)
+	}
+
+	// partial files are modified whenever a chunk is received, thus any file not modified since the cutoff
+	// belongs to a stale upload
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), partialFileExt) {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return purged, fmt.Errorf("failed to stat partial file: %w", err)
+		}
+
+		if info.ModTime().After(cutoff) {
+			continue
+		}
+
+		if err := RemovePartialFile(mntBaseDirAbs, strings.TrimSuffix(entry.Name(), partialFileExt)); err != nil {
+			return purged, err
+		}
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,160 @@
+package uploads_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/uploads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestAppendChunk(t *testing.T) {
+	mntBaseDirAbs := t.TempDir()
+	uploadID := "b5f4c6a0-4d8e-4d9c-9a1f-6f7d2c3e1b0a"
+
+	err := uploads.CreatePartialFile(mntBaseDirAbs, uploadID)
+	require.NoError(t, err)
+
+	path := uploads.PartialFilePath(mntBaseDirAbs, uploadID)
+
+	written, err := uploads.AppendChunk(path, 0, strings.NewReader("hello "), 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), written)
+
+	written, err = uploads.AppendChunk(path, 6, strings.NewReader("world"), 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), written)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(content))
+
+	// chunks exceeding the max size are rejected without modifying the file
+	_, err = uploads.AppendChunk(path, 11, bytes.NewReader(make([]byte, 11)), 10)
+	assert.ErrorIs(t, err, uploads.ErrChunkTooLarge)
+
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(content))
+
+	// writing at an earlier offset discards any bytes beyond it
+	written, err = uploads.AppendChunk(path, 6, strings.NewReader("there"), 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), written)
+
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "hello there", string(content))
+
+	err = uploads.RemovePartialFile(mntBaseDirAbs, uploadID)
+	require.NoError(t, err)
+	assert.NoFileExists(t, path)
+
+	// removing partial files already removed is not an error
+	err = uploads.RemovePartialFile(mntBaseDirAbs, uploadID)
+	assert.NoError(t, err)
+}
+
+func TestLockPartialFile(t *testing.T) {
+	mntBaseDirAbs := t.TempDir()
+	uploadID := "b5f4c6a0-4d8e-4d9c-9a1f-6f7d2c3e1b0a"
+
+	err := uploads.CreatePartialFile(mntBaseDirAbs, uploadID)
+	require.NoError(t, err)
+
+	path := uploads.PartialFilePath(mntBaseDirAbs, uploadID)
+
+	unlock, err := uploads.LockPartialFile(path)
+	require.NoError(t, err)
+
+	// concurrent writers are rejected instead of waiting for the lock
+	_, err = uploads.LockPartialFile(path)
+	assert.ErrorIs(t, err, uploads.ErrChunkInProgress)
+
+	// the lock does not prevent its holder from appending chunks
+	written, err := uploads.AppendChunk(path, 0, strings.NewReader("hello"), 5)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), written)
+
+	err = unlock()
+	require.NoError(t, err)
+
+	unlock, err = uploads.LockPartialFile(path)
+	require.NoError(t, err)
+	require.NoError(t, unlock())
+
+	err = uploads.RemovePartialFile(mntBaseDirAbs, uploadID)
+	require.NoError(t, err)
+
+	_, err = uploads.LockPartialFile(path)
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestPurgeStale(t *testing.T) {
+	test.WithTestDatabase(t, func(db *sql.DB) {
+		ctx := context.Background()
+		fixtures := test.Fixtures()
+		mntBaseDirAbs := t.TempDir()
+
+		stale := models.Upload{UserID: fixtures.User1.ID, MimeType: "image/jpeg", Size: 100}
+		active := models.Upload{UserID: fixtures.User1.ID, MimeType: "image/jpeg", Size: 100}
+		completed := models.Upload{UserID: fixtures.User1.ID, MimeType: "image/jpeg", Size: 100, BytesReceived: 100, BlobKey: null.StringFrom("uploads/test.jpg"), CompletedAt: null.TimeFrom(time.Now().Add(-time.Hour * 48))}
+
+		for _, upload := range []*models.Upload{&stale, &active, &completed} {
+			err := upload.Insert(ctx, db, boil.Infer())
+			require.NoError(t, err)
+
+			err = uploads.CreatePartialFile(mntBaseDirAbs, upload.ID)
+			require.NoError(t, err)
+		}
+
+		// backdate the stale and completed uploads including their partial files
+		twoDaysAgo := time.Now().Add(-time.Hour * 48)
+		_, err := models.Uploads(models.UploadWhere.ID.IN([]string{stale.ID, completed.ID})).UpdateAll(ctx, db, models.M{
+			models.UploadColumns.UpdatedAt: twoDaysAgo,
+		})
+		require.NoError(t, err)
+
+		for _, uploadID := range []string{stale.ID, completed.ID} {
+			err = os.Chtimes(uploads.PartialFilePath(mntBaseDirAbs, uploadID), twoDaysAgo, twoDaysAgo)
+			require.NoError(t, err)
+		}
+
+		// partial files without an upload are removed as well
+		orphanID := "0d7b1a52-2f3c-4c1e-8b6a-5e9d4f3a2c1b"
+		err = uploads.CreatePartialFile(mntBaseDirAbs, orphanID)
+		require.NoError(t, err)
+		err = os.Chtimes(uploads.PartialFilePath(mntBaseDirAbs, orphanID), twoDaysAgo, twoDaysAgo)
+		require.NoError(t, err)
+
+		purged, err := uploads.PurgeStale(ctx, db, mntBaseDirAbs, time.Hour*24)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), purged)
+
+		exists, err := models.UploadExists(ctx, db, stale.ID)
+		require.NoError(t, err)
+		assert.False(t, exists)
+		assert.NoFileExists(t, uploads.PartialFilePath(mntBaseDirAbs, stale.ID))
+		assert.NoFileExists(t, uploads.PartialFilePath(mntBaseDirAbs, orphanID))
+
+		exists, err = models.UploadExists(ctx, db, active.ID)
+		require.NoError(t, err)
+		assert.True(t, exists)
+		assert.FileExists(t, uploads.PartialFilePath(mntBaseDirAbs, active.ID))
+
+		exists, err = models.UploadExists(ctx, db, completed.ID)
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+}
@@ -0,0 +1,51 @@
+package uploads
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"allaboutapps.dev/aw/go-starter/internal/imaging"
+)
+
+const (
+	// VariantsDir is the directory below the server's mnt base dir generated image variants of completed uploads are cached in
+	VariantsDir = "variants"
+
+	// VariantOriginal names variants having the original size of the image, only converted to another format
+	VariantOriginal = "original"
+)
+
+// VariantFilePath returns the absolute path the given variant of the given upload is cached at in the given format.
+func VariantFilePath(mntBaseDirAbs string, uploadID string, variant string, format imaging.Format) string {
+	return filepath.Join(mntBaseDirAbs, VariantsDir, uploadID, variant+format.Extension())
+}
+
+// WriteVariantFile atomically writes the given generated variant to path, so concurrent requests never read
+// partially written variants. Variants generated concurrently simply replace each other.
+func WriteVariantFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create variants directory: %w", err)
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary variant file: %w", err)
+	}
+	defer os.Remove(f.Name()) // no-op once renamed
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write temporary variant file: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary variant file: %w", err)
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("failed to move variant file: %w", err)
+	}
+
+	return nil
+}
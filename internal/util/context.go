@@ -10,6 +10,10 @@ type contextKey string
 const (
 	CTXKeyUser          contextKey = "user"
 	CTXKeyAccessToken   contextKey = "access_token"
+	CTXKeySessionID     contextKey = "session_id"
+	CTXKeyScopes        contextKey = "scopes"
+	CTXKeyPermissions   contextKey = "permissions"
+	CTXKeyRestricted    contextKey = "restricted"
 	CTXKeyRequestID     contextKey = "request_id"
 	CTXKeyDisableLogger contextKey = "disable_logger"
 	CTXKeyCacheControl  contextKey = "cache_control"
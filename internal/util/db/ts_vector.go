@@ -1,8 +1,18 @@
 package db
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
+
+	"allaboutapps.dev/aw/go-starter/internal/types"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// TSConfig is the text search configuration used for all search vectors and queries.
+	// It must match the configuration used by the generated tsvector columns in the migrations.
+	TSConfig = "simple"
 )
 
 var (
@@ -26,3 +36,19 @@ func SearchStringToTSQuery(s *string) string {
 
 	return "'" + tsQueryWhiteSpaceRegex.ReplaceAllString(v, "':* & '") + "':*"
 }
+
+// WhereTSMatch returns a query mod matching the tsvector column at the given path
+// against a TSQuery string as returned by SearchStringToTSQuery.
+// The path provided will be joined to construct the full SQL path used,
+// allowing for matching of search vectors nested across multiple joins if needed.
+func WhereTSMatch(tsQuery string, path ...string) qm.QueryMod {
+	// ! Attention: we **must** use ? instead of $1 or similar to bind query parameters here, see ILike.
+	return qm.Where(fmt.Sprintf("%s @@ to_tsquery('%s', ?)", strings.Join(path, "."), TSConfig), tsQuery)
+}
+
+// OrderByTSRank returns a query mod ordering by the rank of the tsvector column at the given path
+// against a TSQuery string as returned by SearchStringToTSQuery, most relevant rows first if
+// orderDir is descending.
+func OrderByTSRank(orderDir types.OrderDir, tsQuery string, path ...string) qm.QueryMod {
+	return qm.OrderBy(fmt.Sprintf("ts_rank(%s, to_tsquery('%s', ?)) %s", strings.Join(path, "."), TSConfig, strings.ToUpper(string(orderDir))), tsQuery)
+}
@@ -3,9 +3,14 @@ package db_test
 import (
 	"testing"
 
+	"allaboutapps.dev/aw/go-starter/internal/models"
+	"allaboutapps.dev/aw/go-starter/internal/test"
+	"allaboutapps.dev/aw/go-starter/internal/types"
 	"allaboutapps.dev/aw/go-starter/internal/util/db"
 	"github.com/go-openapi/swag"
 	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 func TestSearchStringToTSQuery(t *testing.T) {
@@ -39,3 +44,19 @@ func TestSearchStringToTSQuery(t *testing.T) {
 	out = db.SearchStringToTSQuery(in)
 	assert.Equal(t, expected, out)
 }
+
+func TestWhereTSMatch(t *testing.T) {
+	tsQuery := db.SearchStringToTSQuery(swag.String("max muster"))
+
+	q := models.NewQuery(
+		qm.Select("*"),
+		qm.From("users"),
+		db.WhereTSMatch(tsQuery, "users", "search_vector"),
+		db.OrderByTSRank(types.OrderDirDesc, tsQuery, "users", "search_vector"),
+	)
+
+	sql, args := queries.BuildQuery(q)
+
+	test.Snapshoter.Label("SQL").Save(t, sql)
+	test.Snapshoter.Label("Args").Save(t, args)
+}
@@ -113,6 +113,28 @@ func GetEnvAsStringArrTrimmed(key string, defaultVal []string, separator ...stri
 	return slc
 }
 
+// GetEnvAsStringMap reads ENV and returns the key/value pairs split by separator (default ","),
+// with each pair's key separated from its value by the first ":". Pairs without a key are ignored.
+func GetEnvAsStringMap(key string, defaultVal map[string]string, separator ...string) map[string]string {
+	slc := GetEnvAsStringArrTrimmed(key, nil, separator...)
+
+	if len(slc) == 0 {
+		return defaultVal
+	}
+
+	res := make(map[string]string, len(slc))
+	for _, pair := range slc {
+		k, v, _ := strings.Cut(pair, ":")
+		if len(k) == 0 {
+			continue
+		}
+
+		res[k] = v
+	}
+
+	return res
+}
+
 func GetEnvAsURL(key string, defaultVal string) *url.URL {
 	strVal := GetEnv(key, "")
 
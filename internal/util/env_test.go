@@ -187,6 +187,21 @@ func TestGetEnvAsStringArrTrimmed(t *testing.T) {
 	assert.Equal(t, []string{"a", "b", "c"}, res)
 }
 
+func TestGetEnvAsStringMap(t *testing.T) {
+	testVarKey := "TEST_ONLY_FOR_UNIT_TEST_STRING_MAP"
+	res := util.GetEnvAsStringMap(testVarKey, map[string]string{"a": "b"})
+	assert.Equal(t, map[string]string{"a": "b"}, res)
+
+	t.Setenv(testVarKey, "key1:value1, key2:value:2,:ignored,key3")
+	defer os.Unsetenv(testVarKey)
+	res = util.GetEnvAsStringMap(testVarKey, nil)
+	assert.Equal(t, map[string]string{"key1": "value1", "key2": "value:2", "key3": ""}, res)
+
+	t.Setenv(testVarKey, "key1:value1;key2:value2")
+	res = util.GetEnvAsStringMap(testVarKey, nil, ";")
+	assert.Equal(t, map[string]string{"key1": "value1", "key2": "value2"}, res)
+}
+
 func TestGetMgmtSecret(t *testing.T) {
 	rs, err := util.GenerateRandomHexString(8)
 	require.NoError(t, err)
@@ -8,6 +8,7 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"strings"
 
 	"allaboutapps.dev/aw/go-starter/internal/api/httperrors"
 	"allaboutapps.dev/aw/go-starter/internal/types"
@@ -17,10 +18,15 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
 )
 
 const (
 	HTTPHeaderCacheControl = "Cache-Control"
+	HTTPHeaderETag         = "ETag"
+	HTTPHeaderIfMatch      = "If-Match"
+	HTTPHeaderIfNoneMatch  = "If-None-Match"
+	HTTPHeaderUploadOffset = "Upload-Offset"
 )
 
 // BindAndValidateBody binds the request, parsing **only** its body (depending on the `Content-Type` request header) and performs validation
@@ -169,50 +175,97 @@ func ParseFileUpload(c echo.Context, formNameFile string, allowedMIMETypes []str
 		return nil, nil, nil, err
 	}
 
-	mime, err := mimetype.DetectReader(file)
+	fileLog := log.With().Str("filename", fh.Filename).Int64("fileSize", fh.Size).Logger()
+
+	mime, err := DetectAllowedMIMEType(&fileLog, file, allowedMIMETypes)
 	if err != nil {
-		log.Debug().Err(err).Str("filename", fh.Filename).Int64("fileSize", fh.Size).Msg("Failed to detect MIME type of uploaded file")
 		file.Close()
 		return nil, nil, nil, err
 	}
 
+	return fh, file, mime, nil
+}
+
+// DetectAllowedMIMEType detects the MIME type of the given uploaded file and ensures it is one of the allowed MIME types,
+// returning echo.ErrUnsupportedMediaType otherwise. The file is reset to its start afterwards, so it can be processed further.
+func DetectAllowedMIMEType(log *zerolog.Logger, file io.ReadSeeker, allowedMIMETypes []string) (*mimetype.MIME, error) {
+	mime, err := mimetype.DetectReader(file)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to detect MIME type of uploaded file")
+		return nil, err
+	}
+
 	// ! Important: we *MUST* reset the reader back to 0, since `minetype.DetectReader` reads the beginning of the
 	// ! file in order to detect it's MIME type. Continuing to use the reader without resetting it results in a
 	// ! corrupted file unable to be processed or opened otherwise.
 	if _, err = file.Seek(0, io.SeekStart); err != nil {
-		log.Debug().Err(err).Str("filename", fh.Filename).Int64("fileSize", fh.Size).Msg("Failed to reset reader of uploaded file to start")
-		file.Close()
-		return nil, nil, nil, err
+		log.Debug().Err(err).Msg("Failed to reset reader of uploaded file to start")
+		return nil, err
 	}
 
-	allowed := false
 	for _, allowedType := range allowedMIMETypes {
 		if mime.Is(allowedType) {
 			log.Debug().
 				Str("mimeType", mime.String()).
 				Str("mimeTypeFileExtension", mime.Extension()).
-				Str("filename", fh.Filename).
-				Int64("fileSize", fh.Size).
 				Str("allowedMIMEType", allowedType).
 				Msg("MIME type of uploaded file is allowed, processing")
 
-			allowed = true
-			break
+			return mime, nil
 		}
 	}
 
-	if !allowed {
-		log.Debug().
-			Str("mimeType", mime.String()).
-			Str("mimeTypeFileExtension", mime.Extension()).
-			Str("filename", fh.Filename).
-			Int64("fileSize", fh.Size).
-			Msg("MIME type of uploaded file is not allowed, rejecting")
-		file.Close()
-		return nil, nil, nil, echo.ErrUnsupportedMediaType
+	log.Debug().
+		Str("mimeType", mime.String()).
+		Str("mimeTypeFileExtension", mime.Extension()).
+		Msg("MIME type of uploaded file is not allowed, rejecting")
+
+	return nil, echo.ErrUnsupportedMediaType
+}
+
+// HasIfMatch reports whether the request carries an If-Match header. Handlers requiring conditional updates
+// reject requests without one using 428 Precondition Required as per RFC 6585.
+func HasIfMatch(c echo.Context) bool {
+	return len(c.Request().Header.Get(HTTPHeaderIfMatch)) > 0
+}
+
+// CheckIfMatch reports whether the request's If-Match header matches the given (quoted) entity tag, allowing
+// clients to perform conditional updates as per RFC 9110. Requests without an If-Match header always match,
+// use HasIfMatch to require one. Weak entity tags never match as strong comparison is required.
+func CheckIfMatch(c echo.Context, etag string) bool {
+	ifMatch := c.Request().Header.Get(HTTPHeaderIfMatch)
+	if len(ifMatch) == 0 {
+		return true
 	}
 
-	return fh, file, mime, nil
+	for _, tag := range strings.Split(ifMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckIfNoneMatch reports whether the request's If-None-Match header matches the given (quoted) entity tag, in which
+// case clients already have the current representation cached and 304 Not Modified should be returned as per RFC 9110.
+// Weak comparison is used, requests without an If-None-Match header never match.
+func CheckIfNoneMatch(c echo.Context, etag string) bool {
+	ifNoneMatch := c.Request().Header.Get(HTTPHeaderIfNoneMatch)
+	if len(ifNoneMatch) == 0 {
+		return false
+	}
+
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
 }
 
 func restoreBindAndValidate(c echo.Context, reqBody []byte, v runtime.Validatable) error {
@@ -5,6 +5,7 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -27,7 +28,7 @@ func TestBindAndValidateSuccess(t *testing.T) {
 	//nolint:gosec
 	testToken := "a546daf5-c845-46a7-8fa6-3d94ae7e1424"
 	testResponse := &types.PostLoginResponse{
-		AccessToken:  conv.UUID4(strfmt.UUID4("afbcbc30-4794-48bd-93f1-08373a031fe3")),
+		AccessToken:  swag.String("afbcbc30-4794-48bd-93f1-08373a031fe3"),
 		RefreshToken: conv.UUID4(strfmt.UUID4("1dd1228c-fa9a-4755-b995-30e24dd6247d")),
 		ExpiresIn:    swag.Int64(3600),
 		TokenType:    swag.String("Bearer"),
@@ -144,6 +145,69 @@ func TestParseFileUplaodUnsupported(t *testing.T) {
 	require.Equal(t, http.StatusUnsupportedMediaType, res.Result().StatusCode)
 }
 
+func TestCheckIfMatch(t *testing.T) {
+	etag := `"1591794836000000"`
+
+	tests := []struct {
+		name    string
+		ifMatch string
+		want    bool
+	}{
+		{name: "Missing", ifMatch: "", want: true},
+		{name: "Match", ifMatch: etag, want: true},
+		{name: "Wildcard", ifMatch: "*", want: true},
+		{name: "List", ifMatch: `"1", ` + etag, want: true},
+		{name: "Mismatch", ifMatch: `"1591794836000001"`, want: false},
+		{name: "Weak", ifMatch: "W/" + etag, want: false},
+		{name: "Unquoted", ifMatch: "1591794836000000", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPatch, "/", nil)
+			if len(tt.ifMatch) > 0 {
+				req.Header.Set(util.HTTPHeaderIfMatch, tt.ifMatch)
+			}
+
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			assert.Equal(t, tt.want, util.CheckIfMatch(c, etag))
+			assert.Equal(t, len(tt.ifMatch) > 0, util.HasIfMatch(c))
+		})
+	}
+}
+
+func TestCheckIfNoneMatch(t *testing.T) {
+	etag := `"1591794836000000"`
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        bool
+	}{
+		{name: "Missing", ifNoneMatch: "", want: false},
+		{name: "Match", ifNoneMatch: etag, want: true},
+		{name: "Wildcard", ifNoneMatch: "*", want: true},
+		{name: "List", ifNoneMatch: `"1", ` + etag, want: true},
+		{name: "Mismatch", ifNoneMatch: `"1591794836000001"`, want: false},
+		{name: "Weak", ifNoneMatch: "W/" + etag, want: true},
+		{name: "Unquoted", ifNoneMatch: "1591794836000000", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if len(tt.ifNoneMatch) > 0 {
+				req.Header.Set(util.HTTPHeaderIfNoneMatch, tt.ifNoneMatch)
+			}
+
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			assert.Equal(t, tt.want, util.CheckIfNoneMatch(c, etag))
+		})
+	}
+}
+
 func prepareFileUpload(t *testing.T, filePath string) (*bytes.Buffer, string) {
 	t.Helper()
 
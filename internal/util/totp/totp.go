@@ -0,0 +1,131 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // SHA-1 is mandated by RFC 6238 and supported by all authenticator apps
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/util"
+)
+
+// Implements time-based one-time passwords as specified by RFC 6238 (https://datatracker.ietf.org/doc/html/rfc6238)
+// using the parameters supported by all common authenticator apps (SHA-1, 6 digits, 30 second period).
+
+const (
+	// SecretLength is the length of generated secrets in bytes, as recommended by RFC 4226
+	SecretLength = 20
+	// Digits is the number of digits of generated codes
+	Digits = 6
+	// Period is the duration each code is valid for
+	Period = 30 * time.Second
+	// Skew is the number of periods before and after the current one codes are still accepted for, compensating for clock drift
+	Skew = 1
+)
+
+var (
+	encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// GenerateSecret returns a new random secret in (unpadded) base32 encoding as expected by authenticator apps.
+func GenerateSecret() (string, error) {
+	b, err := util.GenerateRandomBytes(SecretLength)
+	if err != nil {
+		return "", err
+	}
+
+	return encoding.EncodeToString(b), nil
+}
+
+// Step returns the time step (counter) the given time belongs to.
+func Step(t time.Time) int64 {
+	return t.Unix() / int64(Period.Seconds())
+}
+
+// GenerateCode returns the code for the given base32 encoded secret, valid at the given time.
+func GenerateCode(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return hotp(key, Step(t)), nil
+}
+
+// Validate checks whether the provided code is valid for the given base32 encoded secret at the given time.
+// Codes of time steps up to and including lastUsedStep are rejected to prevent replaying a code already used.
+// If the code is valid, the time step it belongs to is returned and should be persisted as the new lastUsedStep.
+func Validate(secret string, code string, t time.Time, lastUsedStep int64) (step int64, valid bool, err error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false, err
+	}
+
+	code = strings.TrimSpace(code)
+	if len(code) != Digits {
+		return 0, false, nil
+	}
+
+	current := Step(t)
+	for s := current - Skew; s <= current+Skew; s++ {
+		if s <= lastUsedStep {
+			continue
+		}
+
+		if subtle.ConstantTimeCompare([]byte(hotp(key, s)), []byte(code)) == 1 {
+			return s, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+// URI returns the otpauth:// key URI for the given base32 encoded secret, which may be presented as a QR code to
+// be scanned by authenticator apps (https://github.com/google/google-authenticator/wiki/Key-Uri-Format).
+func URI(secret string, issuer string, accountName string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(Digits))
+	params.Set("period", fmt.Sprint(int64(Period.Seconds())))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + accountName,
+		RawQuery: params.Encode(),
+	}
+
+	return u.String()
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	return encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+}
+
+// hotp computes the HMAC-based one-time password for the given counter as specified by RFC 4226.
+func hotp(key []byte, counter int64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < Digits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", Digits, value%mod)
+}
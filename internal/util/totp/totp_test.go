@@ -0,0 +1,96 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"allaboutapps.dev/aw/go-starter/internal/util/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 6238 appendix B test secret ("12345678901234567890") in base32 encoding
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestGenerateCode(t *testing.T) {
+	// RFC 6238 appendix B SHA-1 test vectors, truncated to 6 digits
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+
+	for unix, expected := range vectors {
+		code, err := totp.GenerateCode(rfcSecret, time.Unix(unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, expected, code, "time %d", unix)
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	require.NoError(t, err)
+	assert.Len(t, key, totp.SecretLength)
+
+	secret2, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, secret2)
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	code, err := totp.GenerateCode(rfcSecret, now)
+	require.NoError(t, err)
+
+	step, valid, err := totp.Validate(rfcSecret, code, now, 0)
+	require.NoError(t, err)
+	assert.True(t, valid)
+	assert.Equal(t, totp.Step(now), step)
+
+	// clock drift of a single period is tolerated
+	_, valid, err = totp.Validate(rfcSecret, code, now.Add(totp.Period), 0)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	_, valid, err = totp.Validate(rfcSecret, code, now.Add(3*totp.Period), 0)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	// codes cannot be replayed
+	_, valid, err = totp.Validate(rfcSecret, code, now, step)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, valid, err = totp.Validate(rfcSecret, "000000", now, 0)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, valid, err = totp.Validate(rfcSecret, "12345", now, 0)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, _, err = totp.Validate("not base32!", code, now, 0)
+	assert.Error(t, err)
+}
+
+func TestURI(t *testing.T) {
+	uri := totp.URI("JBSWY3DPEHPK3PXP", "go-starter", "user1@example.com")
+
+	u, err := url.Parse(uri)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", u.Scheme)
+	assert.Equal(t, "totp", u.Host)
+	assert.Equal(t, "/go-starter:user1@example.com", u.Path)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", u.Query().Get("secret"))
+	assert.Equal(t, "go-starter", u.Query().Get("issuer"))
+	assert.Equal(t, "6", u.Query().Get("digits"))
+	assert.Equal(t, "30", u.Query().Get("period"))
+}
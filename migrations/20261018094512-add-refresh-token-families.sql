@@ -0,0 +1,25 @@
+-- +migrate Up
+ALTER TABLE refresh_tokens
+    ADD COLUMN family_id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    ADD COLUMN rotated_at timestamptz,
+    ADD COLUMN revoked_at timestamptz;
+
+CREATE INDEX idx_refresh_tokens_family_id ON refresh_tokens USING btree (family_id);
+
+ALTER TABLE access_tokens
+    ADD COLUMN refresh_token_family_id uuid;
+
+CREATE INDEX idx_access_tokens_refresh_token_family_id ON access_tokens USING btree (refresh_token_family_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_access_tokens_refresh_token_family_id;
+
+ALTER TABLE access_tokens
+    DROP COLUMN IF EXISTS refresh_token_family_id;
+
+DROP INDEX IF EXISTS idx_refresh_tokens_family_id;
+
+ALTER TABLE refresh_tokens
+    DROP COLUMN IF EXISTS family_id,
+    DROP COLUMN IF EXISTS rotated_at,
+    DROP COLUMN IF EXISTS revoked_at;
@@ -0,0 +1,22 @@
+-- +migrate Up
+-- A session represents a single login of a user on a device. Its id equals the family_id of all refresh
+-- tokens (and thus access tokens) issued throughout the lifetime of the session.
+CREATE TABLE sessions (
+    id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    device_name text,
+    user_agent text,
+    ip_address text,
+    last_seen_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT sessions_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_sessions_fk_user_id ON sessions USING btree (user_id);
+
+ALTER TABLE sessions
+    ADD CONSTRAINT sessions_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS sessions;
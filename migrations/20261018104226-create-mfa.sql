@@ -0,0 +1,53 @@
+-- +migrate Up
+-- The TOTP secret is only considered active once confirmed_at is set, i.e. the user proved possession by
+-- submitting a first valid code. last_used_step prevents codes from being replayed within their validity.
+CREATE TABLE totp_credentials (
+    user_id uuid NOT NULL,
+    secret text NOT NULL,
+    confirmed_at timestamptz,
+    last_used_step bigint NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT totp_credentials_pkey PRIMARY KEY (user_id)
+);
+
+ALTER TABLE totp_credentials
+    ADD CONSTRAINT totp_credentials_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE TABLE recovery_codes (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    code_hash text NOT NULL,
+    used_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT recovery_codes_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_recovery_codes_fk_user_id ON recovery_codes USING btree (user_id);
+
+ALTER TABLE recovery_codes
+    ADD CONSTRAINT recovery_codes_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE TABLE mfa_challenges (
+    token uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    valid_until timestamptz NOT NULL,
+    user_id uuid NOT NULL,
+    device_name text,
+    failed_attempts int NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT mfa_challenges_pkey PRIMARY KEY (token)
+);
+
+CREATE INDEX idx_mfa_challenges_fk_user_id ON mfa_challenges USING btree (user_id);
+
+ALTER TABLE mfa_challenges
+    ADD CONSTRAINT mfa_challenges_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS mfa_challenges;
+
+DROP TABLE IF EXISTS recovery_codes;
+
+DROP TABLE IF EXISTS totp_credentials;
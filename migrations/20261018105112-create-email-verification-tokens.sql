@@ -0,0 +1,31 @@
+-- +migrate Up
+ALTER TABLE users
+    ADD COLUMN email_verified_at timestamptz;
+
+-- Users registered before email verification was introduced are considered verified.
+UPDATE
+    users
+SET
+    email_verified_at = created_at
+WHERE
+    username IS NOT NULL;
+
+CREATE TABLE email_verification_tokens (
+    token uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    valid_until timestamptz NOT NULL,
+    user_id uuid NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT email_verification_tokens_pkey PRIMARY KEY (token)
+);
+
+CREATE INDEX idx_email_verification_tokens_fk_user_id ON email_verification_tokens USING btree (user_id);
+
+ALTER TABLE email_verification_tokens
+    ADD CONSTRAINT email_verification_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS email_verification_tokens;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS email_verified_at;
@@ -0,0 +1,15 @@
+-- +migrate Up
+-- Failed authentication attempts, counted per key (e.g. username or client IP within a scope such as login),
+-- shared by all replicas. Counters restart once the last failure is older than the configured window.
+CREATE TABLE auth_attempts (
+    key text NOT NULL,
+    failures int NOT NULL DEFAULT 0,
+    last_failure_at timestamptz NOT NULL,
+    blocked_until timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT auth_attempts_pkey PRIMARY KEY (key)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS auth_attempts;
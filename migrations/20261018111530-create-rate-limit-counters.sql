@@ -0,0 +1,15 @@
+-- +migrate Up
+-- Request counters of the rate limiting middleware, shared by all replicas.
+-- Each key keeps the count of its current and previous fixed window to approximate a sliding window.
+CREATE TABLE rate_limit_counters (
+    key text NOT NULL,
+    window_start timestamptz NOT NULL,
+    count int NOT NULL DEFAULT 0,
+    previous_count int NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT rate_limit_counters_pkey PRIMARY KEY (key)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS rate_limit_counters;
@@ -0,0 +1,23 @@
+-- +migrate Up
+-- External identities (e.g. Sign in with Google/Apple) linked to users, identified by the
+-- OpenID Connect issuer and the subject the issuer assigned to the user.
+CREATE TABLE identities (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    issuer text NOT NULL,
+    subject text NOT NULL,
+    email text,
+    last_login_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT identities_pkey PRIMARY KEY (id),
+    CONSTRAINT identities_issuer_subject_key UNIQUE (issuer, subject)
+);
+
+CREATE INDEX idx_identities_fk_user_id ON identities USING btree (user_id);
+
+ALTER TABLE identities
+    ADD CONSTRAINT identities_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS identities;
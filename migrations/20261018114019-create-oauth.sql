@@ -0,0 +1,79 @@
+-- +migrate Up
+-- Third party clients (e.g. partner integrations) obtaining tokens via our OAuth2 authorization server.
+-- Public clients (e.g. native apps) have no secret and must use PKCE. Clients allowed to use the client_credentials
+-- grant act on behalf of their service user, as all tokens are bound to a user.
+CREATE TABLE oauth_clients (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    name text NOT NULL,
+    secret_hash text,
+    redirect_uris text[] NOT NULL,
+    grant_types text[] NOT NULL,
+    scopes text[] NOT NULL,
+    user_id uuid,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT oauth_clients_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_oauth_clients_fk_user_id ON oauth_clients USING btree (user_id);
+
+ALTER TABLE oauth_clients
+    ADD CONSTRAINT oauth_clients_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- Short-lived, single-use authorization codes, bound to the PKCE code challenge of the authorization request.
+CREATE TABLE oauth_authorization_codes (
+    code uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    oauth_client_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    redirect_uri text NOT NULL,
+    scopes text[] NOT NULL,
+    code_challenge text NOT NULL,
+    code_challenge_method text NOT NULL,
+    valid_until timestamptz NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT oauth_authorization_codes_pkey PRIMARY KEY (code)
+);
+
+CREATE INDEX idx_oauth_authorization_codes_fk_oauth_client_id ON oauth_authorization_codes USING btree (oauth_client_id);
+
+CREATE INDEX idx_oauth_authorization_codes_fk_user_id ON oauth_authorization_codes USING btree (user_id);
+
+ALTER TABLE oauth_authorization_codes
+    ADD CONSTRAINT oauth_authorization_codes_oauth_client_id_fkey FOREIGN KEY (oauth_client_id) REFERENCES oauth_clients (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE oauth_authorization_codes
+    ADD CONSTRAINT oauth_authorization_codes_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- Tokens issued to OAuth clients are restricted to the scopes granted, NULL scopes grant all of the user's scopes.
+-- Deleting a client revokes all of its tokens.
+ALTER TABLE access_tokens
+    ADD COLUMN oauth_client_id uuid,
+    ADD COLUMN scopes text[];
+
+CREATE INDEX idx_access_tokens_fk_oauth_client_id ON access_tokens USING btree (oauth_client_id);
+
+ALTER TABLE access_tokens
+    ADD CONSTRAINT access_tokens_oauth_client_id_fkey FOREIGN KEY (oauth_client_id) REFERENCES oauth_clients (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE refresh_tokens
+    ADD COLUMN oauth_client_id uuid,
+    ADD COLUMN scopes text[];
+
+CREATE INDEX idx_refresh_tokens_fk_oauth_client_id ON refresh_tokens USING btree (oauth_client_id);
+
+ALTER TABLE refresh_tokens
+    ADD CONSTRAINT refresh_tokens_oauth_client_id_fkey FOREIGN KEY (oauth_client_id) REFERENCES oauth_clients (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+ALTER TABLE refresh_tokens
+    DROP COLUMN IF EXISTS oauth_client_id,
+    DROP COLUMN IF EXISTS scopes;
+
+ALTER TABLE access_tokens
+    DROP COLUMN IF EXISTS oauth_client_id,
+    DROP COLUMN IF EXISTS scopes;
+
+DROP TABLE IF EXISTS oauth_authorization_codes;
+
+DROP TABLE IF EXISTS oauth_clients;
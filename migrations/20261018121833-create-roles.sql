@@ -0,0 +1,38 @@
+-- +migrate Up
+-- Roles bundle permissions (e.g. "push:send", "users:*" or "*" for all permissions) granted to the users assigned.
+-- Default roles are implicitly granted to all users without requiring an assignment.
+CREATE TABLE roles (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    name text NOT NULL,
+    description text,
+    permissions text[] NOT NULL,
+    is_default boolean NOT NULL DEFAULT FALSE,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT roles_pkey PRIMARY KEY (id),
+    CONSTRAINT roles_name_key UNIQUE (name)
+);
+
+CREATE TABLE user_roles (
+    user_id uuid NOT NULL,
+    role_id uuid NOT NULL,
+    created_at timestamptz NOT NULL,
+    CONSTRAINT user_roles_pkey PRIMARY KEY (user_id, role_id)
+);
+
+CREATE INDEX idx_user_roles_fk_role_id ON user_roles USING btree (role_id);
+
+ALTER TABLE user_roles
+    ADD CONSTRAINT user_roles_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE user_roles
+    ADD CONSTRAINT user_roles_role_id_fkey FOREIGN KEY (role_id) REFERENCES roles (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- Built-in roles, permissions are defined in internal/api/auth/permissions.go
+INSERT INTO roles (name, description, permissions, is_default, created_at, updated_at)
+    VALUES ('user', 'Granted to all users', '{push:send}', TRUE, now(), now()), ('admin', 'Grants all permissions', '{*}', FALSE, now(), now());
+
+-- +migrate Down
+DROP TABLE IF EXISTS user_roles;
+
+DROP TABLE IF EXISTS roles;
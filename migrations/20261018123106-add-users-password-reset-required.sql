@@ -0,0 +1,8 @@
+-- +migrate Up
+-- Set by administrators forcing a password reset, users are unable to log in until they reset their password.
+ALTER TABLE users
+    ADD COLUMN password_reset_required bool NOT NULL DEFAULT FALSE;
+
+-- +migrate Down
+ALTER TABLE users
+    DROP COLUMN IF EXISTS password_reset_required;
@@ -0,0 +1,12 @@
+-- +migrate Up
+-- Generated full-text search vector, kept in sync by Postgres and queried via db.WhereTSMatch/db.OrderByTSRank.
+ALTER TABLE users
+    ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (to_tsvector('simple', COALESCE(username, ''))) STORED;
+
+CREATE INDEX idx_users_search_vector ON users USING gin (search_vector);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_users_search_vector;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,14 @@
+-- +migrate Up
+-- Set when users delete their account while a grace period is configured, users are purged once it has passed.
+ALTER TABLE users
+    ADD COLUMN deleted_at timestamptz;
+
+CREATE INDEX idx_users_deleted_at ON users USING btree (deleted_at)
+WHERE
+    deleted_at IS NOT NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_users_deleted_at;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,37 @@
+-- +migrate Up
+-- Sent to the new email address, the username is only changed once the token has been confirmed.
+CREATE TABLE email_change_tokens (
+    token uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    valid_until timestamptz NOT NULL,
+    user_id uuid NOT NULL,
+    new_username text NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT email_change_tokens_pkey PRIMARY KEY (token)
+);
+
+CREATE INDEX idx_email_change_tokens_fk_user_id ON email_change_tokens USING btree (user_id);
+
+ALTER TABLE email_change_tokens
+    ADD CONSTRAINT email_change_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- Sent to the old email address after a change has been confirmed, allowing users to revert unauthorized changes.
+CREATE TABLE email_change_revert_tokens (
+    token uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    valid_until timestamptz NOT NULL,
+    user_id uuid NOT NULL,
+    old_username text NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT email_change_revert_tokens_pkey PRIMARY KEY (token)
+);
+
+CREATE INDEX idx_email_change_revert_tokens_fk_user_id ON email_change_revert_tokens USING btree (user_id);
+
+ALTER TABLE email_change_revert_tokens
+    ADD CONSTRAINT email_change_revert_tokens_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS email_change_revert_tokens;
+
+DROP TABLE IF EXISTS email_change_tokens;
@@ -0,0 +1,44 @@
+-- +migrate Up
+-- Versioned legal documents (e.g. terms of service, privacy policy) users have to accept.
+-- Each document type may be published in multiple locales, all sharing the same version number.
+CREATE TABLE legal_documents (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    type text NOT NULL,
+    version int NOT NULL,
+    locale text NOT NULL,
+    url text NOT NULL,
+    mandatory bool NOT NULL DEFAULT TRUE,
+    published_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT legal_documents_pkey PRIMARY KEY (id),
+    CONSTRAINT legal_documents_type_version_locale_key UNIQUE (type, version, locale)
+);
+
+CREATE INDEX idx_legal_documents_published_at ON legal_documents USING btree (published_at);
+
+CREATE TABLE legal_acceptances (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    legal_document_id uuid NOT NULL,
+    accepted_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT legal_acceptances_pkey PRIMARY KEY (id),
+    CONSTRAINT legal_acceptances_user_id_legal_document_id_key UNIQUE (user_id, legal_document_id)
+);
+
+CREATE INDEX idx_legal_acceptances_fk_user_id ON legal_acceptances USING btree (user_id);
+
+CREATE INDEX idx_legal_acceptances_fk_legal_document_id ON legal_acceptances USING btree (legal_document_id);
+
+ALTER TABLE legal_acceptances
+    ADD CONSTRAINT legal_acceptances_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE legal_acceptances
+    ADD CONSTRAINT legal_acceptances_legal_document_id_fkey FOREIGN KEY (legal_document_id) REFERENCES legal_documents (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS legal_acceptances;
+
+DROP TABLE IF EXISTS legal_documents;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- Profile details editable by users. Avatars are stored below SERVER_PATHS_MNT_BASE_DIR_ABS,
+-- avatar_file_name holds the file name relative to the avatars directory.
+ALTER TABLE app_user_profiles
+    ADD COLUMN display_name text,
+    ADD COLUMN given_name text,
+    ADD COLUMN family_name text,
+    ADD COLUMN locale text,
+    ADD COLUMN avatar_file_name text,
+    ADD COLUMN avatar_mime_type text;
+
+-- +migrate Down
+ALTER TABLE app_user_profiles
+    DROP COLUMN IF EXISTS display_name,
+    DROP COLUMN IF EXISTS given_name,
+    DROP COLUMN IF EXISTS family_name,
+    DROP COLUMN IF EXISTS locale,
+    DROP COLUMN IF EXISTS avatar_file_name,
+    DROP COLUMN IF EXISTS avatar_mime_type;
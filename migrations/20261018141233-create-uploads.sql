@@ -0,0 +1,28 @@
+-- +migrate Up
+-- Resumable uploads, created with the expected size and MIME type and received in chunks.
+-- Chunks are appended to a partial file in the mnt directory until the upload is completed and moved to the blobstore.
+CREATE TABLE uploads (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    file_name text,
+    mime_type text NOT NULL,
+    size bigint NOT NULL,
+    bytes_received bigint NOT NULL DEFAULT 0,
+    blob_key text,
+    completed_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT uploads_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_uploads_fk_user_id ON uploads USING btree (user_id);
+
+CREATE INDEX idx_uploads_updated_at_incomplete ON uploads USING btree (updated_at)
+WHERE
+    completed_at IS NULL;
+
+ALTER TABLE uploads
+    ADD CONSTRAINT uploads_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS uploads;
@@ -0,0 +1,58 @@
+-- +migrate Up
+-- Outbox of push messages, delivered asynchronously by the push workers.
+-- Each message is delivered to every push token of its user at the time of sending, tracked by a delivery each.
+CREATE TYPE push_delivery_status AS ENUM (
+    'pending',
+    'delivered',
+    'invalid_token',
+    'dead_letter'
+);
+
+CREATE TABLE push_messages (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    user_id uuid NOT NULL,
+    payload jsonb NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT push_messages_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_push_messages_fk_user_id ON push_messages USING btree (user_id);
+
+CREATE INDEX idx_push_messages_created_at ON push_messages USING btree (created_at);
+
+ALTER TABLE push_messages
+    ADD CONSTRAINT push_messages_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- Deliveries are claimed by workers by postponing their next_attempt_at (lease), so deliveries of crashed workers
+-- are retried once their lease has expired.
+CREATE TABLE push_deliveries (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    push_message_id uuid NOT NULL,
+    provider provider_type NOT NULL,
+    token text NOT NULL,
+    status push_delivery_status NOT NULL DEFAULT 'pending',
+    attempts int NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL,
+    last_error text,
+    delivered_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT push_deliveries_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_push_deliveries_fk_push_message_id ON push_deliveries USING btree (push_message_id);
+
+CREATE INDEX idx_push_deliveries_next_attempt_at_pending ON push_deliveries USING btree (next_attempt_at)
+WHERE
+    status = 'pending';
+
+ALTER TABLE push_deliveries
+    ADD CONSTRAINT push_deliveries_push_message_id_fkey FOREIGN KEY (push_message_id) REFERENCES push_messages (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- +migrate Down
+DROP TABLE IF EXISTS push_deliveries;
+
+DROP TABLE IF EXISTS push_messages;
+
+DROP TYPE IF EXISTS push_delivery_status;
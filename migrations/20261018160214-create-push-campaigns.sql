@@ -0,0 +1,57 @@
+-- +migrate Up
+-- Broadcasts of a push message to a segment of users. Campaign messages are enqueued into the push outbox per page of
+-- push tokens and language, the outcome of their deliveries is counted on the campaign as deliveries are purged.
+CREATE TYPE push_campaign_segment AS ENUM (
+    'all',
+    'language',
+    'sql'
+);
+
+CREATE TABLE push_campaigns (
+    id uuid NOT NULL DEFAULT uuid_generate_v4 (),
+    segment push_campaign_segment NOT NULL,
+    -- language or SQL query of the segment, depending on its type
+    segment_value text,
+    payload jsonb NOT NULL,
+    users_targeted int NOT NULL DEFAULT 0,
+    tokens_targeted int NOT NULL DEFAULT 0,
+    delivered_count int NOT NULL DEFAULT 0,
+    invalid_token_count int NOT NULL DEFAULT 0,
+    failed_count int NOT NULL DEFAULT 0,
+    -- set once all push tokens of the segment have been enqueued
+    enqueued_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT push_campaigns_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_push_campaigns_created_at ON push_campaigns USING btree (created_at);
+
+-- Campaign messages are not bound to a single user
+ALTER TABLE push_messages
+    ALTER COLUMN user_id DROP NOT NULL,
+    ADD COLUMN push_campaign_id uuid,
+    ADD CONSTRAINT push_messages_recipient_check CHECK (user_id IS NOT NULL OR push_campaign_id IS NOT NULL);
+
+CREATE INDEX idx_push_messages_fk_push_campaign_id ON push_messages USING btree (push_campaign_id);
+
+ALTER TABLE push_messages
+    ADD CONSTRAINT push_messages_push_campaign_id_fkey FOREIGN KEY (push_campaign_id) REFERENCES push_campaigns (id) ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- Segments are streamed ordered by user and push token
+CREATE INDEX idx_push_tokens_user_id_id ON push_tokens USING btree (user_id, id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_push_tokens_user_id_id;
+
+DELETE FROM push_messages
+WHERE user_id IS NULL;
+
+ALTER TABLE push_messages
+    DROP CONSTRAINT IF EXISTS push_messages_recipient_check,
+    DROP COLUMN IF EXISTS push_campaign_id,
+    ALTER COLUMN user_id SET NOT NULL;
+
+DROP TABLE IF EXISTS push_campaigns;
+
+DROP TYPE IF EXISTS push_campaign_segment;
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- Session the email change was requested from, which remains signed in once the change has been confirmed.
+ALTER TABLE email_change_tokens
+    ADD COLUMN session_id uuid;
+
+-- +migrate Down
+ALTER TABLE email_change_tokens
+    DROP COLUMN IF EXISTS session_id;
@@ -96,6 +96,7 @@ type SwaggerResource struct {
 	URLName           string
 	Properties        []Property
 	PayloadProperties []Property
+	HasSearch         bool
 }
 
 func GenerateSwagger(resource *StorageResource, outputPath string, force bool) error {
@@ -121,10 +122,20 @@ func GenerateSwagger(resource *StorageResource, outputPath string, force bool) e
 
 var payloadExcluded = []string{"ID", "CreatedAt", "UpdatedAt"}
 
+// searchVectorField is the name of the generated tsvector column used for full-text search.
+// It is never exposed via the API, resources having it get a search query parameter instead.
+const searchVectorField = "SearchVector"
+
 func toSwaggerResource(resource *StorageResource) *SwaggerResource {
 	properties := make([]Property, 0, len(resource.Fields))
 	payloadProperties := make([]Property, 0, len(resource.Fields))
+	hasSearch := false
 	for _, field := range resource.Fields {
+		if field.Name == searchVectorField {
+			hasSearch = true
+			continue
+		}
+
 		property := fieldToProperty(field)
 		properties = append(properties, property)
 
@@ -138,6 +149,7 @@ func toSwaggerResource(resource *StorageResource) *SwaggerResource {
 		URLName:           strings.ToLower(resource.Name), // TODO: Use dash separator
 		Properties:        properties,
 		PayloadProperties: payloadProperties,
+		HasSearch:         hasSearch,
 	}
 
 	return &swaggerResource
@@ -193,8 +205,9 @@ type HandlerField struct {
 }
 
 type HandlerResource struct {
-	Name   string
-	Fields []HandlerField
+	Name      string
+	Fields    []HandlerField
+	HasSearch bool
 }
 
 type Handler struct {
@@ -204,17 +217,23 @@ type Handler struct {
 }
 
 func toHandlerResource(storageResource *StorageResource, swaggerResource *SwaggerResource) *HandlerResource {
-	fields := make([]HandlerField, len(swaggerResource.Properties))
-	for i, property := range swaggerResource.Properties {
-		fields[i] = propertyToHandlerField(property)
+	fields := make([]HandlerField, 0, len(swaggerResource.Properties))
+	for _, field := range storageResource.Fields {
+		if field.Name == searchVectorField {
+			continue
+		}
+
+		handlerField := propertyToHandlerField(swaggerResource.Properties[len(fields)])
 
 		// Hack to get the proper field name.
-		fields[i].Name = storageResource.Fields[i].Name
+		handlerField.Name = field.Name
+		fields = append(fields, handlerField)
 	}
 
 	return &HandlerResource{
-		Name:   swaggerResource.Name,
-		Fields: fields,
+		Name:      swaggerResource.Name,
+		Fields:    fields,
+		HasSearch: swaggerResource.HasSearch,
 	}
 }
 
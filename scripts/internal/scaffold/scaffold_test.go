@@ -49,6 +49,14 @@ func TestGenerateSwagger_Success(t *testing.T) {
 	assert.FileExists(t, filepath.Join(definitionsPath, "testresource.yml"), "Should generate the definition spec")
 	assert.FileExists(t, filepath.Join(pathsPath, "testresource.yml"), "Should generate the paths spec")
 
+	definitions, err := os.ReadFile(filepath.Join(definitionsPath, "testresource.yml"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(definitions), "searchVector", "Should not expose the search vector")
+
+	paths, err := os.ReadFile(filepath.Join(pathsPath, "testresource.yml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(paths), "name: search", "Should generate the search query parameter")
+
 	// Cleanup
 	err = os.RemoveAll(definitionsPath)
 	require.NoError(t, err)
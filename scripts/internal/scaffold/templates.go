@@ -78,6 +78,13 @@ paths:
         - {{ .Name }}
       summary: "Return a list of {{ .Name }}"
       operationId: Get{{ .Name }}ListRoute
+      {{- if .HasSearch }}
+      parameters:
+        - type: string
+          in: query
+          name: search
+          description: Search string, matching {{ .Name }} containing words beginning with every word provided
+      {{- end }}
       responses:
         "200":
           description: Success
@@ -223,6 +230,22 @@ func get{{ .Resource.Name }}ListHandler(s *api.Server) echo.HandlerFunc {
     return func(c echo.Context) error {
         /* Uncomment for real implementation
         ctx := c.Request().Context()
+        {{- if .Resource.HasSearch }}
+
+        params := {{ .Package }}.NewGet{{ .Resource.Name }}ListRouteParams()
+        err := util.BindAndValidateQueryParams(c, &params)
+        if err != nil {
+            return err
+        }
+
+        filters := []qm.QueryMod{}
+        if tsQuery := db.SearchStringToTSQuery(params.Search); len(tsQuery) > 0 {
+            filters = append(filters,
+                db.WhereTSMatch(tsQuery, models.{{ .Resource.Name }}TableColumns.SearchVector),
+                db.OrderByTSRank(types.OrderDirDesc, tsQuery, models.{{ .Resource.Name }}TableColumns.SearchVector),
+            )
+        }
+        {{- end }}
 
         // TODO: Implement 
         */